@@ -0,0 +1,330 @@
+// Package backup splits a private key among guardians so that any threshold
+// of them can restore it, for social recovery of Mina accounts.
+//
+// Create splits the key with Shamir's secret sharing over the scalar field
+// and encrypts each guardian's share to the guardian's public key with the
+// o1js-compatible scheme of the encryption package. The resulting Bundle is
+// plain JSON and can be stored anywhere: it reveals nothing about the key
+// to fewer than Threshold guardians.
+//
+// The bundle also carries Feldman commitments a_j·G to the coefficients of
+// the sharing polynomial. They let each guardian check its share when it
+// decrypts it, and let Recover reject wrong shares before they corrupt the
+// restored key, which must match the bundle's public key.
+package backup
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/encryption"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+// Version is the bundle format this package writes.
+const Version = 1
+
+// Point is a curve point as decimal coordinates, the JSON form of an o1js
+// Group.
+type Point struct {
+	X string `json:"x"`
+	Y string `json:"y"`
+}
+
+// EncryptedShare is a share encrypted to one guardian.
+type EncryptedShare struct {
+	// Index is the evaluation point of the share, from 1.
+	Index int `json:"index"`
+	// Guardian is the guardian's address.
+	Guardian string `json:"guardian"`
+	// EphemeralKey and CipherText are the encryption.CipherText of the
+	// share, whose two elements are the low and high 128 bits of the
+	// share's value.
+	EphemeralKey Point    `json:"ephemeralKey"`
+	CipherText   []string `json:"cipherText"`
+}
+
+// Bundle is a key backup.
+type Bundle struct {
+	Version int `json:"version"`
+	// PublicKey is the address of the backed-up key.
+	PublicKey string `json:"publicKey"`
+	// Threshold is the number of shares needed to recover the key.
+	Threshold int `json:"threshold"`
+	// Commitments holds a_j·G for the coefficients a_0 = sk, ...,
+	// a_{Threshold-1} of the sharing polynomial.
+	Commitments []Point          `json:"commitments"`
+	Shares      []EncryptedShare `json:"shares"`
+}
+
+// Share is a decrypted share, which a guardian hands to the person
+// recovering the key.
+type Share struct {
+	Index int            `json:"index"`
+	Value *scalar.Scalar `json:"value"`
+}
+
+// Create splits sk into one share per guardian, any threshold of which
+// recover it.
+func Create(sk keys.PrivateKey, guardians []keys.PublicKey, threshold int) (*Bundle, error) {
+	if sk.Value == nil || sk.Value.BigInt().Sign() == 0 {
+		return nil, errcode.New(errcode.MissingValue, "backup: private key is not set")
+	}
+	if threshold < 1 || threshold > len(guardians) {
+		return nil, errcode.Errorf(errcode.OutOfRange, "backup: threshold %d out of range for %d guardians", threshold, len(guardians))
+	}
+	address, err := sk.ToPublicKey().ToBase58()
+	if err != nil {
+		return nil, err
+	}
+	coefficients := []*scalar.Scalar{sk.Value}
+	for len(coefficients) < threshold {
+		a, err := scalar.RandomScalar()
+		if err != nil {
+			return nil, err
+		}
+		coefficients = append(coefficients, a)
+	}
+	b := &Bundle{Version: Version, PublicKey: address, Threshold: threshold}
+	pallas := curve.Pallas()
+	for _, a := range coefficients {
+		b.Commitments = append(b.Commitments, toPoint(pallas.ScaleConstantTime(pallas.One, a.BigInt())))
+	}
+	seen := make(map[string]bool)
+	for i, g := range guardians {
+		guardian, err := g.ToBase58()
+		if err != nil {
+			return nil, fmt.Errorf("backup: guardian %d: %w", i, err)
+		}
+		if seen[guardian] {
+			return nil, errcode.Errorf(errcode.InvalidArgument, "backup: guardian %s appears twice", guardian)
+		}
+		seen[guardian] = true
+		index := i + 1
+		value := evaluate(coefficients, index)
+		mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+		v := value.BigInt()
+		c, err := encryption.Encrypt(g, []*big.Int{new(big.Int).And(v, mask), new(big.Int).Rsh(v, 128)})
+		if err != nil {
+			return nil, fmt.Errorf("backup: guardian %s: %w", guardian, err)
+		}
+		share := EncryptedShare{
+			Index:        index,
+			Guardian:     guardian,
+			EphemeralKey: Point{X: c.PublicKey.X.String(), Y: c.PublicKey.Y.String()},
+		}
+		for _, e := range c.CipherText {
+			share.CipherText = append(share.CipherText, e.String())
+		}
+		b.Shares = append(b.Shares, share)
+	}
+	return b, nil
+}
+
+// Verify checks the structure of b: the version, the threshold, that the
+// first commitment is the public key and that share indices and guardians
+// are distinct.
+func (b *Bundle) Verify() error {
+	if b.Version != Version {
+		return errcode.Errorf(errcode.InvalidVersion, "backup: version %d, want %d", b.Version, Version)
+	}
+	if b.Threshold < 1 || b.Threshold > len(b.Shares) || len(b.Commitments) != b.Threshold {
+		return errcode.Errorf(errcode.InvalidArgument, "backup: threshold %d with %d commitments and %d shares", b.Threshold, len(b.Commitments), len(b.Shares))
+	}
+	pk, err := keys.PublicKeyFromBase58(b.PublicKey)
+	if err != nil {
+		return fmt.Errorf("backup: public key: %w", err)
+	}
+	c0, err := fromPoint(b.Commitments[0])
+	if err != nil {
+		return fmt.Errorf("backup: commitment 0: %w", err)
+	}
+	if !pk.Equal(keys.PublicKeyFromPoint(keys.Point{X: c0.X, Y: c0.Y})) {
+		return errcode.New(errcode.KeyMismatch, "backup: first commitment is not the public key")
+	}
+	for j, p := range b.Commitments[1:] {
+		if _, err := fromPoint(p); err != nil {
+			return fmt.Errorf("backup: commitment %d: %w", j+1, err)
+		}
+	}
+	indices, guardians := make(map[int]bool), make(map[string]bool)
+	for _, s := range b.Shares {
+		if s.Index < 1 || indices[s.Index] || guardians[s.Guardian] {
+			return errcode.Errorf(errcode.OutOfRange, "backup: share %d of %s is out of range or repeated", s.Index, s.Guardian)
+		}
+		indices[s.Index], guardians[s.Guardian] = true, true
+	}
+	return nil
+}
+
+// DecryptShare decrypts the share of the guardian holding sk and checks it
+// against the commitments.
+func (b *Bundle) DecryptShare(sk keys.PrivateKey) (*Share, error) {
+	if err := b.Verify(); err != nil {
+		return nil, err
+	}
+	if sk.Value == nil {
+		return nil, errcode.New(errcode.MissingValue, "backup: private key is not set")
+	}
+	guardian, err := sk.ToPublicKey().ToBase58()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range b.Shares {
+		if s.Guardian != guardian {
+			continue
+		}
+		c, err := s.cipherText()
+		if err != nil {
+			return nil, err
+		}
+		m, err := encryption.Decrypt(sk, c)
+		if err != nil {
+			return nil, fmt.Errorf("backup: share %d: %w", s.Index, err)
+		}
+		if len(m) != 2 || m[0].BitLen() > 128 {
+			return nil, errcode.Errorf(errcode.InvalidEncoding, "backup: share %d is malformed", s.Index)
+		}
+		v, err := scalar.NewScalarErr(new(big.Int).Or(new(big.Int).Lsh(m[1], 128), m[0]))
+		if err != nil {
+			return nil, fmt.Errorf("backup: share %d: %w", s.Index, err)
+		}
+		share := &Share{Index: s.Index, Value: v}
+		if err := b.VerifyShare(share); err != nil {
+			return nil, err
+		}
+		return share, nil
+	}
+	return nil, errcode.Errorf(errcode.NotFound, "backup: no share for %s", guardian)
+}
+
+// VerifyShare checks share against the commitments: share·G must equal
+// the sum of the commitments C_j times index^j.
+func (b *Bundle) VerifyShare(share *Share) error {
+	if share == nil || share.Value == nil {
+		return errcode.New(errcode.MissingValue, "backup: nil share")
+	}
+	if share.Index < 1 {
+		return errcode.Errorf(errcode.OutOfRange, "backup: share index %d out of range", share.Index)
+	}
+	pallas := curve.Pallas()
+	want := pallas.Zero
+	x := scalar.NewScalar(share.Index)
+	power := scalar.NewScalar(1)
+	for j, p := range b.Commitments {
+		c, err := fromPoint(p)
+		if err != nil {
+			return fmt.Errorf("backup: commitment %d: %w", j, err)
+		}
+		want = pallas.Add(want, pallas.Scale(c, power.BigInt()))
+		power = power.Mul(x)
+	}
+	if !pallas.Equal(pallas.ScaleConstantTime(pallas.One, share.Value.BigInt()), want) {
+		return errcode.Errorf(errcode.KeyMismatch, "backup: share %d does not match the commitments", share.Index)
+	}
+	return nil
+}
+
+// Recover restores the private key from at least Threshold shares with
+// distinct indices. Every share is checked against the commitments, and
+// the key against the bundle's public key.
+func (b *Bundle) Recover(shares []Share) (keys.PrivateKey, error) {
+	if err := b.Verify(); err != nil {
+		return keys.PrivateKey{}, err
+	}
+	seen := make(map[int]bool)
+	var use []Share
+	for i := range shares {
+		if seen[shares[i].Index] {
+			continue
+		}
+		if err := b.VerifyShare(&shares[i]); err != nil {
+			return keys.PrivateKey{}, err
+		}
+		seen[shares[i].Index] = true
+		use = append(use, shares[i])
+	}
+	if len(use) < b.Threshold {
+		return keys.PrivateKey{}, errcode.Errorf(errcode.InvalidArgument, "backup: %d distinct shares, need %d", len(use), b.Threshold)
+	}
+	use = use[:b.Threshold]
+
+	// Lagrange interpolation at 0: sk = sum of y_i · prod x_j / (x_j - x_i).
+	secret := scalar.NewScalar(0)
+	for i, si := range use {
+		num, den := scalar.NewScalar(1), scalar.NewScalar(1)
+		xi := scalar.NewScalar(si.Index)
+		for j, sj := range use {
+			if i == j {
+				continue
+			}
+			xj := scalar.NewScalar(sj.Index)
+			num = num.Mul(xj)
+			den = den.Mul(xj.Sub(xi))
+		}
+		l, err := num.Div(den)
+		if err != nil {
+			return keys.PrivateKey{}, fmt.Errorf("backup: %w", err)
+		}
+		secret = secret.Add(si.Value.Mul(l))
+	}
+	sk := keys.PrivateKey{Value: secret}
+	address, err := sk.ToPublicKey().ToBase58()
+	if err != nil {
+		return keys.PrivateKey{}, err
+	}
+	if address != b.PublicKey {
+		return keys.PrivateKey{}, errcode.New(errcode.KeyMismatch, "backup: recovered key does not match the public key")
+	}
+	return sk, nil
+}
+
+// evaluate returns the polynomial with the given coefficients at x.
+func evaluate(coefficients []*scalar.Scalar, x int) *scalar.Scalar {
+	xs := scalar.NewScalar(x)
+	y := scalar.NewScalar(0)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = y.Mul(xs).Add(coefficients[i])
+	}
+	return y
+}
+
+func (s EncryptedShare) cipherText() (*encryption.CipherText, error) {
+	x, okX := new(big.Int).SetString(s.EphemeralKey.X, 10)
+	y, okY := new(big.Int).SetString(s.EphemeralKey.Y, 10)
+	if !okX || !okY {
+		return nil, errcode.Errorf(errcode.InvalidPoint, "backup: share %d: invalid ephemeral key", s.Index)
+	}
+	c := &encryption.CipherText{PublicKey: keys.Point{X: x, Y: y}}
+	for _, e := range s.CipherText {
+		v, ok := new(big.Int).SetString(e, 10)
+		if !ok {
+			return nil, errcode.Errorf(errcode.NonCanonical, "backup: share %d: invalid ciphertext element %q", s.Index, e)
+		}
+		c.CipherText = append(c.CipherText, v)
+	}
+	return c, nil
+}
+
+func toPoint(g *curve.GroupProjective) Point {
+	a := curve.Pallas().ToAffine(g)
+	return Point{X: a.X.String(), Y: a.Y.String()}
+}
+
+// fromPoint decodes p and checks that it is a finite point of Pallas.
+func fromPoint(p Point) (*curve.GroupProjective, error) {
+	x, okX := new(big.Int).SetString(p.X, 10)
+	y, okY := new(big.Int).SetString(p.Y, 10)
+	if !okX || !okY || x.Sign() < 0 || y.Sign() < 0 || x.Cmp(field.P) >= 0 || y.Cmp(field.P) >= 0 {
+		return nil, errcode.New(errcode.NonCanonical, "invalid point coordinates")
+	}
+	g := &curve.GroupProjective{X: x, Y: y, Z: big.NewInt(1)}
+	if err := curve.ValidatePoint(g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
@@ -0,0 +1,93 @@
+package backup_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/backup"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+func guardianKeys(n int) ([]keys.PrivateKey, []keys.PublicKey) {
+	sks := make([]keys.PrivateKey, n)
+	pks := make([]keys.PublicKey, n)
+	for i := range sks {
+		sks[i] = keys.PrivateKey{Value: scalar.NewScalar(1000 + i)}
+		pks[i] = sks[i].ToPublicKey()
+	}
+	return sks, pks
+}
+
+func TestBackupRecover(t *testing.T) {
+	owner := keys.PrivateKey{Value: scalar.NewScalar(987654321)}
+	guardianSKs, guardianPKs := guardianKeys(5)
+	bundle, err := backup.Create(owner, guardianPKs, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The bundle survives a JSON round trip.
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored backup.Bundle
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+	if err := restored.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	var shares []backup.Share
+	for _, i := range []int{4, 0, 2} {
+		s, err := restored.DecryptShare(guardianSKs[i])
+		if err != nil {
+			t.Fatalf("guardian %d: %v", i, err)
+		}
+		shares = append(shares, *s)
+	}
+	if _, err := restored.Recover(shares[:2]); err == nil {
+		t.Error("Recover succeeded below the threshold")
+	}
+	sk, err := restored.Recover(shares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sk.Equal(owner) {
+		t.Error("recovered a different key")
+	}
+
+	shares[1].Value = shares[1].Value.Add(scalar.NewScalar(1))
+	if _, err := restored.Recover(shares); err == nil {
+		t.Error("Recover accepted a wrong share")
+	}
+	if _, err := restored.DecryptShare(keys.PrivateKey{Value: scalar.NewScalar(5)}); err == nil {
+		t.Error("DecryptShare succeeded for a key that is not a guardian")
+	}
+}
+
+func TestBackupRejects(t *testing.T) {
+	owner := keys.PrivateKey{Value: scalar.NewScalar(42)}
+	guardianSKs, guardianPKs := guardianKeys(3)
+	if _, err := backup.Create(owner, guardianPKs, 4); err == nil {
+		t.Error("Create accepted a threshold above the number of guardians")
+	}
+	if _, err := backup.Create(owner, []keys.PublicKey{guardianPKs[0], guardianPKs[0]}, 1); err == nil {
+		t.Error("Create accepted a repeated guardian")
+	}
+
+	bundle, err := backup.Create(owner, guardianPKs, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle.Shares[0].CipherText[0] = "1"
+	if _, err := bundle.DecryptShare(guardianSKs[0]); err == nil {
+		t.Error("DecryptShare accepted a modified ciphertext")
+	}
+	bundle.Commitments[0] = bundle.Commitments[1]
+	if err := bundle.Verify(); err == nil {
+		t.Error("Verify accepted a commitment that is not the public key")
+	}
+}
@@ -0,0 +1,56 @@
+// Package base58check implements the base58check encoding Mina uses for keys,
+// signatures and other values: a version byte, the payload, and the first
+// four bytes of the double SHA-256 of both, encoded in the Bitcoin alphabet.
+package base58check
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/decred/base58"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+var (
+	// ErrChecksum is returned when the checksum of a decoded string does not
+	// match its contents.
+	ErrChecksum = errcode.New(errcode.InvalidChecksum, "base58check: invalid checksum")
+	// ErrVersion is returned when a decoded string carries a different
+	// version byte than expected.
+	ErrVersion = errcode.New(errcode.InvalidVersion, "base58check: unexpected version byte")
+)
+
+const checksumSize = 4
+
+// Encode returns the base58check encoding of payload under version.
+func Encode(version byte, payload []byte) string {
+	raw := make([]byte, 0, 1+len(payload)+checksumSize)
+	raw = append(raw, version)
+	raw = append(raw, payload...)
+	return base58.Encode(append(raw, checksum(raw)...))
+}
+
+// Decode reverses Encode. It checks the checksum and the version byte and
+// returns the payload.
+func Decode(s string, version byte) ([]byte, error) {
+	raw := base58.Decode(s)
+	if len(raw) < 1+checksumSize {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "base58check: input too short (%d bytes)", len(raw))
+	}
+	body, sum := raw[:len(raw)-checksumSize], raw[len(raw)-checksumSize:]
+	if !bytes.Equal(sum, checksum(body)) {
+		return nil, ErrChecksum
+	}
+	if body[0] != version {
+		return nil, fmt.Errorf("%w: got 0x%02x, want 0x%02x", ErrVersion, body[0], version)
+	}
+	return body[1:], nil
+}
+
+func checksum(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:checksumSize]
+}
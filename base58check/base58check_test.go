@@ -0,0 +1,35 @@
+package base58check
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	payload := []byte{0x01, 0x02, 0x03, 0xff}
+	s := Encode(0x5a, payload)
+	got, err := Decode(s, 0x5a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Errorf("Decode(Encode(%x)) = %x", payload, got)
+	}
+	if _, err := Decode(s, 0xcb); !errors.Is(err, ErrVersion) {
+		t.Errorf("Decode with another version error = %v, want ErrVersion", err)
+	}
+
+	corrupted := []byte(s)
+	if corrupted[3] == 'z' {
+		corrupted[3] = 'y'
+	} else {
+		corrupted[3] = 'z'
+	}
+	if _, err := Decode(string(corrupted), 0x5a); !errors.Is(err, ErrChecksum) {
+		t.Errorf("Decode(corrupted) error = %v, want ErrChecksum", err)
+	}
+	if _, err := Decode("", 0x5a); err == nil {
+		t.Error("Decode(\"\") succeeded")
+	}
+}
@@ -0,0 +1,214 @@
+// Package canonicaljson serializes JSON documents in the canonical form of
+// the JSON Canonicalization Scheme (RFC 8785), so equivalent documents
+// produce the same bytes whatever their whitespace, key order, string
+// escapes or number spelling.
+//
+// Object members are sorted by the UTF-16 code units of their names,
+// strings are written with the minimal escapes of RFC 8785, and numbers are
+// written as ECMAScript writes the IEEE 754 double they denote.
+// Documents with duplicate object names or numbers outside the double
+// range are rejected.
+package canonicaljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// Canonicalize returns the canonical form of the JSON document data.
+func Canonicalize(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var buf bytes.Buffer
+	if err := writeValue(&buf, dec); err != nil {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "canonicaljson: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errcode.New(errcode.InvalidEncoding, "canonicaljson: data after the top-level value")
+	}
+	return buf.Bytes(), nil
+}
+
+func writeValue(buf *bytes.Buffer, dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		if t == '{' {
+			return writeObject(buf, dec)
+		}
+		return writeArray(buf, dec)
+	case string:
+		writeString(buf, t)
+	case json.Number:
+		s, err := formatNumber(t)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+	case bool:
+		buf.WriteString(strconv.FormatBool(t))
+	case nil:
+		buf.WriteString("null")
+	}
+	return nil
+}
+
+type member struct {
+	name  string
+	value []byte
+}
+
+func writeObject(buf *bytes.Buffer, dec *json.Decoder) error {
+	var members []member
+	seen := make(map[string]bool)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name := tok.(string)
+		if seen[name] {
+			return errcode.Errorf(errcode.InvalidEncoding, "duplicate object name %q", name)
+		}
+		seen[name] = true
+		var value bytes.Buffer
+		if err := writeValue(&value, dec); err != nil {
+			return err
+		}
+		members = append(members, member{name: name, value: value.Bytes()})
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return lessUTF16(members[i].name, members[j].name)
+	})
+	buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeString(buf, m.name)
+		buf.WriteByte(':')
+		buf.Write(m.value)
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func writeArray(buf *bytes.Buffer, dec *json.Decoder) error {
+	buf.WriteByte('[')
+	for i := 0; dec.More(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeValue(buf, dec); err != nil {
+			return err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+// lessUTF16 orders strings by their UTF-16 code units, as RFC 8785
+// requires.
+func lessUTF16(a, b string) bool {
+	ua, ub := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+	return len(ua) < len(ub)
+}
+
+// writeString writes s with the escapes of RFC 8785: the quotation mark,
+// the backslash and control characters; everything else is written as is.
+func writeString(buf *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hex[r>>4])
+				buf.WriteByte(hex[r&0xf])
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// formatNumber writes n as ECMAScript's Number.prototype.toString writes
+// the nearest double.
+func formatNumber(n json.Number) (string, error) {
+	f, err := strconv.ParseFloat(string(n), 64)
+	if err != nil || math.IsInf(f, 0) {
+		return "", errcode.Errorf(errcode.OutOfRange, "number %s is out of range", n)
+	}
+	if f == 0 {
+		return "0", nil
+	}
+	sign := ""
+	if f < 0 {
+		sign, f = "-", -f
+	}
+	// The shortest digits that round-trip, and the exponent of the
+	// first one.
+	e := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp, _ := strings.Cut(e, "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	x, _ := strconv.Atoi(exp)
+	k, point := len(digits), x+1
+	var s string
+	switch {
+	case k <= point && point <= 21:
+		s = digits + strings.Repeat("0", point-k)
+	case 0 < point && point <= 21:
+		s = digits[:point] + "." + digits[point:]
+	case -6 < point && point <= 0:
+		s = "0." + strings.Repeat("0", -point) + digits
+	default:
+		s = digits[:1]
+		if k > 1 {
+			s += "." + digits[1:]
+		}
+		if point-1 >= 0 {
+			s += "e+" + strconv.Itoa(point-1)
+		} else {
+			s += "e" + strconv.Itoa(point-1)
+		}
+	}
+	return sign + s, nil
+}
@@ -0,0 +1,80 @@
+package canonicaljson_test
+
+import (
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/canonicaljson"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{` { "b" : 1, "a" : [ true , false, null ] } `, `{"a":[true,false,null],"b":1}`},
+		{`{"c":{"z":1,"y":2},"a":"x"}`, `{"a":"x","c":{"y":2,"z":1}}`},
+		// RFC 8785, section 3.2.3: names sort by UTF-16 code units.
+		{"{\"\u20ac\":\"Euro Sign\",\"\\r\":\"Carriage Return\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\",\"1\":\"One\",\"\U0001f600\":\"Emoji: Grinning Face\",\"\u0080\":\"Control\",\"\u00f6\":\"Latin Small Letter O With Diaeresis\"}",
+			"{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\",\"\u00f6\":\"Latin Small Letter O With Diaeresis\",\"\u20ac\":\"Euro Sign\",\"\U0001f600\":\"Emoji: Grinning Face\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}"},
+		{`"A\/\u001fé\n"`, `"A/\u001fé\n"`},
+		{`[]`, `[]`},
+		{`{}`, `{}`},
+	}
+	for _, tt := range tests {
+		got, err := canonicaljson.Canonicalize([]byte(tt.in))
+		if err != nil {
+			t.Errorf("Canonicalize(%s): %v", tt.in, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("Canonicalize(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+// Number serializations from RFC 8785, appendix B.
+func TestCanonicalizeNumbers(t *testing.T) {
+	tests := map[string]string{
+		"0":                       "0",
+		"-0":                      "0",
+		"1.0":                     "1",
+		"100":                     "100",
+		"5e-324":                  "5e-324",
+		"1.7976931348623157e308":  "1.7976931348623157e+308",
+		"-1.7976931348623157e308": "-1.7976931348623157e+308",
+		"9007199254740992":        "9007199254740992",
+		"295147905179352830000":   "295147905179352830000",
+		"1e21":                    "1e+21",
+		"0.000001":                "0.000001",
+		"1e-7":                    "1e-7",
+		"333333333.3333333":       "333333333.3333333",
+		"-1.5":                    "-1.5",
+		"1E+2":                    "100",
+		"4.50":                    "4.5",
+		"2e-3":                    "0.002",
+		"1.0000000000000002":      "1.0000000000000002",
+	}
+	for in, want := range tests {
+		got, err := canonicaljson.Canonicalize([]byte(in))
+		if err != nil {
+			t.Errorf("Canonicalize(%s): %v", in, err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("Canonicalize(%s) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestCanonicalizeRejects(t *testing.T) {
+	for _, in := range []string{
+		`{"a":1,"a":2}`,
+		`1e400`,
+		`{"a":1} {}`,
+		`{"a":}`,
+		``,
+	} {
+		if got, err := canonicaljson.Canonicalize([]byte(in)); err == nil {
+			t.Errorf("Canonicalize(%s) = %s, want an error", in, got)
+		}
+	}
+}
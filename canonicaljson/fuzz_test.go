@@ -0,0 +1,28 @@
+package canonicaljson_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/canonicaljson"
+)
+
+func FuzzCanonicalize(f *testing.F) {
+	f.Add([]byte(` { "b" : 1, "a" : [ true , false, null ] } `))
+	f.Add([]byte(`{"€":1e21,"\r":-0,"1":"\/"}`))
+	f.Add([]byte(`[1.5e-7, 333333333.33333329, "😀"]`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		out, err := canonicaljson.Canonicalize(data)
+		if err != nil {
+			return
+		}
+		if !json.Valid(out) {
+			t.Fatalf("Canonicalize(%q) = %q is not valid JSON", data, out)
+		}
+		again, err := canonicaljson.Canonicalize(out)
+		if err != nil || !bytes.Equal(again, out) {
+			t.Fatalf("Canonicalize is not idempotent on %q: %q, %v", out, again, err)
+		}
+	})
+}
@@ -0,0 +1,147 @@
+// Command mina-benchcmp compares two runs of the benchmark suite and fails
+// when a benchmark got slower than a threshold, so that performance work
+// can be checked against a saved baseline:
+//
+//	go test -run '^$' -bench . -count 5 ./... > old.txt
+//	# change the code
+//	go test -run '^$' -bench . -count 5 ./... > new.txt
+//	mina-benchcmp -threshold 10 old.txt new.txt
+//
+// Each benchmark is summarized by the median of its runs, which ignores
+// the occasional slow run better than the mean. For a statistical
+// comparison use golang.org/x/perf/cmd/benchstat on the same files.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// procSuffix is the -GOMAXPROCS suffix go test appends to benchmark names.
+var procSuffix = regexp.MustCompile(`-\d+$`)
+
+// result holds the runs of one benchmark.
+type result struct {
+	nsPerOp     []float64
+	allocsPerOp []float64
+}
+
+func main() {
+	threshold := flag.Float64("threshold", 10, "percentage by which ns/op may grow before the comparison fails")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: mina-benchcmp [-threshold percent] old.txt new.txt")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	log.SetFlags(0)
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	old, err := parseFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	cur, err := parseFile(flag.Arg(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	names := make([]string, 0, len(cur))
+	for name := range cur {
+		if _, ok := old[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		log.Fatal("mina-benchcmp: the files have no benchmark in common")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "benchmark\told ns/op\tnew ns/op\tdelta\told allocs\tnew allocs\t")
+	var regressions []string
+	for _, name := range names {
+		o, n := median(old[name].nsPerOp), median(cur[name].nsPerOp)
+		delta := (n - o) / o * 100
+		mark := ""
+		if delta > *threshold {
+			mark = " !"
+			regressions = append(regressions, name)
+		}
+		fmt.Fprintf(w, "%s\t%.0f\t%.0f\t%+.1f%%%s\t%s\t%s\t\n", name, o, n, delta, mark,
+			allocs(old[name]), allocs(cur[name]))
+	}
+	w.Flush()
+	if len(regressions) > 0 {
+		log.Fatalf("mina-benchcmp: %d benchmarks slower by more than %g%%: %s",
+			len(regressions), *threshold, strings.Join(regressions, ", "))
+	}
+}
+
+// parseFile reads the benchmark lines of go test output.
+func parseFile(name string) (map[string]*result, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	results := make(map[string]*result)
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		bench := procSuffix.ReplaceAllString(fields[0], "")
+		r := results[bench]
+		if r == nil {
+			r = new(result)
+			results[bench] = r
+		}
+		// After the name and iteration count come value and unit pairs.
+		for i := 2; i+1 < len(fields); i += 2 {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", name, fields[0], err)
+			}
+			switch fields[i+1] {
+			case "ns/op":
+				r.nsPerOp = append(r.nsPerOp, v)
+			case "allocs/op":
+				r.allocsPerOp = append(r.allocsPerOp, v)
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return results, nil
+}
+
+// allocs formats the median allocation count of r, or "-" without
+// -benchmem or b.ReportAllocs.
+func allocs(r *result) string {
+	if len(r.allocsPerOp) == 0 {
+		return "-"
+	}
+	return strconv.FormatFloat(median(r.allocsPerOp), 'f', 0, 64)
+}
+
+func median(v []float64) float64 {
+	s := append([]float64(nil), v...)
+	sort.Float64s(s)
+	if len(s)%2 == 1 {
+		return s[len(s)/2]
+	}
+	return (s[len(s)/2-1] + s[len(s)/2]) / 2
+}
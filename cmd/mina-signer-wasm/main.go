@@ -0,0 +1,13 @@
+//go:build js && wasm
+
+// Command mina-signer-wasm is the browser build of the signer. It installs
+// the minaSigner global described in package wasm and keeps running so the
+// functions stay callable.
+package main
+
+import "github.com/node101-io/mina-signer-go/wasm"
+
+func main() {
+	wasm.Register()
+	select {}
+}
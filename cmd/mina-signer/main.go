@@ -0,0 +1,316 @@
+// Command mina-signer signs transactions offline. Build an unsigned
+// envelope on a networked machine, sign it on an air-gapped one, and merge
+// the signature back:
+//
+//	online$  mina-signer build -network mainnet -from B62q... -to B62q... \
+//	             -amount 1.5 -fee 0.01 -nonce 7 > unsigned.json
+//	offline$ mina-signer sign -key key.txt unsigned.json > signature.json
+//	online$  mina-signer merge -signature signature.json unsigned.json > signed.json
+//	online$  mina-signer verify signed.json
+//
+// build -delegation builds a stake delegation to -to instead of a payment.
+// build -network takes mainnet, devnet or testnet; any other networkId is
+// refused as a likely typo unless -custom-network is given.
+// Amounts and fees are in MINA. The key file holds the base58 private key
+// ("EK...") or is a key file of mina-generate-keypair, whose password is
+// read from MINA_PRIVKEY_PASS as by the node; keygen writes a new one and
+// its .pub file. Either must be readable by its owner only. sign prints the transaction it signs to standard error, so the
+// operator can compare it with the request before carrying the signature
+// back. See package offline for the file formats.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/node101-io/mina-signer-go/keyfile"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/offline"
+	"github.com/node101-io/mina-signer-go/paymenturi"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+const usage = `usage:
+	mina-signer build [-delegation] -network id [-custom-network] -from addr -to addr [-amount mina] -fee mina -nonce n [-valid-until slot] [-memo text]
+	mina-signer sign -key file [envelope]
+	mina-signer merge -signature file [envelope]
+	mina-signer verify [envelope]
+	mina-signer keygen -out file`
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mina-signer: ")
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+	commands := map[string]func(args []string) error{
+		"build":  build,
+		"sign":   sign,
+		"merge":  merge,
+		"verify": verify,
+		"keygen": keygen,
+	}
+	run, ok := commands[os.Args[1]]
+	if !ok {
+		log.Fatal(usage)
+	}
+	if err := run(os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func build(args []string) error {
+	fs := flag.NewFlagSet("build", flag.ExitOnError)
+	network := fs.String("network", "mainnet", "networkId the signature is bound to: mainnet, devnet or testnet")
+	customNetwork := fs.Bool("custom-network", false, "accept a -network id other than mainnet, devnet or testnet")
+	delegation := fs.Bool("delegation", false, "build a stake delegation instead of a payment")
+	from := fs.String("from", "", "address of the fee payer")
+	to := fs.String("to", "", "address of the receiver or new delegate")
+	amount := fs.String("amount", "0", "amount in MINA")
+	fee := fs.String("fee", "", "fee in MINA")
+	nonce := fs.Uint64("nonce", 0, "nonce of the fee payer's account")
+	validUntil := fs.Uint64("valid-until", 0, "last global slot, 0 for no expiry")
+	memo := fs.String("memo", "", "memo")
+	fs.Parse(args)
+
+	net, err := buildNetwork(*network, *customNetwork)
+	if err != nil {
+		return err
+	}
+	fromKey, err := keys.PublicKeyFromBase58(*from)
+	if err != nil {
+		return fmt.Errorf("-from: %w", err)
+	}
+	toKey, err := keys.PublicKeyFromBase58(*to)
+	if err != nil {
+		return fmt.Errorf("-to: %w", err)
+	}
+	if *nonce > math.MaxUint32 || *validUntil > math.MaxUint32 {
+		return fmt.Errorf("-nonce and -valid-until must fit 32 bits")
+	}
+	feeNano, err := paymenturi.ParseAmount(*fee)
+	if err != nil {
+		return fmt.Errorf("-fee: %w", err)
+	}
+	var tx transaction.Command
+	if *delegation {
+		tx = transaction.StakeDelegation{
+			From: fromKey, To: toKey, Fee: feeNano,
+			Nonce: uint32(*nonce), ValidUntil: uint32(*validUntil), Memo: *memo,
+		}
+	} else {
+		amountNano, err := paymenturi.ParseAmount(*amount)
+		if err != nil {
+			return fmt.Errorf("-amount: %w", err)
+		}
+		tx = transaction.Payment{
+			From: fromKey, To: toKey, Amount: amountNano, Fee: feeNano,
+			Nonce: uint32(*nonce), ValidUntil: uint32(*validUntil), Memo: *memo,
+		}
+	}
+	if _, err := tx.InputLegacy(); err != nil {
+		return err
+	}
+	e, err := offline.New(net, tx)
+	if err != nil {
+		return err
+	}
+	return write(e)
+}
+
+// buildNetwork returns the network of the networkId id. An id other than
+// mainnet, devnet or testnet is refused as a likely typo unless custom is
+// set, since it would silently select a custom network.
+func buildNetwork(id string, custom bool) (signer.Network, error) {
+	switch id {
+	case "mainnet", "devnet", "testnet":
+	default:
+		if !custom {
+			return signer.Network{}, fmt.Errorf("-network %q is not mainnet, devnet or testnet; pass -custom-network to sign for a custom network", id)
+		}
+	}
+	return signer.NetworkFromName(id), nil
+}
+
+func sign(args []string) error {
+	fs := flag.NewFlagSet("sign", flag.ExitOnError)
+	keyFile := fs.String("key", "", "file holding the base58 private key, or a mina-generate-keypair key file")
+	fs.Parse(args)
+
+	var e offline.Envelope
+	if err := read(fs.Arg(0), &e); err != nil {
+		return err
+	}
+	key, err := readKeyFile(*keyFile)
+	if err != nil {
+		return err
+	}
+	d, err := offline.Sign(&e, key)
+	if err != nil {
+		return err
+	}
+	describe(os.Stderr, &e)
+	return write(d)
+}
+
+func merge(args []string) error {
+	fs := flag.NewFlagSet("merge", flag.ExitOnError)
+	sigFile := fs.String("signature", "", "file holding the detached signature from sign")
+	fs.Parse(args)
+	if *sigFile == "" {
+		return fmt.Errorf("merge: -signature is required")
+	}
+
+	var e offline.Envelope
+	if err := read(fs.Arg(0), &e); err != nil {
+		return err
+	}
+	var d offline.Detached
+	if err := read(*sigFile, &d); err != nil {
+		return err
+	}
+	signed, err := offline.Merge(&e, &d)
+	if err != nil {
+		return err
+	}
+	return write(signed)
+}
+
+func verify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	fs.Parse(args)
+
+	var e offline.Envelope
+	if err := read(fs.Arg(0), &e); err != nil {
+		return err
+	}
+	signed, err := e.Signed()
+	if err != nil {
+		return err
+	}
+	hash, err := transaction.Hash(signed.Data)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("ok: signed by %s, transaction hash %s\n", signed.PublicKey, hash)
+	return nil
+}
+
+// describe writes the transaction of e in a form an operator can check.
+func describe(w io.Writer, e *offline.Envelope) {
+	switch {
+	case e.Payment != nil:
+		p := e.Payment
+		fmt.Fprintf(w, "payment on %s\n  from   %s\n  to     %s\n  amount %s MINA\n", e.Network, p.From, p.To, paymenturi.FormatAmount(p.Amount))
+		fmt.Fprintf(w, "  fee    %s MINA\n  nonce  %d\n  valid  %s\n  memo   %q\n", paymenturi.FormatAmount(p.Fee), p.Nonce, validity(p.ValidUntil), p.Memo)
+	case e.StakeDelegation != nil:
+		d := e.StakeDelegation
+		fmt.Fprintf(w, "stake delegation on %s\n  from   %s\n  to     %s\n", e.Network, d.From, d.To)
+		fmt.Fprintf(w, "  fee    %s MINA\n  nonce  %d\n  valid  %s\n  memo   %q\n", paymenturi.FormatAmount(d.Fee), d.Nonce, validity(d.ValidUntil), d.Memo)
+	}
+}
+
+// validity describes the ValidUntil slot of a transaction.
+func validity(validUntil uint32) string {
+	if validUntil == 0 || validUntil == transaction.NoExpiry {
+		return "no expiry"
+	}
+	return fmt.Sprintf("until global slot %d", validUntil)
+}
+
+func keygen(args []string) error {
+	fs := flag.NewFlagSet("keygen", flag.ExitOnError)
+	out := fs.String("out", "", "path of the private key file; the address goes to the path with .pub appended")
+	fs.Parse(args)
+	if *out == "" {
+		return fmt.Errorf("keygen: -out is required")
+	}
+	password, err := keyPassword()
+	if err != nil {
+		return err
+	}
+	pair, err := signer.NewClient(signer.NetworkMainnet).GenKeys()
+	if err != nil {
+		return err
+	}
+	key, err := keys.PrivateKeyFromBase58(pair.PrivateKey)
+	if err != nil {
+		return err
+	}
+	if err := keyfile.Write(*out, key, password); err != nil {
+		return err
+	}
+	fmt.Println(pair.PublicKey)
+	return nil
+}
+
+// keyPassword returns the password of key files, from MINA_PRIVKEY_PASS.
+func keyPassword() ([]byte, error) {
+	password, ok := os.LookupEnv(keyfile.PasswordEnv)
+	if !ok {
+		return nil, fmt.Errorf("set %s to the password of the key file", keyfile.PasswordEnv)
+	}
+	return []byte(password), nil
+}
+
+// readKeyFile reads a base58 private key from path, or opens the
+// mina-generate-keypair key file at path. Either must not be accessible to
+// users other than its owner.
+func readKeyFile(path string) (keys.PrivateKey, error) {
+	if path == "" {
+		return keys.PrivateKey{}, fmt.Errorf("sign: -key is required")
+	}
+	if err := keyfile.CheckPermissions(path); err != nil {
+		return keys.PrivateKey{}, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return keys.PrivateKey{}, err
+	}
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		password, err := keyPassword()
+		if err != nil {
+			return keys.PrivateKey{}, err
+		}
+		return keyfile.Read(path, password)
+	}
+	key, err := keys.PrivateKeyFromBase58(strings.TrimSpace(string(data)))
+	if err != nil {
+		return keys.PrivateKey{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return key, nil
+}
+
+// read decodes the JSON file at path, or standard input for an empty path
+// or "-".
+func read(path string, v any) error {
+	r, name := io.Reader(os.Stdin), "standard input"
+	if path != "" && path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r, name = f, path
+	}
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// write encodes v as indented JSON on standard output.
+func write(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
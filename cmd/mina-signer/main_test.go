@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+func TestBuildNetwork(t *testing.T) {
+	for _, id := range []string{"mainnet", "devnet", "testnet"} {
+		n, err := buildNetwork(id, false)
+		if err != nil || n.Name != signer.NetworkFromName(id).Name {
+			t.Errorf("buildNetwork(%q) = %v, %v", id, n.Name, err)
+		}
+	}
+	if _, err := buildNetwork("mainet", false); err == nil {
+		t.Error(`buildNetwork("mainet") accepted a mistyped id`)
+	}
+	n, err := buildNetwork("zeko", true)
+	if err != nil || n.Name != "zeko" {
+		t.Errorf(`buildNetwork("zeko", custom) = %v, %v`, n.Name, err)
+	}
+}
@@ -0,0 +1,47 @@
+// Command mina-testvectors writes a generated test-vector corpus, or checks
+// one written by another implementation.
+//
+//	mina-testvectors -network testnet -seed 1 -n 20 > vectors.json
+//	mina-testvectors -verify vectors.json
+//
+// The zkApp commands of a corpus are self-consistency vectors that o1js
+// cannot rebuild; see package testvectors.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/node101-io/mina-signer-go/testvectors"
+)
+
+func main() {
+	network := flag.String("network", "testnet", "networkId the signatures are bound to")
+	seed := flag.Uint64("seed", 0, "seed of the generated vectors")
+	n := flag.Int("n", 10, "number of vectors of each kind")
+	verify := flag.String("verify", "", "check the corpus in this file instead of generating one")
+	flag.Parse()
+	log.SetFlags(0)
+
+	if *verify != "" {
+		c, err := testvectors.ReadFile(*verify)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := testvectors.Verify(c); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(os.Stderr, "%s: ok\n", *verify)
+		return
+	}
+
+	c, err := testvectors.Generate(*network, *seed, *n)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := testvectors.Write(os.Stdout, c); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Package commitments implements Pedersen commitments on Pallas.
+//
+// A commitment to a value v with blinding r is v·G + r·H. It hides v as long
+// as r is random and binds the committer to (v, r) as long as nobody knows
+// the discrete logarithm of H to the base G. Both generators are therefore
+// derived by hashing a public label to the curve (RFC 9380), so no one can
+// have chosen them with a known relation.
+//
+// Commitments are additively homomorphic: the sum of commitments to (v1, r1)
+// and (v2, r2) opens to (v1 + v2, r1 + r2).
+package commitments
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+// generatorDomain is the RFC 9380 domain separation tag of the generators.
+const generatorDomain = "mina-signer-go-pedersen-generators-v1"
+
+// DefaultLabel is the label of the generators of Default.
+const DefaultLabel = "default"
+
+// Params holds a pair of independent generators.
+type Params struct {
+	G, H *curve.GroupProjective
+}
+
+// NewParams derives the generators for label by hashing label with the
+// suffixes "G" and "H". Different labels give unrelated generators, so
+// applications can keep their commitments apart.
+func NewParams(label string) *Params {
+	c := curve.Pallas()
+	return &Params{
+		G: c.HashToCurve([]byte(generatorDomain), []byte(label+"/G")),
+		H: c.HashToCurve([]byte(generatorDomain), []byte(label+"/H")),
+	}
+}
+
+var defaultParams = NewParams(DefaultLabel)
+
+// Default returns the parameters for DefaultLabel.
+func Default() *Params {
+	return defaultParams
+}
+
+// Commitment is a Pedersen commitment.
+type Commitment struct {
+	point *curve.GroupProjective
+}
+
+// Commit returns the commitment to value with blinding.
+func (p *Params) Commit(value, blinding *scalar.Scalar) (*Commitment, error) {
+	if value == nil || blinding == nil {
+		return nil, errcode.New(errcode.MissingValue, "commitments: nil value or blinding")
+	}
+	c := curve.Pallas()
+	v := c.ScaleConstantTime(p.G, value.BigInt())
+	r := c.ScaleConstantTime(p.H, blinding.BigInt())
+	return &Commitment{point: c.Add(v, r)}, nil
+}
+
+// Verify reports whether c opens to value with blinding.
+func (p *Params) Verify(c *Commitment, value, blinding *scalar.Scalar) bool {
+	if c == nil || c.point == nil {
+		return false
+	}
+	d, err := p.Commit(value, blinding)
+	if err != nil {
+		return false
+	}
+	return c.Equal(d)
+}
+
+// RandomBlinding returns a uniformly random blinding factor.
+func RandomBlinding() (*scalar.Scalar, error) {
+	return scalar.RandomScalar()
+}
+
+// Add returns the commitment c + d, which opens to the sums of the values
+// and blindings of c and d.
+func (c *Commitment) Add(d *Commitment) *Commitment {
+	return &Commitment{point: curve.Pallas().Add(c.point, d.point)}
+}
+
+// Equal reports whether c and d are the same commitment.
+func (c *Commitment) Equal(d *Commitment) bool {
+	a, errA := c.MarshalBinary()
+	b, errB := d.MarshalBinary()
+	return errA == nil && errB == nil && bytes.Equal(a, b)
+}
+
+// MarshalBinary encodes c as a compressed arkworks point of
+// curve.Pallas().ArkworksCompressedSize() bytes: 33, since the x
+// coordinate and the two flag bits do not fit in 32.
+func (c *Commitment) MarshalBinary() ([]byte, error) {
+	if c == nil || c.point == nil {
+		return nil, errcode.New(errcode.MissingValue, "commitments: nil commitment")
+	}
+	return curve.Pallas().MarshalArkworks(c.point, true)
+}
+
+// UnmarshalBinary decodes the output of MarshalBinary.
+func (c *Commitment) UnmarshalBinary(data []byte) error {
+	g, err := curve.Pallas().UnmarshalArkworks(data, true)
+	if err != nil {
+		return fmt.Errorf("commitments: %w", err)
+	}
+	c.point = g
+	return nil
+}
@@ -0,0 +1,96 @@
+package commitments_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/commitments"
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+func mustCommit(t *testing.T, p *commitments.Params, v, r *scalar.Scalar) *commitments.Commitment {
+	t.Helper()
+	c, err := p.Commit(v, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCommitVerify(t *testing.T) {
+	p := commitments.Default()
+	r, err := commitments.RandomBlinding()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := scalar.NewScalar(1000)
+	c := mustCommit(t, p, v, r)
+	if !p.Verify(c, v, r) {
+		t.Fatal("commitment does not open")
+	}
+	if p.Verify(c, scalar.NewScalar(1001), r) {
+		t.Error("commitment opens to another value")
+	}
+	if p.Verify(c, v, r.Add(scalar.NewScalar(1))) {
+		t.Error("commitment opens with another blinding")
+	}
+	if commitments.NewParams("other").Verify(c, v, r) {
+		t.Error("commitment opens under other generators")
+	}
+}
+
+func TestHomomorphicAddition(t *testing.T) {
+	p := commitments.Default()
+	v1, r1 := scalar.NewScalar(7), scalar.NewScalar(11)
+	v2, r2 := scalar.NewScalar(13), scalar.NewScalar(17)
+	sum := mustCommit(t, p, v1, r1).Add(mustCommit(t, p, v2, r2))
+	if !p.Verify(sum, v1.Add(v2), r1.Add(r2)) {
+		t.Error("sum of commitments does not open to the sums")
+	}
+}
+
+func TestGenerators(t *testing.T) {
+	p := commitments.Default()
+	q := commitments.NewParams(commitments.DefaultLabel)
+	c := curve.Pallas()
+	enc := func(g *curve.GroupProjective) []byte {
+		b, err := c.MarshalArkworks(g, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	if !bytes.Equal(enc(p.G), enc(q.G)) || !bytes.Equal(enc(p.H), enc(q.H)) {
+		t.Error("generators are not deterministic")
+	}
+	if bytes.Equal(enc(p.G), enc(p.H)) || bytes.Equal(enc(p.G), enc(c.One)) || bytes.Equal(enc(p.H), enc(c.One)) {
+		t.Error("generators are not distinct")
+	}
+	for _, g := range []*curve.GroupProjective{p.G, p.H} {
+		if err := c.ValidatePoint(g); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	c := mustCommit(t, commitments.Default(), scalar.NewScalar(5), scalar.NewScalar(9))
+	b, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != 33 {
+		t.Errorf("MarshalBinary() is %d bytes, want 33", len(b))
+	}
+	var d commitments.Commitment
+	if err := d.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if !c.Equal(&d) {
+		t.Error("commitment changed in a round trip")
+	}
+	if err := d.UnmarshalBinary(b[1:]); err == nil {
+		t.Error("UnmarshalBinary accepted a short encoding")
+	}
+}
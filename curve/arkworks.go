@@ -0,0 +1,144 @@
+package curve
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// Arkworks encoding of short Weierstrass points, as produced by
+// CanonicalSerialize for ark-ec affine points (ark-serialize 0.4 SWFlags).
+//
+// Field elements are written as their canonical integer in little-endian
+// order. The flag byte follows from the fact that the value and two flag bits
+// need ceil((255+2)/8) = 33 bytes for the Pasta fields:
+//
+//	compressed:   x (33 bytes, flags in the top two bits of the last byte)
+//	uncompressed: x (32 bytes) || y (33 bytes, flags in the last byte)
+//
+// Bit 7 is set when y > -y, i.e. y is the lexicographically larger root, and
+// bit 6 marks the point at infinity, which is encoded with zero coordinates.
+
+const (
+	arkFlagYIsNegative     byte = 1 << 7
+	arkFlagPointAtInfinity byte = 1 << 6
+	arkFlagMask                 = arkFlagYIsNegative | arkFlagPointAtInfinity
+)
+
+// ArkworksCompressedSize returns the length of a compressed encoding on c.
+func (c *Curve) ArkworksCompressedSize() int {
+	return (c.Modulus.BitLen() + 2 + 7) / 8
+}
+
+// ArkworksUncompressedSize returns the length of an uncompressed encoding
+// on c.
+func (c *Curve) ArkworksUncompressedSize() int {
+	return (c.Modulus.BitLen()+7)/8 + c.ArkworksCompressedSize()
+}
+
+// MarshalArkworks encodes g in the arkworks compressed or uncompressed format.
+func (c *Curve) MarshalArkworks(g *GroupProjective, compressed bool) ([]byte, error) {
+	if err := checkPoints(g); err != nil {
+		return nil, err
+	}
+	aff := c.ToAffine(g)
+	x, y, flags := big.NewInt(0), big.NewInt(0), arkFlagPointAtInfinity
+	if !aff.Infinity {
+		x, y, flags = aff.X, aff.Y, 0
+		if c.isLexicographicallyLargest(y) {
+			flags = arkFlagYIsNegative
+		}
+	}
+
+	if compressed {
+		out := make([]byte, c.ArkworksCompressedSize())
+		putLittleEndian(out, x)
+		out[len(out)-1] |= flags
+		return out, nil
+	}
+	fieldSize := (c.Modulus.BitLen() + 7) / 8
+	out := make([]byte, c.ArkworksUncompressedSize())
+	putLittleEndian(out[:fieldSize], x)
+	putLittleEndian(out[fieldSize:], y)
+	out[len(out)-1] |= flags
+	return out, nil
+}
+
+// UnmarshalArkworks decodes a point in the arkworks compressed or
+// uncompressed format, rejecting non-canonical coordinates, unknown flags and
+// points that are not on the curve.
+func (c *Curve) UnmarshalArkworks(data []byte, compressed bool) (*GroupProjective, error) {
+	want := c.ArkworksUncompressedSize()
+	if compressed {
+		want = c.ArkworksCompressedSize()
+	}
+	if len(data) != want {
+		return nil, fmt.Errorf("invalid arkworks point length: %w: expected %d bytes, got %d bytes", field.ErrInvalidLength, want, len(data))
+	}
+	buf := append([]byte{}, data...)
+	flags := buf[len(buf)-1] & arkFlagMask
+	buf[len(buf)-1] &^= arkFlagMask
+	if flags == arkFlagMask {
+		return nil, fmt.Errorf("%w: arkworks flags have both infinity and sign bits set", ErrInvalidPoint)
+	}
+
+	fieldSize := (c.Modulus.BitLen() + 7) / 8
+	var x, y *big.Int
+	if compressed {
+		x = readLittleEndian(buf)
+	} else {
+		x = readLittleEndian(buf[:fieldSize])
+		y = readLittleEndian(buf[fieldSize:])
+	}
+	if x.Cmp(c.Modulus) >= 0 || (y != nil && y.Cmp(c.Modulus) >= 0) {
+		return nil, fmt.Errorf("arkworks coordinate: %w", field.ErrNonCanonical)
+	}
+
+	if flags == arkFlagPointAtInfinity {
+		if x.Sign() != 0 || (y != nil && y.Sign() != 0) {
+			return nil, fmt.Errorf("%w: arkworks point at infinity with non-zero coordinates", ErrInvalidPoint)
+		}
+		return c.Zero, nil
+	}
+
+	if compressed {
+		rhs := c.rhs(x)
+		var err error
+		if y, err = c.Field.SqrtErr(rhs); err != nil {
+			return nil, ErrNotOnCurve
+		}
+		if c.isLexicographicallyLargest(y) != (flags == arkFlagYIsNegative) {
+			y = c.Field.Negate(y)
+		}
+	}
+	g := &GroupProjective{X: x, Y: y, Z: big.NewInt(1)}
+	if !c.IsOnCurve(g) {
+		return nil, ErrNotOnCurve
+	}
+	return g, nil
+}
+
+// isLexicographicallyLargest reports whether y > -y, i.e. y > (p-1)/2.
+func (c *Curve) isLexicographicallyLargest(y *big.Int) bool {
+	half := new(big.Int).Rsh(c.Modulus, 1)
+	return y.Cmp(half) > 0
+}
+
+// putLittleEndian writes v into out in little-endian order; out must be large
+// enough.
+func putLittleEndian(out []byte, v *big.Int) {
+	be := v.Bytes()
+	for i, b := range be {
+		out[len(be)-1-i] = b
+	}
+}
+
+// readLittleEndian interprets b as a little-endian unsigned integer.
+func readLittleEndian(b []byte) *big.Int {
+	be := make([]byte, len(b))
+	for i, v := range b {
+		be[len(b)-1-i] = v
+	}
+	return new(big.Int).SetBytes(be)
+}
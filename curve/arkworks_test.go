@@ -0,0 +1,91 @@
+package curve
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+func TestArkworksGeneratorEncoding(t *testing.T) {
+	pallas := Pallas()
+	got, err := pallas.MarshalArkworks(pallas.One, true)
+	if err != nil {
+		t.Fatalf("MarshalArkworks failed: %v", err)
+	}
+	want := make([]byte, 33)
+	want[0] = 0x01
+	if !bytes.Equal(got, want) {
+		t.Errorf("compressed generator = %x, want %x", got, want)
+	}
+
+	neg, err := pallas.MarshalArkworks(pallas.Negate(pallas.One), true)
+	if err != nil {
+		t.Fatalf("MarshalArkworks failed: %v", err)
+	}
+	want[32] = 0x80
+	if !bytes.Equal(neg, want) {
+		t.Errorf("compressed -generator = %x, want %x", neg, want)
+	}
+}
+
+func TestArkworksRoundTrip(t *testing.T) {
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		points := []*GroupProjective{c.Zero, c.One, c.Negate(c.One)}
+		for i := int64(2); i < 12; i++ {
+			points = append(points, c.Scale(c.One, big.NewInt(i*7919)))
+		}
+		for _, compressed := range []bool{true, false} {
+			for _, g := range points {
+				data, err := c.MarshalArkworks(g, compressed)
+				if err != nil {
+					t.Fatalf("%s: MarshalArkworks failed: %v", c.Name, err)
+				}
+				back, err := c.UnmarshalArkworks(data, compressed)
+				if err != nil {
+					t.Fatalf("%s: UnmarshalArkworks(%x) failed: %v", c.Name, data, err)
+				}
+				if !sameAffine(c, back, g) {
+					t.Fatalf("%s: arkworks round trip changed the point (compressed=%v)", c.Name, compressed)
+				}
+			}
+		}
+	}
+}
+
+func TestArkworksRejectsMalformed(t *testing.T) {
+	pallas := Pallas()
+	valid, _ := pallas.MarshalArkworks(pallas.One, true)
+
+	bothFlags := append([]byte{}, valid...)
+	bothFlags[32] |= 0xc0
+	nonCanonical := make([]byte, 33)
+	putLittleEndian(nonCanonical, pallas.Modulus)
+	infinityWithX := append([]byte{}, valid...)
+	infinityWithX[32] |= 0x40
+
+	// Find an x with no curve point.
+	x := big.NewInt(0)
+	for pallas.Field.IsSquare(pallas.rhs(x)) {
+		x.Add(x, big.NewInt(1))
+	}
+	offCurve := make([]byte, 33)
+	putLittleEndian(offCurve, x)
+
+	for name, data := range map[string][]byte{
+		"short":           valid[:32],
+		"both flags":      bothFlags,
+		"non-canonical x": nonCanonical,
+		"infinity with x": infinityWithX,
+		"off curve":       offCurve,
+	} {
+		if _, err := pallas.UnmarshalArkworks(data, true); err == nil {
+			t.Errorf("UnmarshalArkworks accepted %s input", name)
+		}
+	}
+
+	uncompressed, _ := pallas.MarshalArkworks(pallas.One, false)
+	uncompressed[40] ^= 1
+	if _, err := pallas.UnmarshalArkworks(uncompressed, false); err == nil {
+		t.Error("UnmarshalArkworks accepted an uncompressed point off the curve")
+	}
+}
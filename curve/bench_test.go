@@ -0,0 +1,96 @@
+package curve
+
+import (
+	"math/big"
+	"testing"
+)
+
+var benchScalar = StrToBigInt("0x1b2a9d66c61b5c0d5f7f1e8d09c2f7a6e5d4c3b2a1908f7e6d5c4b3a29180706")
+
+func BenchmarkAdd(b *testing.B) {
+	c := Pallas()
+	g, h := c.Scale(c.One, big.NewInt(3)), c.Scale(c.One, big.NewInt(5))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.Add(g, h)
+	}
+}
+
+func BenchmarkDouble(b *testing.B) {
+	c := Pallas()
+	g := c.Scale(c.One, big.NewInt(3))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.Double(g)
+	}
+}
+
+func BenchmarkScale(b *testing.B) {
+	c := Pallas()
+	benchmarks := []struct {
+		name  string
+		scale func(*GroupProjective, *big.Int) *GroupProjective
+	}{
+		{"DoubleAndAdd", c.Scale},
+		{"Vartime", c.ScaleVartime},
+		{"ConstantTime", c.ScaleConstantTime},
+		{"WNAF", c.ScaleWNAF},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bm.scale(c.One, benchScalar)
+			}
+		})
+	}
+	b.Run("Base", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			c.ScaleBase(benchScalar)
+		}
+	})
+}
+
+func BenchmarkMultiScalarMul(b *testing.B) {
+	pallas := Pallas()
+	points := make([]*GroupProjective, 16)
+	scalars := make([]*big.Int, len(points))
+	for i := range points {
+		points[i] = pallas.Scale(pallas.One, big.NewInt(int64(i+2)))
+		scalars[i] = new(big.Int).Add(benchScalar, big.NewInt(int64(i)))
+	}
+	for _, coords := range []Coordinates{CoordinatesJacobian, CoordinatesXYZZ} {
+		c := CreateCurveProjective(pallas.CurveParams, WithCoordinates(coords))
+		b.Run(coords.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := c.MultiScalarMul(points, scalars); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkToAffine(b *testing.B) {
+	c := Pallas()
+	points := make([]*GroupProjective, 64)
+	for i := range points {
+		points[i] = c.Scale(c.One, big.NewInt(int64(i+2)))
+	}
+	b.Run("OneByOne", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, g := range points {
+				c.ToAffine(g)
+			}
+		}
+	})
+	b.Run("Batch", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			c.batchToAffine(points)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package curve
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// ScalarBlindingBits is the size of the random multiplier r in the blinded
+// scalar s + r·order.
+const ScalarBlindingBits = 128
+
+// BlindingOptions selects side-channel countermeasures for ScaleBlinded. The
+// zero value disables both and makes ScaleBlinded equivalent to Scale.
+type BlindingOptions struct {
+	// Scalar replaces s by s + r·order for a fresh random r, so the bits fed
+	// to the ladder differ on every call while the result stays the same.
+	Scalar bool
+	// Projective rescales the base point to (λ²X, λ³Y, λZ) for a fresh random
+	// λ, so intermediate coordinates are unpredictable.
+	Projective bool
+	// Rand is the randomness source. It defaults to crypto/rand.Reader.
+	Rand io.Reader
+}
+
+// ScaleBlinded returns s·g with the countermeasures selected in opts. With
+// scalar blinding the ladder always runs over the same number of bits,
+// independent of s.
+func (c *Curve) ScaleBlinded(g *GroupProjective, s *big.Int, opts BlindingOptions) (*GroupProjective, error) {
+	if err := checkPoints(g); err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, errcode.New(errcode.MissingValue, "curve: nil scalar")
+	}
+	reader := opts.Rand
+	if reader == nil {
+		reader = rand.Reader
+	}
+
+	if opts.Projective && g.Z.Sign() != 0 {
+		lambda, err := randomNonZero(reader, c.Modulus)
+		if err != nil {
+			return nil, err
+		}
+		f := c.Field
+		lambda2 := f.Square(lambda)
+		g = &GroupProjective{
+			X: f.Mul(g.X, lambda2),
+			Y: f.Mul(g.Y, f.Mul(lambda2, lambda)),
+			Z: f.Mul(g.Z, lambda),
+		}
+	}
+
+	if !opts.Scalar {
+		return projectiveScaleChecked(g, s, c.Modulus, c.A)
+	}
+	r, err := rand.Int(reader, new(big.Int).Lsh(big.NewInt(1), ScalarBlindingBits))
+	if err != nil {
+		return nil, err
+	}
+	blinded := new(big.Int).Mod(s, c.Order)
+	blinded.Add(blinded, r.Mul(r, c.Order))
+
+	var h = projectiveZero
+	for i := 0; i < c.Order.BitLen()+ScalarBlindingBits; i++ {
+		if blinded.Bit(i) == 1 {
+			if h, err = projectiveAdd(h, g, c.Modulus, c.A); err != nil {
+				return nil, err
+			}
+		}
+		if g, err = projectiveDouble(g, c.Modulus, c.A); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// randomNonZero returns a uniform value in [1, n).
+func randomNonZero(reader io.Reader, n *big.Int) (*big.Int, error) {
+	for {
+		v, err := rand.Int(reader, n)
+		if err != nil {
+			return nil, err
+		}
+		if v.Sign() != 0 {
+			return v, nil
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package curve
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestScaleBlinded(t *testing.T) {
+	pallas := Pallas()
+	rng := rand.New(rand.NewSource(5))
+	options := []BlindingOptions{
+		{},
+		{Scalar: true},
+		{Projective: true},
+		{Scalar: true, Projective: true, Rand: rng},
+	}
+	for i := 0; i < 5; i++ {
+		s := new(big.Int).Rand(rng, pallas.Order)
+		want := pallas.Scale(pallas.One, s)
+		for _, opts := range options {
+			got, err := pallas.ScaleBlinded(pallas.One, s, opts)
+			if err != nil {
+				t.Fatalf("ScaleBlinded(%+v) failed: %v", opts, err)
+			}
+			if !sameAffine(pallas, got, want) {
+				t.Fatalf("ScaleBlinded(G, %v, %+v) != Scale(G, %v)", s, opts, s)
+			}
+		}
+	}
+
+	got, err := pallas.ScaleBlinded(pallas.One, pallas.Order, BlindingOptions{Scalar: true, Projective: true})
+	if err != nil {
+		t.Fatalf("ScaleBlinded(G, order) failed: %v", err)
+	}
+	if !pallas.ToAffine(got).Infinity {
+		t.Error("ScaleBlinded(G, order) is not the point at infinity")
+	}
+}
@@ -1,6 +1,7 @@
 package curve
 
 import (
+	"github.com/node101-io/mina-signer-go/errcode"
 	"github.com/node101-io/mina-signer-go/field"
 	"math/big"
 )
@@ -28,6 +29,36 @@ var (
 	}
 )
 
+var (
+	// ErrInvalidPoint is returned when two points share an x-coordinate but
+	// are neither equal nor inverses, which only happens off the curve.
+	ErrInvalidPoint = errcode.New(errcode.InvalidPoint, "curve: invalid point")
+	// ErrUnexpectedInfinity is returned when doubling a point with y = 0.
+	ErrUnexpectedInfinity = errcode.New(errcode.PointAtInfinity, "curve: unexpected point at infinity")
+	// ErrNilPoint is returned when a point or one of its coordinates is nil.
+	ErrNilPoint = errcode.New(errcode.MissingValue, "curve: nil point")
+)
+
+// mustPoint unwraps the result of an error-returning group operation for the
+// panicking API.
+func mustPoint(g *GroupProjective, err error) *GroupProjective {
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// checkPoints returns ErrNilPoint if any of the points or their coordinates
+// are nil.
+func checkPoints(points ...*GroupProjective) error {
+	for _, g := range points {
+		if g == nil || g.X == nil || g.Y == nil || g.Z == nil {
+			return ErrNilPoint
+		}
+	}
+	return nil
+}
+
 type GroupProjective struct {
 	X, Y, Z *big.Int
 }
@@ -37,33 +68,55 @@ type GroupAffine struct {
 	Infinity bool
 }
 
+// Equal reports whether a and b are the same point. Any two points at
+// infinity are equal whatever their coordinates; finite points compare
+// their coordinates by value.
+func (a GroupAffine) Equal(b GroupAffine) bool {
+	if a.Infinity || b.Infinity {
+		return a.Infinity == b.Infinity
+	}
+	return a.X.Cmp(b.X) == 0 && a.Y.Cmp(b.Y) == 0
+}
+
 type CurveParams struct {
 	Name      string
 	Modulus   *big.Int
 	Order     *big.Int
 	Generator *GroupProjective
 	A, B      *big.Int
+	// Cofactor is the number of curve points divided by Order. A nil
+	// Cofactor means it is unknown and subgroup membership is always checked.
+	Cofactor *big.Int
 }
 
-type ProjectiveCurve struct {
+// Curve is a short Weierstrass curve y^2 = x^3 + ax + b whose points are
+// represented in Jacobian projective coordinates. All group operations are
+// methods, so a *Curve can be shared freely between goroutines.
+type Curve struct {
 	CurveParams
 	Field *field.FiniteField
 	Zero  *GroupProjective
 	One   *GroupProjective
-
-	Equal        func(g, h *GroupProjective) bool
-	IsOnCurve    func(g *GroupProjective) bool
-	IsInSubgroup func(g *GroupProjective) bool
-	Add          func(g, h *GroupProjective) *GroupProjective
-	Double       func(g *GroupProjective) *GroupProjective
-	Negate       func(g *GroupProjective) *GroupProjective
-	Sub          func(g, h *GroupProjective) *GroupProjective
-	Scale        func(g *GroupProjective, s *big.Int) *GroupProjective
-	ToAffine     func(g *GroupProjective) GroupAffine
-	FromAffine   func(a GroupAffine) *GroupProjective
+	// Coordinates selects the representation used by MultiScalarMul.
+	Coordinates Coordinates
+	// ConstantTime makes Scale and ScaleBase use ScaleConstantTime.
+	ConstantTime bool
+
+	// baseTable holds the precomputed multiples 2^i·G used by ScaleBase.
+	// It is nil for curves built by CreateCurveProjective.
+	baseTable *fixedBaseTable
 }
 
-func NewPallasCurve() *ProjectiveCurve {
+// ProjectiveCurve is the former name of Curve. Method values such as
+// c.Add or c.Scale keep working for code written against the old
+// closure-based struct.
+//
+// Deprecated: use Curve.
+type ProjectiveCurve = Curve
+
+// NewPallasCurve builds the Pallas curve, configured by opts. Pallas returns
+// a shared instance with the default options.
+func NewPallasCurve(opts ...Option) *Curve {
 	params := CurveParams{
 		Name:      "Pallas",
 		Modulus:   field.P,
@@ -71,11 +124,16 @@ func NewPallasCurve() *ProjectiveCurve {
 		Generator: pallasGenerator,
 		A:         a,
 		B:         b,
+		Cofactor:  big.NewInt(1),
 	}
-	return CreateCurveProjective(params)
+	c := CreateCurveProjective(params, opts...)
+	c.baseTable = pallasBaseTable()
+	return c
 }
 
-func NewVestaCurve() *ProjectiveCurve {
+// NewVestaCurve builds the Vesta curve, configured by opts. Vesta returns a
+// shared instance with the default options.
+func NewVestaCurve(opts ...Option) *Curve {
 	params := CurveParams{
 		Name:      "Vesta",
 		Modulus:   field.Q,
@@ -83,8 +141,28 @@ func NewVestaCurve() *ProjectiveCurve {
 		Generator: vestaGenerator,
 		A:         a,
 		B:         b,
+		Cofactor:  big.NewInt(1),
 	}
-	return CreateCurveProjective(params)
+	c := CreateCurveProjective(params, opts...)
+	c.baseTable = vestaBaseTable()
+	return c
+}
+
+var (
+	pallas = NewPallasCurve()
+	vesta  = NewVestaCurve()
+)
+
+// Pallas returns the package-level Pallas curve. It is built once at init
+// and shared by every caller, so it must be treated as read-only.
+func Pallas() *Curve {
+	return pallas
+}
+
+// Vesta returns the package-level Vesta curve. It is built once at init
+// and shared by every caller, so it must be treated as read-only.
+func Vesta() *Curve {
+	return vesta
 }
 
 func StrToBigInt(s string) *big.Int {
@@ -94,11 +172,14 @@ func StrToBigInt(s string) *big.Int {
 }
 
 func BigIntToBits(n *big.Int) []bool {
-	bits := make([]bool, 255)
-	for i := 0; i < 255; i++ {
-		bits[i] = n.Bit(i) == 1
-	}
-	return bits
+	return Bits(n, 255)
+}
+
+// BigIntToBitsLen returns the n.BitLen() low bits of n, least significant
+// first. Unlike BigIntToBits, the length depends on n, so it must not be used
+// on secret values.
+func BigIntToBitsLen(n *big.Int) []bool {
+	return Bits(n, n.BitLen())
 }
 
 func NegateInField(x *big.Int, p *big.Int) *big.Int {
@@ -116,26 +197,27 @@ func ProjectiveNeg(x *GroupProjective, p *big.Int) *GroupProjective {
 	}
 }
 
+// ProjectiveEqual reports whether g and h represent the same point: both
+// are the point at infinity, or X1·Z2^2 = X2·Z1^2 and Y1·Z2^3 = Y2·Z1^3
+// modulo p. Coordinates need not be reduced.
 func ProjectiveEqual(g, h *GroupProjective, p *big.Int) bool {
-	if (g.Z.Sign() == 0 || h.Z.Sign() == 0) && g.Z.Cmp(h.Z) != 0 {
-		return false
+	gInf := field.Mod(g.Z, p).Sign() == 0
+	hInf := field.Mod(h.Z, p).Sign() == 0
+	if gInf || hInf {
+		return gInf == hInf
 	}
 
 	var gz2 = field.Mod(new(big.Int).Mul(g.Z, g.Z), p)
 	var hz2 = field.Mod(new(big.Int).Mul(h.Z, h.Z), p)
 
-	if field.Mod(new(big.Int).Sub(
-		field.Mod(new(big.Int).Mul(g.X, hz2), p),
-		field.Mod(new(big.Int).Mul(h.X, gz2), p),
-	), p).Sign() != 0 {
+	if field.Mod(new(big.Int).Mul(g.X, hz2), p).Cmp(field.Mod(new(big.Int).Mul(h.X, gz2), p)) != 0 {
 		return false
 	}
 
 	var gz3 = field.Mod(new(big.Int).Mul(g.Z, gz2), p)
 	var hz3 = field.Mod(new(big.Int).Mul(h.Z, hz2), p)
 
-	return field.Mod(new(big.Int).Mul(g.Y, hz3), p) ==
-		field.Mod(new(big.Int).Mul(h.Y, gz3), p)
+	return field.Mod(new(big.Int).Mul(g.Y, hz3), p).Cmp(field.Mod(new(big.Int).Mul(h.Y, gz3), p)) == 0
 }
 
 func ProjectiveOnCurve(g *GroupProjective, p, b, a *big.Int) bool {
@@ -175,6 +257,33 @@ func ProjectiveScale(
 	return h
 }
 
+// projectiveScaleChecked is ProjectiveScale with errors from the group law
+// propagated instead of panicking.
+func projectiveScaleChecked(
+	g *GroupProjective,
+	x, p, a *big.Int,
+) (*GroupProjective, error) {
+	return projectiveScaleBits(g, BigIntToBits(x), p, a)
+}
+
+// projectiveScaleBits returns the multiple of g by the scalar whose bits,
+// least significant first, are bits.
+func projectiveScaleBits(g *GroupProjective, bits []bool, p, a *big.Int) (*GroupProjective, error) {
+	var err error
+	h := projectiveZero
+	for _, bit := range bits {
+		if bit {
+			if h, err = projectiveAdd(h, g, p, a); err != nil {
+				return nil, err
+			}
+		}
+		if g, err = projectiveDouble(g, p, a); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
 func ProjectiveInSubgroup(g *GroupProjective, p, order, a *big.Int) bool {
 	var orderTimesG = ProjectiveScale(g, order, p, a)
 	return ProjectiveEqual(orderTimesG, projectiveZero, p)
@@ -203,7 +312,11 @@ func ProjectiveToAffine(g *GroupProjective, p *big.Int) GroupAffine {
 			Infinity: false,
 		}
 	}
-	zInv := field.Inverse(z, p)
+	zInv, err := field.InverseErr(z, p)
+	if err != nil {
+		// An unreduced Z that is a multiple of p still denotes infinity.
+		return GroupAffine{Infinity: true}
+	}
 	zInvSqrt := field.Mod(new(big.Int).Mul(zInv, zInv), p)
 	x := field.Mod(new(big.Int).Mul(g.X, zInvSqrt), p)
 	y := field.Mod(new(big.Int).Mul(g.Y, field.Mod(new(big.Int).Mul(zInv, zInvSqrt), p)), p)
@@ -215,77 +328,78 @@ func ProjectiveToAffine(g *GroupProjective, p *big.Int) GroupAffine {
 }
 
 func ProjectiveDouble(g *GroupProjective, p, a *big.Int) *GroupProjective {
+	return mustPoint(projectiveDouble(g, p, a))
+}
+
+func projectiveDouble(g *GroupProjective, p, a *big.Int) (*GroupProjective, error) {
 	if a.Sign() == 0 {
-		return ProjectiveDoubleA0(g, p)
+		return projectiveDoubleA0(g, p)
 	}
-	if new(big.Int).Add(a, big.NewInt(3)) == p {
-		return ProjectiveDoubleAminus3(g, p)
+	if field.Mod(new(big.Int).Add(a, big.NewInt(3)), p).Sign() == 0 {
+		return projectiveDoubleAminus3(g, p)
 	}
-
-	panic("Projective doubling is not implemented for general curve parameter a, only a = 0 and a = -3")
-
+	return projectiveDoubleGeneral(g, p, a)
 }
 
 func ProjectiveDoubleA0(g *GroupProjective, p *big.Int) *GroupProjective {
+	return mustPoint(projectiveDoubleA0(g, p))
+}
+
+func projectiveDoubleA0(g *GroupProjective, p *big.Int) (*GroupProjective, error) {
 	if g.Z.Sign() == 0 {
-		return g
+		return g, nil
 	}
 	var X1, Y1, Z1 *big.Int
 	X1, Y1, Z1 = g.X, g.Y, g.Z
 
 	if Y1.Sign() == 0 {
-		panic("Unexpected point at infinity")
-	}
-
-	var A = field.Mod(new(big.Int).Mul(X1, X1), p)
-	var B = field.Mod(new(big.Int).Mul(Y1, Y1), p)
-	var C = field.Mod(new(big.Int).Mul(B, B), p)
-	var D = field.Mod(
-		new(big.Int).Mul(
-			big.NewInt(2),
-			new(big.Int).Sub(
-				new(big.Int).Sub(
-					new(big.Int).Mul(
-						new(big.Int).Add(X1, B),
-						new(big.Int).Add(X1, B),
-					),
-					A,
-				),
-				C,
-			),
-		),
-		p,
-	)
-	var E = field.Mod(new(big.Int).Mul(big.NewInt(3), A), p)
-	var F = field.Mod(new(big.Int).Mul(E, E), p)
-	// X3 = F-2*D
-	var X3 = field.Mod(new(big.Int).Sub(F, new(big.Int).Mul(D, big.NewInt(2))), p)
+		return nil, ErrUnexpectedInfinity
+	}
+
+	// dbl-2009-l, with all intermediates taken from tempPool so that only
+	// the output coordinates are allocated.
+	var A, B, C, D, E, t *big.Int
+	getTemps(&A, &B, &C, &D, &E, &t)
+	defer putTemps(A, B, C, D, E, t)
+
+	// A = X1^2, B = Y1^2, C = B^2
+	reduce(A.Mul(X1, X1), p)
+	reduce(B.Mul(Y1, Y1), p)
+	reduce(C.Mul(B, B), p)
+	// D = 2*((X1+B)^2-A-C)
+	D.Add(X1, B)
+	reduce(D.Mul(D, D).Sub(D, A).Sub(D, C).Lsh(D, 1), p)
+	// E = 3*A
+	reduce(E.Lsh(A, 1).Add(E, A), p)
+	// X3 = E^2-2*D
+	X3 := new(big.Int).Mul(E, E)
+	reduce(X3.Sub(X3, t.Lsh(D, 1)), p)
 	// Y3 = E*(D-X3)-8*C
-	var Y3 = field.Mod(
-		new(big.Int).Sub(
-			new(big.Int).Mul(E, new(big.Int).Sub(D, X3)),
-			new(big.Int).Mul(big.NewInt(8), C),
-		),
-		p,
-	)
+	Y3 := new(big.Int).Sub(D, X3)
+	reduce(Y3.Mul(Y3, E).Sub(Y3, t.Lsh(C, 3)), p)
 	// Z3 = 2*Y1*Z1
-	var Z3 = field.Mod(new(big.Int).Mul(big.NewInt(2), new(big.Int).Mul(Y1, Z1)), p)
+	Z3 := new(big.Int).Mul(Y1, Z1)
+	reduce(Z3.Lsh(Z3, 1), p)
 	return &GroupProjective{
 		X: X3,
 		Y: Y3,
 		Z: Z3,
-	}
+	}, nil
 }
 
 func ProjectiveDoubleAminus3(g *GroupProjective, p *big.Int) *GroupProjective {
+	return mustPoint(projectiveDoubleAminus3(g, p))
+}
+
+func projectiveDoubleAminus3(g *GroupProjective, p *big.Int) (*GroupProjective, error) {
 	if g.Z.Sign() == 0 {
-		return g
+		return g, nil
 	}
 	var X1, Y1, Z1 *big.Int
 	X1, Y1, Z1 = g.X, g.Y, g.Z
 
 	if Y1.Sign() == 0 {
-		panic("Unexpected point at infinity")
+		return nil, ErrUnexpectedInfinity
 	}
 
 	// delta = Z1^2
@@ -298,9 +412,9 @@ func ProjectiveDoubleAminus3(g *GroupProjective, p *big.Int) *GroupProjective {
 	var alpha = field.Mod(
 		new(big.Int).Mul(
 			big.NewInt(3),
-			new(big.Int).Sub(
-				new(big.Int).Add(X1, delta),
+			new(big.Int).Mul(
 				new(big.Int).Sub(X1, delta),
+				new(big.Int).Add(X1, delta),
 			),
 		),
 		p,
@@ -342,144 +456,296 @@ func ProjectiveDoubleAminus3(g *GroupProjective, p *big.Int) *GroupProjective {
 		X: X3,
 		Y: Y3,
 		Z: Z3,
+	}, nil
+}
+
+// ProjectiveDoubleGeneral doubles g on a curve with an arbitrary parameter a
+// (dbl-2007-bl). ProjectiveDouble dispatches to the cheaper formulas when a
+// is 0 or -3.
+func ProjectiveDoubleGeneral(g *GroupProjective, p, a *big.Int) *GroupProjective {
+	return mustPoint(projectiveDoubleGeneral(g, p, a))
+}
+
+func projectiveDoubleGeneral(g *GroupProjective, p, a *big.Int) (*GroupProjective, error) {
+	if g.Z.Sign() == 0 {
+		return g, nil
+	}
+	var X1, Y1, Z1 *big.Int
+	X1, Y1, Z1 = g.X, g.Y, g.Z
+
+	if Y1.Sign() == 0 {
+		return nil, ErrUnexpectedInfinity
 	}
+
+	// XX = X1^2, YY = Y1^2, YYYY = YY^2, ZZ = Z1^2
+	var XX = field.Mod(new(big.Int).Mul(X1, X1), p)
+	var YY = field.Mod(new(big.Int).Mul(Y1, Y1), p)
+	var YYYY = field.Mod(new(big.Int).Mul(YY, YY), p)
+	var ZZ = field.Mod(new(big.Int).Mul(Z1, Z1), p)
+	// S = 2*((X1+YY)^2-XX-YYYY)
+	var X1YY = new(big.Int).Add(X1, YY)
+	var S = field.Mod(
+		new(big.Int).Mul(
+			big.NewInt(2),
+			new(big.Int).Sub(
+				new(big.Int).Mul(X1YY, X1YY),
+				new(big.Int).Add(XX, YYYY),
+			),
+		),
+		p,
+	)
+	// M = 3*XX+a*ZZ^2
+	var M = field.Mod(
+		new(big.Int).Add(
+			new(big.Int).Mul(big.NewInt(3), XX),
+			new(big.Int).Mul(a, new(big.Int).Mul(ZZ, ZZ)),
+		),
+		p,
+	)
+	// X3 = T = M^2-2*S
+	var X3 = field.Mod(
+		new(big.Int).Sub(
+			new(big.Int).Mul(M, M),
+			new(big.Int).Mul(big.NewInt(2), S),
+		),
+		p,
+	)
+	// Y3 = M*(S-T)-8*YYYY
+	var Y3 = field.Mod(
+		new(big.Int).Sub(
+			new(big.Int).Mul(M, new(big.Int).Sub(S, X3)),
+			new(big.Int).Mul(big.NewInt(8), YYYY),
+		),
+		p,
+	)
+	// Z3 = (Y1+Z1)^2-YY-ZZ
+	var Y1Z1 = new(big.Int).Add(Y1, Z1)
+	var Z3 = field.Mod(
+		new(big.Int).Sub(
+			new(big.Int).Mul(Y1Z1, Y1Z1),
+			new(big.Int).Add(YY, ZZ),
+		),
+		p,
+	)
+	return &GroupProjective{
+		X: X3,
+		Y: Y3,
+		Z: Z3,
+	}, nil
 }
 
 func ProjectiveAdd(
 	g, h *GroupProjective,
 	p, a *big.Int,
 ) *GroupProjective {
+	return mustPoint(projectiveAdd(g, h, p, a))
+}
+
+func projectiveAdd(
+	g, h *GroupProjective,
+	p, a *big.Int,
+) (*GroupProjective, error) {
 	if g.Z.Sign() == 0 {
-		return h
+		return h, nil
 	}
 	if h.Z.Sign() == 0 {
-		return g
+		return g, nil
 	}
 	var X1, Y1, Z1, X2, Y2, Z2 *big.Int
 	X1, Y1, Z1 = g.X, g.Y, g.Z
 	X2, Y2, Z2 = h.X, h.Y, h.Z
 
-	var Z1Z1 = field.Mod(new(big.Int).Mul(Z1, Z1), p)
-	var Z2Z2 = field.Mod(new(big.Int).Mul(Z2, Z2), p)
-	var U1 = field.Mod(new(big.Int).Mul(X1, Z2Z2), p)
-	var U2 = field.Mod(new(big.Int).Mul(X2, Z1Z1), p)
-	var S1 = field.Mod(new(big.Int).Mul(Y1, new(big.Int).Mul(Z2, Z2Z2)), p)
-	var S2 = field.Mod(new(big.Int).Mul(Y2, new(big.Int).Mul(Z1, Z1Z1)), p)
-	var H = field.Mod(new(big.Int).Sub(U2, U1), p)
+	// add-2007-bl, with all intermediates taken from tempPool so that only
+	// the output coordinates are allocated.
+	var Z1Z1, Z2Z2, U1, U2, S1, S2, H, I, J, R, V, t *big.Int
+	getTemps(&Z1Z1, &Z2Z2, &U1, &U2, &S1, &S2, &H, &I, &J, &R, &V, &t)
+	defer putTemps(Z1Z1, Z2Z2, U1, U2, S1, S2, H, I, J, R, V, t)
+
+	reduce(Z1Z1.Mul(Z1, Z1), p)
+	reduce(Z2Z2.Mul(Z2, Z2), p)
+	reduce(U1.Mul(X1, Z2Z2), p)
+	reduce(U2.Mul(X2, Z1Z1), p)
+	reduce(S1.Mul(Z2, Z2Z2), p)
+	reduce(S1.Mul(S1, Y1), p)
+	reduce(S2.Mul(Z1, Z1Z1), p)
+	reduce(S2.Mul(S2, Y2), p)
+	reduce(H.Sub(U2, U1), p)
 	if H.Sign() == 0 {
 		if S1.Cmp(S2) == 0 {
-			return ProjectiveDouble(g, p, a)
+			return projectiveDouble(g, p, a)
 		}
-		if field.Mod(new(big.Int).Add(S1, S2), p).Sign() == 0 {
-			return projectiveZero
+		if reduce(t.Add(S1, S2), p).Sign() == 0 {
+			return projectiveZero, nil
 		}
-		panic("Invalid point")
+		return nil, ErrInvalidPoint
 	}
 
 	// I = (2*H)^2
-	var I = field.Mod(
-		new(big.Int).Mul(
-			big.NewInt(4),
-			new(big.Int).Mul(H, H),
-		),
-		p,
-	)
+	I.Lsh(H, 1)
+	reduce(I.Mul(I, I), p)
 	// J = H*I
-	var J = field.Mod(new(big.Int).Mul(H, I), p)
+	reduce(J.Mul(H, I), p)
 	// r = 2*(S2-S1)
-	var R = field.Mod(new(big.Int).Mul(big.NewInt(2), new(big.Int).Sub(S2, S1)), p)
+	reduce(R.Sub(S2, S1).Lsh(R, 1), p)
 	// V = U1*I
-	var V = field.Mod(new(big.Int).Mul(U1, I), p)
+	reduce(V.Mul(U1, I), p)
 	// X3 = r^2-J-2*V
-	var X3 = field.Mod(new(big.Int).Sub(new(big.Int).Sub(new(big.Int).Mul(R, R), J), new(big.Int).Mul(big.NewInt(2), V)), p)
+	X3 := new(big.Int).Mul(R, R)
+	reduce(X3.Sub(X3, J).Sub(X3, t.Lsh(V, 1)), p)
 	// Y3 = r*(V-X3)-2*S1*J
-	var Y3 = field.Mod(new(big.Int).Sub(new(big.Int).Mul(R, new(big.Int).Sub(V, X3)), new(big.Int).Mul(big.NewInt(2), new(big.Int).Mul(S1, J))), p)
+	Y3 := new(big.Int).Sub(V, X3)
+	reduce(Y3.Mul(Y3, R).Sub(Y3, t.Mul(S1, J).Lsh(t, 1)), p)
 	// Z3 = ((Z1+Z2)^2-Z1Z1-Z2Z2)*H
-	var Z3 = field.Mod(
-		new(big.Int).Mul(
-			new(big.Int).Sub(
-				new(big.Int).Mul(
-					new(big.Int).Add(Z1, Z2),
-					new(big.Int).Add(Z1, Z2),
-				),
-				new(big.Int).Add(Z1Z1, Z2Z2),
-			),
-			H,
-		),
-		p,
-	)
+	Z3 := new(big.Int).Add(Z1, Z2)
+	reduce(Z3.Mul(Z3, Z3).Sub(Z3, Z1Z1).Sub(Z3, Z2Z2), p)
+	reduce(Z3.Mul(Z3, H), p)
 	return &GroupProjective{
 		X: X3,
 		Y: Y3,
 		Z: Z3,
-	}
-
+	}, nil
 }
 
-func CreateCurveProjective(params CurveParams) *ProjectiveCurve {
-	curve := &ProjectiveCurve{
+// CreateCurveProjective builds a Curve from its parameters, configured by
+// opts.
+func CreateCurveProjective(params CurveParams, opts ...Option) *Curve {
+	c := &Curve{
 		CurveParams: params,
+		Field:       field.ForModulus(params.Modulus),
+		Zero:        &GroupProjective{X: big.NewInt(1), Y: big.NewInt(1), Z: big.NewInt(0)},
+		One:         &GroupProjective{X: params.Generator.X, Y: params.Generator.Y, Z: big.NewInt(1)},
 	}
-	curve.Field = field.Fp
-
-	curve.Equal = func(g, h *GroupProjective) bool {
-		return ProjectiveEqual(g, h, params.Modulus)
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
+}
 
-	curve.IsOnCurve = func(g *GroupProjective) bool {
-		return ProjectiveOnCurve(g, params.Modulus, params.B, params.A)
-	}
+// Equal reports whether g and h represent the same point, whatever their
+// Z coordinates. See ProjectiveEqual.
+func (c *Curve) Equal(g, h *GroupProjective) bool {
+	return ProjectiveEqual(g, h, c.Modulus)
+}
 
-	curve.IsInSubgroup = func(g *GroupProjective) bool {
-		return ProjectiveInSubgroup(g, params.Modulus, params.Order, params.A)
-	}
+// IsOnCurve reports whether g satisfies the curve equation.
+func (c *Curve) IsOnCurve(g *GroupProjective) bool {
+	return ProjectiveOnCurve(g, c.Modulus, c.B, c.A)
+}
 
-	curve.Add = func(g, h *GroupProjective) *GroupProjective {
-		return ProjectiveAdd(g, h, params.Modulus, params.A)
-	}
+// IsInSubgroup reports whether g lies in the prime-order subgroup.
+func (c *Curve) IsInSubgroup(g *GroupProjective) bool {
+	return ProjectiveInSubgroup(g, c.Modulus, c.Order, c.A)
+}
+
+// Add returns g + h.
+func (c *Curve) Add(g, h *GroupProjective) *GroupProjective {
+	return ProjectiveAdd(g, h, c.Modulus, c.A)
+}
+
+// Double returns 2g.
+func (c *Curve) Double(g *GroupProjective) *GroupProjective {
+	return ProjectiveDouble(g, c.Modulus, c.A)
+}
 
-	curve.Double = func(g *GroupProjective) *GroupProjective {
-		return ProjectiveDouble(g, params.Modulus, params.A)
+// Negate returns -g.
+func (c *Curve) Negate(g *GroupProjective) *GroupProjective {
+	return ProjectiveNeg(g, c.Modulus)
+}
+
+// Sub returns g - h.
+func (c *Curve) Sub(g, h *GroupProjective) *GroupProjective {
+	return ProjectiveAdd(g, ProjectiveNeg(h, c.Modulus), c.Modulus, c.A)
+}
+
+// Scale returns s·g using a double-and-add loop over the low 255 bits of s,
+// or ScaleConstantTime on a curve built with WithConstantTime.
+func (c *Curve) Scale(g *GroupProjective, s *big.Int) *GroupProjective {
+	if c.ConstantTime {
+		return c.ScaleConstantTime(g, s)
 	}
+	return ProjectiveScale(g, s, c.Modulus, c.A)
+}
 
-	curve.Negate = func(g *GroupProjective) *GroupProjective {
-		return ProjectiveNeg(g, params.Modulus)
+// AddChecked returns g + h, or an error instead of panicking when the inputs
+// are malformed.
+func (c *Curve) AddChecked(g, h *GroupProjective) (*GroupProjective, error) {
+	if err := checkPoints(g, h); err != nil {
+		return nil, err
 	}
+	return projectiveAdd(g, h, c.Modulus, c.A)
+}
 
-	curve.Sub = func(g, h *GroupProjective) *GroupProjective {
-		return ProjectiveAdd(g, ProjectiveNeg(h, params.Modulus), params.Modulus, params.A)
+// DoubleChecked returns 2g, or an error instead of panicking when g is
+// malformed.
+func (c *Curve) DoubleChecked(g *GroupProjective) (*GroupProjective, error) {
+	if err := checkPoints(g); err != nil {
+		return nil, err
 	}
+	return projectiveDouble(g, c.Modulus, c.A)
+}
 
-	curve.Scale = func(g *GroupProjective, s *big.Int) *GroupProjective {
-		// println("Scale g:", g.X.String(), g.Y.String(), g.Z.String())
-		// println("Scale s:", s.String())
-		// println("Scale p:", params.Modulus.String())
-		// println("Scale a:", params.A.String())
+// ScaleChecked returns s·g, or an error instead of panicking when g is
+// malformed.
+func (c *Curve) ScaleChecked(g *GroupProjective, s *big.Int) (*GroupProjective, error) {
+	if err := checkPoints(g); err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, errcode.New(errcode.MissingValue, "curve: nil scalar")
+	}
+	return projectiveScaleChecked(g, s, c.Modulus, c.A)
+}
 
-		bits := BigIntToBits(s)
-		h := &GroupProjective{X: big.NewInt(1), Y: big.NewInt(1), Z: big.NewInt(0)}
-		tmp := &GroupProjective{X: g.X, Y: g.Y, Z: g.Z}
-		for _, bit := range bits {
-			if bit {
-				h = ProjectiveAdd(h, tmp, params.Modulus, params.A)
-			}
-			tmp = ProjectiveDouble(tmp, params.Modulus, params.A)
-			// println("h:", h.X.String(), h.Y.String(), h.Z.String())
-			// println("tmp:", tmp.X.String(), tmp.Y.String(), tmp.Z.String())
+// ScaleVartime returns s·g with a double-and-add loop that stops at the bit
+// length of s. Its running time reveals the size of s, so it is meant for
+// public scalars such as verification challenges; use ScaleConstantTime for
+// secrets.
+func (c *Curve) ScaleVartime(g *GroupProjective, s *big.Int) *GroupProjective {
+	h := projectiveZero
+	for _, bit := range BigIntToBitsLen(s) {
+		if bit {
+			h = ProjectiveAdd(h, g, c.Modulus, c.A)
 		}
-		return h
+		g = ProjectiveDouble(g, c.Modulus, c.A)
 	}
+	return h
+}
 
-	curve.ToAffine = func(g *GroupProjective) GroupAffine {
-		return ProjectiveToAffine(g, params.Modulus)
-	}
+// ScaleConstantTime returns s·g with a Montgomery ladder over exactly
+// c.Order.BitLen() bits of s mod order, performing one addition and one
+// doubling per bit whatever their value. The ladder pair is conditionally
+// swapped with masked selection (field.Select) instead of branching on the
+// bit, which fixes both the sequence of group operations and which register
+// feeds them; the underlying big.Int arithmetic is not itself constant-time.
+func (c *Curve) ScaleConstantTime(g *GroupProjective, s *big.Int) *GroupProjective {
+	k := new(big.Int).Mod(s, c.Order)
+	r0 := projectiveZero
+	r1 := &GroupProjective{X: c.Field.Mod(g.X), Y: c.Field.Mod(g.Y), Z: c.Field.Mod(g.Z)}
+	for i := c.Order.BitLen() - 1; i >= 0; i-- {
+		bit := int(k.Bit(i))
+		r0, r1 = selectPoint(bit, r1, r0), selectPoint(bit, r0, r1)
+		r0, r1 = ProjectiveDouble(r0, c.Modulus, c.A), ProjectiveAdd(r0, r1, c.Modulus, c.A)
+		r0, r1 = selectPoint(bit, r1, r0), selectPoint(bit, r0, r1)
+	}
+	return r0
+}
 
-	curve.FromAffine = func(a GroupAffine) *GroupProjective {
-		return ProjectiveFromAffine(a)
+// selectPoint returns a copy of a if flag == 1 and of b if flag == 0, chosen
+// coordinate by coordinate with field.Select. Coordinates must be reduced.
+func selectPoint(flag int, a, b *GroupProjective) *GroupProjective {
+	return &GroupProjective{
+		X: field.Select(flag, a.X, b.X),
+		Y: field.Select(flag, a.Y, b.Y),
+		Z: field.Select(flag, a.Z, b.Z),
 	}
+}
 
-	curve.Zero = &GroupProjective{X: big.NewInt(1), Y: big.NewInt(1), Z: big.NewInt(0)}
-	curve.One = &GroupProjective{X: params.Generator.X, Y: params.Generator.Y, Z: big.NewInt(1)}
+// ToAffine converts g to affine coordinates.
+func (c *Curve) ToAffine(g *GroupProjective) GroupAffine {
+	return ProjectiveToAffine(g, c.Modulus)
+}
 
-	return curve
+// FromAffine converts an affine point to Jacobian coordinates.
+func (c *Curve) FromAffine(a GroupAffine) *GroupProjective {
+	return ProjectiveFromAffine(a)
 }
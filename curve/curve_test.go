@@ -0,0 +1,315 @@
+package curve
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+func TestPallasGeneratorOnCurve(t *testing.T) {
+	pallas := NewPallasCurve()
+	if !pallas.IsOnCurve(pallas.One) {
+		t.Fatal("Pallas generator is not on the curve")
+	}
+}
+
+// sameAffine compares two points through their affine coordinates.
+func sameAffine(c *Curve, g, h *GroupProjective) bool {
+	ga, ha := c.ToAffine(g), c.ToAffine(h)
+	if ga.Infinity || ha.Infinity {
+		return ga.Infinity == ha.Infinity
+	}
+	return ga.X.Cmp(ha.X) == 0 && ga.Y.Cmp(ha.Y) == 0
+}
+
+func TestCurveMethods(t *testing.T) {
+	pallas := NewPallasCurve()
+	g := pallas.One
+
+	double := pallas.Double(g)
+	sum := pallas.Add(g, g)
+	if !sameAffine(pallas, double, sum) {
+		t.Error("Double(G) != Add(G, G)")
+	}
+
+	three := pallas.Scale(g, big.NewInt(3))
+	if !sameAffine(pallas, three, pallas.Add(double, g)) {
+		t.Error("Scale(G, 3) != 2G + G")
+	}
+	if !sameAffine(pallas, pallas.Sub(three, g), double) {
+		t.Error("3G - G != 2G")
+	}
+	if !sameAffine(pallas, pallas.Add(g, pallas.Negate(g)), pallas.Zero) {
+		t.Error("G + (-G) is not the point at infinity")
+	}
+
+	aff := pallas.ToAffine(three)
+	if !sameAffine(pallas, pallas.FromAffine(aff), three) {
+		t.Error("affine round trip changed the point")
+	}
+}
+
+// The deprecated ProjectiveCurve name must keep supporting method values.
+func TestProjectiveCurveCompat(t *testing.T) {
+	var c *ProjectiveCurve = NewPallasCurve()
+	scale := c.Scale
+	if !c.IsOnCurve(scale(c.One, big.NewInt(42))) {
+		t.Error("42G is not on the curve")
+	}
+}
+
+func TestCurveSingletons(t *testing.T) {
+	if Pallas() != Pallas() || Vesta() != Vesta() {
+		t.Fatal("Pallas()/Vesta() must return the same instance on every call")
+	}
+	if Pallas().Modulus.Cmp(NewPallasCurve().Modulus) != 0 {
+		t.Error("Pallas() modulus differs from NewPallasCurve()")
+	}
+	if Vesta().Modulus.Cmp(NewVestaCurve().Modulus) != 0 {
+		t.Error("Vesta() modulus differs from NewVestaCurve()")
+	}
+}
+
+func TestCheckedArithmetic(t *testing.T) {
+	pallas := Pallas()
+	g := pallas.One
+	bogus := &GroupProjective{X: g.X, Y: new(big.Int).Add(g.Y, big.NewInt(1)), Z: big.NewInt(1)}
+
+	if _, err := pallas.AddChecked(g, bogus); err != ErrInvalidPoint {
+		t.Errorf("AddChecked with inconsistent points: got %v, want ErrInvalidPoint", err)
+	}
+	if _, err := pallas.DoubleChecked(&GroupProjective{X: big.NewInt(1), Y: big.NewInt(0), Z: big.NewInt(1)}); err != ErrUnexpectedInfinity {
+		t.Errorf("DoubleChecked with y = 0: got %v, want ErrUnexpectedInfinity", err)
+	}
+	if _, err := pallas.ScaleChecked(&GroupProjective{X: g.X}, big.NewInt(2)); err != ErrNilPoint {
+		t.Errorf("ScaleChecked with nil coordinates: got %v, want ErrNilPoint", err)
+	}
+
+	got, err := pallas.ScaleChecked(g, big.NewInt(5))
+	if err != nil {
+		t.Fatalf("ScaleChecked(G, 5) failed: %v", err)
+	}
+	if !sameAffine(pallas, got, pallas.Scale(g, big.NewInt(5))) {
+		t.Error("ScaleChecked(G, 5) != Scale(G, 5)")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Add with inconsistent points should still panic")
+		}
+	}()
+	pallas.Add(g, bogus)
+}
+
+// affineDouble doubles (x, y) with the textbook affine formula.
+func affineDouble(x, y, a, p *big.Int) (*big.Int, *big.Int) {
+	num := new(big.Int).Mul(big.NewInt(3), new(big.Int).Mul(x, x))
+	num.Add(num, a)
+	den := new(big.Int).ModInverse(new(big.Int).Mul(big.NewInt(2), y), p)
+	lambda := new(big.Int).Mod(new(big.Int).Mul(num, den), p)
+	x3 := new(big.Int).Mul(lambda, lambda)
+	x3.Sub(x3, new(big.Int).Mul(big.NewInt(2), x))
+	x3.Mod(x3, p)
+	y3 := new(big.Int).Mul(lambda, new(big.Int).Sub(x, x3))
+	y3.Sub(y3, y)
+	y3.Mod(y3, p)
+	return x3, y3
+}
+
+func TestDoubleForNonzeroA(t *testing.T) {
+	p := Pallas().Modulus
+	for _, tc := range []struct {
+		name string
+		a    *big.Int
+	}{
+		{"a = -3", new(big.Int).Sub(p, big.NewInt(3))},
+		{"a = 7", big.NewInt(7)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// (1, 1) lies on y^2 = x^3 + ax - a.
+			b := new(big.Int).Sub(p, tc.a)
+			b.Mod(b, p)
+			gen := &GroupProjective{X: big.NewInt(1), Y: big.NewInt(1), Z: big.NewInt(1)}
+			c := CreateCurveProjective(CurveParams{
+				Name:      "test",
+				Modulus:   p,
+				Order:     p,
+				Generator: gen,
+				A:         tc.a,
+				B:         b,
+			})
+			if !c.IsOnCurve(c.One) {
+				t.Fatal("test generator is not on the curve")
+			}
+
+			g := c.One
+			for i := 0; i < 4; i++ {
+				wantX, wantY := affineDouble(c.ToAffine(g).X, c.ToAffine(g).Y, tc.a, p)
+				g = c.Double(g)
+				if !c.IsOnCurve(g) {
+					t.Fatalf("2^%d·G is not on the curve", i+1)
+				}
+				got := c.ToAffine(g)
+				if got.X.Cmp(wantX) != 0 || got.Y.Cmp(wantY) != 0 {
+					t.Fatalf("2^%d·G does not match the affine doubling formula", i+1)
+				}
+			}
+
+			if !sameAffine(c, c.Scale(c.One, big.NewInt(6)), c.Add(c.Double(c.One), c.Scale(c.One, big.NewInt(4)))) {
+				t.Error("6G != 2G + 4G")
+			}
+		})
+	}
+}
+
+func TestCurveFieldMatchesModulus(t *testing.T) {
+	if Pallas().Field != field.Fp {
+		t.Error("Pallas curve must use Fp")
+	}
+	if Vesta().Field != field.Fq {
+		t.Error("Vesta curve must use Fq")
+	}
+
+	p := big.NewInt(1000003)
+	c := CreateCurveProjective(CurveParams{
+		Name:      "small",
+		Modulus:   p,
+		Order:     p,
+		Generator: &GroupProjective{X: big.NewInt(1), Y: big.NewInt(1), Z: big.NewInt(1)},
+		A:         big.NewInt(0),
+		B:         big.NewInt(0),
+	})
+	if c.Field.Modulus.Cmp(p) != 0 {
+		t.Fatalf("custom curve field modulus = %v, want %v", c.Field.Modulus, p)
+	}
+	// 4 is a square mod p, so Sqrt must find one of ±2.
+	if r := c.Field.Sqrt(big.NewInt(4)); r == nil || c.Field.Square(r).Cmp(big.NewInt(4)) != 0 {
+		t.Errorf("custom field Sqrt(4) = %v", r)
+	}
+}
+
+func TestVestaGenerator(t *testing.T) {
+	vesta := Vesta()
+	g := vesta.One
+	if !vesta.IsOnCurve(g) {
+		t.Fatal("Vesta generator is not on the curve")
+	}
+	aff := vesta.ToAffine(g)
+	if !vesta.Field.Equal(vesta.Field.Square(aff.Y), vesta.Field.Add(vesta.Field.Power(aff.X, big.NewInt(3)), vesta.B)) {
+		t.Error("Vesta generator does not satisfy y^2 = x^3 + 5 in Fq")
+	}
+	if !vesta.IsOnCurve(vesta.Scale(g, big.NewInt(1234567))) {
+		t.Error("1234567·G is not on Vesta")
+	}
+	if !vesta.ToAffine(vesta.Scale(g, vesta.Order)).Infinity {
+		t.Error("order·G is not the point at infinity on Vesta")
+	}
+	if !Pallas().ToAffine(Pallas().Scale(Pallas().One, Pallas().Order)).Infinity {
+		t.Error("order·G is not the point at infinity on Pallas")
+	}
+}
+
+func TestScaleVariants(t *testing.T) {
+	pallas := Pallas()
+	g := pallas.Scale(pallas.One, big.NewInt(271828))
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(1 << 40),
+		new(big.Int).Sub(pallas.Order, big.NewInt(1)),
+		StrToBigInt("12345678901234567890123456789012345678901234567890"),
+	}
+	for _, s := range scalars {
+		want := pallas.Scale(g, s)
+		if !sameAffine(pallas, pallas.ScaleVartime(g, s), want) {
+			t.Errorf("ScaleVartime(g, %v) != Scale(g, %v)", s, s)
+		}
+		if !sameAffine(pallas, pallas.ScaleConstantTime(g, s), want) {
+			t.Errorf("ScaleConstantTime(g, %v) != Scale(g, %v)", s, s)
+		}
+	}
+
+	if got := len(BigIntToBitsLen(big.NewInt(5))); got != 3 {
+		t.Errorf("len(BigIntToBitsLen(5)) = %d, want 3", got)
+	}
+	if got := len(BigIntToBits(big.NewInt(5))); got != 255 {
+		t.Errorf("len(BigIntToBits(5)) = %d, want 255", got)
+	}
+}
+
+func TestToAffineUnreducedInfinity(t *testing.T) {
+	pallas := Pallas()
+	// Z = p is a non-canonical zero; it has no inverse and must not panic.
+	g := &GroupProjective{X: big.NewInt(1), Y: big.NewInt(1), Z: new(big.Int).Set(pallas.Modulus)}
+	if !pallas.ToAffine(g).Infinity {
+		t.Error("ToAffine with Z = p is not the point at infinity")
+	}
+	xyzz := pallas.XYZZ()
+	if !xyzz.ToAffine(&GroupXYZZ{X: big.NewInt(1), Y: big.NewInt(1), ZZ: pallas.Modulus, ZZZ: pallas.Modulus}).Infinity {
+		t.Error("XYZZ ToAffine with ZZ = p is not the point at infinity")
+	}
+	out := pallas.batchToAffine([]*GroupProjective{pallas.One, g})
+	want := pallas.ToAffine(pallas.One)
+	if out[0].X.Cmp(want.X) != 0 || out[0].Y.Cmp(want.Y) != 0 {
+		t.Error("batchToAffine changed the generator")
+	}
+	if !out[1].Infinity {
+		t.Error("batchToAffine with Z = p is not the point at infinity")
+	}
+}
+
+// rescale returns the Jacobian representation (l^2 X, l^3 Y, l Z) of g.
+func rescale(c *Curve, g *GroupProjective, l *big.Int) *GroupProjective {
+	F := c.Field
+	l2 := F.Square(l)
+	return &GroupProjective{X: F.Mul(g.X, l2), Y: F.Mul(g.Y, F.Mul(l2, l)), Z: F.Mul(g.Z, l)}
+}
+
+func TestEqual(t *testing.T) {
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		for i := 0; i < 20; i++ {
+			k, _ := rand.Int(rand.Reader, c.Order)
+			g := c.Scale(c.One, k)
+			l, _ := rand.Int(rand.Reader, c.Modulus)
+			if k.Sign() == 0 || l.Sign() == 0 {
+				continue
+			}
+			h := rescale(c, g, l)
+			if !c.Equal(g, h) || !c.Equal(h, g) {
+				t.Fatalf("%s: point differs from its rescaling", c.Name)
+			}
+			if c.Equal(g, c.Negate(h)) {
+				t.Fatalf("%s: point equals its negation", c.Name)
+			}
+			if c.Equal(g, c.Add(h, c.One)) {
+				t.Fatalf("%s: point equals a different point", c.Name)
+			}
+			if c.Equal(g, c.Zero) || c.Equal(c.Zero, g) {
+				t.Fatalf("%s: finite point equals infinity", c.Name)
+			}
+			if !c.ToAffine(g).Equal(c.ToAffine(h)) {
+				t.Fatalf("%s: affine forms differ", c.Name)
+			}
+		}
+
+		// Infinity has many representations.
+		other := &GroupProjective{X: big.NewInt(5), Y: big.NewInt(7), Z: new(big.Int).Set(c.Modulus)}
+		if !c.Equal(c.Zero, other) || !c.Equal(c.Zero, c.Sub(c.One, c.One)) {
+			t.Errorf("%s: representations of infinity differ", c.Name)
+		}
+		if !c.IsInSubgroup(c.One) {
+			t.Errorf("%s: generator is not in the subgroup", c.Name)
+		}
+	}
+
+	inf := GroupAffine{Infinity: true}
+	if !inf.Equal(GroupAffine{X: big.NewInt(1), Y: big.NewInt(2), Infinity: true}) {
+		t.Error("affine points at infinity differ")
+	}
+	if inf.Equal(GroupAffine{X: big.NewInt(0), Y: big.NewInt(0)}) {
+		t.Error("affine infinity equals a finite point")
+	}
+}
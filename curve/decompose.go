@@ -0,0 +1,45 @@
+package curve
+
+import "math/big"
+
+// The functions below, together with WNAF, are the scalar decompositions used
+// by the multiplication code. The scalar package exposes them as methods so
+// callers outside this package recode scalars exactly the same way.
+
+// Bits returns the n low bits of k, least significant first. The length is
+// fixed by n, not by k, so the result can be used for secret values.
+func Bits(k *big.Int, n int) []bool {
+	bits := make([]bool, n)
+	for i := range bits {
+		bits[i] = k.Bit(i) == 1
+	}
+	return bits
+}
+
+// Windows splits the n low bits of a non-negative k into ceil(n/w) unsigned
+// w-bit digits, least significant first, so that k mod 2^n = Σ d_i·2^(w·i).
+// Like Bits, the number of digits depends only on n and w.
+func Windows(k *big.Int, w uint, n int) []int {
+	if w < 1 || w > 16 {
+		panic("curve: window width must be between 1 and 16")
+	}
+	digits := make([]int, (n+int(w)-1)/int(w))
+	for i := range digits {
+		d := 0
+		for j := int(w) - 1; j >= 0; j-- {
+			bit := i*int(w) + j
+			d <<= 1
+			if bit < n {
+				d |= int(k.Bit(bit))
+			}
+		}
+		digits[i] = d
+	}
+	return digits
+}
+
+// NAF returns the non-adjacent form of k, the width-2 case of WNAF: every
+// digit is -1, 0 or 1 and no two adjacent digits are non-zero.
+func NAF(k *big.Int) []int {
+	return WNAF(k, 2)
+}
@@ -0,0 +1,173 @@
+package curve
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+//go:generate go run gen_generator_table.go
+
+// generatorTableSize is the number of precomputed doublings of the
+// generator, one per bit of a reduced scalar.
+const generatorTableSize = 255
+
+// affineLimbs is an affine point (x, y) with each coordinate stored as four
+// little-endian 64-bit limbs, so the generated tables are plain constant
+// data and cost nothing at package initialisation.
+type affineLimbs [2][4]uint64
+
+// fixedBaseTable lazily expands a generated limb table into points the first
+// time it is used.
+type fixedBaseTable struct {
+	limbs  *[generatorTableSize]affineLimbs
+	once   sync.Once
+	points []*GroupProjective
+}
+
+func newFixedBaseTable(limbs *[generatorTableSize]affineLimbs) *fixedBaseTable {
+	return &fixedBaseTable{limbs: limbs}
+}
+
+// get returns the table entries 2^i·G as projective points with Z = 1.
+func (t *fixedBaseTable) get() []*GroupProjective {
+	t.once.Do(func() {
+		t.points = make([]*GroupProjective, len(t.limbs))
+		for i, p := range t.limbs {
+			t.points[i] = &GroupProjective{X: limbsToBigInt(p[0]), Y: limbsToBigInt(p[1]), Z: big.NewInt(1)}
+		}
+	})
+	return t.points
+}
+
+// limbsToBigInt converts little-endian 64-bit limbs to a big.Int.
+func limbsToBigInt(limbs [4]uint64) *big.Int {
+	be := make([]byte, 8*len(limbs))
+	for i, limb := range limbs {
+		for j := 0; j < 8; j++ {
+			be[len(be)-1-8*i-j] = byte(limb >> (8 * j))
+		}
+	}
+	return new(big.Int).SetBytes(be)
+}
+
+// ScaleBase returns s·G for the curve generator G. On Pallas and Vesta it adds
+// entries of an embedded table of 2^i·G, so no doublings are performed at
+// runtime; other curves fall back to Scale. The additions depend on the bits
+// of s, so ScaleBase is meant for public scalars such as the s component of a
+// signature being verified. On a curve built with WithConstantTime it runs
+// ScaleConstantTime instead.
+func (c *Curve) ScaleBase(s *big.Int) *GroupProjective {
+	k := new(big.Int).Mod(s, c.Order)
+	if c.ConstantTime {
+		return c.ScaleConstantTime(c.One, k)
+	}
+	if c.baseTable == nil {
+		return c.Scale(c.One, k)
+	}
+	table := c.baseTable.get()
+	h := projectiveZero
+	for i := 0; i < k.BitLen(); i++ {
+		if k.Bit(i) == 1 {
+			h = ProjectiveAdd(h, table[i], c.Modulus, c.A)
+		}
+	}
+	return h
+}
+
+// FixedBaseTable holds multiples of a point g at every window position,
+// (j+1)·2^(w·i)·g for 0 ≤ j < 2^(w-1), so that ScaleFixedBase computes k·g
+// with one addition per w bits of k and no doublings, as ScaleBase does for
+// the generator. It suits a point multiplied many times, such as a public
+// key that signs much of what a service verifies. A table of width 5 holds
+// 832 points, about 270 KiB. A FixedBaseTable is read-only once built and
+// may be shared between goroutines.
+type FixedBaseTable struct {
+	width uint
+	rows  [][]*GroupProjective
+}
+
+// NewFixedBaseTable precomputes the multiples of g for windows of width w,
+// from 1 to 8. Building the table costs about as much as a few scalar
+// multiplications.
+func (c *Curve) NewFixedBaseTable(g *GroupProjective, w uint) (*FixedBaseTable, error) {
+	if err := checkPoints(g); err != nil {
+		return nil, err
+	}
+	if w < 1 || w > 8 {
+		return nil, errcode.Errorf(errcode.OutOfRange, "curve: fixed-base window width %d, want 1 to 8", w)
+	}
+	p, a := c.Modulus, c.A
+	// The recoding in ScaleFixedBase may carry into one window past the
+	// bits of the order.
+	rows := make([][]*GroupProjective, (c.Order.BitLen()+int(w)-1)/int(w)+1)
+	var points []*GroupProjective
+	base := g
+	for i := range rows {
+		row := make([]*GroupProjective, 1<<(w-1))
+		row[0] = base
+		for j := 1; j < len(row); j++ {
+			var err error
+			if row[j], err = projectiveAdd(row[j-1], base, p, a); err != nil {
+				return nil, err
+			}
+		}
+		rows[i] = row
+		points = append(points, row...)
+		for range w {
+			var err error
+			if base, err = projectiveDouble(base, p, a); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Normalizing to Z = 1 with one batched inversion keeps the table
+	// small.
+	affine := c.batchToAffine(points)
+	for i, row := range rows {
+		for j := range row {
+			row[j] = ProjectiveFromAffine(affine[i*len(row)+j])
+		}
+	}
+	return &FixedBaseTable{width: w, rows: rows}, nil
+}
+
+// ScaleFixedBase returns k·g for the point g of t, which must have been
+// built by the same curve. k is reduced modulo the order and recoded into
+// signed digits of w bits, so it costs one addition per window. Like
+// ScaleBase, it runs in time that depends on k and is meant for public
+// scalars.
+func (c *Curve) ScaleFixedBase(t *FixedBaseTable, k *big.Int) (*GroupProjective, error) {
+	if k == nil {
+		return nil, errcode.New(errcode.MissingValue, "curve: nil scalar")
+	}
+	n := new(big.Int).Mod(k, c.Order)
+	p, a := c.Modulus, c.A
+	w := t.width
+	window := int64(1) << w
+	mask := big.NewInt(window - 1)
+	digit := new(big.Int)
+	h := projectiveZero
+	carry := int64(0)
+	for i := 0; n.Sign() > 0 || carry != 0; i++ {
+		d := digit.And(n, mask).Int64() + carry
+		n.Rsh(n, w)
+		carry = 0
+		if d > window/2 {
+			d -= window
+			carry = 1
+		}
+		var err error
+		switch {
+		case d > 0:
+			h, err = projectiveAdd(h, t.rows[i][d-1], p, a)
+		case d < 0:
+			h, err = projectiveAdd(h, ProjectiveNeg(t.rows[i][-d-1], p), p, a)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
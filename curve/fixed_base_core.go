@@ -0,0 +1,11 @@
+//go:build tinygo || minasigner_core
+
+package curve
+
+// The reduced build leaves out the generated generator tables, 16 KB of
+// constants per curve that ScaleBase expands into about as much heap, so
+// ScaleBase falls back to Scale.
+
+func pallasBaseTable() *fixedBaseTable { return nil }
+
+func vestaBaseTable() *fixedBaseTable { return nil }
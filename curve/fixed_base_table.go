@@ -0,0 +1,7 @@
+//go:build !tinygo && !minasigner_core
+
+package curve
+
+func pallasBaseTable() *fixedBaseTable { return newFixedBaseTable(&pallasGeneratorTable) }
+
+func vestaBaseTable() *fixedBaseTable { return newFixedBaseTable(&vestaGeneratorTable) }
@@ -0,0 +1,99 @@
+package curve
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestGeneratorTableMatchesDoublings(t *testing.T) {
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		if c.baseTable == nil {
+			t.Skip("generator tables are not built with this build tag")
+		}
+		table := c.baseTable.get()
+		g := c.One
+		for i, entry := range table {
+			if !sameAffine(c, entry, g) {
+				t.Fatalf("%s: table entry %d is not 2^%d·G; rerun go generate", c.Name, i, i)
+			}
+			g = c.Double(g)
+		}
+	}
+}
+
+func TestScaleBase(t *testing.T) {
+	custom := CreateCurveProjective(Pallas().CurveParams)
+	custom.Name = "custom"
+	for _, c := range []*Curve{Pallas(), Vesta(), custom} {
+		scalars := []*big.Int{
+			big.NewInt(0),
+			big.NewInt(1),
+			big.NewInt(987654321),
+			new(big.Int).Sub(c.Order, big.NewInt(1)),
+			new(big.Int).Add(c.Order, big.NewInt(5)),
+			big.NewInt(-3),
+		}
+		for _, s := range scalars {
+			want := c.Scale(c.One, new(big.Int).Mod(s, c.Order))
+			if !sameAffine(c, c.ScaleBase(s), want) {
+				t.Errorf("%s: ScaleBase(%s) != Scale(G, %s)", c.Name, s, s)
+			}
+		}
+	}
+}
+
+func TestWithConstantTime(t *testing.T) {
+	pallas := Pallas()
+	ct := NewPallasCurve(WithConstantTime(true), WithCoordinates(CoordinatesXYZZ))
+	if !ct.ConstantTime || ct.Coordinates != CoordinatesXYZZ {
+		t.Fatalf("options not applied: ConstantTime %v, Coordinates %s", ct.ConstantTime, ct.Coordinates)
+	}
+	g := pallas.Scale(pallas.One, big.NewInt(7))
+	for _, s := range []*big.Int{big.NewInt(0), big.NewInt(987654321), new(big.Int).Sub(pallas.Order, big.NewInt(1))} {
+		if !sameAffine(pallas, ct.ScaleBase(s), pallas.ScaleBase(s)) {
+			t.Errorf("ScaleBase(%s) differs from the default curve", s)
+		}
+		if !sameAffine(pallas, ct.Scale(g, s), pallas.Scale(g, s)) {
+			t.Errorf("Scale(%s) differs from the default curve", s)
+		}
+	}
+}
+
+func TestScaleFixedBase(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		g := c.Scale(c.One, big.NewInt(424242))
+		orig := *g
+		for _, w := range []uint{1, 4, 5, 8} {
+			table, err := c.NewFixedBaseTable(g, w)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ks := []*big.Int{
+				big.NewInt(0), big.NewInt(1), big.NewInt(15), big.NewInt(16), big.NewInt(17), big.NewInt(-3),
+				new(big.Int).Sub(c.Order, big.NewInt(1)),
+				new(big.Int).Lsh(big.NewInt(1), 254),
+				new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1)),
+			}
+			for i := 0; i < 10; i++ {
+				ks = append(ks, new(big.Int).Rand(rng, c.Order))
+			}
+			for _, k := range ks {
+				got, err := c.ScaleFixedBase(table, k)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !sameAffine(c, got, c.Scale(g, new(big.Int).Mod(k, c.Order))) {
+					t.Fatalf("%s: ScaleFixedBase(width %d, %v) != Scale", c.Name, w, k)
+				}
+			}
+		}
+		if !c.Equal(g, &orig) || g.Z.Cmp(orig.Z) != 0 {
+			t.Errorf("%s: NewFixedBaseTable modified its point", c.Name)
+		}
+		if _, err := c.NewFixedBaseTable(g, 9); err == nil {
+			t.Errorf("%s: NewFixedBaseTable accepted width 9", c.Name)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package curve
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzUnmarshalArkworks(f *testing.F) {
+	pallas := Pallas()
+	for _, compressed := range []bool{true, false} {
+		g, err := pallas.MarshalArkworks(pallas.One, compressed)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(g, compressed)
+		inf, err := pallas.MarshalArkworks(pallas.Zero, compressed)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(inf, compressed)
+	}
+	f.Fuzz(func(t *testing.T, data []byte, compressed bool) {
+		g, err := pallas.UnmarshalArkworks(data, compressed)
+		if err != nil {
+			return
+		}
+		if !pallas.ToAffine(g).Infinity && !pallas.IsOnCurve(g) {
+			t.Fatal("decoded a point off the curve")
+		}
+		out, err := pallas.MarshalArkworks(g, compressed)
+		if err != nil || !bytes.Equal(out, data) {
+			t.Fatalf("round trip: %x, %v", out, err)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+//go:build ignore
+
+// gen_generator_table writes generator_table.go, the tables of 2^i·G used by
+// Curve.ScaleBase. Run it with go generate from the curve directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math/big"
+	"os"
+
+	"github.com/node101-io/mina-signer-go/curve"
+)
+
+const tableSize = 255
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_generator_table.go; DO NOT EDIT.\n\n")
+	buf.WriteString("//go:build !tinygo && !minasigner_core\n\n")
+	buf.WriteString("package curve\n")
+	writeTable(&buf, "pallasGeneratorTable", curve.NewPallasCurve())
+	writeTable(&buf, "vestaGeneratorTable", curve.NewVestaCurve())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("generator_table.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeTable(buf *bytes.Buffer, name string, c *curve.Curve) {
+	fmt.Fprintf(buf, "\n// %s holds 2^i·G for the %s generator G, i = 0..%d.\n", name, c.Name, tableSize-1)
+	fmt.Fprintf(buf, "var %s = [generatorTableSize]affineLimbs{\n", name)
+	g := c.One
+	for i := 0; i < tableSize; i++ {
+		aff := c.ToAffine(g)
+		fmt.Fprintf(buf, "{%s, %s},\n", limbs(aff.X), limbs(aff.Y))
+		g = c.Double(g)
+	}
+	buf.WriteString("}\n")
+}
+
+// limbs formats v as four little-endian 64-bit limbs.
+func limbs(v *big.Int) string {
+	mask := new(big.Int).SetUint64(^uint64(0))
+	out := "{"
+	for i := 0; i < 4; i++ {
+		limb := new(big.Int).Rsh(v, uint(64*i))
+		if i > 0 {
+			out += ", "
+		}
+		out += fmt.Sprintf("0x%016x", limb.And(limb, mask).Uint64())
+	}
+	return out + "}"
+}
@@ -0,0 +1,523 @@
+// Code generated by gen_generator_table.go; DO NOT EDIT.
+
+//go:build !tinygo && !minasigner_core
+
+package curve
+
+// pallasGeneratorTable holds 2^i·G for the Pallas generator G, i = 0..254.
+var pallasGeneratorTable = [generatorTableSize]affineLimbs{
+	{{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, {0x19cf7a23caed2abb, 0x8f655bd4333d4771, 0x53dfa9f06378ee54, 0x1b74b5a30a12937c}},
+	{{0x3fbc3e941fffffff, 0x156c1f9d85d01bb1, 0x0000000000000000, 0x2800000000000000}, {0xe23563c65a3e7950, 0xf8590375f1009580, 0x432b1d955dce6e12, 0x088fe18ff4899d4d}},
+	{{0x22bc49f42e9c8ff8, 0x059cb843bf5e57c9, 0x89854a0cb1b810ed, 0x1e3b3d5af9a723f7}, {0xb309bb0f5a32e074, 0x5aa4c194b0827eb8, 0xd4271b71e9394726, 0x2be437cf8a08a5ed}},
+	{{0xe70855a2b8fae987, 0xde5f6030be1b06d1, 0x919f9d26e8f5d2dc, 0x1a9f4573921cf6ce}, {0x2d3e73f390e99109, 0x0a900c5e73d93946, 0xa6e4667df3dc1b87, 0x120cab91d9a1a00d}},
+	{{0xcff013cfb5e09bbc, 0xb76f4a2c3b580937, 0x080c5a8c8f9718ef, 0x2e0e0e047cc5f828}, {0x4c5d1d3afab5d28c, 0x91107a571f793f8c, 0x36a15c039f43208a, 0x2f5bffd194fa5934}},
+	{{0x462f2e5163d19c08, 0xe3e30dd4294eadd9, 0xbd027286eb1b57ab, 0x2b0c4f1098ca60d7}, {0xcfef2f0164008b8e, 0x9acef70f343fb8d4, 0xad5fd1feb2a8e321, 0x350b983641025812}},
+	{{0x47d88d9539747c7e, 0x6223451d492e155f, 0x3c91124703fcc6aa, 0x2500f167600b873a}, {0x1b58d6cdce743b3f, 0x17cd0ec13381d580, 0x9a8686e24c1e7e7b, 0x3971b0628073cd19}},
+	{{0xce39d260fcd9e4e8, 0x6fd1bc30631bc051, 0x111de67635f79f3d, 0x26fd4eee9453fbb7}, {0x71f5317118932f48, 0x7ac0e8e261a32dcf, 0x471588e921e75c86, 0x3aaa50a823905949}},
+	{{0xfe1245d4f8d0fe89, 0xc00aa276acae4ab8, 0x1a3535af4f367634, 0x1c34d5eae586a8bb}, {0x0c147775c50e4868, 0xec8d59a43e5214c1, 0xf69ab60262d2072a, 0x20a1484ed1f83ef7}},
+	{{0x612cc00c814e9973, 0xd883088f59b182eb, 0xa64a20a266de2fb0, 0x3b81aa5a91de9626}, {0xbb64336007359aab, 0x4997d9226360c10c, 0x6b39ed8be5a59ef3, 0x2b9e4b6611696b95}},
+	{{0x1f1db02c92c6754c, 0x457bc189958ed9e1, 0x9230bf1f3e8cdc67, 0x037e9fa3e97ddf7d}, {0x6d95e07d15bbec53, 0x0c21687f9d1967b5, 0x0315e261aa2f9bd9, 0x1b7fa1b6bb5e1fc5}},
+	{{0x84654a936aecc7f8, 0xceee4352556182f6, 0xb26238d19b2d8e2c, 0x3dbfc18e2b851ac1}, {0x8c0f4c2aebbed5af, 0x5244b3b296abed46, 0x100a54fde687e816, 0x104ebd721ba8b8b4}},
+	{{0x959bd70dbdc225ea, 0x804c41ebad1370fc, 0x85f345bc6ab07199, 0x0e0c95dfe7ac2664}, {0x19ecac82e7996ac5, 0xdebf739e560ae108, 0x70d5ca407851a156, 0x2e975362a3ea41e9}},
+	{{0x05636f826c61a20b, 0x8d55562c63c9aa7a, 0xb32695ad282c44c0, 0x171d7262c01fd60d}, {0xe19ddea1e4fd3723, 0x4c745d6c093de431, 0xc306b0a161b5052f, 0x0bed158dbb76ac7d}},
+	{{0x727849beaaa67154, 0x14ad73e607672baa, 0xefe2bcecf2bd03f2, 0x29d0e443208440d9}, {0x938856f88109d78c, 0x0a0d8b0522420668, 0x571a00da55d72677, 0x220964ded7b5f335}},
+	{{0xbae7dfd8e2b8e403, 0x62fde9a81d951c6c, 0xe303b6171fb0b121, 0x1e79ac0d9ff6908c}, {0xdc359e2d78b5d848, 0x0027405b4026c0f6, 0xd1ce4554f9de6a0c, 0x0568c36f04d09214}},
+	{{0x235af557955fb2bd, 0x20d7d7a1c8308bf4, 0xeefb5ae9ff7273b7, 0x02dc1bbb3d00c6dd}, {0xcc218d477e22a562, 0x1126a528cd2ff91f, 0x984779e16aec2f0c, 0x0af2ac553c93a64b}},
+	{{0x6433165a89429a96, 0xfa0ed0414a046788, 0xf981241a8e75b180, 0x145b232b49897ab6}, {0xda955dacb91bf414, 0xc405ef35528dcbd9, 0x67bfadccd4591557, 0x151fc2d4fe795b15}},
+	{{0x034ed1da3f39dbf9, 0x1bf60f5c255f7b6e, 0x5c0624bbdad50a1b, 0x0ca566fd60a0a9c2}, {0xc05b9c57f9e5071c, 0xe3495242d92df37f, 0x3077269a997e5368, 0x2587d9e89d9dedee}},
+	{{0xda4d1ed30f118e88, 0x31866ccfebdd2b90, 0x8fd8c2686c7cf8a7, 0x2f05f05f509926b3}, {0x1f857dc6c79c614b, 0xef6ba9fac042227d, 0xb451a87b181656ac, 0x2dd6fcad5689f59d}},
+	{{0xeb7779a5e9ec5eaf, 0x480fa3e2a9e215d0, 0x0c5fc600fd770545, 0x3d594cb0869455c6}, {0x4100621d2a4a5b8e, 0x8b2ddff4aa5c09f2, 0x753a16ddb3b07c77, 0x37895be602668cce}},
+	{{0x2844dc36c3ac4802, 0x33cfda258ed670ff, 0x3b15b6c21d0e7be2, 0x3a68457cfc798b2d}, {0xe7bff9f8fc442c52, 0xfc9a706bba6b6e7e, 0x3de76cdd3cb7a6f9, 0x2c796e768bbe3431}},
+	{{0x63ca272e35099e54, 0xfbc71cefef5cd780, 0xc2b1c4f8614c7852, 0x1228c47df79c8a54}, {0x995569c79d307a77, 0xa68114033160bcdf, 0xb6c491478a8cd4b6, 0x29ff1e36532b50fe}},
+	{{0xf00258d3f7783a2c, 0xfb08f1c19a125ef2, 0xedb387b92a8c0c07, 0x32c47ff88e2a2337}, {0x7179e67feee214e8, 0xec9f8bc5cd6f6e8c, 0xa0b4e6cefad73edd, 0x1fee099a14500e51}},
+	{{0xf716f750fc88afca, 0x1efab787c9e0a286, 0x1e74c914642e1143, 0x1d0132deb462e903}, {0xb5105136dbca7626, 0x2c58b424729ac930, 0x153c105286b6e576, 0x2587890051e171fe}},
+	{{0x832350e22e537be6, 0xd941fd2ba527ce0d, 0x66987ba5051d5d5e, 0x3182c365664cce2d}, {0x9c3a8ff63c9f023e, 0xf860a5dc3da0521f, 0x2627e823cf9c39b8, 0x3da6e92d49a2052e}},
+	{{0x72169eff5d886de7, 0xab3114ed802bfeac, 0x245c120671bd4747, 0x26ac0fff7c631d93}, {0x8949060222deea1c, 0xeb11bf95f8ec9b57, 0x8d38f61dd9f9277a, 0x16df9aa21cecbf9e}},
+	{{0x6f4c50fd6d36b72f, 0xe27173ddcd9fcb3d, 0x162107822706bc0c, 0x304765fb58e8a2a3}, {0xdbdb6063c401f7c2, 0xccfea7c08f67adad, 0x52c055b51b7ae56c, 0x3f92463dcc2d4c42}},
+	{{0x70d03bef887fa7a7, 0x1a6163038c493562, 0x8279285cd3fe2f7a, 0x1858a506966b93bd}, {0x3ae4f5042be19301, 0x27c5f5740d172cd8, 0x321aebc14a570c5b, 0x2dfb25deaecd8788}},
+	{{0xc486eb170c805b7a, 0xace388b0eb614a04, 0x62d24bed4fb78f0f, 0x1026a62861f38bcd}, {0x00193ff7da43c798, 0xd6a22924194cabd1, 0xe3709ef08544120c, 0x0de829d5e931ada0}},
+	{{0xcb9f0849d4ceb2e1, 0x6031a8e4075c92ec, 0xb58cc0e953dc64f8, 0x096ae35dfae009ba}, {0x648265aa973e997e, 0x5e7b22032c90fd8d, 0x7b93500b5f945ed5, 0x3534f1cc81769072}},
+	{{0xbad2fdbcc574e881, 0x708939ed39130ca0, 0x51e20203f6c21cf9, 0x3ebd25c4346ee4a5}, {0x05f5671ad920e1cc, 0x9501dc918d81f79f, 0x8c86e52b5bca8883, 0x08e4d20b9ad75edd}},
+	{{0x627c3a166b76db97, 0x1858f5225c8cc446, 0x4779da0de9764e1c, 0x06337433889bebbb}, {0x29e9b6a09ebf07b6, 0x7e2fc3f903617a11, 0xff18f3b4bc38bc53, 0x01729589f1df036e}},
+	{{0xda08bbc9683ec8af, 0x4c432b4e1e42c00c, 0xdb3a049367f0499a, 0x31b572e058107a32}, {0xd753975baf030075, 0xf6fae8786974a8bb, 0x0e28750673c38514, 0x3815d2e055b4c765}},
+	{{0xd502059a25b6eed2, 0x7a6923737901ce9f, 0x36ccf19e19c2a212, 0x3aabddb4455c9cf8}, {0x9f41f70f6fcb6f28, 0x3f55a462a13e9a27, 0x7e76f0eaac6c4a38, 0x32d214c9edc25ad5}},
+	{{0xfb86ac4d926f53aa, 0xb23ded8aa3c3fce2, 0x2f92a91fa5c74fd8, 0x32f021ad8ed45b66}, {0x2ece4c95878ac838, 0x4279bbea35674261, 0x6c0f90b6ae92625f, 0x06d72deb7a1087ec}},
+	{{0xa38664b5dc309203, 0x2f61eceedc865298, 0x7fcf2d1ad3f05c61, 0x27aa726e37ef0ba4}, {0xedcc7fbd671f7d7c, 0x1fe24dd29b5bfca3, 0x38b9b4f083e406aa, 0x0a8202339d3b5ffd}},
+	{{0xb8a11df4ff866b71, 0x20d4d7eab9fa3a1c, 0x0e0b6b486c82d70d, 0x370fa6957d4b3d9f}, {0xac7ff806f72d1927, 0x1d3860cd8bce85ff, 0x0ee1948c2caaa52f, 0x2a0a00ed240d19da}},
+	{{0xaa84fea233bbe848, 0x0d38690a3e6975e2, 0xef27ca3e6f7dce82, 0x31704fd891d7ae19}, {0xc3d2546b93766db7, 0x75d55caab19d03fe, 0x428f7cbbfc4f5f99, 0x1a3d20f1427a2485}},
+	{{0x9b917a01390525ae, 0x459e5bc608880704, 0x1cf010bdc729d253, 0x17755c6090145bb0}, {0x5a94aadc737affd6, 0xaa6ae8cff80f7af0, 0xb0f1031424e7a263, 0x397e39dc4c56402a}},
+	{{0xe6c731b95947ccc1, 0x123884fd416640ea, 0x205bc2399f8b4f06, 0x2903ab64a4110a7f}, {0x074e7cfada7056e5, 0x202b0321ff3ce7b8, 0x2fa3c58a991c64d2, 0x0d2ee083f4dfa2ca}},
+	{{0x5b577f5d43e19b05, 0x623e0168a76ae806, 0x5fa398710a1d7dd7, 0x1f198aaa9cacbfcc}, {0x2dd7683908aaaee1, 0xa56032e38e19987d, 0xaeb8fd68b61733af, 0x2a4e2e2e0b3db12d}},
+	{{0xc2f62befbb094acf, 0xb2abcd7860ddfa7e, 0x6465c1db5dba000a, 0x098a8d47112ec29a}, {0x3549df12caa8d479, 0x80145284afba2e53, 0xc0c6d972d69a394a, 0x1d086429e18a3268}},
+	{{0x84daad83743be7cf, 0xee00627ce139a515, 0x1b91063cd20dad09, 0x200006d72df4e18d}, {0x2c3008fa1f1e6bb0, 0xa3621a1a5ecf1d44, 0x7429d947fcd59a61, 0x115918d82751a444}},
+	{{0x9d26ea5a5611e6b9, 0xd7124b31dbff74ab, 0x283af616f055af28, 0x0cb721bb334604e3}, {0xf945e9964e771205, 0xb69da500183975a8, 0xd86981c861a9f6e5, 0x32b8b33098297bc0}},
+	{{0x4a0114ee87765c8a, 0xbdd21e7703e5c7a2, 0x5484c9b9ba3bd618, 0x2fe7f61f32f614aa}, {0x840205b317e8b997, 0x42a4ea35fe322644, 0x5e205d476a2283e7, 0x09d3125688de4470}},
+	{{0x84be7af338137352, 0xe0d87e87e3d94c8b, 0x3e791f56c9f11fa7, 0x393254c8ed462d54}, {0x59730ff23f70d8e5, 0xf22e50dc741fef62, 0xb017ad183d003aef, 0x320312be897bdd8d}},
+	{{0x5a6d1f8ab66f04b1, 0x10ab631ded26400f, 0x0b666111b0853610, 0x221162d5af9a9e77}, {0x36935a918250601b, 0x06ce7891a3ff3576, 0x4a5604dbaf11c44a, 0x00ea00ed494df9ce}},
+	{{0x50ac60fe55ed60ea, 0x1b5e5df0064d139d, 0xcb087c9018905463, 0x272344ffcb4a52ef}, {0xcea1ba18d3b0623d, 0xf7536b16f2a036f2, 0x1fbcbf955909884c, 0x2c090a6d4bd64486}},
+	{{0x453237877e115a67, 0x718cb2c69dd0d381, 0x382fb6ded62ee1ca, 0x09ce8c44d939db12}, {0x3204b2446a08dc55, 0xee7e4fd6ba1de785, 0x82fcb996211ddec4, 0x00972b035e0d885b}},
+	{{0x1623ede80c2d80ab, 0xf2711b04e41279f7, 0xae564c5398dc92e4, 0x0fbb454c0292038b}, {0x4f1be379ad75b43c, 0xed30b103ce449bb9, 0xf7f420bfb73936a8, 0x161e442d608840a4}},
+	{{0x0ccef312b5356032, 0xc742c96dced608a3, 0xcd7ff73fc4f3f707, 0x1f00a3947116cc92}, {0xc1d87946509d01fa, 0x9b6109452725a04c, 0x2934b1069959d020, 0x0f459a79d9207a5c}},
+	{{0x54491c3d44161e77, 0x13e638f5a2c9a125, 0x2d60ef1477996bd6, 0x060900b88dbf3051}, {0x51b7bca369774863, 0x7f6dc81546124a7d, 0xa9e055c956eb6a80, 0x2da2d30debf9a432}},
+	{{0x7e8f6e08a63f072f, 0x2a975810c1b70f39, 0xb62120a958f324ce, 0x3e9a372aeab8e63c}, {0x43292221069da2f5, 0x1ae9677e94355329, 0x8106c2250224a6d7, 0x0152b67eaf63f367}},
+	{{0x8f6a2b4785ea30d4, 0xda94363116f040f9, 0x7fb186f1882d4940, 0x3821b7a1364ad61c}, {0xd4b1f395b11800d2, 0x0060ec9246c925c1, 0x3ab9dc7d77f542ed, 0x35b4746cd0b1dceb}},
+	{{0xd48bb5504487c488, 0x8986a6ef2f2b1fca, 0x230dfdcb70308fea, 0x256a33fad5a6907a}, {0x98b660dd2426977d, 0xde97d516989c0be2, 0x11112a447e71fb10, 0x11694717d380e87e}},
+	{{0x4c73e3a8ae821f87, 0x53816da047b2ef63, 0x671e38f4a78a64a9, 0x11771506246a0956}, {0xa91a1040604a97b6, 0x24bd9790d59e7df9, 0x92e67a5a5c2213cd, 0x07655f2cc3e43112}},
+	{{0xb14691c137e6ec72, 0x7d7a73e1fe4e64e6, 0x9eaa5eaa73e9d263, 0x35af989852fb8633}, {0x221747cebcfa6b71, 0x597d376bbff6bfa7, 0x9e04b78f2617d3a0, 0x2b9955621bbf53d0}},
+	{{0x38850ae3443fe2f9, 0x98e189c185a98fbe, 0xa53b87d14ec9a3de, 0x09f3debf53134046}, {0xd2a8268b80693e3a, 0x6c2b0dcd13e2acfd, 0x6f8007bff9c58a65, 0x206480f29dd3a224}},
+	{{0xa268737b48b82b87, 0x531d99a2cd9c0b3d, 0xf421569decb6fb9e, 0x2eb16333fa43f633}, {0x3de6be5f139125d4, 0xbdf92ed7cb7f7672, 0xabd4bb013048df20, 0x35d4324183e699eb}},
+	{{0xd88ac234343235d6, 0x785d258d0826e11b, 0xd8f6f88f2f25a7c2, 0x17c2013faf0cdfc0}, {0xdba7299a8d1d1c3b, 0xec42fcd170f90f7e, 0x0d36baac8722b804, 0x3102e73c7b780dc8}},
+	{{0x1f4af5b05228de17, 0xfd80dd146874b538, 0x832af580772fa126, 0x3334c9456e4fce58}, {0xda8d71fef8f616e4, 0xcf90bb69293c83cf, 0x214928ee31dae0f8, 0x1b6e4948f529282c}},
+	{{0x06a39e43fd387b02, 0xc7e61739895ed5fa, 0x9487b5ac5de74bd9, 0x0c347065bb3b7b5e}, {0x39784b551c1c3be9, 0x00535ae27902c590, 0xda21ab3165ef60f0, 0x3c2f97310bccd91b}},
+	{{0xd62e1066bb45d1a4, 0x90ec3a7543619382, 0xd1c31ae0e4c9df44, 0x258a53d5a9b6565f}, {0x56ca724c0a962aa1, 0x492dc3e6d6649f34, 0xd136c7349b738936, 0x1d05c36d13c0707c}},
+	{{0x3184a46df334a3d5, 0xeef70c8266b31163, 0xfe77f62c0b65996e, 0x10a0625b6e28022c}, {0x9319cb1c569b9208, 0x41aae6e919e35734, 0x45b2baef57380050, 0x0b086f627998a3d0}},
+	{{0x93248434b465c540, 0xcbee01efec22721f, 0x33b7ecc2477bca9d, 0x2f56684c8bdfeb88}, {0xceac4e84d11e1a12, 0x7d0fdf183ed0f5bb, 0xe9f817fe3d859377, 0x116fcd424d36033b}},
+	{{0x35be84052b16b302, 0x5f3ac5110b5814d0, 0x2c5e687acdbb102e, 0x0678578fcc4bd7fb}, {0x8c525f013d05f13c, 0x140a93fa408f2e1c, 0x8f82a0496bd93dbd, 0x07eb1f6d0fdd3fbd}},
+	{{0x97a49995756864c0, 0xe6231e6fc5071440, 0x7c0cdbe42d3ee8db, 0x1b280f10c153d298}, {0x0b4c92e284fcfe77, 0x9fdfb256501ffa04, 0x8c28a781aff10344, 0x0a4dcf000b3c99bf}},
+	{{0x6a186336740d7885, 0x7c80fa6eba95e57a, 0xf4b3c2dcd6ce30d3, 0x2a8b6cd1e9a98faf}, {0x26ad02f4bd344050, 0xa771e45a6ce29066, 0xbc18146ca11b1ed5, 0x0b204ea8f95c4327}},
+	{{0x343189dcdb991155, 0xb789e049131ead29, 0x4d362b707c23f90a, 0x05b911dded650e37}, {0x206e882fc96b2b93, 0x39f3a976c14dea1a, 0x31bb7f38e7eba140, 0x074827a76ca6fa7e}},
+	{{0xb1760e6aa5e4d9c9, 0x3e630ccded335d4a, 0xffbe4a73bd007c77, 0x042d822e0c0c18a7}, {0xf44de32a04765a0b, 0xcfa3eddc53ccfea2, 0xa3d949828b6611d5, 0x35c6eff81d58b649}},
+	{{0xbde45a8f5ec60450, 0x0c9ae5cfafeb2194, 0x5461283a7429193b, 0x1d72dfa1e852e134}, {0x99415af3c4bb4ae4, 0x0c635531dea2a8f5, 0xd7776af2756304d3, 0x1962b5347c68868b}},
+	{{0x84fed91e705ac062, 0x835ced14315fed8d, 0xfe0317a4322ce8ae, 0x1d31714cf81d59b3}, {0x08a86fd310b6955a, 0x5840a881a1dd325b, 0x2bf47e9f5e1013e1, 0x365dd6665a465437}},
+	{{0x15b619376328c695, 0x7f531f6f08f769ec, 0xeedc401b7bcbf777, 0x3ac962c5c2629bd8}, {0xddc01a602a43309b, 0xd93884dac9dac4cf, 0xafccf120241e7588, 0x28b0bfe57c9b63f2}},
+	{{0xed816232ff8840ac, 0x46472946d3dcf960, 0xc25e9e318061c702, 0x09b98464638303fa}, {0x95534a135f0bf7a3, 0x04c04a87eb6d21b9, 0xcef5f04ef1b7ca3f, 0x34899ce20a4b79a5}},
+	{{0xf4c8d03e3d2fcc4d, 0x184f797459ee19a7, 0x098929e6725c8ce8, 0x230188259ce2ff96}, {0x687cadf656d7758b, 0xa1d649e52a64c49a, 0x0592392ec94ee727, 0x152e9dbc16768571}},
+	{{0x75b5ada23ffeba3c, 0x0015dd068e57940e, 0x00bbf485089c56cd, 0x3f617de08f355573}, {0xc4c8c17b7ed01ae2, 0xec8a402b6b3dfac3, 0xfe0f797f73390540, 0x12cca73da2c8b6a1}},
+	{{0xc4a0f2c071922b44, 0xb34f379faf3b274d, 0x8d83e7909e630f69, 0x251ce12a724bec5f}, {0xff38742470802322, 0x066a1b0ea5afc8db, 0x34ea3b2116ca214d, 0x062e10f8ea4254c4}},
+	{{0xbca3df8f1b4b8183, 0x1e8fa9e2c98ba81d, 0xc0897702ec7ee1f3, 0x3cbc2aa409f99837}, {0x76c2915fa53696d5, 0xf7460fa6f606d82d, 0x5aaf679fd542c5d6, 0x286ef9383f4735d3}},
+	{{0x84e2f4b9214509cd, 0xb097051ff091c905, 0xf566bba97f5c4141, 0x36bea5dbc6cd826c}, {0x664c567fb25bb49e, 0xe1c16d6a41aa578e, 0x02bc6a1c976e1d0d, 0x0b7a6e5c0e5125a7}},
+	{{0x41571a87009bf0d3, 0x58df1a1dd1afe5d5, 0x75aef0cffdfe343e, 0x0890e7cc3cab9b97}, {0x3f2cf9690996d19e, 0xff26e57a44d4e563, 0x9fcb5cda3ca38c47, 0x3481dbeef64125b9}},
+	{{0xf7c4ff50fea74006, 0x8310c67a113a88e2, 0x5827979d2cc1d71c, 0x0035cf884d397756}, {0x8aecf61b480a49e6, 0x1c431a950f77074c, 0x84789cdcdcfb3f64, 0x1168bcd4fb5cf6db}},
+	{{0x3ac376ce866807b7, 0x8476635e66137f86, 0x6046b2c99ca8d72d, 0x1efc5f88f16204a1}, {0x4aabdb25101b866c, 0x6ccae1b7bed2c213, 0x5e9fc41d67fcc006, 0x082b18b998babc1b}},
+	{{0x5fb7329974ab9001, 0xd05fd7e4b1cf3641, 0xbccbfcb028fa7234, 0x21f029ce67f9dc1b}, {0x52adca4d700a0783, 0x442ef5a1b1ac22c3, 0xa7ab4c6ff4e27654, 0x2e3414fdd4f384f8}},
+	{{0xa60643e4e349ffbc, 0xee13ca7104b56411, 0x20f41437914d2413, 0x040d7307308d3597}, {0xd957ab57414251ed, 0xd1fd71815a10f9a9, 0x3172061aa494b088, 0x229863be0e83af9a}},
+	{{0xdbf9a7394f01abb0, 0xfbe4e2866baa585c, 0x36403f403c4d9a07, 0x351aa7bb8edc1ec9}, {0xe2be14bac22c7e69, 0x16867d6808e13cc0, 0x16a2a3ff27627af3, 0x3048224732049bca}},
+	{{0xd662627b43e8948a, 0x9e04d14579480423, 0xd34128a9eddfff2e, 0x07a2315149dd267d}, {0x79b2641bc6b67fa3, 0x8e2ee44cc53b7dda, 0x4d752d1f4e6b549f, 0x0461abb25412925b}},
+	{{0xcb8dd97a66b8304d, 0xb41dc2c4f707531e, 0xce338039c8d52e22, 0x050d26cae778cb06}, {0x17465ffd4d8efe7f, 0x38fe7e7e068fd028, 0xc63b9e6707f8c71d, 0x35494f113c5e3e1f}},
+	{{0x297c69d4a1ab8a31, 0x897ba1bd0712c0ae, 0x3c4cc5e01f9007df, 0x1a32cace6e828cdf}, {0xcdb781684e066c5f, 0x565af22cc285d483, 0x3f7d40d41719db3e, 0x33481620f7264b62}},
+	{{0x62d69b97d0e72127, 0x92c0d0a431428740, 0x76627ac88513c586, 0x159dda20c47b32e0}, {0xf0f690f77263e7b0, 0xaf7de389133d7cb9, 0xed926c8bd1caab3c, 0x075dd06d9809f8b2}},
+	{{0x5fa5ca42d70635a7, 0x935029f064714954, 0xa69f0826e310c2e9, 0x02a4707d9df82c6b}, {0x398c960d36022b74, 0x59e024e45a58cbbe, 0x406ec134762c3a68, 0x3fded0e488ead3b8}},
+	{{0x67b47829794254fc, 0x0b4dca5d069a46b0, 0x038b6788dc25aef5, 0x104aa83e77f87cfa}, {0x694635bc05dd68a0, 0xb87ba4c9f9d1b8ad, 0xee309244b2717acf, 0x085597ed0f974462}},
+	{{0xf9f21bea5b26b71a, 0x630dd837ae54d8cf, 0x7dd1ae2edc2bb94c, 0x2cc328a69614d0dc}, {0x33988165ddc471d0, 0x674689eaf7ef61d9, 0x44d8c7a69069271b, 0x3c7be6c57d877171}},
+	{{0x464751da6a7ff945, 0x4073dd76e8d7d85c, 0x55628f4e790947df, 0x224d0fa1d6b5f4c2}, {0xdca029105b642bec, 0x1ef94179f1111383, 0x7215facf6833e487, 0x3406976d4cc7ee62}},
+	{{0xfd8d4bf97de781d9, 0x1ddf6baa1b175d50, 0x80c3aa3c6e6a7e70, 0x17a7e90128e7cdb2}, {0xe9acc842c8d69323, 0xaf7f797f42bfe252, 0x74398b1e5a7d4c91, 0x394ef544df736dc1}},
+	{{0xee816089c05dd4bb, 0xd455eb7cacb87779, 0x54a8c74ff7697248, 0x3d03c15ec3450461}, {0xf1776c867db6c004, 0xeaf1d31e3d428aee, 0x104cd93fa116dc74, 0x3b24956b2d8a3f9c}},
+	{{0x4891a2a3f372d634, 0xa08cc094586c36b6, 0xe0a421c4e1f75c73, 0x0514adac13c738a2}, {0xe9e0fcdf844251d2, 0x737c5ff231978521, 0x13728470d9c8d495, 0x06562ff1bf1cbc2d}},
+	{{0xb7596e8c07d80b3f, 0x1e92e47ed15387b4, 0x037a04c5fcb906b7, 0x2220a4e36ecb6549}, {0xb0bd36ae77a79593, 0x1bf4f33b4ae3d49f, 0xd0008dd6a3aa7d84, 0x3fa040a80a129fb2}},
+	{{0x31a259ae37936b86, 0xc365b21fb61eefa6, 0xb339a9c9f61ff90f, 0x19e0c575c1652644}, {0x2ba22461758485b5, 0xda0da0a3fbabf78b, 0x970afb3fd44c4eed, 0x122d6d34a23555f5}},
+	{{0xd7fa9c852adb03f2, 0x26a8d1b40fc96aed, 0xefaf1e1ab19409c8, 0x3ddc0e9375c203e0}, {0x49a353a24678f5e9, 0x420fa042d4df5451, 0xd751cd4f5ef0d2d3, 0x058d7e83cef9739f}},
+	{{0x3d6964c354caacc0, 0xe3db61fde22b7d1b, 0x6410e3b589963d1f, 0x39b12195b14a3f61}, {0x22a5c065893823f9, 0xa228fbab4e98e067, 0xbf2308e5071c8ad3, 0x250bc98b7249cb16}},
+	{{0x54e8758625d7d62b, 0x8302f9f47231d784, 0x1a5058e501742d86, 0x1aed4157a2db3249}, {0x23558ba32cfbc193, 0x55578424d2762a9a, 0x169e41569d5ad95e, 0x12713f1c4029b758}},
+	{{0x8bfea8d91372917d, 0xb1b9b98fffc2c6a7, 0x7e471051672ba8fa, 0x11cc8b7e70a5754f}, {0xa1799aad8be91353, 0x1fc43263e80bb9bf, 0xb0d6bd4931ab3178, 0x38de6a9d8094b73b}},
+	{{0xc57dde540563d6a0, 0x67a5028db993b963, 0x74f916f2549679df, 0x3a90df88ac0e93f6}, {0x8e4ed4e08f77d2ec, 0xb9b69b614fdaf2fd, 0x34051aa7d920b1c5, 0x200db9021267edda}},
+	{{0x53f9e23dd407194e, 0x08f3ac94ce910330, 0x012f5202130329e1, 0x3add68487be2a519}, {0xaa125364adef47a2, 0xb517aff777865eb6, 0xfe5a1844fd6a3f53, 0x0aaf3ba2d1bf0015}},
+	{{0xa5f0d6f030b978e5, 0x23fd3bc9cb3731d3, 0x5e6a7531646def34, 0x11abdba88b6fe718}, {0x6b7c446288e697eb, 0x5a7dd1c607cd3cff, 0xbbb920c83385266f, 0x1b88495ab57ee155}},
+	{{0x7bc4fff4a1f8ebf9, 0xb4d24384e3c6455e, 0x29082d4f8a4f0866, 0x21a2cbb2c8758888}, {0xb5c78e8e835ab19c, 0xf2c859811a681936, 0xb9bfee5bdb1ab937, 0x216d9839d9c4cd81}},
+	{{0x199727eb6f2fe663, 0x74fa7545087ba908, 0x09d231083d3d293a, 0x0430cb00856c54c9}, {0x4d0e97ec7699d3c7, 0x78b1d3c0245294be, 0x2acf73a136e9cf5e, 0x0b85d8ef9bc7f743}},
+	{{0x1f05ed2a943cfe96, 0x873d16b4a341fd25, 0xd2cfde259b3e4e40, 0x0831ec81c8ee8e8f}, {0x336510dc665d7835, 0x15757ffd9fd7628d, 0xf97f054b35bc0edc, 0x261ea82f442854a5}},
+	{{0x576fd7084b121057, 0x3ae8812960cff772, 0xbbb18bb21d702203, 0x10fab1b75fe64219}, {0x163e917f5c95e72f, 0xebc895a031c7a344, 0xcbdf2a54fdb0e924, 0x36511355cba5b443}},
+	{{0x2a0f38aaedd26d8c, 0x682f5e72bf0ee377, 0xbb2bd02f05a129ea, 0x36ad5168280620a2}, {0x9beb521a6d0f721d, 0x78b122789d71bd1d, 0x183335d166fc42bd, 0x0989ebefa4ab7ea4}},
+	{{0xef07b8053a5ac9cf, 0x8a9bf3e6ecd44dcf, 0xe053812d10d981a3, 0x2eb722ebcc79194e}, {0xa1d822eeb52b8cc4, 0x6d07b59e9300681c, 0x1f43750722a0cc25, 0x0e06bb6f13ae6278}},
+	{{0xf1d0c08b5601457e, 0x8c4f62650587efc9, 0x1edcd0288254cd23, 0x02999a7a798dd204}, {0xa94999f0ae604b9e, 0x3f3f875ece8bffaf, 0x2b934c0396eff355, 0x1dbd04cc921058ce}},
+	{{0x6d0305487590a8ac, 0x4953a31370a70e8c, 0x62241366a6f3fae4, 0x116bebefeeb2b17e}, {0x1d5580714fed39e6, 0x6b043a8011db5a1f, 0x3f854e9bea4611bb, 0x2afdf578f2b7c16e}},
+	{{0x7aac4a4e831efbf8, 0x8d436a4455fd7b11, 0xa7befe81954939e9, 0x3cf7422235b5d2ee}, {0xb98875c840d6b8b0, 0x667d44daaa2516de, 0x579397352d4dbe05, 0x34cc2e0561b9c59f}},
+	{{0xcbd7941f09a5c3a3, 0xb42dfdb3da802348, 0xaf2e273657802bfc, 0x21bf977eb3614b2e}, {0x82b439577e9e86fe, 0x08b2008235cda25c, 0x44bb1042d86d5a03, 0x1778f19f2cd52eda}},
+	{{0x85f9fb9b72cdee27, 0xae8acdc2b0e51cfb, 0x4f7c9fb0a3dd5681, 0x34efc55d43ade7dd}, {0xc807f575a3c852b9, 0x6d6640a806025f86, 0xcc5a40938aeece28, 0x07820f1cd8770c2c}},
+	{{0x67e6b2a142fdaf99, 0xfe4e09cefac52909, 0x595648d13b232b40, 0x2edd2ddc239033a3}, {0xd863cc49968acd16, 0x6039da0a2951bfec, 0x8ecde934107c8086, 0x0116093a61d684d6}},
+	{{0x527ca852d29cf299, 0x313313149654f27a, 0xf0756a39969e5658, 0x0222387c6a8da719}, {0xad60fb06f4d55b52, 0x16db097f6ce15ef0, 0x5001d98a65ddbf00, 0x1f3fc90052f8ad33}},
+	{{0xeaaa6b93e777a62e, 0xa0d3e671af551f46, 0xbda164f8aecb60a7, 0x16b5a3fee1097df5}, {0x6ce8108ff009db8d, 0xe52717ad25e18b0f, 0xae54ef5efacb561f, 0x2a43f3df4de2057f}},
+	{{0x16f3408fff9e59e4, 0x5f8bc45de3293478, 0xfb6ad798e2b1c44f, 0x2dd2473a27fac763}, {0xd77b1890ef4c80b1, 0x263a352ef2744803, 0xb921c2c415626fcb, 0x1537f45a6f957f3a}},
+	{{0x039cf0006f72817a, 0x893da6aec2b2271d, 0x430f379093de9296, 0x1fac5898148ba73d}, {0xacaad16f03e9293a, 0x39223747ecbee71f, 0x18edd146b0043767, 0x0c119f9a9d08065d}},
+	{{0xd95eae6ab8cfee07, 0xaf53600dee7f8d67, 0x03622c2b5948050f, 0x3506b99acf26163c}, {0x13db6c2e0d9d521b, 0xb2207d8022e52f01, 0x40a363150a260ce1, 0x3ce871a35d81af00}},
+	{{0x1bf981c96e7a472b, 0xdadcc1d6dd19a1aa, 0xecfc490de52f3914, 0x1b972bdcff20ea31}, {0x32cb7df4fa81b23d, 0x512543661ee0a9fa, 0xafba899401e62889, 0x260d645de9499942}},
+	{{0x385cb8c1d8a54114, 0x4b80a3f316b126b7, 0x061e51d9e01d9178, 0x2f4107b2fc228d00}, {0x11ee4ce18d5b4f7d, 0xddc86909877b14f2, 0x6060b1b044cf911a, 0x228cd69b7c11c4ea}},
+	{{0x0ef007ed279e1cca, 0x0c35908b664d35b6, 0x9d7878bbb271f569, 0x0ea4d84bbaee65be}, {0xd4323bed581c1789, 0x6975088200a8b931, 0x203647c78a10903f, 0x3dd6d57b3582e64f}},
+	{{0x8857a7366208efb9, 0xfad00612dd7ebe86, 0x2b6fc2a0eb9ccb94, 0x1960b4f937bacbeb}, {0x74e95793fe78d008, 0xdde506ae55985029, 0xb095e29578636639, 0x3dae0c9db990510a}},
+	{{0xbb67acfbde8b0adb, 0xf14c4f90775e4de2, 0xe5b3d01f160442f2, 0x15007aeb5ff46b01}, {0x6aeeff24afffb60e, 0x938d0d51862dc1d4, 0xb35628b236aa6f58, 0x0f983a72ec01cf97}},
+	{{0xc326ac6692030894, 0x10cc3e4172989cd2, 0x13f99a2ea3abe0ca, 0x07b333f594932f97}, {0x6fd3f5d666e2d3f7, 0xce1d91840114b554, 0xee395975ef4bf6ff, 0x34dddeb3629fe6df}},
+	{{0x71372c3710a3cb58, 0x7a31453c12491fc1, 0xe3b9cf051e1af68f, 0x2192e56bbca20d30}, {0x2f6fb9bd15b9331b, 0x481164018d51125a, 0x69575a33e508bbd9, 0x11648bab33cda2e9}},
+	{{0xadff58058fb2359c, 0xc711bfbccb61181d, 0xc101983277f86fda, 0x30b54bd2aad01578}, {0x3b82211cfbdba051, 0x49d9bde0efa98856, 0x797a7ae8681d1827, 0x1f7e80769df7c2fd}},
+	{{0x578bce1b8a2c3056, 0x51fc82e46323c3ef, 0x0defdca2323ac5fd, 0x0948d10cbe3894f9}, {0x8ed3283ea0c62a88, 0x13238b96341343ec, 0xf630103a63251bcd, 0x27ffedab93daec03}},
+	{{0x1ac8b49d5f1be85a, 0x8abd80906840bd41, 0xe3b006b485ed3365, 0x14166e81d38cc891}, {0x87a603253f744a1d, 0xc54fdfeeb2776aaf, 0x222ce49a5d61742a, 0x0d4113ba00a39e34}},
+	{{0x74d2f68ffd99c6f1, 0xee0484bc5071f1e3, 0x50f2d8585b80b2b3, 0x1214a44a80b04639}, {0xb256b948ae1fc832, 0x8b35b797de3da1e7, 0x9930c47a2e9152c6, 0x394cc004b945af02}},
+	{{0x1a4bf1fa48ed76e7, 0x625ddd83325d9e89, 0x334be7a4a0d0e9f6, 0x29fb968028f2ec63}, {0xffc0eda8ea69d6a2, 0x64c5be61321002bf, 0x57234c6c251272ee, 0x3cd6515b2f6768c3}},
+	{{0xb82604a0bee55a77, 0xb351ba3dca171859, 0x1a3d54b85f7e504f, 0x29a4eaf5c94de4d5}, {0x6c749d094f3ed734, 0x20734598d7ff6498, 0x1cace1d2d500a0d8, 0x05eadbcc8aff3e7e}},
+	{{0x8d063f8e079f95ac, 0xeb9405bd30fd5c71, 0x3bd48f910ff86ef0, 0x3af1cf8d702b4db8}, {0xa3e6536360d05f6c, 0xb51c553b068b1ff2, 0x00a227166fef2b69, 0x28752e1e1b8ae651}},
+	{{0xc8c9137b034784f6, 0xcc8f750b1ca2c370, 0xa1b0513835bc6857, 0x161852a155dd87a2}, {0x1fc0d0eb3af8feac, 0x9d5250c330b975aa, 0x56a56f48985b5719, 0x3c523e6997418ea8}},
+	{{0x7a060dd948a4a578, 0x823f9265fb88669e, 0x1af00315347815ae, 0x295565c25b2c83fd}, {0xfab8382618a85c61, 0xb07483201455f27e, 0x9de1a25e3a1e2cbe, 0x2e97b9ce4e2cca75}},
+	{{0x0d2179c74eb23122, 0xda6e6d8cf7405d8b, 0xd10b1576e3cfbfa1, 0x0f63e47eba1c61f8}, {0x407bd8cfb8542f22, 0x24a4e303ae0cb9d7, 0xe2a0508c15a6536f, 0x1ddcbb377a495bc2}},
+	{{0xc26cff2e91816aca, 0x540c3ae54b3d268f, 0xbd39637549fdca1c, 0x2e057120d64687aa}, {0xabadc49316d0c260, 0x5a9d09a67e4a1307, 0x2226cc34fab26896, 0x30936091f7f2ef85}},
+	{{0x0926be4a06de1b05, 0xee91ead137145907, 0xb44be767b3507488, 0x2c458316e31b8a0d}, {0x4d40b460f0b1aafc, 0xeae0220501a52aed, 0x9a7238700fa5b000, 0x1be6409519a44ea8}},
+	{{0x73548b9a4f682f11, 0xde58358f39e07ab4, 0x471a15df1c7fba77, 0x0fe979d67570669c}, {0x56de37e77502394d, 0x14a3ac54907d6704, 0xd7dc3c4e933439d4, 0x1ef141f06d8ccf3a}},
+	{{0xbd6ad60f53703d3b, 0xafcae2b197a0600c, 0xa23501f90b3832ab, 0x174de7fe9db0feab}, {0x46c0eb7bbc06304a, 0x653607d1c20d5385, 0x494635d064ba9af4, 0x30373bb6b52cf70f}},
+	{{0xe612f0d1d9ef1e89, 0x6888b6370e8e0ea8, 0x5f416f51da41f1c1, 0x14b5bb016361165d}, {0x90c7c7c0c29b68d2, 0x9025bfab4a2c4112, 0x9ba27a70b5463701, 0x1f83d625b9cb6860}},
+	{{0x204cd4dc2ab17191, 0xdd0037fa3d548a44, 0xa5a4cd1a9a9e3015, 0x3c4eb007cc62e396}, {0xc49ebe7d595473a2, 0x3c24d119420265b8, 0x2739e0d5168ce15d, 0x0dce77a934d600ee}},
+	{{0xf0f4f7a71c03db6d, 0x4c21e7620b5ef525, 0xdbe5531feae4b2d3, 0x1a4a6b6a5f2426ef}, {0x4d23aaef3feb3b6c, 0x6c5e6eec5f009ae4, 0xbb176f912b9b0295, 0x33d5d27dd2f324a7}},
+	{{0x8dba29e98c4e8dbb, 0x9ad96d9e2e10b25d, 0xa031adfab4677c3c, 0x2a15df768c54fe8f}, {0x0611fc5ed1e08ccd, 0xe4228b23bb4b9fbc, 0x574f252659217000, 0x11bc02717d1f8325}},
+	{{0x97ddb2e1275e4472, 0xf46ad3f397b73f70, 0x52807d67bbfb3d3b, 0x1573c02e6448a937}, {0x9cb0ea3712d346fa, 0xa0c96de94a579926, 0x6771c1c22f3a17e4, 0x00527ecf7a2e93e9}},
+	{{0xa1b264d0a206d421, 0xf4525cb637b5834e, 0x1d585b574122d456, 0x3434f3bc1308d0e5}, {0x2fa3f9ea0712b287, 0xdc476619cfd28e2e, 0x06dd9920dc36b530, 0x0a887acc5eab5de6}},
+	{{0xa3e4e3b55afc1df0, 0xd9e8cbf2cb570261, 0x060e46b51642f247, 0x3c31862a12f2740c}, {0x26a5cd1a7dee173f, 0xb96537c35819b816, 0x7df0dd80f62a8489, 0x265a08c41fc0032a}},
+	{{0xb97359728bf866ab, 0x61ae8664c9a589b0, 0xc9c5a6afced92426, 0x2abb5aeacf9b9269}, {0xbe65354ff522b058, 0x604a9585ddaf6400, 0xd120c947e6de006e, 0x33d0c7ffb825c10a}},
+	{{0x1ee3d44190c69b7f, 0x49ad83f7332845c4, 0x4995c64eaa277ed0, 0x06ec21240fbcff8e}, {0x66f58b866486629e, 0xa15fc525edd769d7, 0xfce3b5f95bdd6936, 0x344669018a7cce19}},
+	{{0x18e06d3c4e5d9fa3, 0x1ceb3f618485e310, 0x78003d8a991eb80a, 0x1c61ebf6fb4763f2}, {0xe7a00af585b72b19, 0xe3d2060e859a5393, 0x230f2b67d36b9683, 0x0cc6e0ff9509ed4d}},
+	{{0x4aa024af6bdf4a1c, 0x4a0daccea43bda4c, 0x5afd7ed65da99a8a, 0x3244fa30e85efc01}, {0x566c726ef9cdf5c2, 0x6bd3456fed964757, 0xb56ee702d3c2fd5a, 0x23a640b9c407a81d}},
+	{{0x7e8d62fe40b35442, 0x068f8836f5cdb48d, 0x467a279c8eb0ff7c, 0x320a2e6272f3a463}, {0x5751e5f0f2e0fb31, 0xe150f6687114add5, 0x18fc60a78c635444, 0x3ca374be335254e2}},
+	{{0x3991628fb2e627a7, 0x6283ca6bb87c54a6, 0x42119d7118785a0f, 0x2bc9a63fbca93275}, {0x7ac57cd379c080a4, 0x4557e30705540674, 0x9afdda62ad380422, 0x35cbdc062febcfdd}},
+	{{0x15f207e73738a74b, 0xfb4513f87cfe5d75, 0x4f4cd39f8b82b716, 0x01b8fc266734804f}, {0x685f40d8eb58aea9, 0x93c76ce27eb8dd20, 0x37ba9f6c47b122db, 0x0c7eb1ba2d5bc60c}},
+	{{0x04e5913f864beed7, 0x80e7f0f74cf016e2, 0x0dc16427c78e3a2d, 0x0bbdf9e869820e45}, {0x1634e7bfdc64521b, 0x783d8e29ddeaa10f, 0xd53cbc02ce4d13d7, 0x39b14938f52310be}},
+	{{0xcc22595a2012b7bd, 0x78c5de58600c7a48, 0xd1d7bc905bd64e77, 0x2e43a36abadf1901}, {0xc23271d9f0045aa4, 0x29faeb50ae39192e, 0xf292cb8b63628ee4, 0x2635d3c5e65a2a4d}},
+	{{0xb989ef323fdace71, 0x78cf83b6b7e8bc45, 0x68b51918d897f8d8, 0x0cb4b100b4534f4f}, {0x446e8f4905d6f2e7, 0xdf3d0a496cc9d5bf, 0x28b65334a4eb2569, 0x0b9e944bf786abb6}},
+	{{0x1a46851049a6d511, 0xcc639dc4062a3b91, 0x854ea6509d19113c, 0x12116626d0a8d51e}, {0x24c8ae6ed71783c2, 0xaa0b2852bb4ed303, 0x5d56f53bdaa12387, 0x1e4f1879ec0ee572}},
+	{{0xfaf9e74b2f88d9ab, 0x485fb33b6a3d0297, 0xa4099b907b77966c, 0x1c5c0aea410e9f83}, {0xa6b72bcf1af43cd3, 0x760681e4df4a83c1, 0x474de58951934de1, 0x09bb34c633846d3c}},
+	{{0xe7c1414bb3e517b3, 0x52c417bd8c8a908c, 0xf387a343bd8f325f, 0x341d5b1dda505f3e}, {0xb65f898f9916fa33, 0xfccdf0c8b214ca7e, 0x28cd42c42bd896ec, 0x09165d204efc69e6}},
+	{{0xc965038cea518fb2, 0x68bd1a1aeeebf109, 0x31e68599f99e3920, 0x21907d7eaf39736a}, {0xbca29d0baac64a7a, 0x724977c497e8ba4b, 0xf0447c6e5b318dc0, 0x168e819c46c16888}},
+	{{0xfbb76fca71ccde48, 0x4659d0cc3ff6055b, 0x65ee508740f03219, 0x197dc9c3f6c44157}, {0x84e491bcccfcabd7, 0x75c132f174892bd3, 0xe6c6257b2834202e, 0x0247496e2dd88d24}},
+	{{0x7f56b0376fea0bbc, 0xeacac8bf769f6fc7, 0x8bc1e24a56f3ec20, 0x2e13ccf8bdefc528}, {0xc3897da762e13dee, 0xecbf4a6a38a3bcc9, 0x5f66fa8d12b9fbcf, 0x325577437fd567c5}},
+	{{0x35e813f566cd1fea, 0x2b56ec89e0eeae78, 0x25f6d38f0ee5b19d, 0x278724c067c3395e}, {0x51d45889a532ae5c, 0x6dd13ef6ebf2883f, 0xa1105a9fe818bd13, 0x296cf831a034f0fa}},
+	{{0x610328452c6cc28a, 0x02a0908b7d9f7f0a, 0xdc4493975f7e1c3a, 0x327d9073528e8370}, {0xd8f51e2f64ed8e58, 0xfd44e0def1fae28f, 0xc4e85de369dd188d, 0x16f0fc9f991218eb}},
+	{{0x9711a3c029ced7b1, 0x9ca504b0fe2e8ee8, 0x60be490c282f1a5e, 0x11256a6fc089acad}, {0xa938ba5ac8b62b9f, 0x7d027825c3bc173b, 0x641d9159a49d25df, 0x3b470bfdb6f4530a}},
+	{{0xe19640f8de2446be, 0xe30f88227decee4b, 0xf1d9670556e72f41, 0x20208f31fdaf9284}, {0x21584daaefef1b38, 0x52413213f8ca9c44, 0xde55652da367d402, 0x1d8e1056ff225c3d}},
+	{{0xb1fc99eb3f96d148, 0xba82b5105172ad2f, 0xfafb393768ecce1a, 0x0578e53897092129}, {0xa4584846cacb4920, 0x7b16c9535d7f84f3, 0x17cf2cf161708ee6, 0x20178a9724466b6e}},
+	{{0xe052c526ee334eba, 0x6a2f223169b18c05, 0xda796e3bf88a4bdf, 0x0916cd1d01139b36}, {0x71dd43c63121b5f2, 0x342c0dd1120456a0, 0x5ba2d91825908916, 0x331b7d1b94416b01}},
+	{{0x9ce9d03e4d6689ae, 0xc6b6d49df888d81d, 0xa016a368c42b39de, 0x079c1f29b7d219b3}, {0x1913f35370a2466d, 0x407aeb825671987b, 0xc377361423d48376, 0x1263607a35f33fd8}},
+	{{0xdcc556f1d292e90c, 0x719cb6c5160edc5e, 0x27fb0c233f4a4d44, 0x0eb4e8a0b9b27807}, {0x7fdfb054df427380, 0x5a113f6e15da39bc, 0x324a420a9b0a4057, 0x1d3b159aace0bc82}},
+	{{0x8ac2b4f5dc19a26c, 0x35dd70d6b371fb49, 0x22a7d6cda1ef4281, 0x1657a75613e8b124}, {0x7cc3f86600807df6, 0x167bf42ac74e021c, 0x42a001b5466d1cac, 0x1155739b10c51900}},
+	{{0x725605809815d285, 0x28413dd253d5af76, 0x8d13d620621286bd, 0x1d770ffeb822713e}, {0x552f0cb557e4415d, 0xb3e6cad211276a4a, 0x3175d86f58be6639, 0x1565fb638e2b2258}},
+	{{0xf62e38d95dd84aed, 0xb8e3cfefe85c7116, 0x89961a8e41def4f9, 0x0027607845061c9a}, {0x705a98db5e80f66f, 0x816c9aeff269cab9, 0xe285580523a0bf2d, 0x3330764cdbdf7b12}},
+	{{0xbd8d60748ec1f0c1, 0x89928a83d47be865, 0x061beab258d1f81d, 0x3a058b1931243154}, {0x0bf05003f3e8a4e9, 0x23d223d5ba4bc524, 0xf00a6ddfc0147742, 0x38845044f683e337}},
+	{{0x23f33c922525506b, 0x0cfa088bc88eb475, 0xad2e0126d85ba979, 0x30060a5a414c07d0}, {0x7433513606f45ab6, 0x45f1be6c9fe9e52e, 0xf917e3c7a2939f43, 0x1f30e58c544b3c5a}},
+	{{0x399991aacb27bc0c, 0x384ee1898ab095ca, 0xd9eadc2b1d216a70, 0x2b5ce5bcd668e324}, {0x013a825a16b4b068, 0x283808f940a89bc7, 0xc015a056ff5f8631, 0x18df67a1dbb8b772}},
+	{{0x799fa58b107d3b4c, 0x440c6cc325a330cb, 0xb6524ef8895c768d, 0x001af535fe24ae62}, {0x591e0dbff6ad3719, 0xa111013c36a77e03, 0x416028c9e21ca48a, 0x27b75faf292a2c9f}},
+	{{0x0dbab6ab53b9480f, 0x3284c7c774511af8, 0xdc9126a751c8ad12, 0x03733126b16c71f0}, {0x58596513a4520c3c, 0xd1823f6b0892903b, 0x8a54633c97349983, 0x091fd80ec6b7c769}},
+	{{0x5b18b3d6cdf1447e, 0xc3f43372d00fe079, 0x49145af11a55cec6, 0x2e84ed9098b29c48}, {0x5c9b97a926f78ad2, 0x12c47a3fc77c3dab, 0xa7d584858532cf72, 0x1b70b3d4046c069a}},
+	{{0xa55af1db99a58249, 0x84a8969263774e6e, 0xd72bec6fc0da1cea, 0x1c8e85df5f31322e}, {0x6614e967f6fcc00b, 0xf2333d91246cead8, 0x47ae1922f0a80f73, 0x012073607e274f61}},
+	{{0xd9f9cfd7031e7d93, 0x28b4f5325d590614, 0x9f7bdc6c14483d8a, 0x0707267ffe99423f}, {0x705682018cb751b2, 0xffa9c293693d80fc, 0xff268ff7522f7645, 0x13ce1af885281b17}},
+	{{0x3407c62765136904, 0x2ebdd63d20c6fe96, 0x1e1baf86c496fbda, 0x3689e8ffd397ab78}, {0x397071d56a8bba62, 0x9f17b8f78f74c076, 0x5943b873fd4652d0, 0x1f002a84bd6e8d97}},
+	{{0x8001ca697e388d1b, 0xf5347431514c0fdf, 0xec7fd1323fb1f039, 0x3994592c454a2791}, {0xb5fe1119e69c0910, 0xbbd801654111f418, 0xa9f21a5859ccfda3, 0x1e01fbe08b07bb1d}},
+	{{0xa98c12c90bf7859a, 0xdaeef51e4a8d433f, 0x4d04af889c601706, 0x2c9ced7c42944204}, {0x4c47195cf7669d0b, 0x255dfb0a80b72e70, 0x5bf49173f2ab2949, 0x20cb93f1a9864213}},
+	{{0x40344739765f0d86, 0xa2eeedfc1a15296a, 0xffbcdc2994536ed2, 0x2167ad1bcb158f52}, {0x3589fa6675017922, 0x80a50ee23259d371, 0xb150021ba387f89b, 0x12ab88bcf394019c}},
+	{{0xeb6cb4bec57e1b19, 0xdd66aed7f0400bd7, 0x6dd2e21ad6ac0bea, 0x3422a5f8d2f750ee}, {0x47c37db33f2050a6, 0x7ed2121e6f22a25d, 0x48660e151e69d4ec, 0x1d69e648874b0949}},
+	{{0x2e50d5ee0bf2e264, 0xa495b26b7b9215e5, 0x08924050e7706d86, 0x32be08e1b8032cbd}, {0x5812b389abbea9db, 0x7a353657ed04d1c2, 0xc84c5b9278f1ba29, 0x23dd70f535bf47b3}},
+	{{0x779bc58fdf68c6ba, 0xc13a5a092c9e28bf, 0x6ce31e0926e30b07, 0x37cb48ca4f224bd0}, {0x64b52a34e73b734b, 0x0db4c6db15488cd8, 0x285f87fd4fb583bb, 0x3ee995080640614c}},
+	{{0xfd1b2a67fe49fe19, 0xa49a1989a45aaa21, 0x9d4cdac1b656c86d, 0x13adb0283c94515a}, {0x5fd304725ba6a264, 0x03e97ada4bdfb15e, 0xc1112eab951ab8b9, 0x05f4d80a0b7be5be}},
+	{{0x1ab4af865bb251e5, 0x2d8908f95ecfd750, 0x112870acf867781a, 0x22d14884fd7059f2}, {0x8c5d3d1f819d88df, 0xe53c3652ecba2b9d, 0x7bc01e8b0b08e343, 0x3fc55b123f7c0569}},
+	{{0x0f39c00cf0236b59, 0xa4a0395ccd0721a3, 0xafea18845e897c7c, 0x01a976455385954a}, {0xc273df21d1e9709b, 0xec7d6a0532e1224a, 0x95bc324dda00ccfe, 0x15acc05b23d234ee}},
+	{{0xbe374b47d8cf7b5c, 0x9698ee93ca435939, 0x27683f925964f9dd, 0x1d0cbe9ef2b8aacf}, {0xa87e7177b5a0b86c, 0x01e87409e2c574e9, 0x07311613504599cf, 0x2aaf277fdf071638}},
+	{{0x1be8bb8e920de7b8, 0xe751e0682e707f8b, 0x0a16dddee8d3f387, 0x1446f8ab4f4adeec}, {0x7113b7e1cdbe49e6, 0x70f8153d29a1d56c, 0x7eccb1717a70e438, 0x1c20fd2eb0ab1316}},
+	{{0x37b2a465b397f85e, 0xfa7d6daa750979e7, 0x3dc33217b170a1d0, 0x11a6d33f9af599da}, {0xf1dc57f4bf7565ca, 0xbf8f00410eafdaa2, 0x397aab9420fae6e3, 0x018bdf18e18778bb}},
+	{{0xbf5d06a14560a871, 0xab37658dfad5f6d0, 0xed1cb210e64fd675, 0x0f0fc46a1807af30}, {0xdd9091ffe413aa4c, 0xa9f8404c724aff44, 0x21c3f7c493151795, 0x1ca1c78fd1d0318c}},
+	{{0x978a04d5bf4a8aa5, 0x26667746de50f60e, 0x2e9a3b3a69611e64, 0x0f3b358ff494a93c}, {0x755a0f424f02d383, 0xb7132b99f70c874f, 0x30f995765084e7cf, 0x1b0d397005941422}},
+	{{0xe0013568bdf50dce, 0x740623cc2d4aac65, 0x6db919ab5553b947, 0x1b2a20fd3fc84eb3}, {0x6822321e66547cd9, 0xebc4043a45b51b25, 0x110ea154af7213f0, 0x0d3839c3786fd9be}},
+	{{0x3a4f5e2be2650af8, 0x3e1b82ab8c441406, 0xac92fdc318f52c07, 0x125df3f9bb8f01a0}, {0x7355e581c6b3708d, 0x52b7887393dda61d, 0x764c7637f880bbc5, 0x08c8c5199d26b78c}},
+	{{0x25c73eb6930e91e9, 0x54cecafbd0420add, 0xb4abf141934fb86a, 0x0ef5a7e74ab69016}, {0xf014b9b7716a41ae, 0xccb65dad7b2cda85, 0x28ff915dc523e52f, 0x38029fcbb1145788}},
+	{{0xfa7e0739bd2c1999, 0x7f480f73a6e59cc9, 0xe44e6c43af4e9ea9, 0x09334dce24ae73c8}, {0x293107ad09022329, 0x76b9a7438b3a7c68, 0x13805ccc891db54f, 0x3acdc8d5fa3d75d0}},
+	{{0xb1f3fc5c2fb965de, 0x85065c82813da2f6, 0x5135e495e1a66dc2, 0x1a663f171c92759f}, {0x7d7cd8d4c2a75693, 0xb156c6141924138d, 0x2b68d0af4897619a, 0x3399fd688ae0a90f}},
+	{{0x42c7d4e9313a8473, 0x5ba323774a7d1080, 0xef79d528c15892db, 0x2409183db3db2bfd}, {0x3f60fe4f6a2c1463, 0xc986632f9b20a8b9, 0xbe095970c482c2d0, 0x1fcb4f9a3a4b6684}},
+	{{0xd0f6030827913998, 0xd72a8ad7df978634, 0x59a37801e1ff3793, 0x3802d17e83a9d40a}, {0x056f43628401ac3b, 0xbc7fe5d6b2ad4af2, 0x0868e9ba8184ce57, 0x3baf899e755f3bde}},
+	{{0x6e157ded427db370, 0xc446f695e11819b1, 0xd1bd49407b2f47f4, 0x09568dc3aeeee04e}, {0xc1c886d6d5b443e8, 0x59a8bb3f4d986afa, 0x2836d6e4ae22093f, 0x340c0b79615fe54d}},
+	{{0xe87e8f84badd14a6, 0x44731c31bec8a92b, 0x1d2d6e7423facedd, 0x2cfef04a9144c575}, {0x1bd92623a2cf8f20, 0x22388aed8ffd51e2, 0x44b96ee01e34404a, 0x0c976ad053a3f480}},
+	{{0x1374690681bf2917, 0x81eafce8077dfdd6, 0x2568c990ce2ad9c8, 0x292e9192ae1a9f79}, {0xe664a8c9f76c74a3, 0x06b4f8d8352f3272, 0x5480efa1f40010ed, 0x24b3fcc5568907ca}},
+	{{0xfa3ba47a11fa3f28, 0x5310464f432decf1, 0x76e66e615ebb780c, 0x092dffa68bb820d8}, {0xb081cb1e299f4b6f, 0xf8794eb5ff0b98aa, 0x3c273cf0b9fa0ec5, 0x2c6fe953579cb07c}},
+	{{0x82fa387e9e841e94, 0x1d10c764638aec69, 0x4205143498fafb2e, 0x3be3ea270f161a5c}, {0xc36702d8654bc4d8, 0xbc9def14fce555e6, 0xfe787d44b846a5bf, 0x1fe256f35e5523e9}},
+	{{0x9a6fccc6c2b65598, 0xc9d3c165527bb707, 0x27491885df89574c, 0x15693eab5ce4a67d}, {0xb74203a6bfc6cc4b, 0x0c75283660818aaa, 0x1c369343e7631004, 0x3bcd3e0755851d25}},
+	{{0xefee9e2a85c573e0, 0x8f28901e9d5056be, 0x07c5030d8573a6dd, 0x251ec8020ba45f47}, {0x5c476fc545c984e1, 0x3cf9aaad244c0221, 0x7083222f7650bceb, 0x031c2241c8756a71}},
+	{{0x78b93fdbee10b792, 0xe7005923e48744ea, 0x6e976655b3fbc837, 0x29a369d4f03226e6}, {0x6fcc234fe2a69b6d, 0x2bbba062937c2003, 0x0236a58fcea7d14a, 0x0a4e179d263d83df}},
+	{{0x11731be2e8058e3d, 0x8ce7378e0f570fb7, 0x30a9d82eb0e61aca, 0x002061f96d16f852}, {0xd96cd72b3ae9617a, 0xc4fa08da21ef653a, 0x35ef60da6a8658c7, 0x1474c4884f7d149c}},
+	{{0xf42cc8391c9b1e46, 0xe0f6319179dd71d3, 0x07a042d81c4ec4fa, 0x3c92947bfb8c1637}, {0x4b0d4389e5cbe9b7, 0x3e8ce5b319f564bd, 0x9ecbaf2e6b179e85, 0x365dbe0a90a0e359}},
+	{{0x321cb878d897d727, 0x53b120adbbc1503d, 0xa3543026973ab3cd, 0x3592dffd9e07ed90}, {0x6d16336aa0597224, 0xc8a823524b30737c, 0x21ad04409ac5252a, 0x053da664eff257cc}},
+	{{0x18c06fcb0dfbf2e7, 0x3c89799c44d83cc9, 0x7e8c2dd64b7d2ea8, 0x0afebba0c3265e7f}, {0x87bfedc3a7463b17, 0x15687c20a6e2794c, 0x432e690b79eb3995, 0x33b8dfe1f62f6795}},
+	{{0x9fd8f8e5e576c1da, 0x8a597ecf58829c0c, 0x3d26b27c8f966471, 0x05f0090c3cd2a321}, {0x86667ed978c3d3e6, 0xfe1775e1e6424164, 0xc85cb280bbb09635, 0x2211e8537dbd4d75}},
+	{{0x1f3bbade570ac260, 0xa7721cec839efcec, 0x8fafd832fe7ff3de, 0x0e6105539ec4d8a2}, {0xc8b6763a21d7fed3, 0xed36fca83d39dab5, 0x8d9e928797dc73e8, 0x1c929cbc2b4e110d}},
+	{{0x352eabdc4e50e723, 0x17f5eefdc1934fe0, 0x8b4b9ab576f00f15, 0x34187113a32f6b3a}, {0x07d09b5d0255ae0a, 0x1562ac98e9a7a4e7, 0x3a9581529b0d6c4d, 0x0d318d7d4a7a7559}},
+	{{0xbf77fa890f8130eb, 0xaefd70946c4d329e, 0x98c89676ea026efc, 0x1e7364f2e0c2973a}, {0x729a03accb7b5f74, 0x34ffabc982f2ece0, 0x9495e9723b082bfd, 0x00c596b3a7e87458}},
+	{{0x70c3ed18ca88874d, 0x8603039ef9e056ee, 0xe0f63e11f16f8cea, 0x07269ecf611c2e74}, {0xaafe766fea1808a9, 0xdcff5c30c6f7fff6, 0x01db2b6f3e91c72c, 0x38eee76b46eed45f}},
+	{{0x9fe2582a2eeb3509, 0xb7a51b0f90627134, 0x379b9556d7321229, 0x07ef013cccf76e07}, {0x73601323de83acdd, 0x677bf995d8ee6468, 0xd6815dd6c4308ebd, 0x35cb5e298ada4565}},
+	{{0x56590edbfac4d46e, 0x1f6044319002d43b, 0x5291068247f9f87b, 0x1c3a3a77cc8429df}, {0xbfbfe43bb625200b, 0x4615807c1b7bbe07, 0x4638ece239fd8f99, 0x3928c78a7216857f}},
+	{{0x04f4171532282b57, 0xf8c8645b0d70a2bb, 0xe0f7643e63102531, 0x183f33773d9fe5a5}, {0x733c24a042bae346, 0x2c600bc3c4f3ef32, 0x753293d15c726bf1, 0x19d561a7024d788d}},
+	{{0x6199ff979f6f1095, 0x89399d0f060e8d1e, 0xde91e9622dec8518, 0x1568967b21976c45}, {0x4b60fa141f40fb8d, 0x26f87ba3f35c3b16, 0x78b01743cf32d00c, 0x2fa1496242f84dc6}},
+	{{0xf67a31d49ed45628, 0x87222e0497479036, 0xae926dd008f25a81, 0x2adc8e56f4ce7f8b}, {0x12ce2683bed153d7, 0xf0e2a9aa73a520c2, 0xdd8cb4f9c88bf652, 0x0fa7856e03776ffb}},
+	{{0xcc80997fb4ffefb2, 0x709e3efdf6116be6, 0x514be1c43aa79b35, 0x3eca6dee6ccb1630}, {0x7b7bc504edf657d6, 0x220ba922d0c2774b, 0xaa555343e69260fc, 0x1fec8511817b281e}},
+	{{0x2e6101bc7653b538, 0xd602e91ba9117171, 0xc3696f614c69bb14, 0x225575eeae70d92b}, {0xaf6aaccf1e5b611a, 0xe8bf2caed5f40c00, 0xea9ccda2d73bff29, 0x15d7c8a326c8d84d}},
+	{{0xc2ecd903e9d5f968, 0xb7f9ebfcce236889, 0x7671b3c0bee47cb6, 0x3bbd91992e4881cf}, {0x23a8d9d43344622d, 0x78b914a778c7c784, 0x2039a50fd4fbb2b8, 0x14cdc321df31b611}},
+	{{0x13331fe9af0c8279, 0x87eeac7dd03ab5ef, 0x3fc09329993a3b35, 0x18edef56b4b4a4dd}, {0x02200d254ab6ef61, 0xb3b93ad594b49601, 0x81091c4cb1fe52e4, 0x3916fec2675514fe}},
+	{{0x26ea7d953d442934, 0xbb195e583d0a54d0, 0x5615e6b8b062650c, 0x15b837032bf005ac}, {0x0a8a3583ffe4348f, 0xb4c62f42a8929a21, 0xd021698a7e23f8d3, 0x0c0d4e3540008de1}},
+	{{0x04f9e99db5e87379, 0x1b9db8ceb99fca87, 0xd2058c499f4246c6, 0x02569456d5a2e2bb}, {0xfe325cf671e459cf, 0x4860831e34370fef, 0xcd55278bc73f083a, 0x3c96d6dfdf2958cc}},
+	{{0x3fe032b10235ec0e, 0xba34c58508212428, 0xa5f4122931e0e466, 0x031de041bc3a4951}, {0x2c6c2631fa0e8e33, 0xe0401831954211c2, 0x490f6d24ef9cb0d3, 0x03cf0d9d2539a3b5}},
+	{{0x5fe2f3974d8ef8f4, 0x48c8b0e501ee229e, 0x2b8221cbe685b0c1, 0x35bbcbdbe56d1ef5}, {0xca05731aeaab8ea0, 0x7b195e4c5b6ea7c2, 0x59c75084c478406c, 0x3636c3b0c9f11b27}},
+	{{0x945bd1a77bdf46b8, 0x4dc4dc1dc947a4ca, 0x08e7901505e4f166, 0x353a38a6369751dc}, {0xe5ed87259d99bbcf, 0x5d2082a8634721a4, 0x4ec4e89047ea0245, 0x32279bd3988d4fe2}},
+	{{0xbcf0d5044036c8ce, 0x612db5983bf03001, 0x124ba602807ba6cc, 0x0b78a723cb96271b}, {0x7333143db318c53c, 0xddeb5df3299c78ba, 0xdac40ca0f7a3f9bc, 0x1e6cc15a0a3881e5}},
+	{{0x8d7a954e3e420766, 0xdea41790b02cb113, 0x41f90b777f0b7550, 0x12082844c18dd2eb}, {0x650aef0ec308c827, 0x069b876b69615109, 0xce3cedbcbe635c23, 0x0f2d46284109c205}},
+	{{0xcf88acc26b162955, 0x9f3e97b92ad2f008, 0x620da02aa9ebd5fe, 0x28b493b1dde4376e}, {0x2c749a19e6460525, 0xe93a10270a7d628d, 0xbec8805d493e7978, 0x1ab5d190123245fd}},
+	{{0x9fbdf20929e15bec, 0x7365697a31ecddf4, 0x959bbb6146044a2c, 0x0e3c0da5cd33a86c}, {0xf487a5d840b7b171, 0xa141c1e2fc4d9fe7, 0x0132f1ca3113f356, 0x091d3e60b8b9ce40}},
+	{{0xea8f8e07deb98d02, 0x8f9f5feddf1d0a86, 0x6f37b7611a30b3d7, 0x221e4f44d8a79098}, {0xbe3b48287c050e02, 0x39e99c089db9c122, 0x05c1fdb002ebd454, 0x01b539a4785244a6}},
+	{{0xd022f08d6a6683fe, 0x3125796de6f11db1, 0xf139a4980f76c99c, 0x143b368dcd0f882a}, {0x7dd1e8c40607cf74, 0x5cb8ea1cc87aa821, 0xf01ba97074b599bb, 0x36e62b6a4e9d84e1}},
+	{{0x886ad961f58f1bca, 0x49b3524532035338, 0x0fb570a2be758d46, 0x163ae2b26b8d7f4d}, {0x4968f16deb62a59f, 0xcfd54a5f101b971f, 0x19f7b2598303c715, 0x10690c95f9d1cdec}},
+	{{0xfb8a95c6ab8816b9, 0xf6b058295c6844b7, 0xdbdca5e3f0f59c3f, 0x0f7febc7d7f7ce48}, {0x7683ccaf588773b6, 0xccfa2754d2bd8336, 0x7851a64d5957b29b, 0x028f48534a86f7da}},
+	{{0xa551c45fb18cff61, 0x378081ea705b7d30, 0x08863301479c3479, 0x339604aa6a868309}, {0xb5956ede0b7d8179, 0x381df4c26f8ecd1e, 0xd306634973a5492a, 0x3ff4c7f2dfeed437}},
+	{{0x8cab3594afc9c6f5, 0x25f837002f27f873, 0xfd169b3c590e5ea8, 0x02259e9a59e99fcf}, {0xfe557478db822657, 0x12656de73d64f34b, 0xf47cf382fd13dc2f, 0x1c31001634bcd5c9}},
+	{{0x9a89ee7e0fddcbac, 0x4913bf4974912f29, 0xb7428d72032f3311, 0x3a0303ce58055fe5}, {0x44b8ab5b78c30331, 0x532638b14242fb0c, 0x398b98644f8d819b, 0x1e0ca87590e79fdf}},
+	{{0x26136bd0926779ee, 0x0473284847e5c7f4, 0x8ebc98910ad904c5, 0x02b7a9dccd176481}, {0x6d0ed9cb27f6c1ad, 0xbfa15d35a6b5ea33, 0x9ebfb9014b34e27e, 0x10ba352b94832dd6}},
+	{{0x03e88d10c149463f, 0x41f5b258067d8694, 0xc9a327d4b79d590d, 0x285b131d1455dd91}, {0x6979c5e7da19c5a2, 0x764eb3ce55cd2a3e, 0xb9e6c85e3cccbb00, 0x21baaf004a0d1a19}},
+	{{0xf6e41ba56a5cec69, 0x097bc04cb9ee1f5d, 0xc379b7d7a9293884, 0x36a8db9dcf5de155}, {0x8e059e96fe15bc3c, 0x7bff200f0f63a9d9, 0xa597c28d23e672ff, 0x2f894237dc0b3475}},
+	{{0x0c4ae1c11493d7f0, 0x518b68d4e23fc826, 0xbb3e1ac21fc85af4, 0x2a43d8f97075e92e}, {0x7d0076d3293e8814, 0x9a74d7e7e8c2349a, 0x1cd0dc58861cdf5c, 0x0b8b38982c68b9d1}},
+	{{0xe762f6f77f027347, 0xc632a7e99832f88d, 0x1ae5c35eab899448, 0x03f1e6d4f03169f6}, {0xd7d5fe7e9be58a20, 0x36d1f8982623b3f6, 0x3ca7b727388469b0, 0x079e8ea65300945c}},
+}
+
+// vestaGeneratorTable holds 2^i·G for the Vesta generator G, i = 0..254.
+var vestaGeneratorTable = [generatorTableSize]affineLimbs{
+	{{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, {0x4e4389b9b0276a62, 0xacce3a7f298ba20c, 0x13b64e3aae89754c, 0x1943666ea922ae6b}},
+	{{0x77ac52f49fffffff, 0x156c1f9d85fce98a, 0x0000000000000000, 0x2800000000000000}, {0x15898a4edb72736f, 0xbc29a511904e3464, 0x3139551bd400bfad, 0x2350d4c9f5dc140b}},
+	{{0x6c73d55702723f92, 0x7db873fd95247908, 0xa9419637021d9ead, 0x01ab5f34e47ef130}, {0x23d3d4f50ec65edf, 0x5b066f84a621f193, 0xc670900b99e9ec59, 0x2482d73c55a217f7}},
+	{{0x08b18ff949ffe96d, 0x5a373e1b77cc4325, 0x66966c21468d3baf, 0x314517461b61e56a}, {0xefcc633da677b584, 0x8b019cfe4fd7ab97, 0x00fc09cec46c6623, 0x1289adec7e1cc278}},
+	{{0xd5c34f1638b57f47, 0xb46db763ef3a03f4, 0x7c11c614093e675c, 0x01fdf18bbe6bd3ac}, {0xfecaf82a6eeb0ae6, 0xa9735983b269fb82, 0x2f588582840430a5, 0x3b9ac632bd7a7fd1}},
+	{{0x81f2ca197de126e7, 0xb1266a3f216ca335, 0x4292f6c503750196, 0x302944e843b9d397}, {0xd63f6c0cc24fa709, 0x3901a7e7efe48bde, 0xa85e2eab8bd5ca7f, 0x3f5aaffa1b9577b4}},
+	{{0x2263218cdf5d3f2e, 0x86b9d27c9a336191, 0x21c444865a9eb695, 0x25dd7030fc687896}, {0x05c230f54fd1664c, 0xd101cf26ceb3323f, 0x91e44cde37721652, 0x0772d8b758818c97}},
+	{{0x8fa1684a003e62da, 0x89359e095eed3ff6, 0xc09872e98f471dff, 0x05dbacb7e26eda5d}, {0x52d23bf3cce905cb, 0x75a1ffce3efee056, 0xf4f02da455af7d69, 0x0ee11af5e03058ad}},
+	{{0x070cb80c6b58163f, 0xa64a67a57db8794d, 0x6deaef64e20c524b, 0x32107c912454f457}, {0xcdac51a7b0ce2c3a, 0x72a36f618430fdf5, 0xe684a321e13f5921, 0x0cba617d47187597}},
+	{{0x781ee2c33d984835, 0xa9360073351621d2, 0x8c7190813142153b, 0x1608f8286a706549}, {0x7a4a4684411857ee, 0x107756d2756868b5, 0xa9977927287682ce, 0x2f8cf4cd2355216d}},
+	{{0x183fb3b0ecdbb02d, 0xe935c97f3516c76e, 0xdcb6d3798b673643, 0x19f39b39a3c624ac}, {0x6405fb773493e478, 0x0f80598fddfc48dd, 0x04592fb3b8851403, 0x0808134dd874e3c8}},
+	{{0x0111071a448ce22f, 0x9c908c828c97f967, 0xbc5bf6c697a53950, 0x1ba8bd9f166e17a2}, {0x54f9769f8542c254, 0xc54b7c5289009850, 0x211f11832c660a8d, 0x16823a112ce59173}},
+	{{0x2f784c2cdb2b4825, 0x2bbefef12b020db9, 0x4e3b16bae4e75377, 0x214c20a67552f32f}, {0xe6843db23867433b, 0x085a6ced2f5bb8ce, 0x2896255707df5fb7, 0x2d0c4da157c5498f}},
+	{{0x4b2499100942fab4, 0xf116e94a957368a3, 0x684ea27a6816a5b9, 0x2add6a5caeac78a4}, {0x39d7b89cb0d88692, 0x584c62f2bc0c71aa, 0xda5f1221dd72dda3, 0x066fe65caff33c5c}},
+	{{0xc8d4cea5f26920ea, 0x8b13e7aba30fc4f3, 0x31a356f071e7a74a, 0x3254084d99c794e5}, {0x4bdba0d8ec0f6434, 0x6e52232af1e80770, 0xb4db47c9e42cecc6, 0x2679d33be7c5c4a6}},
+	{{0xe65376aafbf6e75c, 0x64f04e87cccb96f6, 0x753bc8f1d0e8a300, 0x331301c88a9efd49}, {0xed023c9f54478c37, 0x98c404f1dfc9c77f, 0x3079b054def64d15, 0x3fc7e8df1fbcef07}},
+	{{0x8daa88892b6cf12a, 0x8a0cb91645325392, 0x408aa80d7c4f7950, 0x1dcf94fac551b3c5}, {0x487b500b1c59742c, 0x173295329a467b1c, 0x33b29a243b579b97, 0x32fd7c5d680f6ac8}},
+	{{0x939f05b7cdefec52, 0x02bade0797f56d84, 0x8c23d3c23d972582, 0x133ddfcf86e60d26}, {0xbee288d69926e733, 0xa9e6bacdd1858c13, 0x4c4fa9271a923b81, 0x3bd3e5c7b7346ef9}},
+	{{0xf6bdde21bf37052f, 0xd99c176d43195029, 0x79f3a7221a6799f6, 0x0e6da01898c331cf}, {0xde3e30831262e96d, 0x5d1a2d0bf5ef54cb, 0xbd6de497c42bcc9a, 0x0669fb404517c375}},
+	{{0x47c4c995e73ebff6, 0xb2c52d763e2024c8, 0x0b21f1c15c85051c, 0x3acca4ee34a032fd}, {0x8af5f455627f4367, 0x47bca5801a2c131c, 0x76198805ebde1154, 0x32d3424d8249ea06}},
+	{{0x720772a1f6cb2f13, 0x6d476fdd9d194d47, 0x7e016162ef22b988, 0x39cc6c5cd4773668}, {0x07d9dda8e58419f5, 0x7905a4c5c650f7d2, 0x4f69b484a2f1c8e1, 0x0b45f32ebda5d6a5}},
+	{{0x0c41ff247d57bdf1, 0x10a10ed76cf67d8d, 0x8ac00d4561ac7027, 0x3bf06394af90648b}, {0xa2d2eaea96806ae4, 0x2b68bd9d67e9c67e, 0x7e8de78d3db7c09d, 0x3ff9392bcdca2973}},
+	{{0x11aefa04e6dba009, 0xe470dd8848ab7d04, 0x8fe09a9377cb656a, 0x204d5cfa02000cdd}, {0x7279e427fc17ac83, 0xbf9594506ac7a5d0, 0x4fad2ae6ff842ad9, 0x2738d08bea27dd00}},
+	{{0xf53dd1856acae0e9, 0xc973aa033cb0e2a1, 0x0c3b88cbe2545e7d, 0x1475c6dd1dbb25f8}, {0x9eed55ed8a2a98ef, 0x68ec08512547f7d3, 0xb6a35b6a7556b401, 0x330411897f6fd127}},
+	{{0xdfc1e86303d5f265, 0x4d7860577f0c5fa4, 0x2956fe82abb9d99f, 0x10ce1264553bdf41}, {0xa207dba82c37bab4, 0xaeea1c54fb920ad8, 0x4271d288cde0e646, 0x311a8bd0b525e9fe}},
+	{{0x89fc2f4853467055, 0xacc10d7c2c128bfb, 0xd5fc85c19293253e, 0x165a5731b3ecfe47}, {0x6510ac3f6ef39989, 0xc325ca9e8db90fd6, 0x39f7b5b3df47d5e7, 0x3e44590f08937bdf}},
+	{{0x6444574164ee0bdc, 0x8e92879dacc99506, 0xa8848207fb072329, 0x3d3b2569363ab0a6}, {0xf6bdfdd834c25d51, 0x5a0f437d6e6f74ba, 0xa84ac8b43affc810, 0x03a292a72121271e}},
+	{{0x8d95466b35eff438, 0x515d711348a8284a, 0x771b4ecda9f2e914, 0x10034bd79dc4a81a}, {0x9cefe1576e3054e4, 0xf32ed73ad371fb38, 0x357640573c9fd120, 0x0f9a25e398c67e40}},
+	{{0x569c413538aa9093, 0x0a23cd61f5282042, 0x6ae076a863b433cc, 0x266e5149c7b8356b}, {0x21b99dfe29ead875, 0xf3f397a14f128843, 0x9f6b6ace21340625, 0x1b08c372e827ead7}},
+	{{0xe91043d7aee45c52, 0x12110c9f77bdcec3, 0x01e00c41c7c5d26a, 0x0d1fed22fc525893}, {0x809f0494ffc69b2d, 0x75308f74ac5e56ca, 0x39b30b053afabc0d, 0x3a7d0a1c104a2d7f}},
+	{{0xf0a28e1ca7038895, 0x909bd1aa14909616, 0x44d5f6f4625a529f, 0x09ee516287da6a0c}, {0x45ac6f217be2a44f, 0xe5b92735f85e0242, 0x045c8e50f61f6c50, 0x22a2490e3a93fad0}},
+	{{0x294b751867593760, 0x9640e3685a6bcc8a, 0x89f9137314c2c464, 0x1b8626bfb0631239}, {0x0d25078d824486c5, 0xa76259b7b28a3109, 0xc98a677d79c8fec5, 0x0d9c61e7626f8b54}},
+	{{0x82980c582b593334, 0x92855bbdce0e0ab6, 0xe5542f5c3eed109a, 0x3a22998911bb2a14}, {0x42fa21c0b0955e86, 0xa056e8d4d2805268, 0xf656016e4d0f276d, 0x2cf3600735996af5}},
+	{{0x2a6ad6d44966f38f, 0x7cb36e0ff32ca946, 0xa3774f5ca7bb454d, 0x218549e988bf1f03}, {0x905bfd73df26b070, 0xc58408a8a8e53ed2, 0x03c52aa462ad7b87, 0x0f6254c1144e9754}},
+	{{0x9f2bc88ab52cea29, 0xdb474f9d275af328, 0xf100489fe254878b, 0x000e3d0f41b4711c}, {0x61eb7368cd48f769, 0x36fb3a3e7e46b91a, 0xf471501824e2aba6, 0x218f9ac8a5f9360d}},
+	{{0xffeecfe67f2ac395, 0x3a100336e96f7967, 0x3bd363579cc3be9e, 0x015d7b5ec469132c}, {0x5d6503cca19e3da9, 0xb1cefe6a1ec057d0, 0x3a5ec35792d8b295, 0x05ccfd8db0baaa5e}},
+	{{0xad14fa3ded355f30, 0x0b47f4883afa606e, 0xff80b0b51f0318e3, 0x0989251d8c46dea2}, {0x9faeb88bd9bf20db, 0xa75c69dbeaacdd1d, 0x1d92fb9b7e4e066c, 0x0e187b33a35578e1}},
+	{{0x61d12f526ddf82e3, 0x671d2a6ec1d0d07d, 0xab02c4dd06fca012, 0x337b690fa8634ce8}, {0xf4f1e3b957951679, 0x90eeacf992cb15e3, 0xf4c0504d95f401d0, 0x3bb54124e98f74e5}},
+	{{0x5e41e64a0b3f5e1c, 0x6d62c7e23b0d74eb, 0x9c752df311f94e28, 0x39a4f28b4d703560}, {0x2bd12806bfa67794, 0x51d81db358f39a46, 0x2f5ce8a7cdbd8ce9, 0x030bc164dc52ac82}},
+	{{0xc25e89b7bbb7cdc0, 0xf7bb4f2a1d7a8f2a, 0x8ef157fe421f65e0, 0x3a435eca56045264}, {0x8ea5c774d16a255a, 0x86fbd2462ed68397, 0xcdcc82990ffd6c1c, 0x1eb30fe4a7bd49d2}},
+	{{0x6c5aa8c0acb4929c, 0x972fec702c366509, 0x0d1df0b230294e41, 0x39b7445b59d1656f}, {0xd384adf4cc1ae2c3, 0xfd508596dcb6f4cd, 0x0e8fa03daa255f82, 0x127aa9fa3b934209}},
+	{{0x3f699f76b8afe369, 0x70cf47d465ce9289, 0x4ff9beff4a9d72ce, 0x394b39ed8fcb95e2}, {0x1da3cf4fa51afa9f, 0xf54b8eb8eb1ee305, 0x4ab3d96304ad8726, 0x084a0db155eb8dd5}},
+	{{0xd665c0990a50981a, 0xbc34f20f95ba1201, 0x7a354f69521a03df, 0x21b415d6a4298036}, {0x476ccdd1e7aa1a40, 0x7bfa63aa3313314f, 0x5f470f99485ef6c8, 0x35946eef5a81192a}},
+	{{0x35a11fda816791d2, 0xbf477b2ac2428011, 0xa7774f65d7725570, 0x17c75e67dc697d7f}, {0x61531ba3593e201b, 0x6dd87271e5c806c7, 0x0a82fd9fb01bf9cb, 0x04c28412e1dd2153}},
+	{{0xc1d1eae3475f08c7, 0xb388eda169d1a1ce, 0xac3a87f4bc4fc5f2, 0x15b88348f76587fb}, {0x2fda38aafaa1fd70, 0x40d5334b0f8de902, 0xd306b02baf31da89, 0x2c7a4787230805f5}},
+	{{0xc117fafed8dd48ba, 0x1968d7404436b1b0, 0xb64474a3ff4f5512, 0x0857fb697e656d11}, {0x3fb40ed82323c741, 0x526195d3ac350b56, 0xef0c6e9d4e5a9518, 0x2e8152e5aa6bc512}},
+	{{0xf6270fa52b2f1dca, 0xa17ff4e0dbb43644, 0x457a209d242d6f3c, 0x1b406f27922b32b9}, {0x19397a7c00840513, 0x874b1996b933b4db, 0xe7cce064169cee22, 0x1af148ca4e96ce5f}},
+	{{0x77c2790b50f106d4, 0xbe51cd57b17530b2, 0x584d9a8a6cb582c6, 0x1da81b92ada1fc13}, {0x1427d67e6dd77628, 0xca1189636c135921, 0xbc4c9e94d4a59edb, 0x1ae9bbf6c1faadc2}},
+	{{0xa89b981b2cb99a1d, 0x914e33d5e447a980, 0xd17b004057a529d6, 0x125406b0c7bda756}, {0xe0522ad12ccd1a60, 0xf9bd95b131b8bd73, 0xaae6f75a2444a1f4, 0x225823ea59dc5759}},
+	{{0x128df5f91ab5a46a, 0x1725130905deed99, 0x7b2358207b7cc7d9, 0x1eea76c5f60ec7ba}, {0xfd0f41daf2390dc0, 0x6782ca9e64ae3cb4, 0xb5ed5964ce42bd9c, 0x0797a80d8378d8a7}},
+	{{0x5b9260fec72c81c0, 0xdbbd32e73c23577a, 0xb99f4adfaf04db7b, 0x36f267f129a06efc}, {0xe4b7add8363d4100, 0xc5d6d0ce8c508fb6, 0xca407f4f6cdf2295, 0x3ef25a132c754c72}},
+	{{0xdd1bdb878acfbe9c, 0xdb2c4ba9a71959ae, 0x5b1b157c9a3ecac9, 0x10c765ea04eb28a4}, {0x2bebc3c5c78d9c1e, 0x59530f2c2ace151d, 0x4fa9de0141c7bd35, 0x2b584d38fb296e71}},
+	{{0x8590ede4fac25ef1, 0xe0c9db9fa8befff2, 0x6bc32815afa44ea1, 0x2ceb58acbcfb030f}, {0x0dad2e9ba035d0ba, 0x63ec421414f01fc5, 0x020ebaf06b27e019, 0x262e27ef8f9cdb27}},
+	{{0x5ff02f446fbfbd8e, 0x41ab676f410e043c, 0xe5efabc46fab600f, 0x073ad34fcfc059cc}, {0x35a64499b9058de5, 0x5ef75476fc9b6f33, 0xcc11b9bb5bb7cedf, 0x35d029ce42c3cb4f}},
+	{{0xc951d72e166d004a, 0xd48eefa7d83ba5b7, 0x9755c862a4bdb530, 0x22736273cff37e15}, {0xffe69c50b2f1f221, 0xc6940fc9787de27b, 0x4bb88ea02a1be5c1, 0x2b543f9526b6e650}},
+	{{0x80de27091b4bcc51, 0x020769fe736836d7, 0xf836cb74bb913d8b, 0x1fc4b312dde3843a}, {0xaafc4a28e053ea21, 0x85557c1ca647ad71, 0x1917018f799b44bb, 0x26b0d8cc12bf45d3}},
+	{{0x7629314cdbb79e25, 0x98de46bfd94f3edd, 0xf36ef0d295dace9c, 0x2ad79205555af6cd}, {0x370dc2755200e47c, 0x1d487764cec3e23f, 0xa86263f977c663aa, 0x1958072ccfc49d94}},
+	{{0xfdf591b31c2a2cfb, 0x3377e3585db8052c, 0x9301d848cf658daa, 0x0259bbdf5ae82db9}, {0x625dd460a57bfd86, 0x67a2b446d200f546, 0x566e053e527485fe, 0x02a7e4e6505d1684}},
+	{{0x20aca925b5484cc5, 0xe2fdcfab77541795, 0xa8bca5280245a4fb, 0x285e9cc49cf01e5a}, {0x3663c0ff68a59ccc, 0xff07a0efe00a56f9, 0x4c21e0daa8412878, 0x3034a305cc6572a4}},
+	{{0x6148258221314faf, 0x1914d77f5b6c2dfa, 0xf3f76929e1ffc2bb, 0x219862c2dd89a8af}, {0x3791112818131803, 0x50fa277e64ae7703, 0xf8e0cd8ec03a50e8, 0x225a92b9396b25f2}},
+	{{0xe30524237c7ccc56, 0xd1c9688413254e60, 0xa848e7255f44ecc9, 0x376433aaf66912e5}, {0xf0cb2b9df05cd120, 0x66d4022502fff424, 0x37dae22b78c8a655, 0x0558c7162dfba861}},
+	{{0xeeff31f3a21d0770, 0x5b1f8bb123aa3144, 0x6ec49d1074c0140b, 0x2ba916f6f40afddd}, {0x55b2e9ced54b438f, 0x5aa7187d1f0623ab, 0x881494245458f446, 0x0ce218c9625ffda0}},
+	{{0xc9664fe2ec866c4a, 0xf5c1d107e75a1f4c, 0x350e7cfa446ea6d8, 0x29f79cc42afbd5bd}, {0xafbcf2849b269fbb, 0x7d3074297e23606e, 0x5429f148adcb1258, 0x0f5b3de0438ddbab}},
+	{{0x303f5837ee7f61ba, 0x321ac6b4393bc636, 0xdd13e8bd27b69c9c, 0x1887ca4a7d656d45}, {0x35397f8d4aacae67, 0x660106e73fa41405, 0x15091d31e5968dd0, 0x13423bba542b257d}},
+	{{0x5c50db5e0c59f2cc, 0x0ca616d0e6d877b0, 0x4df0d9d9538ae29f, 0x0949d969262aad5d}, {0xdd108f3d54ba9bf9, 0x9e7b802095901838, 0x2412ce81c4540b6a, 0x0a96d4fba05daef9}},
+	{{0xcb58ee8d8c7d23e9, 0x7ad1ebcf06c5ecd3, 0x6cd7fcdb3189684c, 0x0648d4951b5b51ac}, {0x90bec6a1ada0b7af, 0xc01a21bb0e7335b0, 0x75bd04ce062a597e, 0x0ec56a5c8c829862}},
+	{{0xd41a4c3b8c78fdcd, 0x295e7bdee3879446, 0x206feca23e290479, 0x2fb4a2871bd18175}, {0x13eaa977f656f6d3, 0xc6ebd3cded5b6722, 0x0713c20febfd68e2, 0x2aff0c4c8a56da06}},
+	{{0xe3c6e78555eab745, 0x330205082a7c7b04, 0x95ad14d9243394e3, 0x1ac31c021518a88e}, {0x2059a6729fdd56b2, 0x7eee96eb8ca0ae80, 0x30d23e8ba064e8ce, 0x1490304df9092f29}},
+	{{0x855cb013c3926bf9, 0xdceb0991acf6dd4d, 0xb5cc942cd60d0792, 0x03a3157d44bd19a7}, {0x9977a31cf6a4137c, 0x008f3175da320c98, 0x3f372c35e6f323dc, 0x18c50894304da15c}},
+	{{0x27d9d7823be725a0, 0x3b910bea522d0d35, 0xb42c4ec97cb196c1, 0x24d49d8ca9ff4c9a}, {0xff84447cdcd1d39d, 0xa5620a3f98d72d7c, 0xbf47661f512c02a5, 0x0e2434917ffb2935}},
+	{{0xb7107d996459abb3, 0x6377cbab8ed0b720, 0xf9b85f29798c3e24, 0x3ceef65689c74edb}, {0xe47538ad99f0437d, 0x65e3271f4fdb9553, 0x55eb0058ee96d09b, 0x031c7e58bcef2f93}},
+	{{0xb7a2adde6b3a087a, 0x2dd371cb8ccff3e4, 0x6118adb293ea8107, 0x09e90897ef30a307}, {0x780e896a02ee116e, 0xfb99234b96fb5c4c, 0x48f7f2025fee99d7, 0x17117956420604cc}},
+	{{0xb244dbb071aa571d, 0x5e89a41367ed81a6, 0xc4d18ea9cee30be2, 0x1a61e08dce23acfb}, {0x565efc2bdd022831, 0x9d346d176795edae, 0xff91510b8264122c, 0x38b3874e3260021d}},
+	{{0xdbc2f5e31662aee0, 0xd7083066c2ef509c, 0xd025352232c4dc70, 0x10135cc934d08466}, {0x1915f30ed2a3826f, 0x0eb526d710562921, 0xe6ff44fb0c5179c1, 0x0aa32052fd1f1bfe}},
+	{{0x93fbae45f3e301e3, 0x0e2c53f6ba140557, 0x0441e5f480322346, 0x2bd07b99d9fdd3e3}, {0x303e4c8f7fc34e2e, 0xe6523d63dfd51502, 0x59e84708b7188dd9, 0x024db95bd3b6139e}},
+	{{0x6db515741cf64d42, 0xcadd80cad71f087a, 0x0c9fa090e524b1c7, 0x262938a31361e1bc}, {0x5ffe2c6cebc138bc, 0xfd39a822ba02da02, 0x082f22c43052b93a, 0x22ca8b2297362a8b}},
+	{{0x0325b5e2e56582ac, 0xcaec16fe9e2c0e10, 0xbcde228fa22fb74a, 0x3ac878cd90588048}, {0x4467259c5e9d7b7a, 0x2858d8c3622fbf14, 0x4f60e6c9fd733ba5, 0x365c840819663f72}},
+	{{0x7f73e15baebcb864, 0x75f83a91e91e5a68, 0xfb1e9a76930bd119, 0x1e70969289abad82}, {0x7eec80920da793b4, 0xa113e15eb66978df, 0xe2f509ef50ada467, 0x216bfc34d3689219}},
+	{{0x109d974d59a00748, 0x7c0845a791240b12, 0xce2ca478f7ca20e2, 0x3e2b19ebbed5c7c7}, {0x55d4664460eaf7d5, 0xf695f5559911b815, 0xb242dd012d64f4a5, 0x1232f1dcce30fc2e}},
+	{{0xc4c2a86963237f97, 0x0235de265e79fac7, 0x31f3205ecd2b94fc, 0x09138b94cff85e49}, {0x7b6b34ff1cc688fa, 0x023da4b5471dfb13, 0xa3eebdaefb8c09d9, 0x32c8a7568181a119}},
+	{{0xab3326c695221d87, 0x9f7b50cc238ab20e, 0x0c7957d4fa25452d, 0x04a896b5e9365806}, {0x32d67aa9005edb91, 0x019b2696b04a5c6e, 0x3addc8fd48286cce, 0x372730d3f221bc5b}},
+	{{0xa25ba5541a101e66, 0xd0f8f0de4568192e, 0xe098bb5d8adaa682, 0x3ba2c111842c6619}, {0xa6d058c4e9e674f6, 0x100ec6814c152845, 0x44a97179a5b2eca0, 0x00079c775f0bd98d}},
+	{{0x7aaa146c41488a6f, 0xe214687110a99c4f, 0x4a15e1bf9dfc09f0, 0x24186def410b61f5}, {0x00e4eac542c481a0, 0x34b284f6e238ca85, 0x5568390a26412718, 0x3db00d6c3b5f6ab5}},
+	{{0x2535d5415943030b, 0x0a22f035e6c270ca, 0xb2c4ff5e77db9058, 0x24a3e68e475f239b}, {0xe04b3e774e677f9c, 0xf97557e08baaa1cd, 0xd3ea2b170a132f4e, 0x2336478c701991a5}},
+	{{0x709caab14128532f, 0x20d5a74554aee987, 0x05a41358561a5895, 0x2332e4030366a99b}, {0xbd7a9d97eb5a7e74, 0x388bee45e5d87c57, 0xbc3c3c966b2ddbdc, 0x1b6312d105eb87d3}},
+	{{0x93ac0950fbed065f, 0x08ab8d03e828c24e, 0xd4edf034ec1c6dc5, 0x24f457662cb8dbb6}, {0xa2514ce77c38edf1, 0x0361b79faad0f231, 0x497f0c181bbb25ce, 0x3d042dd2372ee110}},
+	{{0x9aebd320db830644, 0x0492eb375e5e8302, 0xc6a89e9d6c6a5040, 0x0c5ec74e1e712856}, {0x68c78f7225378ef9, 0x10e2206af87d401f, 0xfe84551af8fec6a5, 0x0077ae841953f1fa}},
+	{{0x51e9c529c2dc0777, 0x07c9ca910125ddce, 0xa2ac0656046111cd, 0x286d9a82b2ea997e}, {0xcfddabc5f0225a91, 0xabd25c0679f86960, 0x9e3eab9b280fc5be, 0x008c9e694b99604c}},
+	{{0x3a633225cc53074b, 0x4ed8ee3c379b11ea, 0x3c1585bdcdda139c, 0x259bde92de23cf10}, {0xa5e482d126c95973, 0x7b6bbc70f2511f6f, 0xeea892a5ed9f9bf7, 0x1b6ebd4b3b824ec3}},
+	{{0x05ef50c0f16eaa77, 0x6f5e73c34936c5c7, 0x94ae7e99fc7aee0b, 0x18ca0cb2ce64778c}, {0x0853d60f971766f9, 0x1dabcb9757586288, 0xe3a192dd12b632ea, 0x224b1ad21364f5a3}},
+	{{0x2578ff8daf3d291d, 0x4d09b9e4f50322e7, 0x94e52dd46b91c315, 0x055790acef4fd90c}, {0x7304e7e33fa883b3, 0xb31697fa96266b48, 0x5e5e3df57281f029, 0x11f7572dae22f792}},
+	{{0x349aaaa06307e512, 0x013b3bc3ca508c12, 0xd7de557919275f71, 0x23b3c7a5e6486070}, {0xabd76f084ed5c5c1, 0x791ab634733d8474, 0x18d4914a84fb6965, 0x0e7f2e0b648fef59}},
+	{{0xf1715b015b30d5e9, 0x19b1b220fa7380d3, 0x7cab01cb9cf6b70b, 0x22ede8efcd79c825}, {0x2772721da7d67784, 0x386ee1a82edd9afd, 0xe1d6d85081d56225, 0x0a82fe404b9a61a6}},
+	{{0xb5c3e79a55e06bcf, 0xf41a8d7e717bf5db, 0x396c49b2ea0fbbe2, 0x2e7b0203a462fc28}, {0xfa8dd98d222a9492, 0xa388868fc333462d, 0x29de46efdae6fec9, 0x1b150cb4c2b20be3}},
+	{{0x769a031e02b7883c, 0x73802cb9d141dced, 0x452cbf9778009623, 0x133cc40c0eed32b0}, {0x018c64740030ec69, 0x8d1fb26e6a2caa2a, 0x1a7dfa5cfb5f6df7, 0x35f7c88b8ad7211a}},
+	{{0x86e29c2ffe92ef3a, 0xc1844077c99fe1d0, 0xcfdbf850a98df4b6, 0x04c8b8ed328b6399}, {0xe3214346c58db420, 0xf06fdcd1a67cc293, 0x188ab80d86d71d5c, 0x3d3aa9f335be740d}},
+	{{0x0619e7b8b70f80a1, 0x9d1e3fb92b808490, 0x6f6148e05599592c, 0x3117b8963c5b501a}, {0xeb5b028c7e563744, 0x1b40adbdd766d9d6, 0xf1fb3b6224b20d29, 0x07ea5f2030d854fb}},
+	{{0x6525623ec98277d8, 0x551fd4e939ddb738, 0xc6690454de19675f, 0x3a686a15e88bcd1b}, {0xc6c8d419255566ea, 0x6b7429c9652bfd47, 0xf1426647c4a4b569, 0x2398e2aeb3dbfb0d}},
+	{{0x4586315bde119d5a, 0x5863b118d9de83c2, 0xe5a2ead270c49fc0, 0x04c1164b80cc74b7}, {0x80d9dc9256f89193, 0x931f1f9672fa3dd5, 0x899433d5e64a7480, 0x0f070d349c2aefde}},
+	{{0x120b22a893540ce1, 0x2bc2e17f17c35cf0, 0xb5a65056b7a9bcf6, 0x1073874ea98d84b6}, {0x45ab10ff48570baf, 0x6e534e5ec1dfb38b, 0xb4a14592188682a4, 0x1deeecadb7029442}},
+	{{0xfa82542ea179b3ed, 0x5a0362f8bff28260, 0x557abe504f6dce84, 0x22ec4decb3722286}, {0xdd1645cd46eb6d15, 0x624045419d81535a, 0x0aee41c1f02e2996, 0x1e75b86982c0cc14}},
+	{{0x4f17bc7d96d7d84b, 0x443dd866523cd457, 0xb260f01581c8e74b, 0x161d0d9c61a41565}, {0x7e2630ee83080308, 0xc3b2e3fdbdd332ce, 0x714f8ff25a1674cf, 0x0b870bac6809d0d4}},
+	{{0xd3faa28577b1be63, 0xd4e69d1fffeee5f8, 0xd1826e9efab11712, 0x2891183c792eb67f}, {0xb747cbda9a993193, 0xc9ce381cdcb05f81, 0xb6f985b51bee8c65, 0x2cb43657afbf187a}},
+	{{0xe43519e58a6d2dc9, 0x4d6453c2dfc84ce2, 0x443955d76ba0a4ca, 0x0d4e3cd33b055ea8}, {0x8b5bc73f84b4ae60, 0x0a3671ca1535cad0, 0x03fc512dfc841f34, 0x3204f20c7926299f}},
+	{{0x0451737e9d505f51, 0x94b27c756bea6c4d, 0x6ebd72d5b424f669, 0x2a818709d2d6c1b7}, {0xcb404b95939d44f1, 0xb9accd7e8c4f3829, 0xaa88a295b8b17390, 0x0d724347d387e5a3}},
+	{{0x2218d5162433bd18, 0x467126326e7e8a3a, 0xc32f5de664e0eabd, 0x16663973d6fa9d31}, {0xebd9e50078d04470, 0x008ef5a6f21e5dc6, 0x1af263613d637f25, 0x37347854fcf274cc}},
+	{{0xa3f1faa37a7e695e, 0x79d3430dff5f99b4, 0xc44bb1427597ca08, 0x253439ea1d9a8dd6}, {0xd186bccbd479d3c8, 0x81d554d6a72ac291, 0xa1e4368636b57363, 0x27484e7166581b22}},
+	{{0x7b1dca1c4870db14, 0x18e0b171a56e9dff, 0x9099b721520ebd8b, 0x2f6d8ce56d67df30}, {0x50ecb825b81c0502, 0x9dae63fa529be3dc, 0xebf7aeab4c38bdc0, 0x2c453abeff619687}},
+	{{0x8a71fe4c5fdaa9ee, 0x70cd9f08bb6f3d14, 0xa17687aa7778099c, 0x1ea1ce08b685f1d9}, {0x7c1153c7e00830d8, 0x6c09af422c50d227, 0x7b91e63840c9ceb1, 0x3a3bd1c260844331}},
+	{{0x1753fa5cd6c3645b, 0x81a0ceb6a30bc88d, 0xb7a180587fff1d5a, 0x3d8cc1efecbeb997}, {0x214db8e2a2894bef, 0x4d747af72279b56b, 0x7a8bf2de954d09ed, 0x39c9ec1ca7d268d2}},
+	{{0xa41ca1d505afdadd, 0xdaa8105fa21be9c0, 0x9e219046452458d0, 0x019d422c0b884138}, {0x9448ea0d76811dc4, 0x6d38b2b26b1dbf5e, 0xf5733c01ce54b468, 0x17193a6614cc748c}},
+	{{0xa8affb3f6597cea2, 0x9f640de15131310f, 0x2fba2fbc47bd8132, 0x2bdbd48a53a2b1f9}, {0x2a483f16cfae9ce0, 0xccd1f4e3cb64a3d6, 0xa930bf37f8916c33, 0x25a3d1eabfcc3c08}},
+	{{0x338e3bc72e6cddbd, 0x679d02526cbde88c, 0x85e8ef4e2ffe8aec, 0x2c1f594941a22ab6}, {0x839554295bf258fe, 0x8c3381b34389d775, 0x9ac9e3e276b9a01b, 0x081c03e86f562f26}},
+	{{0x4ae134c0ba365e39, 0x760a5e0373096df9, 0xd8263a609085c760, 0x27f27e91744b6aaf}, {0x357cd41501e99dfb, 0x3a8d0e36732c8344, 0x576873e74e3096b2, 0x05f0402eba60c324}},
+	{{0xb1802aec045eb70e, 0x03da220e5902da48, 0x3c7ed1ac4ff43db0, 0x2f2d2f5b7ed84f99}, {0xcf28ba6969838737, 0xffadbfcf68cbbf15, 0xa870f6ccf9d5dfe2, 0x1dcc069389d1e847}},
+	{{0x9d6495490bb0c692, 0xa4c39ed3337c6de8, 0x8a5787ef722bfa18, 0x2af54f144ccba193}, {0x92a16fb29f9bf9ee, 0xc4c731626cce6d21, 0x962ab174ac4ebbf2, 0x393c3bbf804d8f83}},
+	{{0x5b5abdb20a96da05, 0x3cc3be5320209591, 0xaf56f36ad5a14de2, 0x1d4e515efa42f5a3}, {0xbd0d191de0574aef, 0xaa104efe62fea94c, 0x2f578de2f4c23c38, 0x0e622f4262185dc5}},
+	{{0xbbd47522c4b9b49b, 0xdc948b5b3f59dda9, 0xadd7935d2acf6eb7, 0x3ee4cae1d0fe9860}, {0x93a41dd821c0b025, 0x17e786baf3a2ce36, 0x7795768da4ae0611, 0x3c47938e07f2ac0a}},
+	{{0x0f79a2c16ff75a12, 0x0b98547b6d2a2e6a, 0xd2376f3931d8ce85, 0x2ec0759df8f4bfe9}, {0xa275191a75f86053, 0x0c54625366ac2ae5, 0xd4ce35141b4c1e42, 0x1cb99e07ec458fba}},
+	{{0x08785e2251af3038, 0x20a3741f3970424b, 0x350a450fb80cd404, 0x05b4b48a7b96b219}, {0xecc772b27ecd8fc6, 0x6b285b9bc9114052, 0xa3f592b45a83325c, 0x3963c702f348ac6e}},
+	{{0xe561f5e87f660dcc, 0xf90a36b7cb3cfa13, 0xad9e1b62667d916f, 0x069262116287591e}, {0x76214c1e5833d94d, 0xf77294c67314adbc, 0x5252d15271e8388f, 0x014e085f0064540b}},
+	{{0x830f7c0f9e5d253f, 0x8f00c6b0f0d324df, 0x44a8ae242a790152, 0x1a4a670ba37bc76a}, {0x7da7d5a4a0a20e78, 0x09d11cdb91b7733f, 0xb2cad476ed478715, 0x102bf4a4622b22f4}},
+	{{0xe39c137c9a34c1bd, 0x3a76544cbb2a79be, 0x7c4709ec7d59749a, 0x12253721f012bdf0}, {0xc814ee2ad515f75a, 0x98084e799aa7f070, 0x5df83fae7e7bb882, 0x237a6d132ae8850d}},
+	{{0x20e768a193da73e5, 0x4c4ca0ce4909139e, 0x1c48be968f0ca326, 0x1cfbd5d3b27e2986}, {0xf1179f332a982653, 0x70ddbb7663ab0096, 0xc9eba8fb3226d65c, 0x11abeb2c20d10184}},
+	{{0x3ae4ae12bad66e3c, 0xa51ff0ac0a9ac839, 0x4fbffa272d7b564a, 0x089528145013bc75}, {0xa5c664284947ffd6, 0x57193709dce7f263, 0xa38d1532f2508f04, 0x26e4dd8300218c89}},
+	{{0x0e2a9664e04ac8e5, 0x4fb9f3150659495e, 0x66646b2962a19a6c, 0x1d2b14ecf92c3921}, {0xd3381eb1aa62d7ea, 0x59e3e610ca5ce81e, 0x5e4ababe043c9a4a, 0x12136ff1c88af7d0}},
+	{{0xba70c1013b1c66af, 0x0489acbcdbeb4ade, 0x5b222de3a1217f3d, 0x0f7d7fcc5f9bcb26}, {0x640f18ea4d5ce998, 0xd9a8690a155f2c24, 0x9517dfd7d98c1792, 0x10c50b5441cc50d2}},
+	{{0xf0c84d937f889ed9, 0x9231e1485f9e465c, 0xcc928c52d45522ff, 0x371810cca9fdf463}, {0x48c6d4eea446d973, 0xd73abf210f33bd41, 0xe4666c880fb66010, 0x16af18108c5a2488}},
+	{{0x475b98fc1bdb02d3, 0x69cbd580675ecb21, 0x7f80621c63bd88d0, 0x1c889363fad0e585}, {0xcd130ddf60da80a4, 0xc0eafb77e559592d, 0x23f3a3b1accdd7b7, 0x3263f015d92ac970}},
+	{{0xcca2b5be4ac7a7e6, 0xaca1673cb895f99b, 0xc3b7858a2d728f51, 0x3a9de9f906e03022}, {0x28cd355516f50c9f, 0x97d6b0f6f530db67, 0xc34e7556f3ec25cc, 0x1af557cfefccee50}},
+	{{0xe805d791384e7768, 0x98b2281991242d52, 0xda2adf0c93c9e783, 0x32a6cfb24cf74ce6}, {0xb79ee392d0ed10c4, 0x2cbce3bd16708528, 0x760ddfe8c05c0344, 0x33445c7b1d5f6b07}},
+	{{0x6671ce194fc0d1ed, 0xbfdcccf46b9557c8, 0xfac363498ae9afb1, 0x3a3cbb21a07eade2}, {0x817bacc6e18daf2b, 0xb7a9e45e45441817, 0xf63c8630d58cba3c, 0x29c29bc48e570f55}},
+	{{0x0a1b842a51323fb3, 0xa9f8de7e0c7ef666, 0x5268b520e904ac3c, 0x131ad2c7e9692791}, {0x02b0ef560184c086, 0xcaa65b60a630c502, 0x319755699173be11, 0x0f65d246f277d32e}},
+	{{0xfb50b5990c12c463, 0x2d09f465826d393e, 0x3ca19c3ab096bc63, 0x0b3352e816d0f53a}, {0xe32a2431bb91407c, 0xee18dc99481174da, 0x526dd2560e9d5653, 0x0807c226313043b2}},
+	{{0xa80f417e94dd4302, 0xa9d7e73d3d826361, 0x2e2b642dae1fe078, 0x2f15004edfed2712}, {0xae6dda8eea66f14a, 0xb8b26db76fe156f8, 0x289805000719ad3d, 0x132d8a08f93914da}},
+	{{0x5ef5d29f1ff6f799, 0x447fc8e8aecd3b19, 0x9ec4f16b101bdef6, 0x30dd5a03079b97e6}, {0x0f1a0950b522a4fd, 0x54961b30cc939d5c, 0x15c061a82b677171, 0x320ecea93026107f}},
+	{{0x43bb53a22074fddc, 0xe33f5ffe28bd749d, 0x58b63f598a8dcbba, 0x1bc64603316fe180}, {0x719ea175dbac6c38, 0x39f4dbcfb2397bd0, 0x896ff2629edcbdad, 0x1d2319d063663df5}},
+	{{0x4a04d5e66a3185b5, 0x85b0ed9da392cb34, 0x00c3002e5a99b178, 0x047ef7b7ebe05a87}, {0x1530766bba1a5748, 0x30bd2e64c7d3beb3, 0x4e3115deea2c8208, 0x1f101a808161f998}},
+	{{0x3d65d5989d037307, 0xaa60a274ac2216a3, 0xd0e70ebfd6a9356d, 0x2852f4fb8ae87d47}, {0x8bc070043b437cc6, 0xe98fb15ceef45126, 0x938d693bd965257c, 0x2ed8919852847286}},
+	{{0xf28b2a54b135af49, 0xb6d33d2700766a4a, 0xb58a083d578e32fa, 0x2bfd006bca160dca}, {0x3113f895a6544d5f, 0x394453ae2218d6b0, 0x7261f3f257e4eddf, 0x0fd56b352d359c41}},
+	{{0xc1713cc6794bf4c8, 0xeaed67bfe323e037, 0x7b3c02d5343879c7, 0x1ff4d6ccf13ad893}, {0x746c31de1f053858, 0xef3c594f06e1e496, 0x9a89f147e09b3c2c, 0x2ff58b564e6ee432}},
+	{{0x431e2ac82842ddd6, 0xea60bde6be5068fb, 0xe9739102acce13c1, 0x24cf68628dd80e88}, {0x191ce3155027ac7b, 0x7fed027fd56e0e1c, 0x1e64de06764b1658, 0x3e9470065c7c13c8}},
+	{{0x8a3ac46ff7d3fcc7, 0x91af0828653c0b6c, 0xab74c32eadad726e, 0x165e84eee0ae5b2d}, {0x4cd73c73f7d4d11f, 0x369a6186db92c9b1, 0x745b4b98dd3eba13, 0x3ff95d397737fa40}},
+	{{0xc8b18c65cb7be87d, 0x9b104bf369c9522c, 0x527e8d1849b48a5f, 0x3b2af6f6f15ca856}, {0x36102def4739dcef, 0x78078da90dc911a5, 0x5476263e6c278a7d, 0x1efc22158dc2ce2b}},
+	{{0x7c86d12ceb8422e6, 0x8adffdcf44f5d140, 0x81c8b17a6bcadf2e, 0x046aa3acdaf61f16}, {0x968b6a3a1a17ba43, 0xb9e5f3e10b862ebd, 0xe0c006496d9fbd53, 0x3bcaf6e7eb1cc061}},
+	{{0xce91068bbdb7c4fc, 0xd7dbfb2ff10f1bc7, 0x2e00ea596e06dfb0, 0x0c7f0cbcd73de72c}, {0xc2c343bd7fda5292, 0x6f31f6dcaefe258f, 0x08d51a88f81dbcae, 0x3b5e51afc5bf0845}},
+	{{0xf7adf6b498feb5d8, 0x188881817c790841, 0x25b82d4d201e4aed, 0x1fee2c383907a2ce}, {0x1e1abb933555bd54, 0x4f201a2463d26895, 0x61b9eb8e4d8dbb1b, 0x05f420b19fcbde31}},
+	{{0x5cd432fe98040270, 0x0db4fc394649508e, 0x6ae05251022e6f04, 0x051b1f377e9177af}, {0x2fe4d10d85ada941, 0xcda9a120d040fbf4, 0xea224a3d4b26b357, 0x22bc6f64276e5147}},
+	{{0x407ee47ccf5e05f0, 0xea3c6640d45a2fcd, 0xcc1410323b7d7217, 0x21259b0220233e59}, {0xbaf02b0ebcbe19ab, 0x50abb4b91089c04a, 0xd90b2a4a5fe97533, 0x067d0b9d06bc416d}},
+	{{0x606b6246353e8329, 0x003f545d66f481a5, 0x0c5102de36c21a9e, 0x3b969f5e2996047d}, {0x8a66261b17b5ee91, 0xcf7f04f3c696667f, 0x238150e8b9494f4e, 0x1c7d16e143f3ebeb}},
+	{{0x2bb270ddee72bfc2, 0x99593a7101e6484b, 0x96d0678381b6b918, 0x1748b4ae06b37125}, {0x6e4f6cf275769ddd, 0xe6a6ffe680af42dd, 0x1e642363999f1648, 0x21716aaac37e102a}},
+	{{0xd5ca423e56efd64f, 0x6cdc2f86fd2884fc, 0x99426df381d62984, 0x1a91167ae5278304}, {0x6235c8c91d3c68e6, 0x10ac26ee52d94c06, 0x5f814a66a55fecdd, 0x0c7a6c34ec09a041}},
+	{{0x20f40852b96ea16a, 0x97180c7b0eca9cfe, 0x9335b8107077c7c1, 0x3da222f1905c4909}, {0x49d987d68d5dc10c, 0x1ef5be65a6bb949d, 0x07d2864fbbc3e6d2, 0x3bf7dc9f63aa1199}},
+	{{0x5f8fbcd8c04f4c01, 0x385d183a7ed40f3e, 0x0dd883304383c0e4, 0x3e3be21f052e29aa}, {0xe2b507d92e1046c4, 0x7dd8c6814de32f2f, 0x71d2c2729dc96193, 0x0c5f8bcc13f84a9d}},
+	{{0x2dd17223d3847b44, 0x91f3301a89008fc8, 0xd13c8965d336206e, 0x0b6e6f210c1d0b78}, {0x9127ff384c6385b3, 0x78977dbc2f36c21b, 0x167250602f1dece1, 0x0d554e2652540cf7}},
+	{{0x78f02735b6de3d74, 0x325e0329b474cbad, 0xe832ce903a311dd6, 0x3fb38f6af945e91c}, {0xb457857919fa78c4, 0xb49b6faade193ab3, 0xc89fe78056c6136e, 0x14bcd380279a78ec}},
+	{{0x262abe1ae341cc72, 0xbe3e80a4e0c2b03b, 0x4bd66d17db93c275, 0x2f969687306dc265}, {0x7b5a804a62642d51, 0x31720e4157baebb5, 0x9aa48a0a71ec5054, 0x1e55861f7b269f20}},
+	{{0x73bb6025c18eae0a, 0xf5282c959097f5f0, 0x7ec60103bf28988a, 0x3b9d02d3d33aa4f1}, {0x4c5037c074a547a6, 0xd6a50acc7f30584f, 0x5942bf86653917dd, 0x181b08ce130cf0d6}},
+	{{0x92ce7d9c624b57fc, 0xff7cbc6e96d9ea9d, 0x074c77f6601b9b2b, 0x10c447fee34bb437}, {0x79a06967fb2246ff, 0x0a276891bca2f67e, 0x292efcfcd673409e, 0x2bbeb7568ebcaf31}},
+	{{0xeaa3cd4fa25b2ac9, 0x1063589860adad8e, 0xfc7269b3f64e901c, 0x1a48d5aaad461666}, {0x5547255f0cd34b05, 0x2162f90da3e7a0d1, 0x505ba8ef45a7d23d, 0x1411ff65e163d705}},
+	{{0x09de6150febdfff7, 0xf9d3741a68402015, 0x6135586f56ca345b, 0x24e09032ad63b072}, {0xc4e3151d5d1945e6, 0x70dadf923fee0279, 0x8c97617a8a8a2906, 0x3cff782f82fc578b}},
+	{{0x121c849674ededb2, 0xb96bdc3a59f1970f, 0xa5f1410ffc3d317d, 0x09b2c10fcb754940}, {0x6993034a7056564f, 0x9d834c69db0862a8, 0x4b48d884a855fc5b, 0x353c20e87e48f769}},
+	{{0x562d1cfa0b4680cc, 0x9ab95f1b82d6c318, 0xa1267171cc6aab01, 0x3bf84e522b448088}, {0x26ebfba6357887d9, 0x5558552c39dca8ef, 0x6a61f738e70d4ee0, 0x2f87bf570b89c727}},
+	{{0xb8fc23058fc89d16, 0x454e5278844d95c4, 0xd0aa02b523475ea7, 0x1b26222b1b1ede92}, {0xc144d1f8fb7ba09a, 0x02eda390a5b53a56, 0x2367bc151af00d21, 0x38ac81d6091259f7}},
+	{{0x272b65d248329713, 0x39a61187337e6fcb, 0xcad3178039e2c42c, 0x0d624837604317a0}, {0x566a419918a21704, 0x5763d7a6fc3e13b4, 0xf8ea29cff6a58077, 0x00ae78953bb590f2}},
+	{{0x213bf90298633630, 0xa36dae714a82eed9, 0x1b6afe157179e876, 0x2113430504183f83}, {0x377c1dc6e3d108ae, 0x9f3381730cf73a1e, 0xf9c490f21da75378, 0x3526d60c02ac981c}},
+	{{0xf14cf4a4fe291cda, 0x41f6ae46d420f54f, 0xf4ee953565e2eff0, 0x024ff9d635922105}, {0xed4fa34d286f1f36, 0xfe3ff062843f3f5d, 0xcbe257b37d005ff4, 0x09048209d93bb6a7}},
+	{{0xfdd152c46f41ae4d, 0x57ff3ca4bb70dd2f, 0x875ff15dd329fbab, 0x37a394da29f957f8}, {0xd1736c11f70ee942, 0xbfc21e15554ee8d7, 0xf0c0970e23f6c3af, 0x36606b3a5d216197}},
+	{{0x6cf35feed81503e1, 0xfdedeb541a87eea4, 0x9384e8b324ee5134, 0x0f02711d204d6d77}, {0x388c8633a772403a, 0x8d4f3207e48d3193, 0xe3d7a94821e98a41, 0x17a5a67386866beb}},
+	{{0xa102eb88b1d90d5b, 0x1dca6d42754e7395, 0xe32d91c45076ea5b, 0x34c0be7ca51a0613}, {0xa5d6180d807ce409, 0xde36b6eb2dbafb0e, 0xda9d34ee6283d70f, 0x3fb52df92727e5cd}},
+	{{0x8d62849ca84ae3c4, 0x3846c83ce29edad4, 0x62fe6ee37fcda813, 0x1a4ff0c128b7f5c0}, {0x9aa4b5321a5afb9b, 0x19ae57ff8251319b, 0x95e6dbc6ac424b9e, 0x1032854c3fef85ab}},
+	{{0x11432b9555b525c2, 0xa71ff931e2dcd079, 0x14efc48eda49e458, 0x3186bf012872fdec}, {0xec5bf1c3273d8a08, 0x2845472a2711e877, 0xdb5c5b3d8d94d913, 0x00ca9009314cd4f5}},
+	{{0x4988ca378e6f3971, 0x9e1731b26c0cc048, 0xa9ff0887286966f5, 0x396e8a5ea3439c5c}, {0x10eac45942f6aa54, 0x7643eb33edadc66b, 0x926a58b0814e0536, 0x1c522b59de9c312f}},
+	{{0x4b6c95788ababbfe, 0xf3792ad932a36210, 0xb3af14e67a51027c, 0x26d3761fb1ff4c10}, {0x4c168284df79f583, 0x00ab6f415990cdda, 0x2d6f231ee8888e6f, 0x133ea23c228ba78c}},
+	{{0xc6f6743931555747, 0x2a2f9d92ba429596, 0x36edf23c517da597, 0x30f97714ba2fed26}, {0x975db9b2b1dba4bb, 0x5c3cfaf9a056a8cc, 0xc8763f5bf2fc9fbf, 0x0f541064255c1ad5}},
+	{{0x7aedeac38c9b4d11, 0xe14934549a12b9a6, 0x85243aaff8bb142a, 0x15b5448f0eb97a14}, {0x3e8e418325352635, 0x122d6c07d9d8759b, 0xf4bb5b40b04aff9c, 0x18ef053f283108f5}},
+	{{0xfce0dd1456319f8d, 0x2f5c02c700b06138, 0xae7ad5401ce08be4, 0x072eb02188b32f7b}, {0x33c3d0c7bf277b24, 0xec5b28d6498d243c, 0xa7ca6a047e23cc79, 0x247ba7381597f6c1}},
+	{{0xb58eaab3625ae60d, 0xf8fba92991e8b293, 0xce151b0e72752fb6, 0x0e15fb0401708d5b}, {0x42c97a77cf1dae5a, 0x7ced2f0821eb04be, 0x403cebb4a37dfaf7, 0x341cb7c373e069c6}},
+	{{0x511fa68e73920591, 0x086868436f108ab4, 0xf0b21663ce6177cb, 0x2ea051ffbda7cd51}, {0x578b46e45cb87a95, 0x7ae23a1d5eee778c, 0xb5772069612b490d, 0x0bad0ee166bf7e6a}},
+	{{0x1a6954e1980bd8e8, 0x81d63c1449832b5a, 0xfc11afc694408b08, 0x12a1372fc3cc62a7}, {0xb956dd2191544158, 0x9aa6e1f39ef173a4, 0x693dd35c897c088c, 0x0021998b5d3b6e26}},
+	{{0x378732c2ff24c213, 0x47a5dae9c71da980, 0x9c2cd61d0c48ff42, 0x0fae623eafa4c784}, {0x9497469c997e5e3f, 0xc9861c5f6f40d2b9, 0x89f9c89deef0f95d, 0x37c14cb65013f8e6}},
+	{{0xd919f391e2a4bec5, 0xc694fde5cca23d97, 0x23b56763ec6ac1e4, 0x05f4bd13b6450e02}, {0x274e43280b98b573, 0xa12d59a5420fd0f6, 0x8dec2d8ce1a7c25e, 0x0660581b74d263ae}},
+	{{0x53710a6ef8a07b6c, 0x7ba925b3bbfd282c, 0x9338eb609106652c, 0x3ddba08cf4e09159}, {0x8e5b09ea5de3c41d, 0xae9f1930ba4edf36, 0x484f3df2108fd968, 0x28432bbe715fda86}},
+	{{0x5d64351f3db8f7c4, 0xdce0b4bd50998dfe, 0x3ade2e1dc87917c6, 0x3be4be043978266a}, {0xe0c7e96c047080f7, 0xc937f50d3d655144, 0x2684f3b9e28da713, 0x2adfa9c5996324cb}},
+	{{0x4027c0972b4e5a14, 0x8ec295e08161964b, 0x20b2e14672b94bff, 0x18118e9b613cd949}, {0xf4f64ca393ad88e7, 0xbba8e4747b87fd64, 0x0108c86cd1d85db3, 0x0fadc65239998094}},
+	{{0x85894e9408df0b3a, 0x5f1201c1336879c5, 0x3d8141471768fb12, 0x29bef8a3aae8aeab}, {0x27ef8926ac8b4a4e, 0x3492ad55aceb2f66, 0x3de82e3c6e2dc1ce, 0x2f625a24803f5451}},
+	{{0x061716615eff5f7a, 0xfd897df0de3076df, 0x1e7fcc0bf9ccfa75, 0x165143ad8c108e35}, {0xbfcad2fbbf49479f, 0x9c0062895dc5ae64, 0xc4ea3fdd96961e90, 0x05f76ebd6cf51f11}},
+	{{0x9fafba27802ef80a, 0xa5c735cc885e4e30, 0x8dec831d3df99279, 0x095164f225619034}, {0xb0ee5e44edb2540f, 0x6e07e7d3d6bc949b, 0xce9ae15bfd6e544c, 0x03eecf06eb31f03a}},
+	{{0x3ca464ab0bf54c20, 0x58810b1acf6274fb, 0xdc87f907cb792238, 0x23fee64cd1695499}, {0x21984861241a70eb, 0x708229216acb2480, 0xd4c0fb67afbce652, 0x1712678e217e792a}},
+	{{0x476c416dab38dafa, 0x2b4dcba3a0ca5852, 0x760ef6de11c88352, 0x1b6a2336bd3072d1}, {0x928cfcc73457de02, 0x07dea806cfee00c1, 0x2c0773532479da94, 0x0bd8f214c9380bf2}},
+	{{0x2ad9906c8d4ead24, 0x9d22fb0e0b6729e4, 0xae545fe3d71b0d46, 0x155cbba785e37d88}, {0xc87ecbedaef14e12, 0x9ff6469e6fdbfd45, 0x8ec5ba57deabb811, 0x2fc9e543d16d7ab4}},
+	{{0xbeb12996a873f2d2, 0x9ac5d7ffb62b4152, 0x1f97665cfe58329c, 0x095d46d602a1557f}, {0xd70d9ae9aa4ccbcd, 0x8451a0d85a6002b8, 0x38bf70b6185cfefa, 0x1e195cad23b8b63b}},
+	{{0xaf697867cf875b36, 0x2a77d068e53c9398, 0x078557789a9ffc52, 0x325614ee0fa67bdd}, {0x104467decf3217b6, 0xbc41315d214f19e3, 0xcff4b6ba2bce0647, 0x2342f23930fc5d9c}},
+	{{0xfc25bcef12c913ab, 0x8f07eb9996d9c0e5, 0x1825eeaae52d00dd, 0x094ce3987d01b7fe}, {0xbc1c34bf211c9d4c, 0xd6e5984b917375aa, 0xf64e481740f59726, 0x1830ec29f0debb89}},
+	{{0x3394ec3c5e0d2b27, 0x0de1051db9942a97, 0xbf5307cbfa780910, 0x2281e5b527b471c4}, {0x6343e7b190969176, 0x3c474356e0fc4ab7, 0x1c381ecb5ab3246c, 0x21fcd0b2850b7dc7}},
+	{{0xf14f3437177a00b3, 0xa02ba5b50a3f1723, 0xf2c925c06a3404a1, 0x10b93bfe7321c315}, {0x5112bdf33aed739c, 0x3d22b537e7aad2ad, 0x2cae6e285184155e, 0x2212a3c867732c5d}},
+	{{0x4ecef35fe79f076d, 0x4b54a034b553fc9e, 0xe998d0c78a8dca4f, 0x2bb018d6eba40867}, {0x60ef248b0ca4a036, 0x1b5c0a3bcfed8b60, 0xeb3d91b86a14f330, 0x1112c1700a090e98}},
+	{{0xc840ac27ff8ef9ee, 0xab4f787ac89653b7, 0xc5e171d58d421e8c, 0x0992765bba2f3b7f}, {0xac89600677777857, 0x04d41e4f5e150431, 0x82b52a83525aa141, 0x204bd97ab01e7744}},
+	{{0x4045cde6bd2b8cd5, 0x1bb236cf6231069c, 0x4e33187480ac6b2d, 0x26ee3c56449c3d6e}, {0xfd9a7bfe47d89daa, 0xcf042504ed8e5c4c, 0x04e39e56641da78e, 0x21ab3621b318f55a}},
+	{{0xfecedc13a726a71a, 0x119ee760854ca306, 0x5898426153806af2, 0x36422bf842958d55}, {0x7b98395bc76fbebd, 0x0e05432d3ca4a3a6, 0x91bb9def1f539454, 0x1cec278107bbb4a8}},
+	{{0xa1077c608a5795cc, 0x6e5c5aecf717a447, 0xc503c74a33f4dfdc, 0x232d401ccc9d2cfb}, {0xfb5f92fc9ecfa182, 0x8bc2659743d0ac11, 0x825e46c261da1d19, 0x1b4ebc8e8daa933d}},
+	{{0xda4e3cd7a301b5ec, 0x83a3d833220e068e, 0xaa8813a9a5227f83, 0x2e5f3cf4cf0af576}, {0xf9e8ea1f094a3eb1, 0x2d75d361036ab368, 0x841292174ac2b10c, 0x18d4140d0bcafd36}},
+	{{0xcd8989f1b4b4f804, 0x8ac6d335c52ddae1, 0x23f25a5c651c3b8d, 0x15a4148df9e4a003}, {0x5d31f20b95da79db, 0xcb72e659603973f6, 0xf36ddc9c7d654c0d, 0x07f5f18fb5b0995a}},
+	{{0xbccda3787eb06d9b, 0x7207b86d8679c857, 0x094c350ec5d4e897, 0x3845f475a1796fa5}, {0x5957125dd7b622af, 0x3bf293feb86a9aff, 0xcae68bf5104694cd, 0x3a60a614cc2d074b}},
+	{{0xf6dec5b210cc0815, 0xd66ee121e833c3a0, 0x64cc5f4e01107c46, 0x2e213134aac05fd5}, {0x73f5908ac33d58a4, 0x5e41a5f4f9c40bb9, 0x992ab67c5e45e72e, 0x362dd84ee4982362}},
+	{{0xe0db2ebbfbcb63a1, 0x93ddb54d52616057, 0x033b73cf21784229, 0x0cafc744fb472868}, {0x75a8dfc66e59f4e2, 0x55f73bae2ac18d7f, 0x6ef3ba5fc21040f5, 0x298caa6647596a4d}},
+	{{0x8e3839fd02db4fea, 0xb627cbb27ad4b8f9, 0x7853b511a52936a9, 0x02c29f980944193b}, {0x15e2c8f106217c3d, 0xd03a9b9f4ef84bc7, 0x61756e877938aa59, 0x3c6a77129c524501}},
+	{{0xbf978fdb24442e08, 0xc3aab1dff992ee3d, 0xbfef00704f2098f3, 0x1516c42c8fd5cc39}, {0x7275bec9d6ea7bd4, 0x0457d35db9af887e, 0x941d88e9e84f0e73, 0x0bbac5e3e876869f}},
+	{{0x6df404783e49dde8, 0xfdfba9e0ed0ea69e, 0xe8ded2f7d32d13e0, 0x2c233132c4883c61}, {0xa37333d317b182cf, 0xc9508852e865b923, 0xc2553b031d76d269, 0x1522df4ca9d79fb0}},
+	{{0xf833d65ba8810de5, 0x090ee6911e5e9aeb, 0xc5c0d1f8f64d423e, 0x37d2c70692021688}, {0x46804b468d822f75, 0x29244cf4713620e0, 0x431dbfd753e09585, 0x13473cdf423c742d}},
+	{{0x56b3962ae544237d, 0xbf01971b03ffd1b2, 0x30dac4bcee080ec0, 0x1eb1be93bc36d812}, {0x2d259c11be98756f, 0xa6875a0c174513b6, 0x4afefaa8d3dafc18, 0x296e0ba9c6b8cf2b}},
+	{{0x1ac35f513ff60bb9, 0x1a3f77ee29b2bb59, 0x6057cd7b5b414ed8, 0x3cfcd8d33dc010ca}, {0x098019e4a2299d2b, 0x7a1bed03add4a6fe, 0x7c73b55621ef2422, 0x0582a58e37398b90}},
+	{{0x90a0970b01e51dc8, 0x36ef1755aafc2e8a, 0x070abab6343c567c, 0x1ef3e1bf91c9d828}, {0x836a057e3f5bd698, 0x22e49a79eb9f76f7, 0x2e9211b76ba0e911, 0x04bc90a9814b1906}},
+	{{0x2d31c1d0522aaf8b, 0x51bb4206e02deca8, 0x0d21d671eea4dfda, 0x26e81bc15558d542}, {0x77d7f221aefa9553, 0x6b961f3e3ace126a, 0xa363b172884d5332, 0x0febaaacc7d853e4}},
+	{{0x8350bb5a41b75697, 0x4f6476e9de68c4b5, 0x7ce7b7fc7ad428b2, 0x36159d9839bd07d9}, {0xd454eaf2d266c055, 0x6ebf4cc1ddc5a5b4, 0x61b724549dc042ae, 0x26a21fa559221295}},
+	{{0x5cc03db893cb4399, 0xb58538e5bd1d4f31, 0x31753680dbda263e, 0x3998f548c71011dc}, {0xd48126ad48c7500a, 0x89a5a9f167cc4926, 0xa8e10bf4c8997663, 0x3093d34901a7e33a}},
+	{{0x603554d5637164d7, 0x17a2ae832927b04d, 0x379b25c4fd5b9f26, 0x0a435ed1f2f40af8}, {0xf52d941af1b48300, 0xfe0fb97b50978934, 0x2f5a33bccfbdc9cf, 0x0164ef7bd34cdfbb}},
+	{{0x0b04302497aea333, 0xbce2f476a9259da7, 0x13ac187f00d25138, 0x27b5f72a053ab4d1}, {0x68327a059edc983a, 0x2a8222ffe7ca25d1, 0xf93b4f74795e395f, 0x18fe4afa992af56b}},
+	{{0xf88ef8965de266ce, 0xbdeae7a0ea49e5a6, 0x9db0a152a5cdd333, 0x06048e82bfb8dc0a}, {0xbb79639baae99bfa, 0x9f7500c2b1912f84, 0x0196ae68a6249a60, 0x0039446dd5885d77}},
+	{{0x968f4616086f3157, 0x15f99a374f224053, 0xe5eb535601b6d5b1, 0x3eab20fdc1f6c47c}, {0xb8b1403bc43dbad4, 0x621d316bab94da6e, 0x1d45fde833837706, 0x28e7503f142b7b0c}},
+	{{0x8efc88375b4c92b9, 0x09e3b656a8d2ea89, 0x27099f94f4d0dc25, 0x1deed1043852f71b}, {0x064eb2de9e6ef4bc, 0xfe299c88a007d553, 0x8d7d8917ffaaffb2, 0x3db0a54b8fb3f2d4}},
+	{{0xdce834ed392fc46f, 0xd5a2d29a0557c792, 0x9dad345c789a1370, 0x1b539b5f32a924d9}, {0x19b4c2c872663048, 0x8d54ac2a48e99f2b, 0x840d640fd960c98a, 0x19a791a80a87dfe9}},
+	{{0x4ae8aaf53319eb19, 0x1bd17ef571b41a25, 0xf221cc771556d2ea, 0x1d5452aa3f5374ac}, {0xcddd74de2bafcb8f, 0x86142889ed50cf44, 0x495db128b48f0bc2, 0x269427afee33161b}},
+	{{0x0fe24716e5b84506, 0x1917f63ecf4a8107, 0xb8edc8e477bd5a28, 0x11eb7e5fc11e583a}, {0x03147cc1d105c1e5, 0xd38d2a871b67c99c, 0x1e81cd2c394e7e71, 0x1ffcdffb2a6db301}},
+	{{0x448d6d04dc8d63c4, 0x232bd83ebcdd1707, 0xe400ef162cf80cf8, 0x326efbaff38114ad}, {0x51483d2030626a2a, 0x2af88c2ef809d01a, 0x9d4914dd130127b5, 0x2139c1fd6f00d60c}},
+	{{0xa31ec0efe87f4593, 0xc193a2fb770e64b4, 0x7ad39835233cd021, 0x0137a3b87a9f8abd}, {0xf3d43bd684345401, 0x97015d3bb5e9254b, 0x110d6f6d3df7be9a, 0x1a60f6e8a31ad25b}},
+	{{0xdb030edf28698852, 0xa8f1d14508518232, 0x6eaf28898e463b7f, 0x23bdec6c7782a44b}, {0x5c5f3196d3298b28, 0xffce8d4697e33f90, 0xa0b32f7f929884f0, 0x3945faad838c32de}},
+	{{0x57ed3c0e9d319a36, 0xb9b1f80cdded18b8, 0xc32b855f19dee2ad, 0x241200a50bc26d80}, {0x65a362888ed96fab, 0x72936f9846bacaba, 0x9788b89588cec2cb, 0x049839fd275a67ba}},
+	{{0xc89c77055347c44d, 0x9116afc95dd9a0a4, 0xfdabe40bb3d87465, 0x2cde5e33c4c3ca93}, {0x56a9c14bd984eed8, 0x8285864bc382928d, 0x3f3067cd9e9f1409, 0x3495b4fbd6b63285}},
+	{{0xe2769bfaffe884cc, 0x5b4cd67c3958fd77, 0x5c289e9a55f60fe2, 0x0595f06530e38b49}, {0x8e864746bbbbc7e4, 0xdecbd94b6f72cb88, 0xf5ef8127ffe77519, 0x27907a9063ebdc3e}},
+	{{0x83b7c3b8fe5c89a1, 0x993af62d34d13f5e, 0xf03d7fe840a587d7, 0x071ba01694e46a1d}, {0xfb3089e181e0f80b, 0x397476427d283873, 0x58ae95ac4e18f36b, 0x0098804d897c4def}},
+	{{0xfb2735fffa7fdeb9, 0xd16bbb327d8e0a5c, 0xbe5cd99dd145b754, 0x1da2ea6df2b69497}, {0x9c45789efec916d7, 0xee7ec222f5b4b596, 0x9ff6844aa287d972, 0x2501fa384eca901d}},
+	{{0x12fdee9bd9133331, 0x95ee76bc6744d8f2, 0xe98ac83305b1af96, 0x399e782a86894b75}, {0xd8227ccd0966421c, 0xb2f7416a29fa1390, 0xef5e6a2f2aef06bd, 0x082285a8e0eb54bc}},
+	{{0x19e7e9c94df1c6f7, 0x0c707bbd0274f02d, 0xa471675d606d5111, 0x3f4b007ac62750f4}, {0x0a6bdb4d0e058046, 0xcd5300c2c72783db, 0x1d7d9abb125137ca, 0x12fb214a1f7b0b65}},
+	{{0x03a634c74708bd69, 0x4ba233f1f2557c9f, 0xf30c97c4142cbbb8, 0x13969cabbfe806a3}, {0x27ad6356af539f40, 0xbf2a44b53be3bc54, 0x108ce2f76129d856, 0x2b1669b85e9de3e0}},
+	{{0x79dbfe0048a3824c, 0xe7de7be1abcec5fe, 0x7aade6ec7806db08, 0x25f5e37b2024d394}, {0xc33d9ee90da6998a, 0xa80d963b189c3767, 0xc575e245c0fd644f, 0x20a0c3f73a553812}},
+	{{0xf427a868df5e7a75, 0x529785c422843f52, 0x23d142604562e880, 0x1e31a0c37358f61d}, {0xdc9fb30e97db3155, 0x6bee8ee2f460abfa, 0xc6d6ad76151916d0, 0x22d8b9e5fc6057b5}},
+	{{0x005aa15444fecdde, 0xa6292fd08a5be2b5, 0x854ff6bb7af023d8, 0x3e58bbde4ea765e3}, {0xb01315b9de87c767, 0x149b9708bffe26b2, 0x635a8d7e046f5f7e, 0x373bc1a738d0b913}},
+	{{0x6e7300e4561be760, 0xddd61cbc85620b2d, 0x4f3f0fa52ddac87d, 0x052ff24bea69ecc4}, {0x82421d29b8ce96ac, 0x9441511de758a950, 0x41e36a3a21f57ffc, 0x335cf09712dd9b02}},
+	{{0x4223212fda2af4e4, 0xd625be490b49b713, 0x00712686ae0d3c87, 0x350e96a6c8169a8a}, {0xd1cd63d71dffff4b, 0xbe4280fce1d4292e, 0x7b9d6c17c004c501, 0x21efb203ac54d982}},
+	{{0xbd432de77f708ffc, 0xcf139abf9408a60d, 0x1ec54eeb1563b3b1, 0x1997fadddbdad3cc}, {0x5417818d52f11149, 0x4c752c72e48afd8b, 0x7bb6d603bcc6138b, 0x3400e04f8385f032}},
+	{{0x3df347a3da20be67, 0x41caa2e593e33319, 0xf811bcbd8ecc77a5, 0x31662a92d90e418e}, {0xf38e9e905ac87d31, 0x58eab210a2e5748f, 0x757574e9324d863c, 0x3d3fea3419e337a0}},
+	{{0xb2385b0b3bf2f80d, 0xd05898a5f5599523, 0x37e0e482cf22b56e, 0x1aaa441cd18772d3}, {0x6886e526522faa9c, 0x4d22e77586b98000, 0xc2b09a7ee01e7986, 0x2bae8a3741339967}},
+	{{0x6d1a90c091902397, 0xb000a82097d23793, 0xaf5ef1f65e718d16, 0x14324407a09c49e8}, {0x32bb926b8be0fcc0, 0xc5bea602550e80ea, 0xeaa55f664777cf48, 0x21e2125cedfcbf48}},
+	{{0x6e3e98f69b4084e7, 0xf59281c30c38b5fc, 0x5a04e940aad60c91, 0x1f446b382db40862}, {0x4721665cded89cb4, 0xd54ab18f5247fef8, 0x13d5803fb6c47ed1, 0x043a86335b83cfb7}},
+	{{0x2b78f5e8ed786bbc, 0x0c951dcedbf30481, 0xc885d0e370b6e8d2, 0x3b0e5ee11a8af004}, {0x1c82f9452eec4fef, 0xd840932ba3479d96, 0xef9edd9c97d058ce, 0x21c316341072cfc6}},
+	{{0x3f55764bd588b215, 0x0bd7a3ffd6602890, 0x00cd2bd830ded79c, 0x028fb336c55f7872}, {0x6bafef520117470b, 0xad92f6d65a564bc5, 0x423e514e44eea0dd, 0x359618a95fd0d107}},
+	{{0x5aeb01c3348bd75e, 0x835eb985e7a72d2d, 0xcaa9a0deeb85c48b, 0x0576be43bb48ea3b}, {0x9341e5d5d887e726, 0x6b045019c2599c03, 0x8de718cb6c0652a3, 0x2ea3a6481e916312}},
+	{{0x3769c1f1590acfef, 0x14f76a0d00e4b762, 0xdd0ddc7d1aa4d1d4, 0x0130e902eb4418d3}, {0xd40d41d51b79a1f3, 0x044dd44fa403b607, 0x63f4623c9e1999eb, 0x0d6d8011ad6f744f}},
+	{{0xcc5ac7aeb09c9c61, 0x40154ffaf7efcb9c, 0x7582602518be5540, 0x217606a450a6405c}, {0xb35d613a02dd173d, 0x6da509a642206064, 0xe77b5b428a03402f, 0x13c01d8181ad85a3}},
+	{{0x4df294b4cf30737c, 0x8d5a7359d1c212ec, 0x6ebd57dd91f267da, 0x29c94e5c51130c48}, {0x33485ade12e60d09, 0xba17442f80642c1a, 0xa856b1af6c383568, 0x3f8fadd636cd50cc}},
+	{{0xd63ba3f2217f7177, 0x582a3c1799cbd490, 0xb752f73c8e0bb126, 0x32e5f6888489ee6e}, {0x580d9c9b87c98cbe, 0x08abb26cc44c0914, 0x99fe0e83d108e6ec, 0x383e2f3cc4516b94}},
+	{{0x3fc67ec5f31cf777, 0xa9716919528de881, 0xd843eb5443d2fb5c, 0x1fcde808b9a2a721}, {0xd5612d6e4c23d2c1, 0x1c2426b63ad97805, 0x977654672022ec76, 0x0b2e928b03d5b8b4}},
+	{{0x3c9b94be1237322c, 0xcc5b32c47d71e24d, 0xea7a2c4f67f278e2, 0x03063690677dcdae}, {0xae3e2603d987bc04, 0x3996ee17b0d7b6d5, 0xce1722b23693615b, 0x13e2f2813566b245}},
+	{{0xba88b24c83c468cc, 0x38051cf32cac252e, 0x748d18d94e8cc8af, 0x0b08744c036a3d66}, {0x229ea8f06c97899d, 0x28146648a136eea6, 0x9b17f1ad05402e6f, 0x13a610c6e5571a86}},
+}
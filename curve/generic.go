@@ -0,0 +1,124 @@
+package curve
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// ErrNotOnCurve is returned when a point or x-coordinate does not lie on the
+// curve.
+var ErrNotOnCurve = errcode.New(errcode.NotOnCurve, "curve: point is not on the curve")
+
+// GenericCurve is the set of operations the generic algorithms below need
+// from a curve whose points have type P. *Curve implements it for
+// *GroupProjective on both Pallas and Vesta, so ladders, multi-scalar
+// multiplication and point compression are written once for every curve.
+type GenericCurve[P any] interface {
+	Params() CurveParams
+	Identity() P
+	IsIdentity(g P) bool
+	Add(g, h P) P
+	Double(g P) P
+	Negate(g P) P
+	ToAffine(g P) GroupAffine
+	FromAffine(a GroupAffine) P
+}
+
+var _ GenericCurve[*GroupProjective] = (*Curve)(nil)
+
+// Params returns the parameters c was built from.
+func (c *Curve) Params() CurveParams {
+	return c.CurveParams
+}
+
+// Identity returns the point at infinity.
+func (c *Curve) Identity() *GroupProjective {
+	return c.Zero
+}
+
+// IsIdentity reports whether g is the point at infinity.
+func (c *Curve) IsIdentity(g *GroupProjective) bool {
+	return g.Z.Sign() == 0
+}
+
+// ScaleGeneric returns k·g with a left-to-right double-and-add ladder over
+// the bit length of k. Negative scalars scale -g.
+func ScaleGeneric[P any](c GenericCurve[P], g P, k *big.Int) P {
+	if k.Sign() < 0 {
+		g = c.Negate(g)
+	}
+	n := new(big.Int).Abs(k)
+	h := c.Identity()
+	for i := n.BitLen() - 1; i >= 0; i-- {
+		h = c.Double(h)
+		if n.Bit(i) == 1 {
+			h = c.Add(h, g)
+		}
+	}
+	return h
+}
+
+// MultiScalarMul returns the sum of scalars[i]·points[i]. It interleaves the
+// ladders (Straus' method) so all terms share a single chain of doublings.
+func MultiScalarMul[P any](c GenericCurve[P], points []P, scalars []*big.Int) (P, error) {
+	if len(points) != len(scalars) {
+		var zero P
+		return zero, errcode.New(errcode.InvalidArgument, "curve: MultiScalarMul needs as many scalars as points")
+	}
+	bases := make([]P, len(points))
+	ks := make([]*big.Int, len(scalars))
+	maxBits := 0
+	for i, k := range scalars {
+		bases[i] = points[i]
+		if k.Sign() < 0 {
+			bases[i] = c.Negate(points[i])
+		}
+		ks[i] = new(big.Int).Abs(k)
+		if ks[i].BitLen() > maxBits {
+			maxBits = ks[i].BitLen()
+		}
+	}
+	h := c.Identity()
+	for bit := maxBits - 1; bit >= 0; bit-- {
+		h = c.Double(h)
+		for i, k := range ks {
+			if k.Bit(bit) == 1 {
+				h = c.Add(h, bases[i])
+			}
+		}
+	}
+	return h, nil
+}
+
+// CompressGeneric returns the affine x-coordinate of g together with the
+// parity of y.
+func CompressGeneric[P any](c GenericCurve[P], g P) (*big.Int, bool, error) {
+	a := c.ToAffine(g)
+	if a.Infinity {
+		return nil, false, fmt.Errorf("cannot compress: %w", ErrAtInfinity)
+	}
+	return a.X, a.Y.Bit(0) == 1, nil
+}
+
+// DecompressGeneric recovers the point with x-coordinate x whose y has the
+// given parity. It returns ErrNotOnCurve when x^3 + ax + b is not a square.
+func DecompressGeneric[P any](c GenericCurve[P], x *big.Int, isOdd bool) (P, error) {
+	params := c.Params()
+	f := field.ForModulus(params.Modulus)
+	rhs := f.Add(f.Add(f.Mul(f.Square(x), x), f.Mul(params.A, x)), params.B)
+	isSquare, y := f.SqrtRatio(rhs, big.NewInt(1))
+	if !isSquare {
+		var zero P
+		return zero, ErrNotOnCurve
+	}
+	// Choose between y and -y with a mask rather than a branch on the parity.
+	flip := int(y.Bit(0))
+	if isOdd {
+		flip ^= 1
+	}
+	y = field.Select(flip, f.Negate(y), y)
+	return c.FromAffine(GroupAffine{X: x, Y: y}), nil
+}
@@ -0,0 +1,55 @@
+package curve
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGenericAlgorithmsOnPastaCurves(t *testing.T) {
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		t.Run(c.Name, func(t *testing.T) {
+			g := c.Scale(c.One, big.NewInt(31337))
+			for _, k := range []int64{0, 1, 2, 255, 65537, -7} {
+				want := c.Scale(g, new(big.Int).Abs(big.NewInt(k)))
+				if k < 0 {
+					want = c.Negate(want)
+				}
+				if !sameAffine(c, ScaleGeneric[*GroupProjective](c, g, big.NewInt(k)), want) {
+					t.Errorf("ScaleGeneric(g, %d) != Scale(g, %d)", k, k)
+				}
+			}
+
+			points := []*GroupProjective{c.One, g, c.Double(g)}
+			scalars := []*big.Int{big.NewInt(11), big.NewInt(-4), new(big.Int).Sub(c.Order, big.NewInt(1))}
+			got, err := MultiScalarMul[*GroupProjective](c, points, scalars)
+			if err != nil {
+				t.Fatalf("MultiScalarMul failed: %v", err)
+			}
+			want := c.Identity()
+			for i := range points {
+				want = c.Add(want, ScaleGeneric[*GroupProjective](c, points[i], scalars[i]))
+			}
+			if !sameAffine(c, got, want) {
+				t.Error("MultiScalarMul does not match the sum of individual products")
+			}
+			if _, err := MultiScalarMul[*GroupProjective](c, points, scalars[:1]); err == nil {
+				t.Error("MultiScalarMul accepted mismatched lengths")
+			}
+
+			x, isOdd, err := CompressGeneric[*GroupProjective](c, g)
+			if err != nil {
+				t.Fatalf("CompressGeneric failed: %v", err)
+			}
+			back, err := DecompressGeneric[*GroupProjective](c, x, isOdd)
+			if err != nil {
+				t.Fatalf("DecompressGeneric failed: %v", err)
+			}
+			if !sameAffine(c, back, g) {
+				t.Error("compression round trip changed the point")
+			}
+			if _, _, err := CompressGeneric[*GroupProjective](c, c.Identity()); err == nil {
+				t.Error("CompressGeneric accepted the point at infinity")
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package curve
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// The group map is the one of Bowe and Wahby, "Indifferentiable hashing to
+// Barreto-Naehrig curves" (BW19), which Mina, Kimchi and o1js use to map a
+// field element to a curve point: the VRF message hash of block production
+// and Poseidon's hashToGroup. It only supports curves with a = 0. Unlike
+// HashToCurve, it is not a random oracle on its own; its input must
+// already be a hash.
+
+// groupMapParams holds the BW19 constants of one curve, computed as
+// proof-systems' BWParameters::setup does.
+type groupMapParams struct {
+	u, fu                          *big.Int
+	sqrtNegThreeUSquaredMinusUOver *big.Int // (sqrt(-3u^2) - u) / 2
+	sqrtNegThreeUSquared           *big.Int
+	invThreeUSquared               *big.Int
+}
+
+// groupMapCache maps *Curve to its *groupMapParams.
+var groupMapCache sync.Map
+
+var errGroupMapA = errcode.New(errcode.InvalidArgument, "curve: group map needs a = 0")
+
+func (c *Curve) groupMapParams() (*groupMapParams, error) {
+	if v, ok := groupMapCache.Load(c); ok {
+		return v.(*groupMapParams), nil
+	}
+	if c.A.Sign() != 0 {
+		return nil, errGroupMapA
+	}
+	F := c.Field
+	// u is the least positive element with f(u) != 0.
+	u := big.NewInt(1)
+	fu := c.curveEquation(u)
+	for fu.Sign() == 0 {
+		u = F.Add(u, big.NewInt(1))
+		fu = c.curveEquation(u)
+	}
+	threeUSquared := F.Mul(big.NewInt(3), F.Square(u))
+	sqrtNegThreeUSquared := F.Sqrt(F.Negate(threeUSquared))
+	if sqrtNegThreeUSquared == nil {
+		return nil, errcode.New(errcode.NonSquare, "curve: -3u^2 is not a square")
+	}
+	p := &groupMapParams{
+		u:                              u,
+		fu:                             fu,
+		sqrtNegThreeUSquaredMinusUOver: F.Mul(F.Sub(sqrtNegThreeUSquared, u), F.Inverse(big.NewInt(2))),
+		sqrtNegThreeUSquared:           sqrtNegThreeUSquared,
+		invThreeUSquared:               F.Inverse(threeUSquared),
+	}
+	v, _ := groupMapCache.LoadOrStore(c, p)
+	return v.(*groupMapParams), nil
+}
+
+// curveEquation returns x^3 + b, the right-hand side of the curve equation
+// for a = 0.
+func (c *Curve) curveEquation(x *big.Int) *big.Int {
+	F := c.Field
+	return F.Add(F.Mul(F.Square(x), x), c.B)
+}
+
+// GroupMap maps t to a point of c with the BW19 map, returning the first of
+// the three candidate x coordinates for which x^3 + b is a square and the
+// square root the field's Sqrt returns as y. The result has Z = 1. c must
+// have a = 0.
+//
+// On Pallas and Vesta the field's Sqrt is Tonelli-Shanks from the 2-adic
+// root of unity 5^t, as in ark-ff, so it returns the same root as
+// proof-systems, both here and for sqrt(-3u^2) in the parameters. Mina's
+// VRF uses the point as it is; o1js's Poseidon.hashToGroup then picks the
+// even y, as poseidon.HashToGroup does.
+func (c *Curve) GroupMap(t *big.Int) (*GroupProjective, error) {
+	params, err := c.groupMapParams()
+	if err != nil {
+		return nil, err
+	}
+	F := c.Field
+	t2 := F.Square(F.Mod(t))
+	t2PlusFu := F.Add(t2, params.fu)
+	// alpha = 1 / ((t^2 + f(u)) t^2), or 0 where that is not invertible.
+	alpha := big.NewInt(0)
+	if d := F.Mul(t2PlusFu, t2); d.Sign() != 0 {
+		alpha = F.Inverse(d)
+	}
+	x1 := F.Sub(params.sqrtNegThreeUSquaredMinusUOver, F.Mul(F.Mul(F.Square(t2), alpha), params.sqrtNegThreeUSquared))
+	x2 := F.Sub(F.Negate(params.u), x1)
+	t2Inv := F.Mul(alpha, t2PlusFu)
+	x3 := F.Sub(params.u, F.Mul(F.Mul(F.Square(t2PlusFu), t2Inv), params.invThreeUSquared))
+	for _, x := range []*big.Int{x1, x2, x3} {
+		if y := F.Sqrt(c.curveEquation(x)); y != nil {
+			return &GroupProjective{X: x, Y: y, Z: big.NewInt(1)}, nil
+		}
+	}
+	// BW19 guarantees one of the candidates is on the curve.
+	return nil, errcode.New(errcode.Internal, "curve: group map found no point")
+}
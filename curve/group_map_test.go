@@ -0,0 +1,131 @@
+package curve
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGroupMapParams(t *testing.T) {
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		params, err := c.groupMapParams()
+		if err != nil {
+			t.Fatalf("%s: %v", c.Name, err)
+		}
+		// b = 5, so u = 1 and f(u) = 6.
+		if params.u.Cmp(big.NewInt(1)) != 0 || params.fu.Cmp(big.NewInt(6)) != 0 {
+			t.Errorf("%s: u = %v, f(u) = %v", c.Name, params.u, params.fu)
+		}
+		F := c.Field
+		if got := F.Square(params.sqrtNegThreeUSquared); !F.Equal(got, F.Negate(big.NewInt(3))) {
+			t.Errorf("%s: sqrt(-3u^2)^2 = %v", c.Name, got)
+		}
+	}
+}
+
+func TestGroupMapOnCurve(t *testing.T) {
+	inputs := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), new(big.Int).Sub(pallas.Modulus, big.NewInt(1))}
+	for i := 0; i < 32; i++ {
+		inputs = append(inputs, pallas.Field.Random())
+	}
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		for _, in := range inputs {
+			g, err := c.GroupMap(in)
+			if err != nil {
+				t.Fatalf("%s: GroupMap(%v): %v", c.Name, in, err)
+			}
+			if err := c.ValidatePoint(g); err != nil {
+				t.Errorf("%s: GroupMap(%v) = (%v, %v): %v", c.Name, in, g.X, g.Y, err)
+			}
+		}
+	}
+	// The map depends on t only through t^2.
+	in := big.NewInt(12345)
+	g, _ := pallas.GroupMap(in)
+	h, _ := pallas.GroupMap(pallas.Field.Negate(in))
+	if g.X.Cmp(h.X) != 0 || g.Y.Cmp(h.Y) != 0 {
+		t.Error("GroupMap(t) != GroupMap(-t)")
+	}
+}
+
+// arkworksSqrt is the square root of ark-ff's Tonelli-Shanks, written
+// step by step as ark-ff does it for the fields of proof-systems: z starts
+// at the multiplicative generator 5 raised to the odd factor t of p-1,
+// which is the 2-adic root of unity of the Pasta fields. Which of the two
+// roots it returns decides the y coordinates of BWParameters::setup and
+// to_group.
+func arkworksSqrt(a, p *big.Int) *big.Int {
+	a = new(big.Int).Mod(a, p)
+	if a.Sign() == 0 {
+		return new(big.Int)
+	}
+	t := new(big.Int).Sub(p, big.NewInt(1))
+	s := 0
+	for t.Bit(0) == 0 {
+		t.Rsh(t, 1)
+		s++
+	}
+	mul := func(x, y *big.Int) *big.Int { return new(big.Int).Mod(new(big.Int).Mul(x, y), p) }
+	z := new(big.Int).Exp(big.NewInt(5), t, p)
+	w := new(big.Int).Exp(a, new(big.Int).Rsh(t, 1), p)
+	x := mul(w, a)
+	b := mul(x, w)
+	v := s
+	for b.Cmp(big.NewInt(1)) != 0 {
+		k := 0
+		for b2k := b; b2k.Cmp(big.NewInt(1)) != 0; b2k = mul(b2k, b2k) {
+			k++
+		}
+		if k == s {
+			return nil
+		}
+		w = z
+		for i := 0; i < v-k-1; i++ {
+			w = mul(w, w)
+		}
+		z = mul(w, w)
+		b = mul(b, z)
+		x = mul(x, w)
+		v = k
+	}
+	if mul(x, x).Cmp(a) != 0 {
+		return nil
+	}
+	return x
+}
+
+// TestGroupMapRootsFollowArkworks checks that the square roots of the
+// group map, sqrt(-3u^2) in the parameters and y in the output, are the
+// ones ark-ff returns, not merely some root.
+func TestGroupMapRootsFollowArkworks(t *testing.T) {
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		params, err := c.groupMapParams()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := arkworksSqrt(big.NewInt(-3), c.Modulus); params.sqrtNegThreeUSquared.Cmp(want) != 0 {
+			t.Errorf("%s: sqrt(-3u^2) = %v, want %v", c.Name, params.sqrtNegThreeUSquared, want)
+		}
+		for i := 0; i < 64; i++ {
+			in := c.Field.Random()
+			g, err := c.GroupMap(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := arkworksSqrt(c.curveEquation(g.X), c.Modulus); g.Y.Cmp(want) != 0 {
+				t.Fatalf("%s: GroupMap(%v) has y = %v, want %v", c.Name, in, g.Y, want)
+			}
+			// The field's Sqrt agrees on non-squares too.
+			if got, want := c.Field.Sqrt(in), arkworksSqrt(in, c.Modulus); (got == nil) != (want == nil) || (got != nil && got.Cmp(want) != 0) {
+				t.Fatalf("%s: Sqrt(%v) = %v, want %v", c.Name, in, got, want)
+			}
+		}
+	}
+}
+
+func TestGroupMapRejectsNonZeroA(t *testing.T) {
+	params := pallas.CurveParams
+	params.A = big.NewInt(1)
+	if _, err := CreateCurveProjective(params).GroupMap(big.NewInt(1)); err == nil {
+		t.Error("GroupMap accepted a curve with a != 0")
+	}
+}
@@ -0,0 +1,207 @@
+package curve
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// Hashing to the curve follows RFC 9380 with expand_message_xmd over SHA-256
+// and the Shallue-van de Woestijne map, which works directly on curves with
+// a = 0 such as Pallas and Vesta (SSWU would need an isogenous curve). For
+// Pallas this is the suite "pallas_XMD:SHA-256_SVDW_RO_". Both Pasta curves
+// have prime order, so no cofactor clearing is needed.
+
+const (
+	// hashToFieldSecurity is the target security level k in bits.
+	hashToFieldSecurity = 128
+	// xmdOversizeDSTPrefix is prepended when hashing a DST longer than 255 bytes.
+	xmdOversizeDSTPrefix = "H2C-OVERSIZE-DST-"
+)
+
+var errXMDLength = errcode.New(errcode.OutOfRange, "curve: expand_message_xmd output length out of range")
+
+// svdwParams holds the Shallue-van de Woestijne constants of one curve.
+type svdwParams struct {
+	z, c1, c2, c3, c4 *big.Int
+}
+
+// svdwCache maps *Curve to its *svdwParams.
+var svdwCache sync.Map
+
+// HashToCurve hashes msg to a point on Pallas using the domain separation
+// tag domain.
+func HashToCurve(domain, msg []byte) *GroupProjective {
+	return pallas.HashToCurve(domain, msg)
+}
+
+// HashToCurve hashes msg to a point on c using the domain separation tag
+// domain. The curve is assumed to have prime order.
+func (c *Curve) HashToCurve(domain, msg []byte) *GroupProjective {
+	u := c.HashToField(domain, msg, 2)
+	return c.Add(c.MapToCurve(u[0]), c.MapToCurve(u[1]))
+}
+
+// HashToField hashes msg to count elements of the base field of c
+// (RFC 9380, section 5.2).
+func (c *Curve) HashToField(domain, msg []byte, count int) []*big.Int {
+	l := (c.Modulus.BitLen() + hashToFieldSecurity + 7) / 8
+	uniform, err := expandMessageXMD(msg, domain, count*l)
+	if err != nil {
+		// count*l is far below the 255-block limit for any sane count.
+		panic(err)
+	}
+	out := make([]*big.Int, count)
+	for i := range out {
+		out[i] = c.Field.Mod(new(big.Int).SetBytes(uniform[i*l : (i+1)*l]))
+	}
+	return out
+}
+
+// MapToCurve maps a field element to a curve point with the
+// Shallue-van de Woestijne method (RFC 9380, section 6.6.1).
+func (c *Curve) MapToCurve(u *big.Int) *GroupProjective {
+	f := c.Field
+	s := c.svdw()
+	one := big.NewInt(1)
+
+	tv1 := f.Mul(f.Square(u), s.c1)
+	tv2 := f.Add(one, tv1)
+	tv1 = f.Sub(one, tv1)
+	tv3 := inv0(f.Mul(tv1, tv2), c.Modulus)
+	tv4 := f.Mul(f.Mul(f.Mul(u, tv1), tv3), s.c3)
+
+	x1 := f.Sub(s.c2, tv4)
+	x2 := f.Add(s.c2, tv4)
+	x3 := f.Mul(f.Square(f.Mul(f.Square(tv2), tv3)), s.c4)
+	x3 = f.Add(x3, s.z)
+
+	// SqrtRatio tests for a square and extracts the root in one step; x3 is
+	// always on the curve when neither x1 nor x2 is.
+	x := x1
+	isSquare, y := f.SqrtRatio(c.rhs(x1), one)
+	if !isSquare {
+		x = x2
+		isSquare, y = f.SqrtRatio(c.rhs(x2), one)
+	}
+	if !isSquare {
+		x = x3
+		var err error
+		if y, err = f.SqrtErr(c.rhs(x3)); err != nil {
+			panic("curve: SvdW candidate x3 is not on the curve: " + err.Error())
+		}
+	}
+	if sgn0(u) != sgn0(y) {
+		y = f.Negate(y)
+	}
+	return &GroupProjective{X: x, Y: y, Z: big.NewInt(1)}
+}
+
+// rhs evaluates x^3 + ax + b.
+func (c *Curve) rhs(x *big.Int) *big.Int {
+	f := c.Field
+	return f.Add(f.Add(f.Mul(f.Square(x), x), f.Mul(c.A, x)), c.B)
+}
+
+// svdw returns the cached Shallue-van de Woestijne constants of c.
+func (c *Curve) svdw() *svdwParams {
+	if s, ok := svdwCache.Load(c); ok {
+		return s.(*svdwParams)
+	}
+	s, _ := svdwCache.LoadOrStore(c, newSVDWParams(c))
+	return s.(*svdwParams)
+}
+
+// newSVDWParams finds Z as in RFC 9380, appendix H.1, and derives the
+// constants c1..c4 from it.
+func newSVDWParams(c *Curve) *svdwParams {
+	f := c.Field
+	two, three, four := big.NewInt(2), big.NewInt(3), big.NewInt(4)
+	// t(Z) = 3Z^2 + 4A
+	t := func(z *big.Int) *big.Int {
+		return f.Add(f.Mul(three, f.Square(z)), f.Mul(four, c.A))
+	}
+
+	var z *big.Int
+	for ctr := int64(1); z == nil; ctr++ {
+		for _, cand := range []*big.Int{big.NewInt(ctr), f.Negate(big.NewInt(ctr))} {
+			gz := c.rhs(cand)
+			if gz.Sign() == 0 {
+				continue
+			}
+			h := f.Negate(f.Mul(t(cand), f.Inverse(f.Mul(four, gz))))
+			if h.Sign() == 0 || !f.IsSquare(h) {
+				continue
+			}
+			if f.IsSquare(gz) || f.IsSquare(c.rhs(f.Negate(f.Mul(cand, f.Inverse(two))))) {
+				z = cand
+				break
+			}
+		}
+	}
+
+	gz := c.rhs(z)
+	c3 := f.Sqrt(f.Negate(f.Mul(gz, t(z))))
+	if sgn0(c3) == 1 {
+		c3 = f.Negate(c3)
+	}
+	return &svdwParams{
+		z:  z,
+		c1: gz,
+		c2: f.Negate(f.Mul(z, f.Inverse(two))),
+		c3: c3,
+		c4: f.Negate(f.Mul(f.Mul(four, gz), f.Inverse(t(z)))),
+	}
+}
+
+// sgn0 is the sign of a prime field element as defined by RFC 9380.
+func sgn0(x *big.Int) uint {
+	return x.Bit(0)
+}
+
+// inv0 returns the inverse of x modulo p, or 0 when x is 0.
+func inv0(x, p *big.Int) *big.Int {
+	if x.Sign() == 0 {
+		return big.NewInt(0)
+	}
+	return new(big.Int).ModInverse(x, p)
+}
+
+// expandMessageXMD implements expand_message_xmd with SHA-256
+// (RFC 9380, section 5.3.1).
+func expandMessageXMD(msg, dst []byte, lenInBytes int) ([]byte, error) {
+	const bInBytes, sInBytes = sha256.Size, sha256.BlockSize
+	ell := (lenInBytes + bInBytes - 1) / bInBytes
+	if ell > 255 || lenInBytes > 65535 || lenInBytes <= 0 {
+		return nil, errXMDLength
+	}
+	if len(dst) > 255 {
+		h := sha256.Sum256(append([]byte(xmdOversizeDSTPrefix), dst...))
+		dst = h[:]
+	}
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sInBytes))
+	h.Write(msg)
+	h.Write([]byte{byte(lenInBytes >> 8), byte(lenInBytes), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	out := make([]byte, 0, ell*bInBytes)
+	prev := make([]byte, bInBytes)
+	for i := 1; i <= ell; i++ {
+		h.Reset()
+		for j := range prev {
+			prev[j] ^= b0[j]
+		}
+		h.Write(prev)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		prev = h.Sum(nil)
+		out = append(out, prev...)
+	}
+	return out[:lenInBytes], nil
+}
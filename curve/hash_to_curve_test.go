@@ -0,0 +1,75 @@
+package curve
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+// Vectors from RFC 9380, appendix K.1.
+func TestExpandMessageXMD(t *testing.T) {
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{"", "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{"abc", "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+		{"abcdef0123456789", "eff31487c770a893cfb36f912fbfcbff40d5661771ca4b2cb4eafe524333f5c1"},
+	}
+	for _, tt := range tests {
+		got, err := expandMessageXMD([]byte(tt.msg), dst, 32)
+		if err != nil {
+			t.Fatalf("expandMessageXMD(%q) failed: %v", tt.msg, err)
+		}
+		if hex.EncodeToString(got) != tt.want {
+			t.Errorf("expandMessageXMD(%q) = %x, want %s", tt.msg, got, tt.want)
+		}
+	}
+
+	if _, err := expandMessageXMD(nil, dst, 256*32); err == nil {
+		t.Error("expandMessageXMD accepted more than 255 blocks")
+	}
+}
+
+func TestHashToCurve(t *testing.T) {
+	domain := []byte("mina-signer-go-test")
+	seen := map[string]bool{}
+	for _, msg := range []string{"", "abc", "abcdef0123456789", "a longer message that spans more than one block"} {
+		g := HashToCurve(domain, []byte(msg))
+		if !Pallas().IsOnCurve(g) {
+			t.Fatalf("HashToCurve(%q) is not on the curve", msg)
+		}
+		aff := Pallas().ToAffine(g)
+		if aff.Infinity {
+			t.Fatalf("HashToCurve(%q) is the point at infinity", msg)
+		}
+		if !sameAffine(Pallas(), g, HashToCurve(domain, []byte(msg))) {
+			t.Errorf("HashToCurve(%q) is not deterministic", msg)
+		}
+		key := aff.X.String()
+		if seen[key] {
+			t.Errorf("HashToCurve(%q) collides with an earlier message", msg)
+		}
+		seen[key] = true
+	}
+
+	if !Pallas().IsOnCurve(Pallas().MapToCurve(big.NewInt(0))) {
+		t.Error("MapToCurve(0) is not on the curve")
+	}
+
+	a := HashToCurve([]byte("domain-a"), []byte("msg"))
+	b := HashToCurve([]byte("domain-b"), []byte("msg"))
+	if sameAffine(Pallas(), a, b) {
+		t.Error("different domains produced the same point")
+	}
+}
+
+func TestMapToCurveVesta(t *testing.T) {
+	vesta := Vesta()
+	for _, u := range vesta.HashToField([]byte("vesta"), []byte("msg"), 4) {
+		if !vesta.IsOnCurve(vesta.MapToCurve(u)) {
+			t.Fatalf("MapToCurve(%v) is not on Vesta", u)
+		}
+	}
+}
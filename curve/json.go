@@ -0,0 +1,126 @@
+//go:build !tinygo && !minasigner_core
+
+package curve
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// Points are encoded as JSON objects with decimal coordinate strings, e.g.
+//
+//	{"x":"1","y":"1241...","infinity":false}
+//	{"x":"1","y":"1241...","z":"1"}
+//
+// Decoding also accepts 0x-prefixed hex strings so vectors produced by other
+// implementations can be read directly. The affine point at infinity is
+// {"infinity":true}; a projective point is at infinity when z is 0.
+//
+// Under strict decoding (field.SetStrictDecoding) only that form is
+// accepted: minimal decimal coordinates, no unknown members, and no
+// coordinates next to "infinity":true. The decoders do not know the curve,
+// so coordinates are range-checked by ValidatePoint.
+
+type groupAffineJSON struct {
+	X        string `json:"x,omitempty"`
+	Y        string `json:"y,omitempty"`
+	Infinity bool   `json:"infinity"`
+}
+
+type groupProjectiveJSON struct {
+	X string `json:"x"`
+	Y string `json:"y"`
+	Z string `json:"z"`
+}
+
+// MarshalJSON implements the json.Marshaler interface for GroupAffine.
+func (a GroupAffine) MarshalJSON() ([]byte, error) {
+	if a.Infinity {
+		return json.Marshal(groupAffineJSON{Infinity: true})
+	}
+	if a.X == nil || a.Y == nil {
+		return nil, fmt.Errorf("cannot marshal GroupAffine: %w", ErrNilPoint)
+	}
+	return json.Marshal(groupAffineJSON{X: a.X.String(), Y: a.Y.String()})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for GroupAffine.
+func (a *GroupAffine) UnmarshalJSON(data []byte) error {
+	var temp groupAffineJSON
+	if err := field.DecodeJSONObject(data, &temp); err != nil {
+		return err
+	}
+	if temp.Infinity {
+		if field.IsStrict() && (temp.X != "" || temp.Y != "") {
+			return fmt.Errorf("%w: point at infinity with coordinates", field.ErrNonCanonical)
+		}
+		*a = GroupAffine{Infinity: true}
+		return nil
+	}
+	x, err := parseCoordinate("x", temp.X)
+	if err != nil {
+		return err
+	}
+	y, err := parseCoordinate("y", temp.Y)
+	if err != nil {
+		return err
+	}
+	*a = GroupAffine{X: x, Y: y}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for GroupProjective.
+func (g GroupProjective) MarshalJSON() ([]byte, error) {
+	if g.X == nil || g.Y == nil || g.Z == nil {
+		return nil, fmt.Errorf("cannot marshal GroupProjective: %w", ErrNilPoint)
+	}
+	return json.Marshal(groupProjectiveJSON{X: g.X.String(), Y: g.Y.String(), Z: g.Z.String()})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for GroupProjective.
+func (g *GroupProjective) UnmarshalJSON(data []byte) error {
+	var temp groupProjectiveJSON
+	if err := field.DecodeJSONObject(data, &temp); err != nil {
+		return err
+	}
+	x, err := parseCoordinate("x", temp.X)
+	if err != nil {
+		return err
+	}
+	y, err := parseCoordinate("y", temp.Y)
+	if err != nil {
+		return err
+	}
+	z, err := parseCoordinate("z", temp.Z)
+	if err != nil {
+		return err
+	}
+	*g = GroupProjective{X: x, Y: y, Z: z}
+	return nil
+}
+
+// parseCoordinate parses a decimal or 0x-prefixed hex coordinate, or only
+// a minimal decimal one under strict decoding.
+func parseCoordinate(name, s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errcode.Errorf(errcode.MissingValue, "missing %s coordinate", name)
+	}
+	strict := field.IsStrict()
+	base := 10
+	if !strict && (strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X")) {
+		s, base = s[2:], 16
+	}
+	v, err := field.ParseInt(s, base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s coordinate: %w", name, err)
+	}
+	if v.Sign() < 0 {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "failed to parse %s coordinate %q", name, s)
+	}
+	return v, nil
+}
@@ -0,0 +1,105 @@
+//go:build !tinygo && !minasigner_core
+
+package curve
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestGroupAffineJSON(t *testing.T) {
+	pallas := Pallas()
+	g := pallas.ToAffine(pallas.Scale(pallas.One, big.NewInt(77)))
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("json.Marshal(GroupAffine) failed: %v", err)
+	}
+	var back GroupAffine
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("json.Unmarshal(GroupAffine) failed: %v", err)
+	}
+	if back.Infinity || back.X.Cmp(g.X) != 0 || back.Y.Cmp(g.Y) != 0 {
+		t.Errorf("GroupAffine JSON round trip = %+v, want %+v", back, g)
+	}
+
+	data, err = json.Marshal(GroupAffine{Infinity: true})
+	if err != nil {
+		t.Fatalf("json.Marshal(infinity) failed: %v", err)
+	}
+	if string(data) != `{"infinity":true}` {
+		t.Errorf("infinity encodes as %s", data)
+	}
+	back = GroupAffine{}
+	if err := json.Unmarshal(data, &back); err != nil || !back.Infinity {
+		t.Errorf("infinity round trip = %+v, %v", back, err)
+	}
+
+	hexJSON := `{"x":"0x1","y":"` + "0x" + pallas.One.Y.Text(16) + `"}`
+	if err := json.Unmarshal([]byte(hexJSON), &back); err != nil {
+		t.Fatalf("json.Unmarshal(hex) failed: %v", err)
+	}
+	if back.X.Cmp(big.NewInt(1)) != 0 || back.Y.Cmp(pallas.One.Y) != 0 {
+		t.Errorf("hex coordinates decoded to %+v", back)
+	}
+
+	for _, bad := range []string{`{"x":"1"}`, `{"x":"abc","y":"1"}`, `{"x":"-1","y":"1"}`} {
+		if err := json.Unmarshal([]byte(bad), &back); err == nil {
+			t.Errorf("json.Unmarshal(%s) expected error, got nil", bad)
+		}
+	}
+	if _, err := json.Marshal(GroupAffine{}); err == nil {
+		t.Error("json.Marshal of a finite point with nil coordinates expected error")
+	}
+}
+
+func TestGroupProjectiveJSON(t *testing.T) {
+	pallas := Pallas()
+	g := pallas.Scale(pallas.One, big.NewInt(99))
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("json.Marshal(GroupProjective) failed: %v", err)
+	}
+	var back GroupProjective
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("json.Unmarshal(GroupProjective) failed: %v", err)
+	}
+	if back.X.Cmp(g.X) != 0 || back.Y.Cmp(g.Y) != 0 || back.Z.Cmp(g.Z) != 0 {
+		t.Error("GroupProjective JSON round trip changed the coordinates")
+	}
+
+	if err := json.Unmarshal([]byte(`{"x":"1","y":"1"}`), &back); err == nil {
+		t.Error("json.Unmarshal without z expected error, got nil")
+	}
+}
+
+// FuzzUnmarshalJSON decodes arbitrary points, which need not be on the
+// curve, and checks that validating and normalizing them does not panic.
+func FuzzUnmarshalJSON(f *testing.F) {
+	f.Add([]byte(`{"x":"1","y":"2"}`), false)
+	f.Add([]byte(`{"x":"0x1","y":"0x2","z":"0"}`), true)
+	f.Add([]byte(`{"infinity":true}`), false)
+	f.Fuzz(func(t *testing.T, data []byte, projective bool) {
+		pallas := Pallas()
+		var g *GroupProjective
+		if projective {
+			g = new(GroupProjective)
+			if err := json.Unmarshal(data, g); err != nil {
+				return
+			}
+		} else {
+			var a GroupAffine
+			if err := json.Unmarshal(data, &a); err != nil {
+				return
+			}
+			g = pallas.FromAffine(a)
+		}
+		if pallas.ValidatePoint(g) != nil {
+			return
+		}
+		pallas.ToAffine(g)
+		pallas.Equal(g, pallas.One)
+	})
+}
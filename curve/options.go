@@ -0,0 +1,21 @@
+package curve
+
+// Option configures a Curve built by CreateCurveProjective, NewPallasCurve
+// or NewVestaCurve. Options are applied in order, so a later option
+// overrides an earlier one.
+type Option func(*Curve)
+
+// WithCoordinates selects the representation MultiScalarMul works in. The
+// default is CoordinatesJacobian.
+func WithCoordinates(coords Coordinates) Option {
+	return func(c *Curve) { c.Coordinates = coords }
+}
+
+// WithConstantTime makes Scale and ScaleBase run the Montgomery ladder of
+// ScaleConstantTime, for a curve whose scalars are all secret. It trades the
+// speed of the variable-time algorithms and of the precomputed generator
+// table for a fixed sequence of group operations. The explicit Scale*
+// methods are not affected.
+func WithConstantTime(enabled bool) Option {
+	return func(c *Curve) { c.ConstantTime = enabled }
+}
@@ -0,0 +1,41 @@
+package curve
+
+import (
+	"math/big"
+	"sync"
+)
+
+// tempPool recycles the big.Int temporaries of the group law. Every addition
+// and doubling needs around a dozen intermediate values; reusing them keeps
+// scalar multiplication from allocating thousands of short-lived integers.
+var tempPool = sync.Pool{
+	New: func() any { return new(big.Int) },
+}
+
+// getTemps fills ts with temporaries from tempPool. Their values are
+// unspecified.
+func getTemps(ts ...**big.Int) {
+	for _, t := range ts {
+		*t = tempPool.Get().(*big.Int)
+	}
+}
+
+// putTemps returns temporaries to tempPool. They must not be referenced
+// afterwards, in particular not as coordinates of a returned point.
+func putTemps(ts ...*big.Int) {
+	for _, t := range ts {
+		tempPool.Put(t)
+	}
+}
+
+// reduce sets z to z mod p in [0, p) and returns z. Unlike big.Int.Mod it
+// takes the quotient from tempPool instead of allocating one.
+func reduce(z, p *big.Int) *big.Int {
+	q := tempPool.Get().(*big.Int)
+	q.QuoRem(z, p, z)
+	tempPool.Put(q)
+	if z.Sign() < 0 {
+		z.Add(z, p)
+	}
+	return z
+}
@@ -0,0 +1,71 @@
+package curve
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// In-circuit scalar multiplication (kimchi's VarBaseMul, used by o1js's
+// Group.scale) does not consume a scalar s directly. It consumes a 255-bit
+// "shifted" value t and computes (2t + 2^255 + 1)·P, which lets every ladder
+// step add either +P or -P and never the identity. ShiftScalar and
+// UnshiftScalar convert between the two representations modulo the group
+// order, and ScaleShifted reproduces the circuit's ladder so results can be
+// cross-checked against circuit outputs step for step.
+
+// ShiftedScalarBits is the number of bits of a shifted scalar.
+const ShiftedScalarBits = 255
+
+// scalarShift is 2^255 + 1.
+var scalarShift = new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), ShiftedScalarBits), big.NewInt(1))
+
+// ShiftScalar returns t = (s - 2^255 - 1) / 2 modulo the group order, the
+// shifted representation of s.
+func (c *Curve) ShiftScalar(s *big.Int) *big.Int {
+	t := new(big.Int).Sub(s, scalarShift)
+	t.Mul(t, new(big.Int).ModInverse(big.NewInt(2), c.Order))
+	return t.Mod(t, c.Order)
+}
+
+// UnshiftScalar returns s = 2t + 2^255 + 1 modulo the group order, the scalar
+// represented by the shifted value t.
+func (c *Curve) UnshiftScalar(t *big.Int) *big.Int {
+	s := new(big.Int).Lsh(t, 1)
+	s.Add(s, scalarShift)
+	return s.Mod(s, c.Order)
+}
+
+// ScaleShifted returns (2t + 2^255 + 1)·g for a shifted scalar
+// 0 <= t < 2^255, following the same ladder as the circuit: starting from
+// 2g, each bit of t from the top sets acc = (acc + q) + acc with q = g for a
+// one bit and q = -g for a zero bit.
+func (c *Curve) ScaleShifted(g *GroupProjective, t *big.Int) (*GroupProjective, error) {
+	if err := checkPoints(g); err != nil {
+		return nil, err
+	}
+	if t == nil || t.Sign() < 0 || t.BitLen() > ShiftedScalarBits {
+		return nil, errcode.New(errcode.OutOfRange, "curve: shifted scalar must be in [0, 2^255)")
+	}
+	p, a := c.Modulus, c.A
+	neg := ProjectiveNeg(g, p)
+
+	acc, err := projectiveDouble(g, p, a)
+	if err != nil {
+		return nil, err
+	}
+	for i := ShiftedScalarBits - 1; i >= 0; i-- {
+		q := neg
+		if t.Bit(i) == 1 {
+			q = g
+		}
+		sum, err := projectiveAdd(acc, q, p, a)
+		if err != nil {
+			return nil, err
+		}
+		if acc, err = projectiveAdd(sum, acc, p, a); err != nil {
+			return nil, err
+		}
+	}
+	return acc, nil
+}
@@ -0,0 +1,48 @@
+package curve
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestShiftScalarRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		for i := 0; i < 50; i++ {
+			s := new(big.Int).Rand(rng, c.Order)
+			if got := c.UnshiftScalar(c.ShiftScalar(s)); got.Cmp(s) != 0 {
+				t.Fatalf("%s: UnshiftScalar(ShiftScalar(%v)) = %v", c.Name, s, got)
+			}
+		}
+	}
+}
+
+func TestScaleShifted(t *testing.T) {
+	pallas := Pallas()
+	rng := rand.New(rand.NewSource(4))
+	g := pallas.Scale(pallas.One, big.NewInt(4242))
+	for i := 0; i < 10; i++ {
+		s := new(big.Int).Rand(rng, pallas.Order)
+		got, err := pallas.ScaleShifted(g, pallas.ShiftScalar(s))
+		if err != nil {
+			t.Fatalf("ScaleShifted failed: %v", err)
+		}
+		if !sameAffine(pallas, got, pallas.Scale(g, s)) {
+			t.Fatalf("ScaleShifted(g, shift(%v)) != Scale(g, %v)", s, s)
+		}
+	}
+
+	// t = 0 is (2^255 + 1)·g.
+	got, err := pallas.ScaleShifted(g, big.NewInt(0))
+	if err != nil {
+		t.Fatalf("ScaleShifted(g, 0) failed: %v", err)
+	}
+	if !sameAffine(pallas, got, pallas.Scale(g, new(big.Int).Mod(scalarShift, pallas.Order))) {
+		t.Error("ScaleShifted(g, 0) != (2^255 + 1)·g")
+	}
+
+	if _, err := pallas.ScaleShifted(g, new(big.Int).Lsh(big.NewInt(1), 255)); err == nil {
+		t.Error("ScaleShifted accepted a 256-bit shifted scalar")
+	}
+}
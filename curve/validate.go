@@ -0,0 +1,48 @@
+package curve
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+var (
+	// ErrWrongSubgroup is returned for a curve point outside the prime-order
+	// subgroup.
+	ErrWrongSubgroup = errcode.New(errcode.WrongSubgroup, "curve: point is not in the prime-order subgroup")
+	// ErrAtInfinity is returned where the point at infinity is not allowed.
+	ErrAtInfinity = errcode.New(errcode.PointAtInfinity, "curve: point at infinity")
+)
+
+// ValidatePoint checks that g is a finite point of the prime-order subgroup
+// of Pallas. See (*Curve).ValidatePoint.
+func ValidatePoint(g *GroupProjective) error {
+	return pallas.ValidatePoint(g)
+}
+
+// ValidatePoint checks that g is well formed, not the point at infinity, on
+// the curve and in the prime-order subgroup. It returns ErrNilPoint,
+// ErrAtInfinity, ErrNotOnCurve or ErrWrongSubgroup respectively. The
+// subgroup check is skipped when the curve's cofactor is known to be 1.
+func (c *Curve) ValidatePoint(g *GroupProjective) error {
+	if err := checkPoints(g); err != nil {
+		return err
+	}
+	if new(big.Int).Mod(g.Z, c.Modulus).Sign() == 0 {
+		return ErrAtInfinity
+	}
+	if !c.IsOnCurve(g) {
+		return ErrNotOnCurve
+	}
+	if c.Cofactor != nil && c.Cofactor.Cmp(big.NewInt(1)) == 0 {
+		return nil
+	}
+	// The order is public, so it is scaled by with all of its bits rather
+	// than the fixed 255 of a secret scalar, which would truncate the
+	// order of a larger curve.
+	h, err := projectiveScaleBits(g, BigIntToBitsLen(c.Order), c.Modulus, c.A)
+	if err != nil || h.Z.Sign() != 0 {
+		return ErrWrongSubgroup
+	}
+	return nil
+}
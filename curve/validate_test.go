@@ -0,0 +1,55 @@
+package curve
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestValidatePoint(t *testing.T) {
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		t.Run(c.Name, func(t *testing.T) {
+			g := c.Scale(c.One, big.NewInt(12345))
+			if err := c.ValidatePoint(g); err != nil {
+				t.Errorf("ValidatePoint rejected a valid point: %v", err)
+			}
+			if err := c.ValidatePoint(c.Zero); !errors.Is(err, ErrAtInfinity) {
+				t.Errorf("ValidatePoint(zero) = %v, want ErrAtInfinity", err)
+			}
+			if err := c.ValidatePoint(nil); !errors.Is(err, ErrNilPoint) {
+				t.Errorf("ValidatePoint(nil) = %v, want ErrNilPoint", err)
+			}
+			bad := &GroupProjective{X: big.NewInt(0), Y: big.NewInt(1), Z: big.NewInt(1)}
+			if err := c.ValidatePoint(bad); !errors.Is(err, ErrNotOnCurve) {
+				t.Errorf("ValidatePoint(off curve) = %v, want ErrNotOnCurve", err)
+			}
+
+			// Without a known cofactor the full subgroup check runs, and every
+			// point of a prime-order curve must still pass it.
+			unknown := CreateCurveProjective(CurveParams{
+				Name: c.Name, Modulus: c.Modulus, Order: c.Order,
+				Generator: c.Generator, A: c.A, B: c.B,
+			})
+			if err := unknown.ValidatePoint(g); err != nil {
+				t.Errorf("full subgroup check rejected a valid point: %v", err)
+			}
+			// Claiming a smaller order makes the generator fail the check.
+			wrong := CreateCurveProjective(CurveParams{
+				Name: c.Name, Modulus: c.Modulus, Order: big.NewInt(7),
+				Generator: c.Generator, A: c.A, B: c.B,
+			})
+			if err := wrong.ValidatePoint(g); !errors.Is(err, ErrWrongSubgroup) {
+				t.Errorf("ValidatePoint with wrong order = %v, want ErrWrongSubgroup", err)
+			}
+			// An order above 2^255 is used whole: cut to 255 bits, the
+			// order + 2^255 below would be the true order again.
+			long := CreateCurveProjective(CurveParams{
+				Name: c.Name, Modulus: c.Modulus, Order: new(big.Int).SetBit(new(big.Int).Set(c.Order), 255, 1),
+				Generator: c.Generator, A: c.A, B: c.B,
+			})
+			if err := long.ValidatePoint(g); !errors.Is(err, ErrWrongSubgroup) {
+				t.Errorf("ValidatePoint with a 256-bit order = %v, want ErrWrongSubgroup", err)
+			}
+		})
+	}
+}
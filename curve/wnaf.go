@@ -0,0 +1,99 @@
+package curve
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// DefaultWNAFWidth is the window width used by ScaleWNAF. A width of 5 keeps
+// a table of 8 odd multiples and needs about one addition per 6 bits.
+const DefaultWNAFWidth = 5
+
+// WNAF returns the width-w non-adjacent form of k, least significant digit
+// first. Every non-zero digit is odd, lies in (-2^(w-1), 2^(w-1)), and is
+// followed by at least w-1 zeros. The sign of k is carried by the digits.
+func WNAF(k *big.Int, w uint) []int {
+	if w < 2 || w > 16 {
+		panic("curve: wNAF width must be between 2 and 16")
+	}
+	n := new(big.Int).Abs(k)
+	neg := k.Sign() < 0
+	window := int64(1) << w
+	half := window >> 1
+	mask := big.NewInt(window - 1)
+
+	digits := make([]int, 0, n.BitLen()+1)
+	tmp := new(big.Int)
+	for n.Sign() > 0 {
+		var d int64
+		if n.Bit(0) == 1 {
+			d = tmp.And(n, mask).Int64()
+			if d >= half {
+				d -= window
+			}
+			n.Sub(n, big.NewInt(d))
+		}
+		if neg {
+			digits = append(digits, int(-d))
+		} else {
+			digits = append(digits, int(d))
+		}
+		n.Rsh(n, 1)
+	}
+	return digits
+}
+
+// ScaleWNAF returns k·g using a width-5 wNAF recoding of k. It runs in time
+// that depends on k and must only be used for public scalars, such as those
+// in signature verification.
+func (c *Curve) ScaleWNAF(g *GroupProjective, k *big.Int) *GroupProjective {
+	return mustPoint(c.scaleWNAF(g, k, DefaultWNAFWidth))
+}
+
+// ScaleWNAFChecked is ScaleWNAF returning an error instead of panicking when
+// g is malformed.
+func (c *Curve) ScaleWNAFChecked(g *GroupProjective, k *big.Int) (*GroupProjective, error) {
+	if err := checkPoints(g); err != nil {
+		return nil, err
+	}
+	if k == nil {
+		return nil, errcode.New(errcode.MissingValue, "curve: nil scalar")
+	}
+	return c.scaleWNAF(g, k, DefaultWNAFWidth)
+}
+
+func (c *Curve) scaleWNAF(g *GroupProjective, k *big.Int, w uint) (*GroupProjective, error) {
+	p, a := c.Modulus, c.A
+
+	// table[i] = (2i+1)·g
+	table := make([]*GroupProjective, 1<<(w-2))
+	table[0] = g
+	g2, err := projectiveDouble(g, p, a)
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(table); i++ {
+		if table[i], err = projectiveAdd(table[i-1], g2, p, a); err != nil {
+			return nil, err
+		}
+	}
+
+	digits := WNAF(k, w)
+	h := projectiveZero
+	for i := len(digits) - 1; i >= 0; i-- {
+		if h, err = projectiveDouble(h, p, a); err != nil {
+			return nil, err
+		}
+		switch d := digits[i]; {
+		case d > 0:
+			h, err = projectiveAdd(h, table[d/2], p, a)
+		case d < 0:
+			h, err = projectiveAdd(h, ProjectiveNeg(table[-d/2], p), p, a)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
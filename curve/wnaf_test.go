@@ -0,0 +1,93 @@
+package curve
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestWNAF(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		k := new(big.Int).Rand(rng, Pallas().Order)
+		if i%2 == 1 {
+			k.Neg(k)
+		}
+		for _, w := range []uint{2, 4, 5, 8} {
+			digits := WNAF(k, w)
+			sum := new(big.Int)
+			lastNonZero := -int(w)
+			for j := len(digits) - 1; j >= 0; j-- {
+				sum.Lsh(sum, 1)
+				sum.Add(sum, big.NewInt(int64(digits[j])))
+			}
+			for j, d := range digits {
+				if d == 0 {
+					continue
+				}
+				if d%2 == 0 || d >= 1<<(w-1) || d <= -(1<<(w-1)) {
+					t.Fatalf("WNAF(%v, %d) digit %d out of range", k, w, d)
+				}
+				if j-lastNonZero < int(w) {
+					t.Fatalf("WNAF(%v, %d) non-zero digits closer than %d", k, w, w)
+				}
+				lastNonZero = j
+			}
+			if sum.Cmp(k) != 0 {
+				t.Fatalf("WNAF(%v, %d) evaluates to %v", k, w, sum)
+			}
+		}
+	}
+}
+
+func TestScaleWNAF(t *testing.T) {
+	pallas := Pallas()
+	rng := rand.New(rand.NewSource(2))
+	g := pallas.Scale(pallas.One, big.NewInt(987654321))
+	for i := 0; i < 20; i++ {
+		k := new(big.Int).Rand(rng, pallas.Order)
+		if !sameAffine(pallas, pallas.ScaleWNAF(g, k), pallas.Scale(g, k)) {
+			t.Fatalf("ScaleWNAF(g, %v) != Scale(g, %v)", k, k)
+		}
+	}
+	for _, k := range []int64{0, 1, 2, 3, 15, 16, 17, -1, -5} {
+		want := pallas.Scale(g, new(big.Int).Abs(big.NewInt(k)))
+		if k < 0 {
+			want = pallas.Negate(want)
+		}
+		got, err := pallas.ScaleWNAFChecked(g, big.NewInt(k))
+		if err != nil {
+			t.Fatalf("ScaleWNAFChecked(g, %d) failed: %v", k, err)
+		}
+		if !sameAffine(pallas, got, want) {
+			t.Errorf("ScaleWNAFChecked(g, %d) mismatch", k)
+		}
+	}
+}
+
+func TestWindows(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 100; i++ {
+		k := new(big.Int).Rand(rng, Pallas().Order)
+		for _, w := range []uint{1, 3, 4, 8, 16} {
+			digits := Windows(k, w, 255)
+			if want := (255 + int(w) - 1) / int(w); len(digits) != want {
+				t.Fatalf("Windows(%v, %d) has %d digits, want %d", k, w, len(digits), want)
+			}
+			sum := new(big.Int)
+			for j := len(digits) - 1; j >= 0; j-- {
+				if digits[j] < 0 || digits[j] >= 1<<w {
+					t.Fatalf("Windows(%v, %d) digit %d out of range", k, w, digits[j])
+				}
+				sum.Lsh(sum, w)
+				sum.Add(sum, big.NewInt(int64(digits[j])))
+			}
+			if sum.Cmp(k) != 0 {
+				t.Fatalf("Windows(%v, %d) evaluates to %v", k, w, sum)
+			}
+		}
+	}
+	if got := Windows(big.NewInt(0xff), 4, 6); len(got) != 2 || got[0] != 0xf || got[1] != 0x3 {
+		t.Errorf("Windows(0xff, 4, 6) = %v, want [15 3]", got)
+	}
+}
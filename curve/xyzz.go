@@ -0,0 +1,345 @@
+package curve
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// Coordinates selects the point representation a Curve uses internally for
+// multi-scalar multiplication. Points passed to and returned from the public
+// API are always Jacobian GroupProjective values.
+type Coordinates int
+
+const (
+	// CoordinatesJacobian runs every operation in Jacobian coordinates.
+	CoordinatesJacobian Coordinates = iota
+	// CoordinatesXYZZ runs multi-scalar multiplication in extended Jacobian
+	// (XYZZ) coordinates. The bases are normalized to affine once, after
+	// which every addition is a mixed addition costing 8M+2S.
+	CoordinatesXYZZ
+)
+
+// String returns the name of the coordinate system.
+func (k Coordinates) String() string {
+	switch k {
+	case CoordinatesJacobian:
+		return "jacobian"
+	case CoordinatesXYZZ:
+		return "xyzz"
+	default:
+		return "unknown"
+	}
+}
+
+// CreateCurveWithCoordinates builds a Curve from its parameters that uses
+// coords for multi-scalar multiplication.
+//
+// Deprecated: use CreateCurveProjective(params, WithCoordinates(coords)).
+func CreateCurveWithCoordinates(params CurveParams, coords Coordinates) *Curve {
+	return CreateCurveProjective(params, WithCoordinates(coords))
+}
+
+// GroupXYZZ is a point in extended Jacobian coordinates: it represents the
+// affine point (X/ZZ, Y/ZZZ) with ZZ^3 = ZZZ^2. The point at infinity has
+// ZZ = 0.
+type GroupXYZZ struct {
+	X, Y, ZZ, ZZZ *big.Int
+}
+
+// XYZZCurve exposes the group law of a Curve on GroupXYZZ points. It
+// implements GenericCurve, so the generic ladders and MultiScalarMul run on it
+// unchanged.
+type XYZZCurve struct {
+	c *Curve
+}
+
+var _ GenericCurve[*GroupXYZZ] = XYZZCurve{}
+
+// XYZZ returns the XYZZ view of c.
+func (c *Curve) XYZZ() XYZZCurve {
+	return XYZZCurve{c: c}
+}
+
+// Params returns the parameters of the underlying curve.
+func (x XYZZCurve) Params() CurveParams {
+	return x.c.CurveParams
+}
+
+// Identity returns the point at infinity.
+func (x XYZZCurve) Identity() *GroupXYZZ {
+	return &GroupXYZZ{X: big.NewInt(1), Y: big.NewInt(1), ZZ: big.NewInt(0), ZZZ: big.NewInt(0)}
+}
+
+// IsIdentity reports whether g is the point at infinity.
+func (x XYZZCurve) IsIdentity(g *GroupXYZZ) bool {
+	return g.ZZ.Sign() == 0
+}
+
+// Negate returns -g.
+func (x XYZZCurve) Negate(g *GroupXYZZ) *GroupXYZZ {
+	return &GroupXYZZ{X: g.X, Y: NegateInField(g.Y, x.c.Modulus), ZZ: g.ZZ, ZZZ: g.ZZZ}
+}
+
+// FromJacobian converts a Jacobian point to XYZZ coordinates.
+func (x XYZZCurve) FromJacobian(g *GroupProjective) *GroupXYZZ {
+	if g.Z.Sign() == 0 {
+		return x.Identity()
+	}
+	p := x.c.Modulus
+	zz := reduce(new(big.Int).Mul(g.Z, g.Z), p)
+	zzz := reduce(new(big.Int).Mul(zz, g.Z), p)
+	return &GroupXYZZ{X: g.X, Y: g.Y, ZZ: zz, ZZZ: zzz}
+}
+
+// ToJacobian converts g to Jacobian coordinates without an inversion, using
+// Z = ZZ·ZZZ, X = X·ZZ·ZZZ^2 and Y = Y·ZZ^3·ZZZ^2.
+func (x XYZZCurve) ToJacobian(g *GroupXYZZ) *GroupProjective {
+	if g.ZZ.Sign() == 0 {
+		return x.c.Zero
+	}
+	p := x.c.Modulus
+	var zzz2, zz3 *big.Int
+	getTemps(&zzz2, &zz3)
+	defer putTemps(zzz2, zz3)
+
+	reduce(zzz2.Mul(g.ZZZ, g.ZZZ), p)
+	reduce(zz3.Mul(g.ZZ, g.ZZ), p)
+	reduce(zz3.Mul(zz3, g.ZZ), p)
+	X := reduce(new(big.Int).Mul(g.X, g.ZZ), p)
+	reduce(X.Mul(X, zzz2), p)
+	Y := reduce(new(big.Int).Mul(g.Y, zz3), p)
+	reduce(Y.Mul(Y, zzz2), p)
+	Z := reduce(new(big.Int).Mul(g.ZZ, g.ZZZ), p)
+	return &GroupProjective{X: X, Y: Y, Z: Z}
+}
+
+// FromAffine converts an affine point to XYZZ coordinates.
+func (x XYZZCurve) FromAffine(a GroupAffine) *GroupXYZZ {
+	if a.Infinity {
+		return x.Identity()
+	}
+	return &GroupXYZZ{X: a.X, Y: a.Y, ZZ: big.NewInt(1), ZZZ: big.NewInt(1)}
+}
+
+// ToAffine converts g to affine coordinates.
+func (x XYZZCurve) ToAffine(g *GroupXYZZ) GroupAffine {
+	p := x.c.Modulus
+	zzInv, err := field.InverseErr(g.ZZ, p)
+	if err != nil {
+		return GroupAffine{Infinity: true}
+	}
+	zzzInv, err := field.InverseErr(g.ZZZ, p)
+	if err != nil {
+		return GroupAffine{Infinity: true}
+	}
+	return GroupAffine{
+		X: reduce(new(big.Int).Mul(g.X, zzInv), p),
+		Y: reduce(new(big.Int).Mul(g.Y, zzzInv), p),
+	}
+}
+
+// Double returns 2g (dbl-2008-s-1).
+func (x XYZZCurve) Double(g *GroupXYZZ) *GroupXYZZ {
+	if g.ZZ.Sign() == 0 {
+		return g
+	}
+	p, a := x.c.Modulus, x.c.A
+	var U, V, W, S, M, t *big.Int
+	getTemps(&U, &V, &W, &S, &M, &t)
+	defer putTemps(U, V, W, S, M, t)
+
+	// U = 2*Y1, V = U^2, W = U*V, S = X1*V
+	reduce(U.Lsh(g.Y, 1), p)
+	reduce(V.Mul(U, U), p)
+	reduce(W.Mul(U, V), p)
+	reduce(S.Mul(g.X, V), p)
+	// M = 3*X1^2+a*ZZ1^2
+	reduce(M.Mul(g.X, g.X), p)
+	M.Add(M, t.Lsh(M, 1))
+	if a.Sign() != 0 {
+		reduce(t.Mul(g.ZZ, g.ZZ), p)
+		M.Add(M, t.Mul(t, a))
+	}
+	reduce(M, p)
+	// X3 = M^2-2*S
+	X3 := new(big.Int).Mul(M, M)
+	reduce(X3.Sub(X3, t.Lsh(S, 1)), p)
+	// Y3 = M*(S-X3)-W*Y1
+	Y3 := new(big.Int).Sub(S, X3)
+	reduce(Y3.Mul(Y3, M).Sub(Y3, t.Mul(W, g.Y)), p)
+	// ZZ3 = V*ZZ1, ZZZ3 = W*ZZZ1
+	ZZ3 := reduce(new(big.Int).Mul(V, g.ZZ), p)
+	ZZZ3 := reduce(new(big.Int).Mul(W, g.ZZZ), p)
+	return &GroupXYZZ{X: X3, Y: Y3, ZZ: ZZ3, ZZZ: ZZZ3}
+}
+
+// Add returns g + h (add-2008-s).
+func (x XYZZCurve) Add(g, h *GroupXYZZ) *GroupXYZZ {
+	if g.ZZ.Sign() == 0 {
+		return h
+	}
+	if h.ZZ.Sign() == 0 {
+		return g
+	}
+	p := x.c.Modulus
+	var U1, S1, P, R, PP, PPP, Q, ZZ, ZZZ, t *big.Int
+	getTemps(&U1, &S1, &P, &R, &PP, &PPP, &Q, &ZZ, &ZZZ, &t)
+	defer putTemps(U1, S1, P, R, PP, PPP, Q, ZZ, ZZZ, t)
+
+	// U1 = X1*ZZ2, U2 = X2*ZZ1, S1 = Y1*ZZZ2, S2 = Y2*ZZZ1
+	// P = U2-U1, R = S2-S1
+	reduce(U1.Mul(g.X, h.ZZ), p)
+	reduce(P.Mul(h.X, g.ZZ), p)
+	reduce(P.Sub(P, U1), p)
+	reduce(S1.Mul(g.Y, h.ZZZ), p)
+	reduce(R.Mul(h.Y, g.ZZZ), p)
+	reduce(R.Sub(R, S1), p)
+	if P.Sign() == 0 {
+		if R.Sign() == 0 {
+			return x.Double(g)
+		}
+		return x.Identity()
+	}
+	// PP = P^2, PPP = P*PP, Q = U1*PP
+	reduce(PP.Mul(P, P), p)
+	reduce(PPP.Mul(P, PP), p)
+	reduce(Q.Mul(U1, PP), p)
+	// ZZ3 = ZZ1*ZZ2*PP, ZZZ3 = ZZZ1*ZZZ2*PPP
+	reduce(ZZ.Mul(g.ZZ, h.ZZ), p)
+	reduce(ZZZ.Mul(g.ZZZ, h.ZZZ), p)
+	return x.finishAdd(R, PP, PPP, Q, S1, ZZ, ZZZ, t)
+}
+
+// AddAffine returns g + a for an affine point a (madd-2008-s). This is the
+// cheap mixed addition that makes XYZZ attractive for multi-scalar
+// multiplication with normalized bases.
+func (x XYZZCurve) AddAffine(g *GroupXYZZ, a GroupAffine) *GroupXYZZ {
+	if a.Infinity {
+		return g
+	}
+	if g.ZZ.Sign() == 0 {
+		return x.FromAffine(a)
+	}
+	p := x.c.Modulus
+	var P, R, PP, PPP, Q, t *big.Int
+	getTemps(&P, &R, &PP, &PPP, &Q, &t)
+	defer putTemps(P, R, PP, PPP, Q, t)
+
+	// P = X2*ZZ1-X1, R = Y2*ZZZ1-Y1
+	reduce(P.Mul(a.X, g.ZZ), p)
+	reduce(P.Sub(P, g.X), p)
+	reduce(R.Mul(a.Y, g.ZZZ), p)
+	reduce(R.Sub(R, g.Y), p)
+	if P.Sign() == 0 {
+		if R.Sign() == 0 {
+			return x.Double(g)
+		}
+		return x.Identity()
+	}
+	// PP = P^2, PPP = P*PP, Q = X1*PP
+	reduce(PP.Mul(P, P), p)
+	reduce(PPP.Mul(P, PP), p)
+	reduce(Q.Mul(g.X, PP), p)
+	// ZZ3 = ZZ1*PP, ZZZ3 = ZZZ1*PPP
+	return x.finishAdd(R, PP, PPP, Q, g.Y, g.ZZ, g.ZZZ, t)
+}
+
+// finishAdd computes the output shared by add-2008-s and madd-2008-s:
+// X3 = R^2-PPP-2*Q, Y3 = R*(Q-X3)-S1*PPP, ZZ3 = zz*PP and ZZZ3 = zzz*PPP.
+// t is a scratch value distinct from the other arguments.
+func (x XYZZCurve) finishAdd(R, PP, PPP, Q, S1, zz, zzz, t *big.Int) *GroupXYZZ {
+	p := x.c.Modulus
+	X3 := new(big.Int).Mul(R, R)
+	reduce(X3.Sub(X3, PPP).Sub(X3, t.Lsh(Q, 1)), p)
+	Y3 := new(big.Int).Sub(Q, X3)
+	reduce(Y3.Mul(Y3, R).Sub(Y3, t.Mul(S1, PPP)), p)
+	ZZ3 := reduce(new(big.Int).Mul(zz, PP), p)
+	ZZZ3 := reduce(new(big.Int).Mul(zzz, PPP), p)
+	return &GroupXYZZ{X: X3, Y: Y3, ZZ: ZZ3, ZZZ: ZZZ3}
+}
+
+// MultiScalarMul returns the sum of scalars[i]·points[i] using the
+// coordinate system selected when c was built. With CoordinatesXYZZ the
+// bases are normalized to affine with a single batched inversion and every
+// addition is a mixed XYZZ addition.
+func (c *Curve) MultiScalarMul(points []*GroupProjective, scalars []*big.Int) (*GroupProjective, error) {
+	if err := checkPoints(points...); err != nil {
+		return nil, err
+	}
+	for _, k := range scalars {
+		if k == nil {
+			return nil, errcode.New(errcode.MissingValue, "curve: nil scalar")
+		}
+	}
+	if c.Coordinates != CoordinatesXYZZ {
+		return MultiScalarMul[*GroupProjective](c, points, scalars)
+	}
+	if len(points) != len(scalars) {
+		return nil, errcode.New(errcode.InvalidArgument, "curve: MultiScalarMul needs as many scalars as points")
+	}
+
+	x := c.XYZZ()
+	bases := c.batchToAffine(points)
+	ks := make([]*big.Int, len(scalars))
+	maxBits := 0
+	for i, k := range scalars {
+		if k.Sign() < 0 && !bases[i].Infinity {
+			bases[i].Y = NegateInField(bases[i].Y, c.Modulus)
+		}
+		ks[i] = new(big.Int).Abs(k)
+		if ks[i].BitLen() > maxBits {
+			maxBits = ks[i].BitLen()
+		}
+	}
+	h := x.Identity()
+	for bit := maxBits - 1; bit >= 0; bit-- {
+		h = x.Double(h)
+		for i, k := range ks {
+			if k.Bit(bit) == 1 {
+				h = x.AddAffine(h, bases[i])
+			}
+		}
+	}
+	return x.ToJacobian(h), nil
+}
+
+// batchToAffine converts points to affine coordinates with Montgomery's
+// trick, paying for one field inversion in total.
+func (c *Curve) batchToAffine(points []*GroupProjective) []GroupAffine {
+	p := c.Modulus
+	out := make([]GroupAffine, len(points))
+	// prefix[i] is the product of the non-zero Z coordinates before i.
+	prefix := make([]*big.Int, len(points))
+	acc := big.NewInt(1)
+	for i, g := range points {
+		prefix[i] = acc
+		if g.Z.Sign() != 0 {
+			acc = reduce(new(big.Int).Mul(acc, g.Z), p)
+		}
+	}
+	inv, err := field.InverseErr(acc, p)
+	if err != nil {
+		// Some Z is a non-zero multiple of p; convert one by one instead.
+		for i, g := range points {
+			out[i] = c.ToAffine(g)
+		}
+		return out
+	}
+	for i := len(points) - 1; i >= 0; i-- {
+		g := points[i]
+		if g.Z.Sign() == 0 {
+			out[i] = GroupAffine{Infinity: true}
+			continue
+		}
+		zInv := reduce(new(big.Int).Mul(inv, prefix[i]), p)
+		reduce(inv.Mul(inv, g.Z), p)
+		zInv2 := reduce(new(big.Int).Mul(zInv, zInv), p)
+		out[i] = GroupAffine{
+			X: reduce(new(big.Int).Mul(g.X, zInv2), p),
+			Y: reduce(new(big.Int).Mul(g.Y, reduce(zInv2.Mul(zInv2, zInv), p)), p),
+		}
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+package curve
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestXYZZMatchesJacobian(t *testing.T) {
+	for _, c := range []*Curve{Pallas(), Vesta()} {
+		t.Run(c.Name, func(t *testing.T) {
+			x := c.XYZZ()
+			g := c.Scale(c.One, big.NewInt(1234567))
+			h := c.Scale(c.One, big.NewInt(7654321))
+			gx, hx := x.FromJacobian(g), x.FromJacobian(h)
+
+			check := func(name string, got *GroupXYZZ, want *GroupProjective) {
+				t.Helper()
+				if !sameAffine(c, x.ToJacobian(got), want) {
+					t.Errorf("%s: XYZZ result differs from Jacobian", name)
+				}
+				if a, b := x.ToAffine(got), c.ToAffine(want); a.Infinity != b.Infinity ||
+					(!a.Infinity && (a.X.Cmp(b.X) != 0 || a.Y.Cmp(b.Y) != 0)) {
+					t.Errorf("%s: XYZZ ToAffine differs from Jacobian", name)
+				}
+			}
+			check("add", x.Add(gx, hx), c.Add(g, h))
+			check("double", x.Double(gx), c.Double(g))
+			check("add self", x.Add(gx, gx), c.Double(g))
+			check("add inverse", x.Add(gx, x.Negate(gx)), c.Zero)
+			check("add identity", x.Add(x.Identity(), hx), h)
+			check("mixed add", x.AddAffine(x.Double(gx), c.ToAffine(h)), c.Add(c.Double(g), h))
+			check("mixed add self", x.AddAffine(x.Double(gx), c.ToAffine(c.Double(g))), c.Scale(g, big.NewInt(4)))
+			check("scale", ScaleGeneric[*GroupXYZZ](x, gx, big.NewInt(-99991)), c.Negate(c.Scale(g, big.NewInt(99991))))
+		})
+	}
+}
+
+func TestMultiScalarMulCoordinates(t *testing.T) {
+	pallas := Pallas()
+	jacobian := CreateCurveProjective(pallas.CurveParams, WithCoordinates(CoordinatesJacobian))
+	xyzz := CreateCurveProjective(pallas.CurveParams, WithCoordinates(CoordinatesXYZZ))
+
+	g := pallas.Scale(pallas.One, big.NewInt(42))
+	points := []*GroupProjective{pallas.One, g, pallas.Double(g), pallas.Zero, pallas.Add(g, pallas.One)}
+	scalars := []*big.Int{
+		big.NewInt(3),
+		big.NewInt(-17),
+		new(big.Int).Sub(pallas.Order, big.NewInt(2)),
+		big.NewInt(5),
+		StrToBigInt("0x1234567890abcdef1234567890abcdef"),
+	}
+	want, err := jacobian.MultiScalarMul(points, scalars)
+	if err != nil {
+		t.Fatalf("Jacobian MultiScalarMul failed: %v", err)
+	}
+	got, err := xyzz.MultiScalarMul(points, scalars)
+	if err != nil {
+		t.Fatalf("XYZZ MultiScalarMul failed: %v", err)
+	}
+	if !sameAffine(pallas, got, want) {
+		t.Error("XYZZ MultiScalarMul differs from Jacobian")
+	}
+	if _, err := xyzz.MultiScalarMul(points, scalars[:2]); err == nil {
+		t.Error("MultiScalarMul accepted mismatched lengths")
+	}
+	if xyzz.Coordinates.String() != "xyzz" || jacobian.Coordinates.String() != "jacobian" {
+		t.Error("unexpected Coordinates names")
+	}
+}
@@ -1,8 +1,8 @@
 package curvebigint
 
 import (
-	"errors"
 	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/errcode"
 	"github.com/node101-io/mina-signer-go/field"
 	"math/big"
 )
@@ -23,15 +23,20 @@ func GroupToProjective(g Group) *curve.GroupProjective {
 
 // Convert projective to affine (throws if at infinity)
 func GroupFromProjective(gp *curve.GroupProjective) (Group, error) {
-	affine := curve.ProjectiveToAffine(gp, field.P)
+	return GroupFromProjectiveOn(curve.Pallas(), gp)
+}
+
+// GroupFromProjectiveOn converts a projective point of c to affine (throws if at infinity)
+func GroupFromProjectiveOn(c curve.GenericCurve[*curve.GroupProjective], gp *curve.GroupProjective) (Group, error) {
+	affine := c.ToAffine(gp)
 	if affine.Infinity {
-		return Group{}, errors.New("Group.fromProjective: point is infinity")
+		return Group{}, errcode.New(errcode.PointAtInfinity, "Group.fromProjective: point is infinity")
 	}
 	return Group{X: affine.X, Y: affine.Y}, nil
 }
 
 func GeneratorMina() Group {
-	c := curve.NewPallasCurve()
+	c := curve.Pallas()
 	if c.One == nil {
 		panic("curve.One is nil!")
 	}
@@ -49,13 +54,20 @@ func GroupScale(g Group, scalar *big.Int) Group {
 		Infinity: false,
 	})
 
-	resProj := curve.NewPallasCurve().Scale(gProj, scalar)
+	resProj := curve.Pallas().Scale(gProj, scalar)
 
 	resAff := curve.ProjectiveToAffine(resProj, field.P)
 	return Group{X: resAff.X, Y: resAff.Y}
 }
 
+// GroupScaleOn scales a point of c by scalar using the generic ladder
+func GroupScaleOn(c curve.GenericCurve[*curve.GroupProjective], g Group, scalar *big.Int) Group {
+	gProj := c.FromAffine(curve.GroupAffine{X: g.X, Y: g.Y})
+	resAff := c.ToAffine(curve.ScaleGeneric(c, gProj, scalar))
+	return Group{X: resAff.X, Y: resAff.Y}
+}
+
 // Get curve b parameter
 func GroupB() *big.Int {
-	return curve.NewPallasCurve().B
+	return curve.Pallas().B
 }
@@ -0,0 +1,339 @@
+// Package dkg runs a distributed key generation among n parties over Pallas,
+// after which any t of them can sign for a shared public key that no party
+// ever held the private key of.
+//
+// The protocol is the Pedersen DKG with proofs of knowledge from the FROST
+// paper (Komlo and Goldberg, 2020), as in RFC 9591's key generation:
+//
+//  1. Each party draws a random polynomial of degree t-1 and broadcasts a
+//     Round1Message with Feldman commitments to its coefficients and a
+//     Schnorr proof of knowledge of its constant term.
+//  2. After receiving every Round1Message, each party verifies the proofs
+//     and sends every other party j a Round2Message carrying its
+//     polynomial evaluated at j. These messages hold secrets and must go
+//     over a confidential channel, for example encrypted with the
+//     encryption package.
+//  3. Each party checks the shares it received against the senders'
+//     commitments and sums them into its signing share.
+//
+// The result is a KeyPackage in the form FROST signing uses: the party's
+// identifier, its signing share, the verifying shares of all parties and
+// the group public key. Messages are plain structs with JSON encodings, so
+// they can travel over any transport.
+package dkg
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// proofDomain separates the proof of knowledge challenge from other hashes.
+const proofDomain = "mina-signer-go-dkg-v1-pok"
+
+// Point is a curve point as decimal coordinates, the JSON form of an o1js
+// Group.
+type Point struct {
+	X string `json:"x"`
+	Y string `json:"y"`
+}
+
+// Round1Message is broadcast by each party in the first round.
+type Round1Message struct {
+	From int `json:"from"`
+	// Commitments holds a_k·G for the coefficients a_0, ..., a_{t-1} of
+	// the sender's polynomial.
+	Commitments []Point `json:"commitments"`
+	// ProofR and ProofZ prove knowledge of a_0: ProofZ·G = ProofR +
+	// c·Commitments[0] for the challenge c.
+	ProofR Point          `json:"proofR"`
+	ProofZ *scalar.Scalar `json:"proofZ"`
+}
+
+// Round2Message carries the share one party computed for another. It is
+// secret.
+type Round2Message struct {
+	From  int            `json:"from"`
+	To    int            `json:"to"`
+	Share *scalar.Scalar `json:"share"`
+}
+
+// KeyPackage is a party's result of the key generation.
+type KeyPackage struct {
+	// Identifier is the party's index, from 1.
+	Identifier int `json:"identifier"`
+	// Threshold is the number of parties needed to sign.
+	Threshold int `json:"threshold"`
+	// SigningShare is the party's secret share of the group key.
+	SigningShare *scalar.Scalar `json:"signingShare"`
+	// VerifyingShares maps each identifier to its signing share times G.
+	VerifyingShares map[int]Point `json:"verifyingShares"`
+	// GroupPublicKey is the shared public key.
+	GroupPublicKey Point `json:"groupPublicKey"`
+}
+
+// PublicKey returns the group public key as a Mina public key.
+func (k *KeyPackage) PublicKey() (keys.PublicKey, error) {
+	g, err := fromPoint(k.GroupPublicKey)
+	if err != nil {
+		return keys.PublicKey{}, fmt.Errorf("dkg: group public key: %w", err)
+	}
+	return keys.PublicKeyFromPoint(keys.Point{X: g.X, Y: g.Y}), nil
+}
+
+// Participant is one party's state. It is not safe for concurrent use.
+type Participant struct {
+	id, threshold, parties int
+	coefficients           []*scalar.Scalar
+	round1                 map[int]*Round1Message
+}
+
+// NewParticipant returns the state of party id among parties, of which
+// threshold are needed to sign.
+func NewParticipant(id, threshold, parties int) (*Participant, error) {
+	if threshold < 1 || threshold > parties {
+		return nil, errcode.Errorf(errcode.OutOfRange, "dkg: threshold %d out of range for %d parties", threshold, parties)
+	}
+	if id < 1 || id > parties {
+		return nil, errcode.Errorf(errcode.OutOfRange, "dkg: identifier %d out of range for %d parties", id, parties)
+	}
+	return &Participant{id: id, threshold: threshold, parties: parties}, nil
+}
+
+// Round1 draws the party's polynomial and returns the message to
+// broadcast.
+func (p *Participant) Round1() (*Round1Message, error) {
+	if p.coefficients != nil {
+		return nil, errcode.New(errcode.InvalidState, "dkg: round 1 already ran")
+	}
+	coefficients := make([]*scalar.Scalar, p.threshold)
+	for i := range coefficients {
+		a, err := scalar.RandomScalar()
+		if err != nil {
+			return nil, err
+		}
+		coefficients[i] = a
+	}
+	pallas := curve.Pallas()
+	msg := &Round1Message{From: p.id}
+	for _, a := range coefficients {
+		msg.Commitments = append(msg.Commitments, toPoint(pallas.ScaleConstantTime(pallas.One, a.BigInt())))
+	}
+	k, err := scalar.RandomScalar()
+	if err != nil {
+		return nil, err
+	}
+	msg.ProofR = toPoint(pallas.ScaleConstantTime(pallas.One, k.BigInt()))
+	c := challenge(p.id, msg.Commitments[0], msg.ProofR)
+	msg.ProofZ = k.Add(coefficients[0].Mul(c))
+	p.coefficients = coefficients
+	return msg, nil
+}
+
+// Round2 checks the Round1Messages of all parties, including or excluding
+// the party's own, and returns the shares to send to the others, ordered by
+// recipient.
+func (p *Participant) Round2(msgs []Round1Message) ([]Round2Message, error) {
+	if p.coefficients == nil {
+		return nil, errcode.New(errcode.InvalidState, "dkg: round 1 has not run")
+	}
+	round1 := make(map[int]*Round1Message)
+	for i := range msgs {
+		m := &msgs[i]
+		if m.From == p.id {
+			continue
+		}
+		if m.From < 1 || m.From > p.parties || round1[m.From] != nil {
+			return nil, errcode.Errorf(errcode.OutOfRange, "dkg: round 1 message from %d is out of range or repeated", m.From)
+		}
+		if err := p.verifyRound1(m); err != nil {
+			return nil, err
+		}
+		round1[m.From] = m
+	}
+	if len(round1) != p.parties-1 {
+		return nil, errcode.Errorf(errcode.InvalidArgument, "dkg: %d round 1 messages from other parties, want %d", len(round1), p.parties-1)
+	}
+	p.round1 = round1
+	out := make([]Round2Message, 0, p.parties-1)
+	for j := 1; j <= p.parties; j++ {
+		if j != p.id {
+			out = append(out, Round2Message{From: p.id, To: j, Share: evaluate(p.coefficients, j)})
+		}
+	}
+	return out, nil
+}
+
+func (p *Participant) verifyRound1(m *Round1Message) error {
+	if len(m.Commitments) != p.threshold {
+		return errcode.Errorf(errcode.InvalidArgument, "dkg: round 1 message from %d has %d commitments, want %d", m.From, len(m.Commitments), p.threshold)
+	}
+	if m.ProofZ == nil {
+		return errcode.Errorf(errcode.MissingValue, "dkg: round 1 message from %d has no proof", m.From)
+	}
+	for k, c := range m.Commitments {
+		if _, err := fromPoint(c); err != nil {
+			return fmt.Errorf("dkg: party %d commitment %d: %w", m.From, k, err)
+		}
+	}
+	r, err := fromPoint(m.ProofR)
+	if err != nil {
+		return fmt.Errorf("dkg: party %d proof: %w", m.From, err)
+	}
+	a0, _ := fromPoint(m.Commitments[0])
+	pallas := curve.Pallas()
+	c := challenge(m.From, m.Commitments[0], m.ProofR)
+	if !pallas.Equal(pallas.ScaleBase(m.ProofZ.BigInt()), pallas.Add(r, pallas.Scale(a0, c.BigInt()))) {
+		return errcode.Errorf(errcode.InvalidSignature, "dkg: party %d proof of knowledge is invalid", m.From)
+	}
+	return nil
+}
+
+// Finalize checks the shares sent to the party in round 2 and returns its
+// KeyPackage.
+func (p *Participant) Finalize(msgs []Round2Message) (*KeyPackage, error) {
+	if p.round1 == nil {
+		return nil, errcode.New(errcode.InvalidState, "dkg: round 2 has not run")
+	}
+	pallas := curve.Pallas()
+	received := make(map[int]bool)
+	signingShare := evaluate(p.coefficients, p.id)
+	for _, m := range msgs {
+		if m.To != p.id {
+			continue
+		}
+		sender, ok := p.round1[m.From]
+		if !ok || received[m.From] || m.Share == nil {
+			return nil, errcode.Errorf(errcode.InvalidArgument, "dkg: unexpected round 2 message from %d", m.From)
+		}
+		want, err := commitmentAt(sender.Commitments, p.id)
+		if err != nil {
+			return nil, err
+		}
+		if !pallas.Equal(pallas.ScaleConstantTime(pallas.One, m.Share.BigInt()), want) {
+			return nil, errcode.Errorf(errcode.KeyMismatch, "dkg: share from %d does not match its commitments", m.From)
+		}
+		received[m.From] = true
+		signingShare = signingShare.Add(m.Share)
+	}
+	if len(received) != p.parties-1 {
+		return nil, errcode.Errorf(errcode.InvalidArgument, "dkg: %d shares, want %d", len(received), p.parties-1)
+	}
+
+	// The commitments of the group polynomial are the sums of the
+	// parties' commitments.
+	own := make([]Point, len(p.coefficients))
+	for i, a := range p.coefficients {
+		own[i] = toPoint(pallas.ScaleConstantTime(pallas.One, a.BigInt()))
+	}
+	all := [][]Point{own}
+	ids := make([]int, 0, len(p.round1))
+	for id := range p.round1 {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		all = append(all, p.round1[id].Commitments)
+	}
+	group := make([]*curve.GroupProjective, p.threshold)
+	for k := range group {
+		group[k] = pallas.Zero
+		for _, commitments := range all {
+			c, err := fromPoint(commitments[k])
+			if err != nil {
+				return nil, err
+			}
+			group[k] = pallas.Add(group[k], c)
+		}
+	}
+	if pallas.ToAffine(group[0]).Infinity {
+		return nil, errcode.New(errcode.PointAtInfinity, "dkg: group public key is the point at infinity")
+	}
+	pkg := &KeyPackage{
+		Identifier:      p.id,
+		Threshold:       p.threshold,
+		SigningShare:    signingShare,
+		VerifyingShares: make(map[int]Point),
+		GroupPublicKey:  toPoint(group[0]),
+	}
+	for j := 1; j <= p.parties; j++ {
+		pkg.VerifyingShares[j] = toPoint(polynomialAt(group, j))
+	}
+	if !pallas.Equal(pallas.ScaleConstantTime(pallas.One, signingShare.BigInt()), polynomialAt(group, p.id)) {
+		return nil, errcode.New(errcode.KeyMismatch, "dkg: signing share does not match the group commitments")
+	}
+	return pkg, nil
+}
+
+// evaluate returns the polynomial with the given coefficients at x.
+func evaluate(coefficients []*scalar.Scalar, x int) *scalar.Scalar {
+	xs := scalar.NewScalar(x)
+	y := scalar.NewScalar(0)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = y.Mul(xs).Add(coefficients[i])
+	}
+	return y
+}
+
+// commitmentAt returns the sum of C_k·x^k, the commitment to the
+// polynomial's value at x.
+func commitmentAt(commitments []Point, x int) (*curve.GroupProjective, error) {
+	points := make([]*curve.GroupProjective, len(commitments))
+	for k, c := range commitments {
+		g, err := fromPoint(c)
+		if err != nil {
+			return nil, err
+		}
+		points[k] = g
+	}
+	return polynomialAt(points, x), nil
+}
+
+func polynomialAt(commitments []*curve.GroupProjective, x int) *curve.GroupProjective {
+	pallas := curve.Pallas()
+	xs := big.NewInt(int64(x))
+	acc := pallas.Zero
+	for k := len(commitments) - 1; k >= 0; k-- {
+		acc = pallas.Add(pallas.Scale(acc, xs), commitments[k])
+	}
+	return acc
+}
+
+// challenge hashes the party's identifier, the commitment to its secret
+// and the proof's nonce commitment to a scalar.
+func challenge(id int, a0, r Point) *scalar.Scalar {
+	h, _ := blake2b.New512(nil)
+	h.Write([]byte(proofDomain))
+	for _, s := range []string{fmt.Sprint(id), a0.X, a0.Y, r.X, r.Y} {
+		h.Write([]byte{byte(len(s))})
+		h.Write([]byte(s))
+	}
+	return scalar.ScalarFromBytesLE(h.Sum(nil))
+}
+
+func toPoint(g *curve.GroupProjective) Point {
+	a := curve.Pallas().ToAffine(g)
+	return Point{X: a.X.String(), Y: a.Y.String()}
+}
+
+// fromPoint decodes p and checks that it is a finite point of Pallas.
+func fromPoint(p Point) (*curve.GroupProjective, error) {
+	x, okX := new(big.Int).SetString(p.X, 10)
+	y, okY := new(big.Int).SetString(p.Y, 10)
+	if !okX || !okY || x.Sign() < 0 || y.Sign() < 0 || x.Cmp(field.P) >= 0 || y.Cmp(field.P) >= 0 {
+		return nil, errcode.New(errcode.NonCanonical, "invalid point coordinates")
+	}
+	g := &curve.GroupProjective{X: x, Y: y, Z: big.NewInt(1)}
+	if err := curve.ValidatePoint(g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
@@ -0,0 +1,167 @@
+package dkg_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/dkg"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+// run performs the key generation among parties and returns each party's
+// key package.
+func run(t *testing.T, threshold, parties int) []*dkg.KeyPackage {
+	t.Helper()
+	ps := make([]*dkg.Participant, parties)
+	var round1 []dkg.Round1Message
+	for i := range ps {
+		p, err := dkg.NewParticipant(i+1, threshold, parties)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ps[i] = p
+		m, err := p.Round1()
+		if err != nil {
+			t.Fatal(err)
+		}
+		round1 = append(round1, *m)
+	}
+	// Round 1 messages go over the wire as JSON.
+	data, err := json.Marshal(round1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var received []dkg.Round1Message
+	if err := json.Unmarshal(data, &received); err != nil {
+		t.Fatal(err)
+	}
+	var round2 []dkg.Round2Message
+	for _, p := range ps {
+		out, err := p.Round2(received)
+		if err != nil {
+			t.Fatal(err)
+		}
+		round2 = append(round2, out...)
+	}
+	pkgs := make([]*dkg.KeyPackage, parties)
+	for i, p := range ps {
+		pkg, err := p.Finalize(round2)
+		if err != nil {
+			t.Fatalf("party %d: %v", i+1, err)
+		}
+		pkgs[i] = pkg
+	}
+	return pkgs
+}
+
+// lagrange combines the signing shares of pkgs into the group secret.
+func lagrange(pkgs []*dkg.KeyPackage) *scalar.Scalar {
+	secret := scalar.NewScalar(0)
+	for _, a := range pkgs {
+		num, den := scalar.NewScalar(1), scalar.NewScalar(1)
+		for _, b := range pkgs {
+			if a.Identifier != b.Identifier {
+				num = num.Mul(scalar.NewScalar(b.Identifier))
+				den = den.Mul(scalar.NewScalar(b.Identifier - a.Identifier))
+			}
+		}
+		l, _ := num.Div(den)
+		secret = secret.Add(a.SigningShare.Mul(l))
+	}
+	return secret
+}
+
+func TestKeyGeneration(t *testing.T) {
+	pkgs := run(t, 3, 5)
+	group, err := pkgs[0].PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkg := range pkgs[1:] {
+		pub, err := pkg.PublicKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !pub.Equal(group) {
+			t.Fatalf("party %d derived a different group key", pkg.Identifier)
+		}
+		if pkg.VerifyingShares[pkg.Identifier] != pkgs[0].VerifyingShares[pkg.Identifier] {
+			t.Errorf("party %d verifying share differs between packages", pkg.Identifier)
+		}
+	}
+
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}} {
+		var signers []*dkg.KeyPackage
+		for _, i := range subset {
+			signers = append(signers, pkgs[i])
+		}
+		sk := keys.PrivateKey{Value: lagrange(signers)}
+		if pub := sk.ToPublicKey(); !pub.Equal(group) {
+			t.Errorf("shares %v do not combine to the group key", subset)
+		}
+	}
+	sk := keys.PrivateKey{Value: lagrange(pkgs[:2])}
+	if pub := sk.ToPublicKey(); pub.Equal(group) {
+		t.Error("two shares combined to the group key")
+	}
+}
+
+func TestRejectsBadProof(t *testing.T) {
+	a, _ := dkg.NewParticipant(1, 2, 2)
+	b, _ := dkg.NewParticipant(2, 2, 2)
+	if _, err := a.Round1(); err != nil {
+		t.Fatal(err)
+	}
+	m, err := b.Round1()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.ProofZ = m.ProofZ.Add(scalar.NewScalar(1))
+	if _, err := a.Round2([]dkg.Round1Message{*m}); err == nil {
+		t.Error("accepted an invalid proof of knowledge")
+	}
+}
+
+func TestRejectsBadShare(t *testing.T) {
+	ps := make([]*dkg.Participant, 3)
+	var round1 []dkg.Round1Message
+	for i := range ps {
+		ps[i], _ = dkg.NewParticipant(i+1, 2, 3)
+		m, err := ps[i].Round1()
+		if err != nil {
+			t.Fatal(err)
+		}
+		round1 = append(round1, *m)
+	}
+	var round2 []dkg.Round2Message
+	for _, p := range ps {
+		out, err := p.Round2(round1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		round2 = append(round2, out...)
+	}
+	for i := range round2 {
+		if round2[i].From == 2 && round2[i].To == 1 {
+			round2[i].Share = round2[i].Share.Add(scalar.NewScalar(1))
+		}
+	}
+	if _, err := ps[0].Finalize(round2); err == nil {
+		t.Error("accepted a share that does not match its commitments")
+	}
+	if _, err := ps[2].Finalize(round2); err != nil {
+		t.Errorf("party 3: %v", err)
+	}
+	if _, err := ps[1].Finalize(round2[:1]); err == nil {
+		t.Error("finalized with missing shares")
+	}
+}
+
+func TestNewParticipantRange(t *testing.T) {
+	for _, c := range [][3]int{{0, 2, 3}, {4, 2, 3}, {1, 0, 3}, {1, 4, 3}} {
+		if _, err := dkg.NewParticipant(c[0], c[1], c[2]); err == nil {
+			t.Errorf("NewParticipant%v succeeded", c)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Package encryption encrypts field elements to a Mina public key in the
+// format of o1js's Encryption module, so ciphertexts made by either side
+// decrypt on the other.
+//
+// The scheme is ECIES-like: an ephemeral Pallas key pair is drawn for each
+// message, the x coordinate of the Diffie-Hellman point with the
+// recipient's key seeds a Poseidon sponge, the sponge's squeezes are added
+// to the message elements as a key stream, and the ciphertext is absorbed
+// back and squeezed once more for an authentication tag.
+package encryption
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/constants"
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidon"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+// ErrAuthentication is returned by Decrypt when the authentication tag does
+// not match, because the ciphertext was modified or the key is wrong.
+var ErrAuthentication = errcode.New(errcode.AuthenticationFailed, "encryption: authentication failed")
+
+var kimchi = poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp)
+
+// CipherText is an encrypted message, the CipherText type of o1js.
+type CipherText struct {
+	// PublicKey is the ephemeral public key as a point.
+	PublicKey keys.Point
+	// CipherText holds one encrypted element per message element followed
+	// by the authentication tag.
+	CipherText []*big.Int
+}
+
+// Encrypt encrypts the base field elements of message to pub.
+func Encrypt(pub keys.PublicKey, message []*big.Int) (*CipherText, error) {
+	for i, m := range message {
+		if m == nil || m.Sign() < 0 || m.Cmp(field.P) >= 0 {
+			return nil, errcode.Errorf(errcode.NonCanonical, "encryption: message[%d] is not a field element", i)
+		}
+	}
+	recipient, err := pub.ToGroup()
+	if err != nil {
+		return nil, fmt.Errorf("encryption: %w", err)
+	}
+	r, err := scalar.RandomScalar()
+	if err != nil {
+		return nil, err
+	}
+	c := curve.Pallas()
+	ephemeral := c.ToAffine(c.ScaleConstantTime(c.One, r.BigInt()))
+	shared := c.ToAffine(c.ScaleConstantTime(&curve.GroupProjective{X: recipient.X, Y: recipient.Y, Z: big.NewInt(1)}, r.BigInt()))
+
+	sponge := kimchi.NewSponge()
+	sponge.Absorb(shared.X)
+	out := make([]*big.Int, 0, len(message)+1)
+	for i, m := range message {
+		out = append(out, field.Fp.Add(m, sponge.Squeeze()))
+		absorbChunk(sponge, out, i, len(message))
+	}
+	out = append(out, sponge.Squeeze())
+	return &CipherText{PublicKey: keys.Point{X: ephemeral.X, Y: ephemeral.Y}, CipherText: out}, nil
+}
+
+// Decrypt decrypts c with sk, returning ErrAuthentication if the tag does
+// not match.
+func Decrypt(sk keys.PrivateKey, c *CipherText) ([]*big.Int, error) {
+	if sk.Value == nil {
+		return nil, errcode.New(errcode.MissingValue, "encryption: private key is not set")
+	}
+	if c == nil || len(c.CipherText) == 0 {
+		return nil, errcode.New(errcode.InvalidLength, "encryption: ciphertext has no authentication tag")
+	}
+	if c.PublicKey.X == nil || c.PublicKey.Y == nil {
+		return nil, errcode.New(errcode.MissingValue, "encryption: ephemeral public key is nil")
+	}
+	for i, e := range c.CipherText {
+		if e == nil || e.Sign() < 0 || e.Cmp(field.P) >= 0 {
+			return nil, errcode.Errorf(errcode.NonCanonical, "encryption: cipherText[%d] is not a field element", i)
+		}
+	}
+	ephemeral := &curve.GroupProjective{X: c.PublicKey.X, Y: c.PublicKey.Y, Z: big.NewInt(1)}
+	if err := curve.ValidatePoint(ephemeral); err != nil {
+		return nil, fmt.Errorf("encryption: ephemeral public key: %w", err)
+	}
+	pallas := curve.Pallas()
+	shared := pallas.ToAffine(pallas.ScaleConstantTime(ephemeral, sk.Value.BigInt()))
+
+	body, tag := c.CipherText[:len(c.CipherText)-1], c.CipherText[len(c.CipherText)-1]
+	sponge := kimchi.NewSponge()
+	sponge.Absorb(shared.X)
+	message := make([]*big.Int, len(body))
+	for i, e := range body {
+		message[i] = field.Fp.Sub(e, sponge.Squeeze())
+		absorbChunk(sponge, body, i, len(body))
+	}
+	if sponge.Squeeze().Cmp(tag) != 0 {
+		return nil, ErrAuthentication
+	}
+	return message, nil
+}
+
+// absorbChunk absorbs the ciphertext for the authentication tag after
+// element i of n has been processed: two elements at a time to save
+// permutations, and the last one alone when n is odd.
+func absorbChunk(sponge *poseidon.Sponge, cipherText []*big.Int, i, n int) {
+	if i%2 == 1 {
+		sponge.Absorb(cipherText[i-1])
+	}
+	if i%2 == 1 || i == n-1 {
+		sponge.Absorb(cipherText[i])
+	}
+}
@@ -0,0 +1,86 @@
+package encryption_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/encryption"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+func testKey(t *testing.T) keys.PrivateKey {
+	t.Helper()
+	sk, err := keys.PrivateKeyFromBase58("EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sk
+}
+
+func message(n int) []*big.Int {
+	m := make([]*big.Int, n)
+	for i := range m {
+		m[i] = field.Fp.Random()
+	}
+	return m
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	sk := testKey(t)
+	for n := 0; n <= 5; n++ {
+		msg := message(n)
+		c, err := encryption.Encrypt(sk.ToPublicKey(), msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(c.CipherText) != n+1 {
+			t.Fatalf("%d elements: ciphertext has %d elements", n, len(c.CipherText))
+		}
+		got, err := encryption.Decrypt(sk, c)
+		if err != nil {
+			t.Fatalf("%d elements: %v", n, err)
+		}
+		for i := range msg {
+			if got[i].Cmp(msg[i]) != 0 {
+				t.Errorf("%d elements: element %d = %s, want %s", n, i, got[i], msg[i])
+			}
+		}
+	}
+}
+
+func TestDecryptRejects(t *testing.T) {
+	sk := testKey(t)
+	msg := message(3)
+	c, err := encryption.Encrypt(sk.ToPublicKey(), msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range c.CipherText {
+		tampered := &encryption.CipherText{PublicKey: c.PublicKey, CipherText: append([]*big.Int(nil), c.CipherText...)}
+		tampered.CipherText[i] = field.Fp.Add(tampered.CipherText[i], big.NewInt(1))
+		if _, err := encryption.Decrypt(sk, tampered); !errors.Is(err, encryption.ErrAuthentication) {
+			t.Errorf("element %d tampered: err = %v", i, err)
+		}
+	}
+	other := keys.PrivateKey{Value: sk.Value.Add(scalar.NewScalar(1))}
+	if _, err := encryption.Decrypt(other, c); !errors.Is(err, encryption.ErrAuthentication) {
+		t.Errorf("wrong key: err = %v", err)
+	}
+	offCurve := &encryption.CipherText{PublicKey: keys.Point{X: c.PublicKey.X, Y: big.NewInt(1)}, CipherText: c.CipherText}
+	if _, err := encryption.Decrypt(sk, offCurve); err == nil {
+		t.Error("Decrypt accepted an ephemeral key off the curve")
+	}
+	if _, err := encryption.Decrypt(sk, &encryption.CipherText{PublicKey: c.PublicKey}); err == nil {
+		t.Error("Decrypt accepted a ciphertext without a tag")
+	}
+}
+
+func TestEncryptRejectsNonField(t *testing.T) {
+	pub := testKey(t).ToPublicKey()
+	if _, err := encryption.Encrypt(pub, []*big.Int{field.P}); err == nil {
+		t.Error("Encrypt accepted an element equal to the modulus")
+	}
+}
@@ -0,0 +1,145 @@
+// Package errcode gives the errors of this module machine-readable codes.
+//
+// Every error a package of this module creates, sentinel or not, carries a
+// Code. Errors from other packages that it passes on are wrapped with %w, so
+// errors.Is and errors.As see through any context added on the way:
+//
+//	if _, err := keys.PublicKeyFromBase58(s); err != nil {
+//		switch errcode.CodeOf(err) {
+//		case errcode.InvalidChecksum:
+//			// a typo in the address
+//		case errcode.NotOnCurve, errcode.NonCanonical:
+//			// a forged or corrupted address
+//		}
+//	}
+//
+// Codes are stable strings, suitable for metric labels and API responses;
+// the error text is not.
+package errcode
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code classifies an error. The zero value is Unknown.
+type Code string
+
+// Codes shared by the packages of this module.
+const (
+	// Unknown is the code of errors that carry none, such as I/O errors
+	// passed on unchanged.
+	Unknown Code = ""
+	// InvalidArgument is a parameter outside what a function accepts that
+	// no more specific code describes.
+	InvalidArgument Code = "invalid_argument"
+	// InvalidEncoding is text or bytes that do not parse at all.
+	InvalidEncoding Code = "invalid_encoding"
+	// InvalidLength is an encoding of the wrong size.
+	InvalidLength Code = "invalid_length"
+	// NonCanonical is a value that parses but is out of range or not in
+	// the single accepted form.
+	NonCanonical Code = "non_canonical"
+	// OutOfRange is a count, index or amount outside its allowed range.
+	OutOfRange Code = "out_of_range"
+	// InvalidChecksum is a base58check string whose checksum does not match.
+	InvalidChecksum Code = "invalid_checksum"
+	// InvalidVersion is an encoding with an unexpected version or header.
+	InvalidVersion Code = "invalid_version"
+	// MissingValue is a key, signature, point or other value that is nil
+	// or unset where it is required.
+	MissingValue Code = "missing_value"
+	// InvalidPoint is a curve point that is not valid for the operation.
+	InvalidPoint Code = "invalid_point"
+	// NotOnCurve is a point or x coordinate that is not on the curve.
+	NotOnCurve Code = "not_on_curve"
+	// WrongSubgroup is a point outside the prime-order subgroup.
+	WrongSubgroup Code = "wrong_subgroup"
+	// PointAtInfinity is the point at infinity where it is not allowed.
+	PointAtInfinity Code = "point_at_infinity"
+	// NotInvertible is a field or scalar element without an inverse.
+	NotInvertible Code = "not_invertible"
+	// NonSquare is a field element without a square root.
+	NonSquare Code = "non_square"
+	// InvalidSignature is a signature or proof that does not verify.
+	InvalidSignature Code = "invalid_signature"
+	// KeyMismatch is a key that does not belong to the account or value it
+	// is used with.
+	KeyMismatch Code = "key_mismatch"
+	// Unsupported is a type, network or platform this module does not
+	// handle.
+	Unsupported Code = "unsupported"
+	// InvalidState is a call out of order, such as a protocol round run
+	// twice or a destroyed buffer used again.
+	InvalidState Code = "invalid_state"
+	// NotFound is a stored key or secret that does not exist.
+	NotFound Code = "not_found"
+	// AlreadyExists is a stored key that already exists.
+	AlreadyExists Code = "already_exists"
+	// AuthenticationFailed is ciphertext that fails authentication.
+	AuthenticationFailed Code = "authentication_failed"
+	// InsecurePermissions is a secret file or directory that users other
+	// than its owner can access.
+	InsecurePermissions Code = "insecure_permissions"
+	// Upstream is a failure reported by a remote service or a hardware
+	// device.
+	Upstream Code = "upstream"
+	// Internal is a failure that should not happen, such as a broken
+	// randomness source or corrupted memory.
+	Internal Code = "internal"
+)
+
+// PackageError is implemented by the errors of this module.
+type PackageError interface {
+	error
+	// Code returns the classification of the error.
+	Code() Code
+}
+
+// Error is the PackageError of this module: an error with a code.
+type Error struct {
+	code Code
+	err  error
+}
+
+var _ PackageError = (*Error)(nil)
+
+// New returns an error with code whose text is text, for use like
+// errors.New.
+func New(code Code, text string) error {
+	return &Error{code: code, err: errors.New(text)}
+}
+
+// Errorf returns an error with code formatted like fmt.Errorf, which
+// includes wrapping the operands of %w verbs.
+func Errorf(code Code, format string, args ...any) error {
+	return &Error{code: code, err: fmt.Errorf(format, args...)}
+}
+
+// Error returns the text of e.
+func (e *Error) Error() string { return e.err.Error() }
+
+// Code returns the code of e.
+func (e *Error) Code() Code { return e.code }
+
+// Unwrap returns the errors that e wraps, if any.
+func (e *Error) Unwrap() []error {
+	switch u := e.err.(type) {
+	case interface{ Unwrap() error }:
+		return []error{u.Unwrap()}
+	case interface{ Unwrap() []error }:
+		return u.Unwrap()
+	}
+	return nil
+}
+
+// CodeOf returns the code of the outermost PackageError in the tree of
+// err, so that a caller wrapping an error with Errorf can reclassify it,
+// or Unknown if there is none.
+func CodeOf(err error) Code {
+	var pe PackageError
+	if errors.As(err, &pe) {
+		return pe.Code()
+	}
+	return Unknown
+}
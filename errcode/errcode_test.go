@@ -0,0 +1,74 @@
+package errcode_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+const testPublicKey = "B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg"
+
+func TestError(t *testing.T) {
+	sentinel := errcode.New(errcode.NotFound, "test: not found")
+	if sentinel.Error() != "test: not found" {
+		t.Errorf("Error() = %q", sentinel.Error())
+	}
+	wrapped := fmt.Errorf("lookup: %w", sentinel)
+	if got := errcode.CodeOf(wrapped); got != errcode.NotFound {
+		t.Errorf("CodeOf(wrapped) = %q, want %q", got, errcode.NotFound)
+	}
+
+	reclassified := errcode.Errorf(errcode.Upstream, "backend: %w and %w", sentinel, io.EOF)
+	if got := errcode.CodeOf(reclassified); got != errcode.Upstream {
+		t.Errorf("CodeOf(reclassified) = %q, want the outer %q", got, errcode.Upstream)
+	}
+	if !errors.Is(reclassified, sentinel) || !errors.Is(reclassified, io.EOF) {
+		t.Error("Errorf does not wrap its %w operands")
+	}
+	if reclassified.Error() != "backend: test: not found and EOF" {
+		t.Errorf("Error() = %q", reclassified.Error())
+	}
+
+	if got := errcode.CodeOf(io.EOF); got != errcode.Unknown {
+		t.Errorf("CodeOf(io.EOF) = %q, want Unknown", got)
+	}
+	if got := errcode.CodeOf(nil); got != errcode.Unknown {
+		t.Errorf("CodeOf(nil) = %q, want Unknown", got)
+	}
+}
+
+func TestModuleErrors(t *testing.T) {
+	_, errChecksum := keys.PublicKeyFromBase58(testPublicKey[:len(testPublicKey)-1] + "h")
+	_, errShort := keys.PublicKeyFromBase58("B62")
+	_, errNonCanonical := field.Fp.FromString(field.P.String(), 10)
+	_, errMemo := transaction.EncodeMemo(strings.Repeat("x", transaction.MaxMemoLength+1))
+	_, errNilKey := keys.PrivateKey{}.Sign(poseidonbigint.HashInput{}, "testnet")
+	tests := []struct {
+		name string
+		err  error
+		want errcode.Code
+	}{
+		{"bad checksum", errChecksum, errcode.InvalidChecksum},
+		{"short address", errShort, errcode.InvalidEncoding},
+		{"field element p", errNonCanonical, errcode.NonCanonical},
+		{"long memo", errMemo, errcode.InvalidLength},
+		{"unset key", errNilKey, errcode.MissingValue},
+	}
+	for _, tt := range tests {
+		if tt.err == nil {
+			t.Errorf("%s: no error", tt.name)
+			continue
+		}
+		if got := errcode.CodeOf(tt.err); got != tt.want {
+			t.Errorf("%s: CodeOf(%v) = %q, want %q", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package field
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// barrett holds the constants for Barrett reduction of 512-bit values modulo
+// a four-limb modulus (HAC 14.42 with b = 2^64, k = 4). Unlike Montgomery
+// form it works on plain integers, so a single product of canonical operands
+// can be reduced without converting in and out.
+type barrett struct {
+	modulus *big.Int
+	p       [Limbs]uint64
+	mu      [Limbs + 1]uint64 // floor(2^512 / p)
+}
+
+var (
+	barrettFp = newBarrett(P)
+	barrettFq = newBarrett(Q)
+)
+
+// barrettFor returns the Barrett constants for p, reusing the precomputed
+// ones for the Pasta moduli, or nil if p is outside newBarrett's range.
+func barrettFor(p *big.Int) *barrett {
+	switch {
+	case p.Cmp(P) == 0:
+		return barrettFp
+	case p.Cmp(Q) == 0:
+		return barrettFq
+	}
+	return newBarrett(p)
+}
+
+// newBarrett derives the Barrett constants for a modulus of 193 to 256 bits,
+// the range in which mu fits in five limbs. It returns nil for any other
+// modulus.
+func newBarrett(p *big.Int) *barrett {
+	if p.BitLen() <= 64*(Limbs-1) || p.BitLen() > 64*Limbs {
+		return nil
+	}
+	b := &barrett{modulus: new(big.Int).Set(p), p: limbsOf(p)}
+	mu := new(big.Int).Lsh(big.NewInt(1), 2*64*Limbs)
+	mu.Quo(mu, p)
+	// mu has up to five 64-bit limbs; big.Word may be 32 bits, so split it
+	// through limbsOf rather than reading mu.Bits().
+	mask := new(big.Int).Lsh(big.NewInt(1), 64*Limbs)
+	mask.Sub(mask, big.NewInt(1))
+	low := limbsOf(new(big.Int).And(mu, mask))
+	copy(b.mu[:Limbs], low[:])
+	b.mu[Limbs] = new(big.Int).Rsh(mu, 64*Limbs).Uint64()
+	return b
+}
+
+// mulWide sets z to the full 512-bit product x*y.
+func mulWide(z *[2 * Limbs]uint64, x, y *[Limbs]uint64) {
+	*z = [2 * Limbs]uint64{}
+	for i := 0; i < Limbs; i++ {
+		var c uint64
+		for j := 0; j < Limbs; j++ {
+			c, z[i+j] = madd(x[j], y[i], z[i+j], c)
+		}
+		z[i+Limbs] = c
+	}
+}
+
+// reduce sets z = x mod p for any x < 2^512.
+func (b *barrett) reduce(z *[Limbs]uint64, x *[2 * Limbs]uint64) {
+	// q3 = floor(floor(x / b^3) * mu / b^5), an estimate of floor(x / p)
+	// that is at most two too small.
+	var q2 [2 * (Limbs + 1)]uint64
+	for i := 0; i <= Limbs; i++ {
+		var c uint64
+		for j := 0; j <= Limbs; j++ {
+			c, q2[i+j] = madd(x[Limbs-1+j], b.mu[i], q2[i+j], c)
+		}
+		q2[i+Limbs+1] = c
+	}
+	q3 := q2[Limbs+1:]
+
+	// r = (x - q3*p) mod b^5, computed from the low five limbs only.
+	var r2 [Limbs + 1]uint64
+	for i := 0; i <= Limbs; i++ {
+		var c uint64
+		for j := 0; j < Limbs && i+j <= Limbs; j++ {
+			c, r2[i+j] = madd(b.p[j], q3[i], r2[i+j], c)
+		}
+		if i+Limbs <= Limbs {
+			r2[i+Limbs] = c
+		}
+	}
+	var r [Limbs + 1]uint64
+	var borrow uint64
+	for i := range r {
+		r[i], borrow = bits.Sub64(x[i], r2[i], borrow)
+	}
+
+	for i := 0; i < 2; i++ {
+		var d [Limbs + 1]uint64
+		borrow = 0
+		for j := 0; j < Limbs; j++ {
+			d[j], borrow = bits.Sub64(r[j], b.p[j], borrow)
+		}
+		d[Limbs], borrow = bits.Sub64(r[Limbs], 0, borrow)
+		if borrow == 0 {
+			r = d
+		}
+	}
+	copy(z[:], r[:Limbs])
+}
+
+// mul sets z = x*y mod p for x, y < p.
+func (b *barrett) mul(z, x, y *[Limbs]uint64) {
+	var w [2 * Limbs]uint64
+	mulWide(&w, x, y)
+	b.reduce(z, &w)
+}
+
+// limbs returns the limbs of x mod p.
+func (b *barrett) limbs(x *big.Int) [Limbs]uint64 {
+	if x.Sign() < 0 || x.Cmp(b.modulus) >= 0 {
+		x = Mod(x, b.modulus)
+	}
+	return limbsOf(x)
+}
+
+func (b *barrett) mulBig(x, y *big.Int) *big.Int {
+	return b.mulBigInto(new(big.Int), x, y)
+}
+
+func (b *barrett) squareBig(x *big.Int) *big.Int {
+	return b.squareBigInto(new(big.Int), x)
+}
+
+// mulBigInto sets dst = x*y mod p and returns dst.
+func (b *barrett) mulBigInto(dst, x, y *big.Int) *big.Int {
+	xl, yl := b.limbs(x), b.limbs(y)
+	b.mul(&xl, &xl, &yl)
+	return setLimbs(dst, &xl)
+}
+
+// squareBigInto sets dst = x² mod p and returns dst.
+func (b *barrett) squareBigInto(dst, x *big.Int) *big.Int {
+	xl := b.limbs(x)
+	b.mul(&xl, &xl, &xl)
+	return setLimbs(dst, &xl)
+}
@@ -0,0 +1,85 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestBarrettMatchesBigInt(t *testing.T) {
+	r := rand.New(rand.NewSource(8))
+	for _, b := range []*barrett{barrettFp, barrettFq} {
+		p := b.modulus
+		pMinusOne := new(big.Int).Sub(p, big.NewInt(1))
+		inputs := []*big.Int{big.NewInt(0), big.NewInt(1), pMinusOne, new(big.Int).Rsh(p, 1)}
+		for i := 0; i < 500; i++ {
+			inputs = append(inputs, randomBelow(r, p))
+		}
+		for i, x := range inputs {
+			y := inputs[(i*7+1)%len(inputs)]
+			for _, y := range []*big.Int{y, pMinusOne} {
+				want := Mod(new(big.Int).Mul(x, y), p)
+				if got := b.mulBig(x, y); got.Cmp(want) != 0 {
+					t.Fatalf("mul(%s, %s) = %s, want %s", x, y, got, want)
+				}
+			}
+		}
+
+		// reduce accepts any 512-bit value, not only products below p².
+		var max [2 * Limbs]uint64
+		for i := range max {
+			max[i] = ^uint64(0)
+		}
+		var z [Limbs]uint64
+		b.reduce(&z, &max)
+		all := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 512), big.NewInt(1))
+		if got, want := bigOf(&z), new(big.Int).Mod(all, p); got.Cmp(want) != 0 {
+			t.Errorf("reduce(2^512-1) = %s, want %s", got, want)
+		}
+	}
+	if newBarrett(big.NewInt(1000003)) != nil {
+		t.Error("newBarrett accepted a modulus below 2^192")
+	}
+}
+
+// TestBarrettConstants checks the limbs of p and mu against big.Int
+// arithmetic. newBarrett must not depend on the size of big.Word, so the
+// test is meant to pass under GOARCH=386 as well as on 64-bit targets.
+func TestBarrettConstants(t *testing.T) {
+	for _, b := range []*barrett{barrettFp, barrettFq} {
+		if got := bigOf(&b.p); got.Cmp(b.modulus) != 0 {
+			t.Errorf("p limbs = %s, want %s", got, b.modulus)
+		}
+		mu := new(big.Int)
+		for i := len(b.mu) - 1; i >= 0; i-- {
+			mu.Lsh(mu, 64)
+			mu.Or(mu, new(big.Int).SetUint64(b.mu[i]))
+		}
+		want := new(big.Int).Lsh(big.NewInt(1), 2*64*Limbs)
+		want.Quo(want, b.modulus)
+		if mu.Cmp(want) != 0 {
+			t.Errorf("mu for %s = %s, want %s", b.modulus, mu, want)
+		}
+	}
+}
+
+func BenchmarkReduction(b *testing.B) {
+	r := rand.New(rand.NewSource(9))
+	x, y := randomBelow(r, P), randomBelow(r, P)
+	dst := new(big.Int)
+	b.Run("Montgomery", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			montFp.mulBigInto(dst, x, y)
+		}
+	})
+	b.Run("Barrett", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			barrettFp.mulBigInto(dst, x, y)
+		}
+	})
+	b.Run("BigInt", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			reduceInto(dst.Mul(x, y), P)
+		}
+	})
+}
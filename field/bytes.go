@@ -0,0 +1,58 @@
+package field
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Byte order of field elements.
+//
+// Mina and o1js serialize field elements little-endian: FromBytes, the
+// Poseidon prefix and bit packing in poseidonbigint, and nonce derivation
+// (the blake2b digest read by scalar.ScalarFromBytesLE) all use that order.
+// Base58 payloads follow Mina and store values little-endian too: the x
+// coordinate of an address, a private key, and both halves of a signature.
+// The MarshalBytes wire formats of the keys and signature packages are this
+// module's own and store values big-endian, matching big.Int.Bytes.
+//
+// The helpers below make the order explicit. They always use exactly
+// SizeInBytes bytes (32 for Fp and Fq) and the From functions reject inputs of
+// any other length or encodings of values that are not below the modulus.
+
+// ToBytesLE returns x mod p as SizeInBytes little-endian bytes.
+func (f *FiniteField) ToBytesLE(x *big.Int) []byte {
+	out := f.ToBytesBE(x)
+	reverseBytes(out)
+	return out
+}
+
+// ToBytesBE returns x mod p as SizeInBytes big-endian bytes.
+func (f *FiniteField) ToBytesBE(x *big.Int) []byte {
+	out := make([]byte, f.SizeInBytes())
+	return f.Mod(x).FillBytes(out)
+}
+
+// FromBytesLE decodes a canonical little-endian field element.
+func (f *FiniteField) FromBytesLE(b []byte) (*big.Int, error) {
+	be := append([]byte(nil), b...)
+	reverseBytes(be)
+	return f.FromBytesBE(be)
+}
+
+// FromBytesBE decodes a canonical big-endian field element.
+func (f *FiniteField) FromBytesBE(b []byte) (*big.Int, error) {
+	if len(b) != f.SizeInBytes() {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidLength, f.SizeInBytes(), len(b))
+	}
+	x := new(big.Int).SetBytes(b)
+	if x.Cmp(f.Modulus) >= 0 {
+		return nil, fmt.Errorf("%w: encoded value is not below the modulus", ErrNonCanonical)
+	}
+	return x, nil
+}
+
+func reverseBytes(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
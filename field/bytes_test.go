@@ -0,0 +1,40 @@
+package field
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+func TestByteOrderHelpers(t *testing.T) {
+	x := new(big.Int).SetBytes([]byte{0x01, 0x02, 0x03})
+	be := Fp.ToBytesBE(x)
+	le := Fp.ToBytesLE(x)
+	if len(be) != 32 || len(le) != 32 {
+		t.Fatalf("encodings have lengths %d and %d, want 32", len(be), len(le))
+	}
+	if !bytes.Equal(be[29:], []byte{1, 2, 3}) || !bytes.Equal(le[:3], []byte{3, 2, 1}) {
+		t.Errorf("unexpected encodings BE=%x LE=%x", be, le)
+	}
+	if got := Fp.FromBytes(le); got.Cmp(x) != 0 {
+		t.Errorf("FromBytes(LE) = %s, want %s", got, x)
+	}
+	for name, decode := range map[string]func([]byte) (*big.Int, error){
+		"LE": Fp.FromBytesLE, "BE": Fp.FromBytesBE,
+	} {
+		enc := le
+		if name == "BE" {
+			enc = be
+		}
+		got, err := decode(enc)
+		if err != nil || got.Cmp(x) != 0 {
+			t.Errorf("FromBytes%s round trip = %v, %v", name, got, err)
+		}
+		if _, err := decode(enc[:31]); err == nil {
+			t.Errorf("FromBytes%s accepted 31 bytes", name)
+		}
+	}
+	if _, err := Fp.FromBytesBE(P.FillBytes(make([]byte, 32))); err == nil {
+		t.Error("FromBytesBE accepted the modulus")
+	}
+}
@@ -0,0 +1,72 @@
+package field
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// ErrNonCanonical is wrapped by the strict decoders when a value is negative
+// or not below the modulus. Accepting such values would give one element
+// several encodings, which for signatures means malleability.
+var ErrNonCanonical = errcode.New(errcode.NonCanonical, "field: value is not canonical")
+
+// ErrInvalidLength is wrapped by the fixed-size decoders of this and the
+// packages built on it when the input has the wrong number of bytes.
+var ErrInvalidLength = errcode.New(errcode.InvalidLength, "field: invalid length")
+
+// IsCanonical reports whether x is the canonical representative of its
+// residue class, i.e. 0 <= x < p.
+func (f *FiniteField) IsCanonical(x *big.Int) bool {
+	return x.Sign() >= 0 && x.Cmp(f.Modulus) < 0
+}
+
+// Reduce returns the canonical representative of x mod p. x is not modified.
+func (f *FiniteField) Reduce(x *big.Int) *big.Int {
+	if f.IsCanonical(x) {
+		return new(big.Int).Set(x)
+	}
+	return Mod(x, f.Modulus)
+}
+
+// FromString parses s in the given base, as big.Int.SetString does, and
+// rejects values that are not canonical instead of reducing them. In strict
+// mode s must also be in minimal form; see ParseInt.
+func (f *FiniteField) FromString(s string, base int, opts ...DecodeOption) (*big.Int, error) {
+	x, err := ParseInt(s, base, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if !f.IsCanonical(x) {
+		return nil, fmt.Errorf("%w: %s", ErrNonCanonical, s)
+	}
+	return x, nil
+}
+
+// SetString sets z to the value of s, a decimal or 0x-prefixed hex integer.
+// Unlike SetBigInt it rejects values outside [0, p); z is unchanged on error.
+// In strict mode s must also be in minimal form; see ParseInt.
+func (z *Element[F]) SetString(s string, opts ...DecodeOption) (*Element[F], error) {
+	x, err := ParseInt(s, 0, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return z.setCanonical(x)
+}
+
+// SetBytesCanonical sets z to the big-endian encoding b, which must be
+// exactly 32 bytes and encode a value below p; z is unchanged on error.
+func (z *Element[F]) SetBytesCanonical(b []byte) (*Element[F], error) {
+	if len(b) != 8*Limbs {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidLength, 8*Limbs, len(b))
+	}
+	return z.setCanonical(new(big.Int).SetBytes(b))
+}
+
+func (z *Element[F]) setCanonical(x *big.Int) (*Element[F], error) {
+	if x.Sign() < 0 || x.Cmp(z.mont().modulus) >= 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNonCanonical, x)
+	}
+	return z.SetBigInt(x), nil
+}
@@ -0,0 +1,75 @@
+package field
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestCanonicalHelpers(t *testing.T) {
+	pMinusOne := new(big.Int).Sub(P, big.NewInt(1))
+	for _, tc := range []struct {
+		x         *big.Int
+		canonical bool
+		reduced   *big.Int
+	}{
+		{big.NewInt(0), true, big.NewInt(0)},
+		{pMinusOne, true, pMinusOne},
+		{P, false, big.NewInt(0)},
+		{new(big.Int).Add(P, big.NewInt(5)), false, big.NewInt(5)},
+		{big.NewInt(-1), false, pMinusOne},
+	} {
+		if got := Fp.IsCanonical(tc.x); got != tc.canonical {
+			t.Errorf("IsCanonical(%s) = %v, want %v", tc.x, got, tc.canonical)
+		}
+		before := new(big.Int).Set(tc.x)
+		if got := Fp.Reduce(tc.x); got.Cmp(tc.reduced) != 0 || got == tc.x {
+			t.Errorf("Reduce(%s) = %s, want a new %s", tc.x, got, tc.reduced)
+		}
+		if tc.x.Cmp(before) != 0 {
+			t.Errorf("Reduce modified its argument")
+		}
+	}
+}
+
+func TestStrictParsing(t *testing.T) {
+	if x, err := Fp.FromString("42", 10); err != nil || x.Int64() != 42 {
+		t.Errorf("FromString(42) = %v, %v", x, err)
+	}
+	for _, s := range []string{P.String(), "-1"} {
+		if _, err := Fp.FromString(s, 10); !errors.Is(err, ErrNonCanonical) {
+			t.Errorf("FromString(%s) error = %v, want ErrNonCanonical", s, err)
+		}
+	}
+	if _, err := Fp.FromString("12z", 10); err == nil || errors.Is(err, ErrNonCanonical) {
+		t.Errorf("FromString(12z) error = %v, want a syntax error", err)
+	}
+
+	var z FpElement
+	if _, err := z.SetString("0x2a"); err != nil || z.BigInt().Int64() != 42 {
+		t.Errorf("SetString(0x2a) = %s, %v", &z, err)
+	}
+	for _, s := range []string{P.String(), "-3", Q.String()} {
+		if _, err := z.SetString(s); !errors.Is(err, ErrNonCanonical) {
+			t.Errorf("SetString(%s) error = %v, want ErrNonCanonical", s, err)
+		}
+	}
+	if z.BigInt().Int64() != 42 {
+		t.Error("SetString modified the element on error")
+	}
+	// Q > P, so Q-1 is canonical in Fq but not in Fp.
+	qMinusOne := new(big.Int).Sub(Q, big.NewInt(1))
+	var fq FqElement
+	if _, err := fq.SetBytesCanonical(qMinusOne.FillBytes(make([]byte, 32))); err != nil {
+		t.Errorf("SetBytesCanonical(q-1) in Fq: %v", err)
+	}
+	if _, err := z.SetBytesCanonical(qMinusOne.FillBytes(make([]byte, 32))); !errors.Is(err, ErrNonCanonical) {
+		t.Errorf("SetBytesCanonical(q-1) in Fp error = %v, want ErrNonCanonical", err)
+	}
+	if _, err := z.SetBytesCanonical(make([]byte, 31)); err == nil {
+		t.Error("SetBytesCanonical accepted 31 bytes")
+	}
+	if _, err := Fp.FromBytesBE(P.FillBytes(make([]byte, 32))); !errors.Is(err, ErrNonCanonical) {
+		t.Errorf("FromBytesBE(p) error = %v, want ErrNonCanonical", err)
+	}
+}
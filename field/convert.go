@@ -0,0 +1,43 @@
+package field
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// ErrFieldOverflow is returned when a value does not fit in the field it is
+// converted to.
+var ErrFieldOverflow = errcode.New(errcode.OutOfRange, "field: value does not fit in the destination field")
+
+// Moving values between Fp and Fq.
+//
+// The Pasta moduli satisfy p < q, and they differ only in the low half, so
+// every canonical Fp value is a canonical Fq value but Fq values in [p, q)
+// have no Fp counterpart. The Schnorr scheme crosses fields in two places:
+// the Poseidon challenge e is computed in Fp and used as a scalar, which
+// ConvertFpToFq always permits; and deriveNonce feeds the private key, an Fq
+// value, into an Fp hash. The latter deliberately wraps with FromBigInt to
+// match o1js, which reinterprets the key's bits as a base field element.
+// Any other crossing should use the checked helpers below.
+
+// ConvertFpToFq returns the Fq value equal to the canonical Fp value x.
+func ConvertFpToFq(x *big.Int) (*big.Int, error) {
+	if !Fp.IsCanonical(x) {
+		return nil, fmt.Errorf("ConvertFpToFq: %w", ErrNonCanonical)
+	}
+	return new(big.Int).Set(x), nil
+}
+
+// ConvertFqToFp returns the Fp value equal to the canonical Fq value x, or
+// ErrFieldOverflow if x is at least p.
+func ConvertFqToFp(x *big.Int) (*big.Int, error) {
+	if !Fq.IsCanonical(x) {
+		return nil, fmt.Errorf("ConvertFqToFp: %w", ErrNonCanonical)
+	}
+	if x.Cmp(P) >= 0 {
+		return nil, fmt.Errorf("ConvertFqToFp: %w", ErrFieldOverflow)
+	}
+	return new(big.Int).Set(x), nil
+}
@@ -0,0 +1,33 @@
+package field
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestConvertBetweenFpAndFq(t *testing.T) {
+	pMinusOne := new(big.Int).Sub(P, big.NewInt(1))
+	if x, err := ConvertFpToFq(pMinusOne); err != nil || x.Cmp(pMinusOne) != 0 {
+		t.Errorf("ConvertFpToFq(p-1) = %v, %v", x, err)
+	}
+	if _, err := ConvertFpToFq(P); !errors.Is(err, ErrNonCanonical) {
+		t.Errorf("ConvertFpToFq(p) error = %v, want ErrNonCanonical", err)
+	}
+
+	if x, err := ConvertFqToFp(pMinusOne); err != nil || x.Cmp(pMinusOne) != 0 {
+		t.Errorf("ConvertFqToFp(p-1) = %v, %v", x, err)
+	}
+	// p itself is a valid scalar but has no base field counterpart.
+	if _, err := ConvertFqToFp(P); !errors.Is(err, ErrFieldOverflow) {
+		t.Errorf("ConvertFqToFp(p) error = %v, want ErrFieldOverflow", err)
+	}
+	if _, err := ConvertFqToFp(Q); !errors.Is(err, ErrNonCanonical) {
+		t.Errorf("ConvertFqToFp(q) error = %v, want ErrNonCanonical", err)
+	}
+
+	// FromBigInt is the deliberate wrapping conversion.
+	if got := FromBigInt(new(big.Int).Add(P, big.NewInt(3))); got.Int64() != 3 {
+		t.Errorf("FromBigInt(p+3) = %s, want 3", got)
+	}
+}
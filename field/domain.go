@@ -0,0 +1,75 @@
+package field
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// GetRootOfUnity returns a primitive 2^log2n-th root of unity, derived from
+// the field's stored two-adic root. log2n may not exceed the two-adicity M.
+func (f *FiniteField) GetRootOfUnity(log2n uint) (*big.Int, error) {
+	if f.TwoadicRoot == nil || f.M == nil {
+		return nil, errcode.New(errcode.Unsupported, "field: two-adic root is not known")
+	}
+	m := f.M.Uint64()
+	if uint64(log2n) > m {
+		return nil, errcode.Errorf(errcode.Unsupported, "field: no 2^%d-th root of unity, two-adicity is %d", log2n, m)
+	}
+	exp := new(big.Int).Lsh(big.NewInt(1), uint(m)-log2n)
+	return f.Power(f.TwoadicRoot, exp), nil
+}
+
+// Domain is the multiplicative subgroup {1, ω, ω², …, ω^(n-1)} of a field
+// for a power of two n, the evaluation domain of radix-2 FFTs. A Domain
+// caches its twiddle factors and must not be copied after first use.
+type Domain struct {
+	Field *FiniteField
+	// Size is the number of elements n, and LogSize is log2(n).
+	Size    uint64
+	LogSize uint
+	// Generator is the primitive n-th root of unity ω, GeneratorInv is ω^-1
+	// and SizeInv is n^-1.
+	Generator    *big.Int
+	GeneratorInv *big.Int
+	SizeInv      *big.Int
+
+	tw twiddles
+}
+
+// NewDomain returns the evaluation domain of the given size, which must be a
+// power of two no larger than 2^M.
+func (f *FiniteField) NewDomain(size uint64) (*Domain, error) {
+	if size == 0 || size&(size-1) != 0 {
+		return nil, errcode.Errorf(errcode.InvalidArgument, "field: domain size %d is not a power of two", size)
+	}
+	logSize := uint(new(big.Int).SetUint64(size).BitLen() - 1)
+	omega, err := f.GetRootOfUnity(logSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Domain{
+		Field:        f,
+		Size:         size,
+		LogSize:      logSize,
+		Generator:    omega,
+		GeneratorInv: f.Inverse(omega),
+		SizeInv:      f.Inverse(new(big.Int).SetUint64(size)),
+	}, nil
+}
+
+// Element returns ω^i.
+func (d *Domain) Element(i uint64) *big.Int {
+	return d.Field.Power(d.Generator, new(big.Int).SetUint64(i%d.Size))
+}
+
+// Elements returns all elements of the domain in the order ω^0, ω^1, ….
+func (d *Domain) Elements() []*big.Int {
+	out := make([]*big.Int, d.Size)
+	x := big.NewInt(1)
+	for i := range out {
+		out[i] = x
+		x = d.Field.Mul(x, d.Generator)
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package field
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRootsOfUnity(t *testing.T) {
+	for _, f := range []*FiniteField{Fp, Fq} {
+		for _, k := range []uint{0, 1, 5, 32} {
+			w, err := f.GetRootOfUnity(k)
+			if err != nil {
+				t.Fatalf("GetRootOfUnity(%d) failed: %v", k, err)
+			}
+			n := new(big.Int).Lsh(big.NewInt(1), k)
+			if f.Power(w, n).Cmp(big.NewInt(1)) != 0 {
+				t.Errorf("root for 2^%d does not have order dividing 2^%d", k, k)
+			}
+			if k > 0 && f.Power(w, new(big.Int).Rsh(n, 1)).Cmp(big.NewInt(1)) == 0 {
+				t.Errorf("root for 2^%d is not primitive", k)
+			}
+		}
+		if _, err := f.GetRootOfUnity(33); err == nil {
+			t.Error("GetRootOfUnity(33) succeeded")
+		}
+	}
+}
+
+func TestDomain(t *testing.T) {
+	d, err := Fp.NewDomain(8)
+	if err != nil {
+		t.Fatalf("NewDomain(8) failed: %v", err)
+	}
+	if d.LogSize != 3 || Fp.Mul(d.Generator, d.GeneratorInv).Cmp(big.NewInt(1)) != 0 ||
+		Fp.Mul(d.SizeInv, big.NewInt(8)).Cmp(big.NewInt(1)) != 0 {
+		t.Error("domain constants are inconsistent")
+	}
+	seen := map[string]bool{}
+	for i, x := range d.Elements() {
+		if x.Cmp(d.Element(uint64(i))) != 0 {
+			t.Errorf("Elements()[%d] != Element(%d)", i, i)
+		}
+		seen[x.String()] = true
+	}
+	if len(seen) != 8 {
+		t.Errorf("domain has %d distinct elements, want 8", len(seen))
+	}
+	for _, size := range []uint64{0, 3, 12} {
+		if _, err := Fp.NewDomain(size); err == nil {
+			t.Errorf("NewDomain(%d) succeeded", size)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package field
+
+import (
+	"math/big"
+)
+
+// FieldParams identifies the field of an Element. It is implemented by FpParams
+// and FqParams only, so elements of different fields cannot be mixed.
+type FieldParams interface {
+	montgomery() *montgomery
+}
+
+// FpParams selects the Pallas base field for Element.
+type FpParams struct{}
+
+// FqParams selects the Pallas scalar field for Element.
+type FqParams struct{}
+
+func (FpParams) montgomery() *montgomery { return montFp }
+func (FqParams) montgomery() *montgomery { return montFq }
+
+// Element is a field element stored as four 64-bit limbs in Montgomery form.
+// The zero value is 0. Methods follow the math/big convention: the receiver
+// holds the result, may alias the operands, and is returned.
+type Element[F FieldParams] [Limbs]uint64
+
+type (
+	// FpElement is an element of Fp, the Pallas base field.
+	FpElement = Element[FpParams]
+	// FqElement is an element of Fq, the Pallas scalar field.
+	FqElement = Element[FqParams]
+)
+
+func (z *Element[F]) mont() *montgomery {
+	var f F
+	return f.montgomery()
+}
+
+func (z *Element[F]) limbs() *[Limbs]uint64 {
+	return (*[Limbs]uint64)(z)
+}
+
+// SetZero sets z to 0.
+func (z *Element[F]) SetZero() *Element[F] {
+	*z = Element[F]{}
+	return z
+}
+
+// SetOne sets z to 1.
+func (z *Element[F]) SetOne() *Element[F] {
+	*z = Element[F](z.mont().one)
+	return z
+}
+
+// SetUint64 sets z to v.
+func (z *Element[F]) SetUint64(v uint64) *Element[F] {
+	return z.SetBigInt(new(big.Int).SetUint64(v))
+}
+
+// SetBigInt sets z to x mod p.
+func (z *Element[F]) SetBigInt(x *big.Int) *Element[F] {
+	z.mont().toMont(z.limbs(), x)
+	return z
+}
+
+// Set sets z to x.
+func (z *Element[F]) Set(x *Element[F]) *Element[F] {
+	*z = *x
+	return z
+}
+
+// BigInt returns the canonical integer in [0, p) represented by z.
+func (z *Element[F]) BigInt() *big.Int {
+	return z.mont().fromMont(z.limbs())
+}
+
+// String returns the decimal representation of z.
+func (z *Element[F]) String() string {
+	return z.BigInt().String()
+}
+
+// Add sets z to x + y.
+func (z *Element[F]) Add(x, y *Element[F]) *Element[F] {
+	z.mont().add(z.limbs(), x.limbs(), y.limbs())
+	return z
+}
+
+// Double sets z to 2x.
+func (z *Element[F]) Double(x *Element[F]) *Element[F] {
+	return z.Add(x, x)
+}
+
+// Sub sets z to x - y.
+func (z *Element[F]) Sub(x, y *Element[F]) *Element[F] {
+	z.mont().sub(z.limbs(), x.limbs(), y.limbs())
+	return z
+}
+
+// Neg sets z to -x.
+func (z *Element[F]) Neg(x *Element[F]) *Element[F] {
+	z.mont().neg(z.limbs(), x.limbs())
+	return z
+}
+
+// Mul sets z to x·y.
+func (z *Element[F]) Mul(x, y *Element[F]) *Element[F] {
+	z.mont().mul(z.limbs(), x.limbs(), y.limbs())
+	return z
+}
+
+// Square sets z to x².
+func (z *Element[F]) Square(x *Element[F]) *Element[F] {
+	z.mont().mul(z.limbs(), x.limbs(), x.limbs())
+	return z
+}
+
+// Exp sets z to x^e for a non-negative exponent e.
+func (z *Element[F]) Exp(x *Element[F], e *big.Int) *Element[F] {
+	z.mont().exp(z.limbs(), x.limbs(), e)
+	return z
+}
+
+// Inverse sets z to 1/x. The inverse of 0 is 0.
+func (z *Element[F]) Inverse(x *Element[F]) *Element[F] {
+	z.mont().inverse(z.limbs(), x.limbs())
+	return z
+}
+
+// Equal reports whether z and x represent the same element.
+func (z *Element[F]) Equal(x *Element[F]) bool {
+	return *z == *x
+}
+
+// IsZero reports whether z is 0.
+func (z *Element[F]) IsZero() bool {
+	return isZeroLimbs(z.limbs())
+}
+
+// IsOne reports whether z is 1.
+func (z *Element[F]) IsOne() bool {
+	return *z == Element[F](z.mont().one)
+}
@@ -0,0 +1,148 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func randomBelow(r *rand.Rand, p *big.Int) *big.Int {
+	return new(big.Int).Rand(r, p)
+}
+
+func TestElementMatchesBigInt(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	pMinusOne := new(big.Int).Sub(P, big.NewInt(1))
+	inputs := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), pMinusOne}
+	for i := 0; i < 50; i++ {
+		inputs = append(inputs, randomBelow(r, P))
+	}
+	for _, a := range inputs {
+		for _, b := range []*big.Int{big.NewInt(0), pMinusOne, randomBelow(r, P)} {
+			var x, y, z FpElement
+			x.SetBigInt(a)
+			y.SetBigInt(b)
+			if x.BigInt().Cmp(a) != 0 {
+				t.Fatalf("round trip of %s gave %s", a, x.BigInt())
+			}
+			check := func(op string, got *FpElement, want *big.Int) {
+				t.Helper()
+				if got.BigInt().Cmp(Mod(want, P)) != 0 {
+					t.Errorf("%s(%s, %s) = %s, want %s", op, a, b, got, Mod(want, P))
+				}
+			}
+			check("Add", z.Add(&x, &y), new(big.Int).Add(a, b))
+			check("Sub", z.Sub(&x, &y), new(big.Int).Sub(a, b))
+			check("Mul", z.Mul(&x, &y), new(big.Int).Mul(a, b))
+			check("Square", z.Square(&x), new(big.Int).Mul(a, a))
+			check("Neg", z.Neg(&x), new(big.Int).Neg(a))
+			check("Double", z.Double(&x), new(big.Int).Lsh(a, 1))
+			check("Exp", z.Exp(&x, b), new(big.Int).Exp(a, b, P))
+		}
+	}
+
+	var x, inv, one FpElement
+	x.SetBigInt(randomBelow(r, P))
+	inv.Inverse(&x)
+	if !one.Mul(&x, &inv).IsOne() {
+		t.Error("x * x^-1 != 1")
+	}
+	if !inv.Inverse(new(FpElement)).IsZero() {
+		t.Error("inverse of zero is not zero")
+	}
+}
+
+func TestElementFieldsAreDistinct(t *testing.T) {
+	a := big.NewInt(123456789)
+	var x FpElement
+	var y FqElement
+	x.SetBigInt(a)
+	y.SetBigInt(a)
+	// 2^255 wraps differently in the two fields.
+	big255 := new(big.Int).Lsh(big.NewInt(1), 255)
+	x.Mul(&x, new(FpElement).SetBigInt(big255))
+	y.Mul(&y, new(FqElement).SetBigInt(big255))
+	wantP := Mod(new(big.Int).Mul(a, big255), P)
+	wantQ := Mod(new(big.Int).Mul(a, big255), Q)
+	if x.BigInt().Cmp(wantP) != 0 || y.BigInt().Cmp(wantQ) != 0 {
+		t.Error("Fp and Fq elements reduce by the wrong modulus")
+	}
+}
+
+func TestFiniteFieldUsesMontgomery(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for _, f := range []*FiniteField{Fp, Fq, ForModulus(big.NewInt(1000003))} {
+		for i := 0; i < 20; i++ {
+			a, b := randomBelow(r, f.Modulus), randomBelow(r, f.Modulus)
+			if f.Mul(a, b).Cmp(Mod(new(big.Int).Mul(a, b), f.Modulus)) != 0 {
+				t.Fatalf("Mul mismatch modulo %s", f.Modulus)
+			}
+			if f.Square(new(big.Int).Neg(a)).Cmp(Mod(new(big.Int).Mul(a, a), f.Modulus)) != 0 {
+				t.Fatalf("Square mismatch modulo %s", f.Modulus)
+			}
+			if f.Power(a, b).Cmp(new(big.Int).Exp(a, b, f.Modulus)) != 0 {
+				t.Fatalf("Power mismatch modulo %s", f.Modulus)
+			}
+		}
+	}
+}
+
+func BenchmarkMul(b *testing.B) {
+	r := rand.New(rand.NewSource(3))
+	x, y := randomBelow(r, P), randomBelow(r, P)
+	b.Run("BigInt", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Mod(new(big.Int).Mul(x, y), P)
+		}
+	})
+	b.Run("FiniteField", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Fp.Mul(x, y)
+		}
+	})
+	b.Run("Element", func(b *testing.B) {
+		var ex, ey FpElement
+		ex.SetBigInt(x)
+		ey.SetBigInt(y)
+		for i := 0; i < b.N; i++ {
+			ex.Mul(&ex, &ey)
+		}
+	})
+}
+
+func BenchmarkPower(b *testing.B) {
+	x := big.NewInt(987654321)
+	seven := big.NewInt(7)
+	b.Run("BigInt", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Power(x, seven, P)
+		}
+	})
+	b.Run("FiniteField", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Fp.Power(x, seven)
+		}
+	})
+}
+
+func BenchmarkInverse(b *testing.B) {
+	r := rand.New(rand.NewSource(4))
+	x := randomBelow(r, P)
+	b.Run("BigInt", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			new(big.Int).ModInverse(x, P)
+		}
+	})
+	b.Run("Euclid", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Inverse(x, P)
+		}
+	})
+	b.Run("Element", func(b *testing.B) {
+		var ex, z FpElement
+		ex.SetBigInt(x)
+		for i := 0; i < b.N; i++ {
+			z.Inverse(&ex)
+		}
+	})
+}
@@ -2,7 +2,12 @@ package field
 
 import (
 	"crypto/rand"
+	"fmt"
+	"io"
 	"math/big"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/errcode"
 )
 
 var (
@@ -65,37 +70,42 @@ func Inverse(a, p *big.Int) *big.Int {
 	return Mod(x, p)
 }
 
-func Sqrt(n, p, Q, c, M *big.Int) *big.Int {
-	n = Mod(n, p)
-	if n.Sign() == 0 {
-		return big.NewInt(0)
-	}
-	t := Power(n, new(big.Int).Sub(Q, big.NewInt(1)).Rsh(Q, 1), p)
-	R := Mod(new(big.Int).Mul(t, n), p)
-	t = Mod(new(big.Int).Mul(t, R), p)
-
-	one := big.NewInt(1)
-	for {
-		if t.Cmp(one) == 0 {
-			return R
-		}
-		i := big.NewInt(0)
-		s := new(big.Int).Set(t)
-		for s.Cmp(one) != 0 {
-			s = Mod(new(big.Int).Mul(s, s), p)
-			i.Add(i, one)
-		}
-		if i.Cmp(M) == 0 {
-			return nil
-		}
-		exp := new(big.Int).Sub(M, i)
-		exp.Sub(exp, one)
-		b := Power(c, new(big.Int).Lsh(one, uint(exp.Int64())), p)
-		M = i
-		c = Mod(new(big.Int).Mul(b, b), p)
-		t = Mod(new(big.Int).Mul(t, c), p)
-		R = Mod(new(big.Int).Mul(R, b), p)
+// ErrNotInvertible and ErrNonSquare are returned, possibly wrapped, by
+// InverseErr and SqrtErr, whose nil-returning counterparts Inverse and Sqrt
+// leave the failure for the caller to notice.
+var (
+	ErrNotInvertible = errcode.New(errcode.NotInvertible, "field: element is not invertible")
+	ErrNonSquare     = errcode.New(errcode.NonSquare, "field: element is not a square")
+)
+
+// InverseErr is Inverse with an error instead of a nil result when a has no
+// inverse modulo p.
+func InverseErr(a, p *big.Int) (*big.Int, error) {
+	inv := Inverse(a, p)
+	if inv == nil {
+		return nil, fmt.Errorf("%w: %s mod %s", ErrNotInvertible, a, p)
+	}
+	return inv, nil
+}
+
+// SqrtErr is Sqrt with ErrNonSquare instead of a nil result when n is not a
+// square.
+func SqrtErr(n, p, Q, c, M *big.Int) (*big.Int, error) {
+	y := Sqrt(n, p, Q, c, M)
+	if y == nil {
+		return nil, ErrNonSquare
 	}
+	return y, nil
+}
+
+// Sqrt returns a square root of n modulo p = Q·2^M + 1, or nil if n is not a
+// square, using Tonelli-Shanks with the primitive 2^M-th root of unity c.
+// None of the arguments are modified. FiniteField.Sqrt caches the
+// precomputation and should be preferred for repeated use.
+func Sqrt(n, p, Q, c, M *big.Int) *big.Int {
+	mul := func(x, y *big.Int) *big.Int { return Mod(new(big.Int).Mul(x, y), p) }
+	pow := func(x, e *big.Int) *big.Int { return Power(x, e, p) }
+	return newTonelliShanks(p, Q, c, int(M.Int64()), mul, pow).sqrt(n)
 }
 
 func IsSquare(x, p *big.Int) bool {
@@ -108,16 +118,39 @@ func IsSquare(x, p *big.Int) bool {
 	return sqrt1.Cmp(big.NewInt(1)) == 0
 }
 
+// maxRandomAttempts bounds the rejection sampling in RandomFieldFrom. Each
+// attempt succeeds with probability above 1/2 for a correctly masked modulus,
+// so hitting the bound means the reader is not producing random bytes.
+const maxRandomAttempts = 128
+
+// RandomField returns a uniformly random element below p read from
+// crypto/rand. It panics if the system randomness source fails; use
+// RandomFieldFrom to handle that error instead.
 func RandomField(p *big.Int, sizeInBytes int, hiBitMask byte) *big.Int {
-	for {
-		bytes := make([]byte, sizeInBytes)
-		_, _ = rand.Read(bytes)
-		bytes[sizeInBytes-1] &= hiBitMask
+	x, err := RandomFieldFrom(rand.Reader, p, sizeInBytes, hiBitMask)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// RandomFieldFrom returns a uniformly random element below p by rejection
+// sampling big-endian values of sizeInBytes bytes from r, with the most
+// significant byte masked by hiBitMask. It returns the reader's error, or an
+// error after too many rejected samples.
+func RandomFieldFrom(r io.Reader, p *big.Int, sizeInBytes int, hiBitMask byte) (*big.Int, error) {
+	bytes := make([]byte, sizeInBytes)
+	for i := 0; i < maxRandomAttempts; i++ {
+		if _, err := io.ReadFull(r, bytes); err != nil {
+			return nil, errcode.Errorf(errcode.Internal, "field: reading randomness: %w", err)
+		}
+		bytes[0] &= hiBitMask
 		x := BytesToBigInt(bytes)
 		if x.Cmp(p) < 0 {
-			return x
+			return x, nil
 		}
 	}
+	return nil, errcode.New(errcode.Internal, "field: randomness source keeps producing out-of-range values")
 }
 
 func BytesToBigInt(b []byte) *big.Int {
@@ -144,18 +177,51 @@ type FiniteField struct {
 	Inverse  func(x *big.Int) *big.Int
 	IsSquare func(x *big.Int) bool
 	Sqrt     func(x *big.Int) *big.Int
-	Power    func(x, n *big.Int) *big.Int
-	Equal    func(x, y *big.Int) bool
-	IsEven   func(x *big.Int) bool
-	Random   func() *big.Int
+	// InverseErr and SqrtErr report failure with ErrNotInvertible and
+	// ErrNonSquare where Inverse and Sqrt return nil.
+	InverseErr func(x *big.Int) (*big.Int, error)
+	SqrtErr    func(x *big.Int) (*big.Int, error)
+	// SqrtRatio returns (true, sqrt(u/v)) when u/v is a square and
+	// (false, sqrt(Z·u/v)) otherwise, where Z is the non-residue
+	// TwoadicRoot. This is the sqrt_ratio of RFC 9380; v must be non-zero
+	// and (false, nil) is returned when it is not.
+	SqrtRatio func(u, v *big.Int) (bool, *big.Int)
+	Power     func(x, n *big.Int) *big.Int
+	// The Into variants store the result in dst, which may alias an
+	// operand, and return it. They reuse dst's storage instead of
+	// allocating, for hot loops such as the Poseidon permutation.
+	AddInto    func(dst, x, y *big.Int) *big.Int
+	SubInto    func(dst, x, y *big.Int) *big.Int
+	MulInto    func(dst, x, y *big.Int) *big.Int
+	SquareInto func(dst, x *big.Int) *big.Int
+	PowerInto  func(dst, x, n *big.Int) *big.Int
+	Equal      func(x, y *big.Int) bool
+	IsEven     func(x *big.Int) bool
+	Random     func() *big.Int
+	// RandomFrom is Random with an explicit randomness source and error.
+	RandomFrom func(r io.Reader) (*big.Int, error)
 }
 
-func NewFiniteField(p, oddFactor, twoadicRoot, twoadicity *big.Int) *FiniteField {
+// NewFiniteField returns the field of integers modulo the odd prime p. The
+// Tonelli-Shanks parameters are derived from p: M is the two-adicity of p-1
+// and T its odd factor. twoadicRoot must be a primitive 2^M-th root of unity;
+// if it is nil the smallest quadratic non-residue raised to T is used.
+func NewFiniteField(p, twoadicRoot *big.Int) (*FiniteField, error) {
+	if p.Cmp(big.NewInt(3)) < 0 || p.Bit(0) == 0 {
+		return nil, errcode.Errorf(errcode.InvalidArgument, "field: modulus %s is not an odd prime", p)
+	}
+	oddFactor, m := twoAdicDecomposition(p)
+	twoadicity := big.NewInt(int64(m))
+	if twoadicRoot == nil {
+		twoadicRoot = findTwoadicRoot(p, oddFactor)
+	} else if !isPrimitiveRoot(twoadicRoot, m, p) {
+		return nil, errcode.Errorf(errcode.InvalidArgument, "field: %s is not a primitive 2^%d-th root of unity modulo %s", twoadicRoot, m, p)
+	}
 	sizeInBits := Log2(p)
 	sizeInBytes := (sizeInBits + 7) / 8
 	sizeHighestByte := sizeInBits - 8*(sizeInBytes-1)
 	hiBitMask := byte((1 << sizeHighestByte) - 1)
-	return &FiniteField{
+	f := &FiniteField{
 		Modulus:     p,
 		SizeInBits:  sizeInBits,
 		T:           oddFactor,
@@ -188,13 +254,24 @@ func NewFiniteField(p, oddFactor, twoadicRoot, twoadicity *big.Int) *FiniteField
 		IsSquare: func(x *big.Int) bool {
 			return IsSquare(x, p)
 		},
-		Sqrt: func(x *big.Int) *big.Int {
-			// Provide Q, c, M for Tonelli-Shanks
-			return Sqrt(x, p, oddFactor, twoadicRoot, twoadicity)
-		},
 		Power: func(x, n *big.Int) *big.Int {
 			return Power(x, n, p)
 		},
+		AddInto: func(dst, x, y *big.Int) *big.Int {
+			return reduceInto(dst.Add(x, y), p)
+		},
+		SubInto: func(dst, x, y *big.Int) *big.Int {
+			return reduceInto(dst.Sub(x, y), p)
+		},
+		MulInto: func(dst, x, y *big.Int) *big.Int {
+			return reduceInto(dst.Mul(x, y), p)
+		},
+		SquareInto: func(dst, x *big.Int) *big.Int {
+			return reduceInto(dst.Mul(x, x), p)
+		},
+		PowerInto: func(dst, x, n *big.Int) *big.Int {
+			return dst.Set(Power(x, n, p))
+		},
 		Equal: func(x, y *big.Int) bool {
 			return Mod(x, p).Cmp(Mod(y, p)) == 0
 		},
@@ -204,9 +281,73 @@ func NewFiniteField(p, oddFactor, twoadicRoot, twoadicity *big.Int) *FiniteField
 		Random: func() *big.Int {
 			return RandomField(p, sizeInBytes, hiBitMask)
 		},
+		RandomFrom: func(r io.Reader) (*big.Int, error) {
+			return RandomFieldFrom(r, p, sizeInBytes, hiBitMask)
+		},
+	}
+	// Moduli that fit in four limbs multiply in Montgomery form.
+	if m := montgomeryFor(p); m != nil {
+		f.Mul = m.mulBig
+		f.Square = m.squareBig
+		f.Power = m.powerBig
+		f.MulInto = m.mulBigInto
+		f.SquareInto = m.squareBigInto
+		f.PowerInto = m.powerBigInto
+	}
+	// A single product of plain integers pays for two conversions into
+	// Montgomery form and one out of it. Without an assembly multiplication
+	// Barrett reduction of the product is cheaper; see BenchmarkReduction.
+	if b := barrettFor(p); b != nil && preferBarrett() {
+		f.Mul = b.mulBig
+		f.Square = b.squareBig
+		f.MulInto = b.mulBigInto
+		f.SquareInto = b.squareBigInto
+	}
+	// The Tonelli-Shanks tables are built on first use and shared by all
+	// later square roots in the field.
+	var (
+		sqrtOnce sync.Once
+		ts       *tonelliShanks
+	)
+	tables := func() *tonelliShanks {
+		sqrtOnce.Do(func() {
+			ts = newTonelliShanks(p, oddFactor, twoadicRoot, int(m), f.Mul, f.Power)
+		})
+		return ts
+	}
+	f.Sqrt = func(x *big.Int) *big.Int {
+		return tables().sqrt(x)
+	}
+	f.SqrtRatio = func(u, v *big.Int) (bool, *big.Int) {
+		return tables().sqrtRatio(u, v)
+	}
+	f.InverseErr = func(x *big.Int) (*big.Int, error) {
+		return InverseErr(x, p)
 	}
+	f.SqrtErr = func(x *big.Int) (*big.Int, error) {
+		if y := f.Sqrt(x); y != nil {
+			return y, nil
+		}
+		return nil, ErrNonSquare
+	}
+	return f, nil
+}
+
+// montgomeryFor returns the Montgomery constants for p, reusing the
+// precomputed ones for the Pasta moduli.
+func montgomeryFor(p *big.Int) *montgomery {
+	switch {
+	case p.Cmp(P) == 0:
+		return montFp
+	case p.Cmp(Q) == 0:
+		return montFq
+	}
+	return newMontgomery(p)
 }
 
+// FromBigInt reduces x modulo P. Applied to an Fq value it silently wraps
+// values in [p, q); deriveNonce relies on that, everything else should use
+// ConvertFqToFp.
 func FromBigInt(x *big.Int) *big.Int {
 	return Mod(x, P)
 }
@@ -215,6 +356,9 @@ func (f *FiniteField) SizeInBytes() int {
 	return int((f.SizeInBits + 7) / 8)
 }
 
+// FromBytes interprets bs as a little-endian integer of any length and
+// reduces it modulo p. Use FromBytesLE or FromBytesBE to decode a fixed-size
+// encoding strictly.
 func (f *FiniteField) FromBytes(bs []byte) *big.Int {
 
 	rev := make([]byte, len(bs))
@@ -226,6 +370,55 @@ func (f *FiniteField) FromBytes(bs []byte) *big.Int {
 }
 
 var (
-	Fp = NewFiniteField(P, PMinusOneOddFactor, TwoadicRootFp, big.NewInt(32))
-	Fq = NewFiniteField(Q, QMinusOneOddFactor, TwoadicRootFq, big.NewInt(32))
+	Fp = mustFiniteField(P, TwoadicRootFp)
+	Fq = mustFiniteField(Q, TwoadicRootFq)
 )
+
+func mustFiniteField(p, twoadicRoot *big.Int) *FiniteField {
+	f, err := NewFiniteField(p, twoadicRoot)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// ForModulus returns the field of integers modulo p. The predefined Fp and Fq
+// are returned for the Pasta moduli; any other odd prime gets a new field
+// whose Tonelli-Shanks parameters are derived from p. It panics if p is not
+// an odd modulus greater than 2.
+func ForModulus(p *big.Int) *FiniteField {
+	switch {
+	case p.Cmp(P) == 0:
+		return Fp
+	case p.Cmp(Q) == 0:
+		return Fq
+	}
+	return mustFiniteField(p, nil)
+}
+
+// twoAdicDecomposition writes p - 1 as oddFactor * 2^twoadicity.
+func twoAdicDecomposition(p *big.Int) (*big.Int, uint) {
+	pMinusOne := new(big.Int).Sub(p, big.NewInt(1))
+	twoadicity := pMinusOne.TrailingZeroBits()
+	return new(big.Int).Rsh(pMinusOne, twoadicity), twoadicity
+}
+
+// isPrimitiveRoot reports whether root has multiplicative order exactly 2^m
+// modulo p, i.e. squaring it m-1 times gives -1.
+func isPrimitiveRoot(root *big.Int, m uint, p *big.Int) bool {
+	x := Mod(root, p)
+	for i := uint(1); i < m; i++ {
+		x.Mul(x, x).Mod(x, p)
+	}
+	return x.Cmp(new(big.Int).Sub(p, big.NewInt(1))) == 0
+}
+
+// findTwoadicRoot returns z^oddFactor for the smallest quadratic non-residue
+// z, which is a primitive 2^twoadicity-th root of unity.
+func findTwoadicRoot(p, oddFactor *big.Int) *big.Int {
+	z := big.NewInt(2)
+	for IsSquare(z, p) {
+		z.Add(z, big.NewInt(1))
+	}
+	return Power(z, oddFactor, p)
+}
@@ -0,0 +1,149 @@
+package field
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// ErrFieldMismatch is the panic value of FieldElement operations whose
+// operands belong to different fields.
+var ErrFieldMismatch = errcode.New(errcode.InvalidArgument, "field: operands belong to different fields")
+
+// FieldElement is an integer modulo the modulus of the field it is bound to.
+// Unlike a naked *big.Int it cannot be combined with an element of another
+// field by accident: arithmetic between an Fp and an Fq element panics with
+// ErrFieldMismatch instead of silently reducing by the wrong modulus.
+//
+// FieldElement values are immutable; every operation returns a new element.
+type FieldElement struct {
+	v *big.Int
+	f *FiniteField
+}
+
+// NewElement returns x mod p as an element of f.
+func (f *FiniteField) NewElement(x *big.Int) *FieldElement {
+	return &FieldElement{v: f.Mod(x), f: f}
+}
+
+// Zero returns the additive identity of f.
+func (f *FiniteField) Zero() *FieldElement {
+	return &FieldElement{v: big.NewInt(0), f: f}
+}
+
+// One returns the multiplicative identity of f.
+func (f *FiniteField) One() *FieldElement {
+	return &FieldElement{v: big.NewInt(1), f: f}
+}
+
+// Field returns the field x belongs to.
+func (x *FieldElement) Field() *FiniteField {
+	return x.f
+}
+
+// BigInt returns a copy of the canonical representative of x in [0, p).
+func (x *FieldElement) BigInt() *big.Int {
+	return new(big.Int).Set(x.v)
+}
+
+// String returns the decimal representation of x.
+func (x *FieldElement) String() string {
+	return x.v.String()
+}
+
+// SameField reports whether x and y belong to the same field.
+func (x *FieldElement) SameField(y *FieldElement) bool {
+	return x.f == y.f || x.f.Modulus.Cmp(y.f.Modulus) == 0
+}
+
+func (x *FieldElement) check(y *FieldElement) {
+	if !x.SameField(y) {
+		panic(fmt.Errorf("%w: modulus %s and %s", ErrFieldMismatch, x.f.Modulus, y.f.Modulus))
+	}
+}
+
+func (x *FieldElement) with(v *big.Int) *FieldElement {
+	return &FieldElement{v: v, f: x.f}
+}
+
+// Equal reports whether x and y are the same element of the same field.
+func (x *FieldElement) Equal(y *FieldElement) bool {
+	return x.SameField(y) && x.v.Cmp(y.v) == 0
+}
+
+// IsZero reports whether x is 0.
+func (x *FieldElement) IsZero() bool {
+	return x.v.Sign() == 0
+}
+
+// IsEven reports whether the canonical representative of x is even.
+func (x *FieldElement) IsEven() bool {
+	return x.v.Bit(0) == 0
+}
+
+// Add returns x + y.
+func (x *FieldElement) Add(y *FieldElement) *FieldElement {
+	x.check(y)
+	return x.with(x.f.Add(x.v, y.v))
+}
+
+// Sub returns x - y.
+func (x *FieldElement) Sub(y *FieldElement) *FieldElement {
+	x.check(y)
+	return x.with(x.f.Sub(x.v, y.v))
+}
+
+// Mul returns x·y.
+func (x *FieldElement) Mul(y *FieldElement) *FieldElement {
+	x.check(y)
+	return x.with(x.f.Mul(x.v, y.v))
+}
+
+// Div returns x/y, or an error when y is 0.
+func (x *FieldElement) Div(y *FieldElement) (*FieldElement, error) {
+	x.check(y)
+	yInv, err := y.Inverse()
+	if err != nil {
+		return nil, err
+	}
+	return x.Mul(yInv), nil
+}
+
+// Neg returns -x.
+func (x *FieldElement) Neg() *FieldElement {
+	return x.with(x.f.Negate(x.v))
+}
+
+// Square returns x².
+func (x *FieldElement) Square() *FieldElement {
+	return x.with(x.f.Square(x.v))
+}
+
+// Exp returns x^n for a non-negative exponent n.
+func (x *FieldElement) Exp(n *big.Int) *FieldElement {
+	return x.with(x.f.Power(x.v, n))
+}
+
+// Inverse returns 1/x, or an error when x is 0.
+func (x *FieldElement) Inverse() (*FieldElement, error) {
+	inv, err := x.f.InverseErr(x.v)
+	if err != nil {
+		return nil, err
+	}
+	return x.with(inv), nil
+}
+
+// IsSquare reports whether x is a quadratic residue.
+func (x *FieldElement) IsSquare() bool {
+	return x.f.IsSquare(x.v)
+}
+
+// Sqrt returns a square root of x, and false if x is not a square.
+func (x *FieldElement) Sqrt() (*FieldElement, bool) {
+	y, err := x.f.SqrtErr(x.v)
+	if err != nil {
+		return nil, false
+	}
+	return x.with(y), true
+}
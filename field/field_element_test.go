@@ -0,0 +1,53 @@
+package field
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestFieldElementArithmetic(t *testing.T) {
+	a := Fp.NewElement(big.NewInt(-5))
+	b := Fp.NewElement(big.NewInt(7))
+	if a.BigInt().Cmp(new(big.Int).Sub(P, big.NewInt(5))) != 0 {
+		t.Fatalf("NewElement(-5) = %s", a)
+	}
+	if got := a.Add(b); got.BigInt().Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("-5 + 7 = %s", got)
+	}
+	if got := a.Mul(b).Add(Fp.NewElement(big.NewInt(35))); !got.IsZero() {
+		t.Errorf("-5*7 + 35 = %s", got)
+	}
+	q, err := b.Div(a)
+	if err != nil {
+		t.Fatalf("Div failed: %v", err)
+	}
+	if !q.Mul(a).Equal(b) {
+		t.Error("(b/a)*a != b")
+	}
+	if _, err := b.Div(Fp.Zero()); err == nil {
+		t.Error("division by zero succeeded")
+	}
+	root, ok := b.Square().Sqrt()
+	if !ok || !root.Square().Equal(b.Square()) {
+		t.Error("Sqrt of a square failed")
+	}
+	if !Fp.One().Exp(big.NewInt(12345)).Equal(Fp.One()) {
+		t.Error("1^n != 1")
+	}
+}
+
+func TestFieldElementRejectsMixedFields(t *testing.T) {
+	x, y := Fp.NewElement(big.NewInt(3)), Fq.NewElement(big.NewInt(3))
+	if x.Equal(y) {
+		t.Error("elements of different fields compare equal")
+	}
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok || !errors.Is(err, ErrFieldMismatch) {
+			t.Errorf("mixing fields panicked with %v, want ErrFieldMismatch", r)
+		}
+	}()
+	x.Add(y)
+}
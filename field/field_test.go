@@ -0,0 +1,85 @@
+package field
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestNewFiniteFieldDerivesTwoAdicity(t *testing.T) {
+	for _, tc := range []struct {
+		f         *FiniteField
+		oddFactor *big.Int
+	}{
+		{Fp, PMinusOneOddFactor},
+		{Fq, QMinusOneOddFactor},
+	} {
+		if tc.f.M.Int64() != 32 || tc.f.T.Cmp(tc.oddFactor) != 0 {
+			t.Errorf("modulus %x: got M = %s, T = %x", tc.f.Modulus, tc.f.M, tc.f.T)
+		}
+	}
+
+	// 97 - 1 = 3 * 2^5
+	f, err := NewFiniteField(big.NewInt(97), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.M.Int64() != 5 || f.T.Int64() != 3 {
+		t.Errorf("mod 97: got M = %s, T = %s, want 5 and 3", f.M, f.T)
+	}
+	if !isPrimitiveRoot(f.TwoadicRoot, 5, f.Modulus) {
+		t.Errorf("mod 97: derived root %s is not a primitive 32nd root of unity", f.TwoadicRoot)
+	}
+}
+
+func TestNewFiniteFieldRejectsBadParameters(t *testing.T) {
+	squaredRoot := new(big.Int).Exp(TwoadicRootFp, big.NewInt(2), P)
+	for _, tc := range []struct {
+		name    string
+		p, root *big.Int
+	}{
+		{"even modulus", big.NewInt(96), nil},
+		{"modulus 2", big.NewInt(2), nil},
+		{"root of unity of lower order", P, squaredRoot},
+		{"one", P, big.NewInt(1)},
+		{"minus one", P, new(big.Int).Sub(P, big.NewInt(1))},
+		{"root of the other field", P, TwoadicRootFq},
+	} {
+		if _, err := NewFiniteField(tc.p, tc.root); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+	if _, err := NewFiniteField(P, TwoadicRootFp); err != nil {
+		t.Errorf("Pallas base field: %v", err)
+	}
+	if _, err := NewFiniteField(Q, TwoadicRootFq); err != nil {
+		t.Errorf("Pallas scalar field: %v", err)
+	}
+}
+
+func TestErrorReturningInverseAndSqrt(t *testing.T) {
+	if _, err := InverseErr(big.NewInt(0), P); !errors.Is(err, ErrNotInvertible) {
+		t.Errorf("InverseErr(0) error = %v, want ErrNotInvertible", err)
+	}
+	if _, err := Fp.InverseErr(P); !errors.Is(err, ErrNotInvertible) {
+		t.Errorf("Fp.InverseErr(p) error = %v, want ErrNotInvertible", err)
+	}
+	if inv, err := Fp.InverseErr(big.NewInt(2)); err != nil || Fp.Mul(inv, big.NewInt(2)).Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("Fp.InverseErr(2) = %v, %v", inv, err)
+	}
+
+	// 5 is not a square mod p, which is why x = 0 is not on Pallas.
+	if _, err := Fp.SqrtErr(big.NewInt(5)); !errors.Is(err, ErrNonSquare) {
+		t.Errorf("Fp.SqrtErr(5) error = %v, want ErrNonSquare", err)
+	}
+	if _, err := SqrtErr(big.NewInt(5), P, Fp.T, Fp.TwoadicRoot, Fp.M); !errors.Is(err, ErrNonSquare) {
+		t.Errorf("SqrtErr(5) error = %v, want ErrNonSquare", err)
+	}
+	if y, err := Fp.SqrtErr(big.NewInt(4)); err != nil || Fp.Square(y).Int64() != 4 {
+		t.Errorf("Fp.SqrtErr(4) = %v, %v", y, err)
+	}
+
+	if _, err := Fp.Zero().Inverse(); !errors.Is(err, ErrNotInvertible) {
+		t.Errorf("FieldElement.Inverse(0) error = %v, want ErrNotInvertible", err)
+	}
+}
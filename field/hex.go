@@ -0,0 +1,44 @@
+package field
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// ToHex returns x mod p as a 0x-prefixed big-endian hex string with exactly
+// 2·SizeInBytes digits, e.g. 0x0000…002a for 42.
+func (f *FiniteField) ToHex(x *big.Int) string {
+	return "0x" + hex.EncodeToString(f.ToBytesBE(x))
+}
+
+// FromHex parses a 0x-prefixed big-endian hex string. Leading zeros may be
+// omitted, but the string may not be wider than ToHex's output and the value
+// must be below the modulus. In strict mode s must be exactly what ToHex
+// writes.
+func (f *FiniteField) FromHex(s string, opts ...DecodeOption) (*big.Int, error) {
+	digits, ok := strings.CutPrefix(s, "0x")
+	if !ok {
+		digits, ok = strings.CutPrefix(s, "0X")
+	}
+	if !ok {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "field: hex value %q lacks the 0x prefix", s)
+	}
+	if digits == "" || len(digits) > 2*f.SizeInBytes() {
+		return nil, errcode.Errorf(errcode.InvalidLength, "field: hex value %q must have 1 to %d digits", s, 2*f.SizeInBytes())
+	}
+	if strings.Trim(digits, "0123456789abcdefABCDEF") != "" {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "field: invalid hex value %q", s)
+	}
+	x, _ := new(big.Int).SetString(digits, 16)
+	if x.Cmp(f.Modulus) >= 0 {
+		return nil, fmt.Errorf("%w: hex value %q is not below the modulus", ErrNonCanonical, s)
+	}
+	if IsStrict(opts...) && s != f.ToHex(x) {
+		return nil, fmt.Errorf("%w: hex value %q is not in the form %s", ErrNonCanonical, s, f.ToHex(x))
+	}
+	return x, nil
+}
@@ -0,0 +1,33 @@
+package field
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestHex(t *testing.T) {
+	s := Fp.ToHex(big.NewInt(42))
+	if s != "0x"+strings.Repeat("0", 62)+"2a" {
+		t.Errorf("ToHex(42) = %s", s)
+	}
+	for _, in := range []string{s, "0x2a", "0X2A", "0x002a"} {
+		x, err := Fp.FromHex(in)
+		if err != nil || x.Int64() != 42 {
+			t.Errorf("FromHex(%q) = %v, %v", in, x, err)
+		}
+	}
+	pMinusOne := new(big.Int).Sub(P, big.NewInt(1))
+	if x, err := Fp.FromHex(Fp.ToHex(pMinusOne)); err != nil || x.Cmp(pMinusOne) != 0 {
+		t.Errorf("round trip of p-1 = %v, %v", x, err)
+	}
+	for _, in := range []string{
+		"2a", "0x", "0x-1", "0xzz", " 0x2a",
+		"0x" + P.Text(16),
+		"0x" + strings.Repeat("0", 65),
+	} {
+		if _, err := Fp.FromHex(in); err == nil {
+			t.Errorf("FromHex(%q) succeeded", in)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+//go:build !tinygo && !minasigner_core
+
+package field
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// DecodeJSONObject decodes the single JSON object data into v, for the
+// UnmarshalJSON methods of this module. It rejects anything but whitespace
+// after the object and, under strict decoding, unknown members and members
+// that appear more than once, compared without regard to case as
+// encoding/json matches them.
+func DecodeJSONObject(data []byte, v any) error {
+	strict := IsStrict()
+	if strict {
+		if err := checkDuplicateMembers(data); err != nil {
+			return err
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil {
+		return errcode.Errorf(errcode.InvalidEncoding, "field: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return errcode.New(errcode.InvalidEncoding, "field: data after the JSON object")
+	}
+	return nil
+}
+
+// checkDuplicateMembers fails if the JSON object data names a member twice.
+// Input that is not an object is left for json.Decoder to reject.
+func checkDuplicateMembers(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil
+		}
+		name, _ := tok.(string)
+		key := strings.ToLower(name)
+		if seen[key] {
+			return errcode.Errorf(errcode.InvalidEncoding, "field: JSON member %q appears more than once", name)
+		}
+		seen[key] = true
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
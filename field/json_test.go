@@ -0,0 +1,41 @@
+//go:build !tinygo && !minasigner_core
+
+package field
+
+import (
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+func TestDecodeJSONObject(t *testing.T) {
+	t.Cleanup(func() { SetStrictDecoding(false) })
+	type point struct {
+		X string `json:"x"`
+	}
+	var v point
+	for _, in := range []string{`{"x":"1"} garbage`, `{"x":"1"}{"x":"2"}`, `{"x":"1"`} {
+		if err := DecodeJSONObject([]byte(in), &v); errcode.CodeOf(err) != errcode.InvalidEncoding {
+			t.Errorf("DecodeJSONObject(%s) = %v, want InvalidEncoding", in, err)
+		}
+	}
+	if err := DecodeJSONObject([]byte(" {\"x\":\"1\"}\n"), &v); err != nil || v.X != "1" {
+		t.Errorf("DecodeJSONObject with surrounding space = %+v, %v", v, err)
+	}
+
+	duplicates := []string{`{"x":"1","x":"2"}`, `{"x":"1","X":"2"}`}
+	for _, in := range duplicates {
+		if err := DecodeJSONObject([]byte(in), &v); err != nil {
+			t.Errorf("lenient DecodeJSONObject(%s): %v", in, err)
+		}
+	}
+	SetStrictDecoding(true)
+	for _, in := range duplicates {
+		if err := DecodeJSONObject([]byte(in), &v); errcode.CodeOf(err) != errcode.InvalidEncoding {
+			t.Errorf("strict DecodeJSONObject(%s) = %v, want InvalidEncoding", in, err)
+		}
+	}
+	if err := DecodeJSONObject([]byte(`{"x":"1"}`), &v); err != nil {
+		t.Errorf("strict DecodeJSONObject: %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+package field
+
+import (
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+)
+
+// Limbs is the number of 64-bit words in a Montgomery field element.
+const Limbs = 4
+
+// montgomery holds the constants for arithmetic on 4×64-bit limbs in
+// Montgomery form, where an element x is stored as xR mod p with R = 2^256.
+// It works for any odd modulus below 2^256; the Pasta moduli are the ones the
+// package instantiates.
+type montgomery struct {
+	modulus *big.Int
+	p       [Limbs]uint64 // little-endian limbs of the modulus
+	inv     uint64        // -p^-1 mod 2^64
+	one     [Limbs]uint64 // R mod p
+	rSquare [Limbs]uint64 // R^2 mod p
+	// noCarry is set when the top limb of p is below 2^63-1. The assembly
+	// multiplications rely on it to keep every intermediate within five
+	// words; both Pasta moduli satisfy it.
+	noCarry bool
+}
+
+var (
+	montFp = newMontgomery(P)
+	montFq = newMontgomery(Q)
+)
+
+// newMontgomery derives the Montgomery constants for an odd modulus p below
+// 2^256. It returns nil for any other modulus.
+func newMontgomery(p *big.Int) *montgomery {
+	if p.Sign() <= 0 || p.Bit(0) == 0 || p.BitLen() > 64*Limbs {
+		return nil
+	}
+	m := &montgomery{modulus: new(big.Int).Set(p)}
+	m.p = limbsOf(p)
+	m.noCarry = m.p[Limbs-1] < 1<<63-1
+
+	word := new(big.Int).Lsh(big.NewInt(1), 64)
+	inv := new(big.Int).ModInverse(new(big.Int).Mod(p, word), word)
+	m.inv = new(big.Int).Sub(word, inv).Uint64()
+
+	r := new(big.Int).Lsh(big.NewInt(1), 64*Limbs)
+	m.one = limbsOf(new(big.Int).Mod(r, p))
+	m.rSquare = limbsOf(new(big.Int).Mod(new(big.Int).Mul(r, r), p))
+	return m
+}
+
+// limbsOf returns the little-endian limbs of 0 <= x < 2^256.
+func limbsOf(x *big.Int) [Limbs]uint64 {
+	var buf [8 * Limbs]byte
+	x.FillBytes(buf[:])
+	var z [Limbs]uint64
+	for i := range z {
+		z[i] = binary.BigEndian.Uint64(buf[8*(Limbs-1-i):])
+	}
+	return z
+}
+
+// bigOf returns the integer with little-endian limbs z.
+func bigOf(z *[Limbs]uint64) *big.Int {
+	return setLimbs(new(big.Int), z)
+}
+
+// setLimbs sets dst to the integer with little-endian limbs z and returns
+// dst, reusing its storage.
+func setLimbs(dst *big.Int, z *[Limbs]uint64) *big.Int {
+	var buf [8 * Limbs]byte
+	for i := range z {
+		binary.BigEndian.PutUint64(buf[8*(Limbs-1-i):], z[i])
+	}
+	return dst.SetBytes(buf[:])
+}
+
+// madd returns the 128-bit value a*b + c + d as (hi, lo).
+func madd(a, b, c, d uint64) (uint64, uint64) {
+	hi, lo := bits.Mul64(a, b)
+	var carry uint64
+	lo, carry = bits.Add64(lo, c, 0)
+	hi += carry
+	lo, carry = bits.Add64(lo, d, 0)
+	hi += carry
+	return hi, lo
+}
+
+// mulGeneric sets z = x*y*R^-1 mod p using the CIOS method. It is the
+// portable implementation behind mul; see the per-architecture files.
+func (m *montgomery) mulGeneric(z, x, y *[Limbs]uint64) {
+	var t [Limbs + 2]uint64
+	for i := 0; i < Limbs; i++ {
+		// t += x * y[i]
+		var c uint64
+		for j := 0; j < Limbs; j++ {
+			c, t[j] = madd(x[j], y[i], t[j], c)
+		}
+		t[Limbs], c = bits.Add64(t[Limbs], c, 0)
+		t[Limbs+1] = c
+
+		// t = (t + q*p) / 2^64 with q chosen so the low word vanishes.
+		q := t[0] * m.inv
+		c, _ = madd(q, m.p[0], t[0], 0)
+		for j := 1; j < Limbs; j++ {
+			c, t[j-1] = madd(q, m.p[j], t[j], c)
+		}
+		t[Limbs-1], c = bits.Add64(t[Limbs], c, 0)
+		t[Limbs] = t[Limbs+1] + c
+	}
+	var r [Limbs]uint64
+	copy(r[:], t[:Limbs])
+	m.reduceOnce(z, &r, t[Limbs])
+}
+
+// reduceOnce sets z = x - p when the value carry·2^256 + x is at least p, and
+// z = x otherwise. Like add, sub and neg it runs in constant time: the choice
+// is made with a mask, not a branch.
+func (m *montgomery) reduceOnce(z, x *[Limbs]uint64, carry uint64) {
+	var d [Limbs]uint64
+	var borrow uint64
+	for i := 0; i < Limbs; i++ {
+		d[i], borrow = bits.Sub64(x[i], m.p[i], borrow)
+	}
+	ctSelect(z, -(carry | (borrow ^ 1)), &d, x)
+}
+
+// add sets z = x + y mod p.
+func (m *montgomery) add(z, x, y *[Limbs]uint64) {
+	var s [Limbs]uint64
+	var carry uint64
+	for i := 0; i < Limbs; i++ {
+		s[i], carry = bits.Add64(x[i], y[i], carry)
+	}
+	m.reduceOnce(z, &s, carry)
+}
+
+// sub sets z = x - y mod p.
+func (m *montgomery) sub(z, x, y *[Limbs]uint64) {
+	var d [Limbs]uint64
+	var borrow uint64
+	for i := 0; i < Limbs; i++ {
+		d[i], borrow = bits.Sub64(x[i], y[i], borrow)
+	}
+	// Add p back if the subtraction borrowed.
+	mask := -borrow
+	var carry uint64
+	for i := 0; i < Limbs; i++ {
+		d[i], carry = bits.Add64(d[i], m.p[i]&mask, carry)
+	}
+	*z = d
+}
+
+// neg sets z = -x mod p.
+func (m *montgomery) neg(z, x *[Limbs]uint64) {
+	// p - x, masked to zero when x is zero so the result stays below p.
+	t := x[0] | x[1] | x[2] | x[3]
+	mask := -((t | -t) >> 63)
+	var borrow uint64
+	for i := 0; i < Limbs; i++ {
+		z[i], borrow = bits.Sub64(m.p[i], x[i], borrow)
+		z[i] &= mask
+	}
+}
+
+// exp sets z = x^e for a non-negative exponent e.
+func (m *montgomery) exp(z, x *[Limbs]uint64, e *big.Int) {
+	base := *x
+	acc := m.one
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		m.mul(&acc, &acc, &acc)
+		if e.Bit(i) == 1 {
+			m.mul(&acc, &acc, &base)
+		}
+	}
+	*z = acc
+}
+
+// inverse sets z = x^(p-2), the inverse of a non-zero x.
+func (m *montgomery) inverse(z, x *[Limbs]uint64) {
+	m.exp(z, x, new(big.Int).Sub(m.modulus, big.NewInt(2)))
+}
+
+// toMont sets z to the Montgomery form of x, reducing x modulo p first.
+func (m *montgomery) toMont(z *[Limbs]uint64, x *big.Int) {
+	if x.Sign() < 0 || x.Cmp(m.modulus) >= 0 {
+		x = Mod(x, m.modulus)
+	}
+	v := limbsOf(x)
+	m.mul(z, &v, &m.rSquare)
+}
+
+// fromMont returns the integer represented by the Montgomery form x.
+func (m *montgomery) fromMont(x *[Limbs]uint64) *big.Int {
+	return m.fromMontInto(new(big.Int), x)
+}
+
+// fromMontInto sets dst to the integer represented by the Montgomery form x
+// and returns dst.
+func (m *montgomery) fromMontInto(dst *big.Int, x *[Limbs]uint64) *big.Int {
+	var v [Limbs]uint64
+	m.mul(&v, x, &[Limbs]uint64{1})
+	return setLimbs(dst, &v)
+}
+
+func isZeroLimbs(x *[Limbs]uint64) bool {
+	return x[0]|x[1]|x[2]|x[3] == 0
+}
+
+// The big.Int compatibility layer: FiniteField values for moduli with
+// Montgomery constants route their multiplicative operations through these
+// helpers, so existing callers get limb arithmetic without changing types.
+// The Into variants write to dst and allocate nothing once dst has room for
+// four words.
+
+func (m *montgomery) mulBig(x, y *big.Int) *big.Int {
+	return m.mulBigInto(new(big.Int), x, y)
+}
+
+func (m *montgomery) mulBigInto(dst, x, y *big.Int) *big.Int {
+	var a, b [Limbs]uint64
+	m.toMont(&a, x)
+	m.toMont(&b, y)
+	m.mul(&a, &a, &b)
+	return m.fromMontInto(dst, &a)
+}
+
+func (m *montgomery) squareBig(x *big.Int) *big.Int {
+	return m.squareBigInto(new(big.Int), x)
+}
+
+func (m *montgomery) squareBigInto(dst, x *big.Int) *big.Int {
+	var a [Limbs]uint64
+	m.toMont(&a, x)
+	m.mul(&a, &a, &a)
+	return m.fromMontInto(dst, &a)
+}
+
+func (m *montgomery) powerBig(x, n *big.Int) *big.Int {
+	return m.powerBigInto(new(big.Int), x, n)
+}
+
+func (m *montgomery) powerBigInto(dst, x, n *big.Int) *big.Int {
+	if n.Sign() < 0 {
+		return dst.Set(Power(x, n, m.modulus))
+	}
+	var a [Limbs]uint64
+	m.toMont(&a, x)
+	m.exp(&a, &a, n)
+	return m.fromMontInto(dst, &a)
+}
@@ -0,0 +1,27 @@
+//go:build !purego && !tinygo
+
+package field
+
+import "golang.org/x/sys/cpu"
+
+// supportAdx reports whether the CPU has the ADX and BMI2 extensions that
+// mulADX needs for its MULX/ADCX/ADOX carry chains.
+var supportAdx = cpu.X86.HasADX && cpu.X86.HasBMI2
+
+// mulADX sets z = x*y*R^-1 mod p for a modulus with the noCarry property.
+//
+//go:noescape
+func mulADX(z, x, y, p *[Limbs]uint64, inv uint64)
+
+// mul sets z = x*y*R^-1 mod p.
+func (m *montgomery) mul(z, x, y *[Limbs]uint64) {
+	if supportAdx && m.noCarry {
+		mulADX(z, x, y, &m.p, m.inv)
+		return
+	}
+	m.mulGeneric(z, x, y)
+}
+
+// preferBarrett reports whether FiniteField should reduce single products by
+// Barrett reduction rather than through Montgomery form.
+func preferBarrett() bool { return !supportAdx }
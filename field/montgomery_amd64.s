@@ -0,0 +1,98 @@
+//go:build !purego && !tinygo
+
+#include "textflag.h"
+
+// Montgomery multiplication on four limbs with MULX and the two independent
+// carry chains of ADCX (CF) and ADOX (OF). The accumulator t lives in
+// R8..R12; each round adds x*y[i] and then q*p with q = t0*inv, after which
+// t0 is zero and the words shift down by one. With p below 2^255 the running
+// value stays under 2p, so a single conditional subtraction finishes.
+//
+// Registers: SI = x, DI = y, R14 = p, R15 = inv, R13 = 0, AX/BX = product.
+
+// MUL_ROUND adds x*DX to t0..t4, starting t4 from zero.
+#define MUL_ROUND               \
+	XORQ  R12, R12;         \
+	MULXQ 0(SI), AX, BX;    \
+	ADOXQ AX, R8;           \
+	ADCXQ BX, R9;           \
+	MULXQ 8(SI), AX, BX;    \
+	ADOXQ AX, R9;           \
+	ADCXQ BX, R10;          \
+	MULXQ 16(SI), AX, BX;   \
+	ADOXQ AX, R10;          \
+	ADCXQ BX, R11;          \
+	MULXQ 24(SI), AX, BX;   \
+	ADOXQ AX, R11;          \
+	ADCXQ BX, R12;          \
+	ADOXQ R13, R12
+
+// REDUCE_ROUND adds q*p to t0..t4 and shifts t down one word.
+#define REDUCE_ROUND            \
+	MOVQ  R8, DX;           \
+	IMULQ R15, DX;          \
+	XORQ  R13, R13;         \
+	MULXQ 0(R14), AX, BX;   \
+	ADOXQ AX, R8;           \
+	ADCXQ BX, R9;           \
+	MULXQ 8(R14), AX, BX;   \
+	ADOXQ AX, R9;           \
+	ADCXQ BX, R10;          \
+	MULXQ 16(R14), AX, BX;  \
+	ADOXQ AX, R10;          \
+	ADCXQ BX, R11;          \
+	MULXQ 24(R14), AX, BX;  \
+	ADOXQ AX, R11;          \
+	ADCXQ BX, R12;          \
+	ADOXQ R13, R12;         \
+	MOVQ  R9, R8;           \
+	MOVQ  R10, R9;          \
+	MOVQ  R11, R10;         \
+	MOVQ  R12, R11
+
+// func mulADX(z, x, y, p *[4]uint64, inv uint64)
+TEXT ·mulADX(SB), NOSPLIT, $0-40
+	MOVQ x+8(FP), SI
+	MOVQ y+16(FP), DI
+	MOVQ p+24(FP), R14
+	MOVQ inv+32(FP), R15
+
+	XORQ R8, R8
+	XORQ R9, R9
+	XORQ R10, R10
+	XORQ R11, R11
+	XORQ R13, R13
+
+	MOVQ 0(DI), DX
+	MUL_ROUND
+	REDUCE_ROUND
+	MOVQ 8(DI), DX
+	MUL_ROUND
+	REDUCE_ROUND
+	MOVQ 16(DI), DX
+	MUL_ROUND
+	REDUCE_ROUND
+	MOVQ 24(DI), DX
+	MUL_ROUND
+	REDUCE_ROUND
+
+	// z = t - p if t >= p, else t.
+	MOVQ    R8, AX
+	MOVQ    R9, BX
+	MOVQ    R10, CX
+	MOVQ    R11, DX
+	SUBQ    0(R14), AX
+	SBBQ    8(R14), BX
+	SBBQ    16(R14), CX
+	SBBQ    24(R14), DX
+	CMOVQCC AX, R8
+	CMOVQCC BX, R9
+	CMOVQCC CX, R10
+	CMOVQCC DX, R11
+
+	MOVQ z+0(FP), DI
+	MOVQ R8, 0(DI)
+	MOVQ R9, 8(DI)
+	MOVQ R10, 16(DI)
+	MOVQ R11, 24(DI)
+	RET
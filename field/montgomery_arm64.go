@@ -0,0 +1,21 @@
+//go:build !purego && !tinygo
+
+package field
+
+// mulARM64 sets z = x*y*R^-1 mod p for a modulus with the noCarry property.
+//
+//go:noescape
+func mulARM64(z, x, y, p *[Limbs]uint64, inv uint64)
+
+// mul sets z = x*y*R^-1 mod p.
+func (m *montgomery) mul(z, x, y *[Limbs]uint64) {
+	if m.noCarry {
+		mulARM64(z, x, y, &m.p, m.inv)
+		return
+	}
+	m.mulGeneric(z, x, y)
+}
+
+// preferBarrett reports whether FiniteField should reduce single products by
+// Barrett reduction rather than through Montgomery form.
+func preferBarrett() bool { return false }
@@ -0,0 +1,81 @@
+//go:build !purego && !tinygo
+
+#include "textflag.h"
+
+// Montgomery multiplication on four limbs with MUL/UMULH and the single
+// carry flag of ADDS/ADC. Each round interleaves the two CIOS chains: A
+// carries x*y[i] into t and C carries q*p with q = t0*inv, writing t shifted
+// down by one word. Because the top limb of p is below 2^63-1, C+A cannot
+// overflow, so no fifth word is needed (the "no-carry" variant).
+//
+// Registers: x in R4-R7, p in R8-R11, inv in R12, t in R13-R16, y[i] in R17,
+// lo/hi in R19/R20, A in R21, C in R22, q in R23.
+
+// STEP adds xj*y[i] + A to tj, and then tj + q*pj + C into tk (k = j-1).
+#define STEP(xj, pj, tj, tk) \
+	MUL   xj, R17, R19;    \
+	UMULH xj, R17, R20;    \
+	ADDS  R19, tj, tj;     \
+	ADC   ZR, R20, R20;    \
+	ADDS  R21, tj, tj;     \
+	ADC   ZR, R20, R21;    \
+	MUL   R23, pj, R19;    \
+	UMULH R23, pj, R20;    \
+	ADDS  R19, tj, R19;    \
+	ADC   ZR, R20, R20;    \
+	ADDS  R22, R19, tk;    \
+	ADC   ZR, R20, R22
+
+// ROUND performs one CIOS round for the word of y at offset off.
+#define ROUND(off) \
+	MOVD  off(R2), R17;    \
+	MUL   R4, R17, R19;    \
+	UMULH R4, R17, R21;    \
+	ADDS  R19, R13, R13;   \
+	ADC   ZR, R21, R21;    \
+	MUL   R12, R13, R23;   \
+	MUL   R23, R8, R19;    \
+	UMULH R23, R8, R22;    \
+	ADDS  R19, R13, R19;   \
+	ADC   ZR, R22, R22;    \
+	STEP(R5, R9, R14, R13); \
+	STEP(R6, R10, R15, R14); \
+	STEP(R7, R11, R16, R15); \
+	ADD   R21, R22, R16
+
+// func mulARM64(z, x, y, p *[4]uint64, inv uint64)
+TEXT ·mulARM64(SB), NOSPLIT, $0-40
+	MOVD x+8(FP), R1
+	MOVD y+16(FP), R2
+	MOVD p+24(FP), R3
+	MOVD inv+32(FP), R12
+
+	LDP 0(R1), (R4, R5)
+	LDP 16(R1), (R6, R7)
+	LDP 0(R3), (R8, R9)
+	LDP 16(R3), (R10, R11)
+
+	MOVD ZR, R13
+	MOVD ZR, R14
+	MOVD ZR, R15
+	MOVD ZR, R16
+
+	ROUND(0)
+	ROUND(8)
+	ROUND(16)
+	ROUND(24)
+
+	// z = t - p if t >= p, else t.
+	SUBS R8, R13, R19
+	SBCS R9, R14, R20
+	SBCS R10, R15, R21
+	SBCS R11, R16, R22
+	CSEL CS, R19, R13, R13
+	CSEL CS, R20, R14, R14
+	CSEL CS, R21, R15, R15
+	CSEL CS, R22, R16, R16
+
+	MOVD z+0(FP), R0
+	STP  (R13, R14), 0(R0)
+	STP  (R15, R16), 16(R0)
+	RET
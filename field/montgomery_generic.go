@@ -0,0 +1,12 @@
+//go:build purego || tinygo || !(amd64 || arm64)
+
+package field
+
+// mul sets z = x*y*R^-1 mod p.
+func (m *montgomery) mul(z, x, y *[Limbs]uint64) {
+	m.mulGeneric(z, x, y)
+}
+
+// preferBarrett reports whether FiniteField should reduce single products by
+// Barrett reduction rather than through Montgomery form.
+func preferBarrett() bool { return true }
@@ -0,0 +1,71 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestMulMatchesGeneric checks the architecture-specific mul against the
+// portable CIOS implementation. Under the purego tag both are the same code.
+func TestMulMatchesGeneric(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for _, m := range []*montgomery{montFp, montFq} {
+		if !m.noCarry {
+			t.Fatalf("modulus %s should satisfy the no-carry condition", m.modulus)
+		}
+		edges := []*big.Int{
+			big.NewInt(0),
+			big.NewInt(1),
+			new(big.Int).Sub(m.modulus, big.NewInt(1)),
+			new(big.Int).Sub(m.modulus, big.NewInt(2)),
+			new(big.Int).Rsh(m.modulus, 1),
+		}
+		inputs := append([]*big.Int{}, edges...)
+		for i := 0; i < 2000; i++ {
+			inputs = append(inputs, randomBelow(r, m.modulus))
+		}
+		for i, a := range inputs {
+			x := limbsOf(a)
+			for _, b := range append(edges, inputs[(i+1)%len(inputs)]) {
+				y := limbsOf(b)
+				var got, want [Limbs]uint64
+				m.mul(&got, &x, &y)
+				m.mulGeneric(&want, &x, &y)
+				if got != want {
+					t.Fatalf("mul(%s, %s) = %x, want %x", a, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestMulAliasing(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	m := montFp
+	for i := 0; i < 100; i++ {
+		x := limbsOf(randomBelow(r, m.modulus))
+		var want [Limbs]uint64
+		m.mulGeneric(&want, &x, &x)
+		m.mul(&x, &x, &x)
+		if x != want {
+			t.Fatalf("in-place square = %x, want %x", x, want)
+		}
+	}
+}
+
+func BenchmarkMontgomeryMul(b *testing.B) {
+	r := rand.New(rand.NewSource(5))
+	x := limbsOf(randomBelow(r, P))
+	y := limbsOf(randomBelow(r, P))
+	b.Run("dispatch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			montFp.mul(&x, &x, &y)
+		}
+	})
+	b.Run("generic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			montFp.mulGeneric(&x, &x, &y)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package field
+
+import (
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// twiddles holds ω^i and ω^-i for i < n/2, computed on the first transform
+// over a domain.
+type twiddles struct {
+	once    sync.Once
+	forward []*big.Int
+	inverse []*big.Int
+}
+
+func (d *Domain) twiddleTables() *twiddles {
+	d.tw.once.Do(func() {
+		half := d.Size / 2
+		d.tw.forward = make([]*big.Int, half)
+		d.tw.inverse = make([]*big.Int, half)
+		w, wInv := big.NewInt(1), big.NewInt(1)
+		for i := uint64(0); i < half; i++ {
+			d.tw.forward[i], d.tw.inverse[i] = w, wInv
+			w = d.Field.Mul(w, d.Generator)
+			wInv = d.Field.Mul(wInv, d.GeneratorInv)
+		}
+	})
+	return &d.tw
+}
+
+// NTT evaluates the polynomial with the given coefficients, lowest degree
+// first, at every element of the domain and returns the evaluations in the
+// order of Elements. Fewer than Size coefficients are padded with zeros.
+func (d *Domain) NTT(coeffs []*big.Int) ([]*big.Int, error) {
+	return d.transform(coeffs, false)
+}
+
+// InverseNTT interpolates evaluations over the domain, given in the order of
+// Elements, back to coefficients, lowest degree first.
+func (d *Domain) InverseNTT(evals []*big.Int) ([]*big.Int, error) {
+	out, err := d.transform(evals, true)
+	if err != nil {
+		return nil, err
+	}
+	for i := range out {
+		out[i] = d.Field.Mul(out[i], d.SizeInv)
+	}
+	return out, nil
+}
+
+// transform is an iterative radix-2 Cooley-Tukey FFT on a bit-reversed copy
+// of values.
+func (d *Domain) transform(values []*big.Int, inverse bool) ([]*big.Int, error) {
+	if uint64(len(values)) > d.Size {
+		return nil, errcode.Errorf(errcode.OutOfRange, "field: %d values do not fit a domain of size %d", len(values), d.Size)
+	}
+	f := d.Field
+	n := d.Size
+	out := make([]*big.Int, n)
+	for i := uint64(0); i < n; i++ {
+		v := big.NewInt(0)
+		if i < uint64(len(values)) {
+			if values[i] == nil {
+				return nil, errcode.Errorf(errcode.MissingValue, "field: value %d is nil", i)
+			}
+			v = f.Mod(values[i])
+		}
+		out[bitReverse(i, d.LogSize)] = v
+	}
+
+	tw := d.twiddleTables().forward
+	if inverse {
+		tw = d.twiddleTables().inverse
+	}
+	for size := uint64(2); size <= n; size <<= 1 {
+		half, step := size/2, n/size
+		for start := uint64(0); start < n; start += size {
+			for j := uint64(0); j < half; j++ {
+				u := out[start+j]
+				v := f.Mul(out[start+j+half], tw[j*step])
+				out[start+j] = f.Add(u, v)
+				out[start+j+half] = f.Sub(u, v)
+			}
+		}
+	}
+	return out, nil
+}
+
+// bitReverse reverses the low logN bits of i.
+func bitReverse(i uint64, logN uint) uint64 {
+	if logN == 0 {
+		return 0
+	}
+	return bits.Reverse64(i) >> (64 - logN)
+}
@@ -0,0 +1,79 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// evaluate computes the polynomial with coefficients coeffs at x.
+func evaluate(f *FiniteField, coeffs []*big.Int, x *big.Int) *big.Int {
+	acc := big.NewInt(0)
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		acc = f.Add(f.Mul(acc, x), coeffs[i])
+	}
+	return acc
+}
+
+func TestNTT(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	for _, f := range []*FiniteField{Fp, Fq} {
+		for _, size := range []uint64{1, 2, 16} {
+			d, err := f.NewDomain(size)
+			if err != nil {
+				t.Fatal(err)
+			}
+			coeffs := make([]*big.Int, size)
+			for i := range coeffs {
+				coeffs[i] = randomBelow(r, f.Modulus)
+			}
+			evals, err := d.NTT(coeffs)
+			if err != nil {
+				t.Fatalf("NTT failed: %v", err)
+			}
+			for i, x := range d.Elements() {
+				if evals[i].Cmp(evaluate(f, coeffs, x)) != 0 {
+					t.Fatalf("size %d: NTT value %d does not match direct evaluation", size, i)
+				}
+			}
+			back, err := d.InverseNTT(evals)
+			if err != nil {
+				t.Fatalf("InverseNTT failed: %v", err)
+			}
+			for i := range coeffs {
+				if back[i].Cmp(coeffs[i]) != 0 {
+					t.Fatalf("size %d: InverseNTT did not recover coefficient %d", size, i)
+				}
+			}
+		}
+	}
+
+	d, _ := Fp.NewDomain(4)
+	evals, err := d.NTT([]*big.Int{big.NewInt(7)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range evals {
+		if v.Int64() != 7 {
+			t.Errorf("constant polynomial evaluates to %s at point %d", v, i)
+		}
+	}
+	if _, err := d.NTT(make([]*big.Int, 5)); err == nil {
+		t.Error("NTT accepted more values than the domain size")
+	}
+}
+
+func BenchmarkNTT(b *testing.B) {
+	d, _ := Fp.NewDomain(1 << 10)
+	r := rand.New(rand.NewSource(7))
+	coeffs := make([]*big.Int, d.Size)
+	for i := range coeffs {
+		coeffs[i] = randomBelow(r, P)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := d.NTT(coeffs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package field
+
+import (
+	"math/big"
+	"sync"
+)
+
+// intPool recycles the scratch integers of the in-place field operations, such
+// as the quotient of a reduction, so that loops built on the Into variants do
+// not allocate per operation.
+var intPool = sync.Pool{
+	New: func() any { return new(big.Int) },
+}
+
+func getInt() *big.Int  { return intPool.Get().(*big.Int) }
+func putInt(x *big.Int) { intPool.Put(x) }
+
+// reduceInto sets z to z mod p in [0, p) and returns z. Values already in
+// [0, 2p), the common case after adding two reduced operands, need a single
+// subtraction; anything else goes through a division with a pooled quotient.
+func reduceInto(z, p *big.Int) *big.Int {
+	if z.Sign() >= 0 {
+		if z.Cmp(p) < 0 {
+			return z
+		}
+		z.Sub(z, p)
+		if z.Cmp(p) < 0 {
+			return z
+		}
+	}
+	q := getInt()
+	q.QuoRem(z, p, z)
+	putInt(q)
+	if z.Sign() < 0 {
+		z.Add(z, p)
+	}
+	return z
+}
@@ -0,0 +1,61 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestIntoMatchesAllocating(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	small := ForModulus(big.NewInt(97))
+	for _, f := range []*FiniteField{Fp, Fq, small} {
+		p := f.Modulus
+		inputs := []*big.Int{big.NewInt(0), big.NewInt(1), new(big.Int).Sub(p, big.NewInt(1)), big.NewInt(-5), new(big.Int).Lsh(p, 3)}
+		for i := 0; i < 20; i++ {
+			inputs = append(inputs, randomBelow(r, p))
+		}
+		seven := big.NewInt(7)
+		for _, x := range inputs {
+			for _, y := range inputs[:8] {
+				cases := []struct {
+					name      string
+					got, want *big.Int
+				}{
+					{"AddInto", f.AddInto(new(big.Int), x, y), f.Add(x, y)},
+					{"SubInto", f.SubInto(new(big.Int), x, y), f.Sub(x, y)},
+					{"MulInto", f.MulInto(new(big.Int), x, y), f.Mul(x, y)},
+					{"SquareInto", f.SquareInto(new(big.Int), x), f.Square(x)},
+					{"PowerInto", f.PowerInto(new(big.Int), x, seven), f.Power(x, seven)},
+				}
+				for _, c := range cases {
+					if c.got.Cmp(c.want) != 0 {
+						t.Fatalf("mod %s: %s(%s, %s) = %s, want %s", p, c.name, x, y, c.got, c.want)
+					}
+				}
+				// dst aliasing an operand.
+				z := new(big.Int).Set(x)
+				if f.MulInto(z, z, y).Cmp(f.Mul(x, y)) != 0 {
+					t.Fatalf("mod %s: aliased MulInto(%s, %s) = %s", p, x, y, z)
+				}
+			}
+		}
+	}
+}
+
+func TestIntoDoesNotAllocate(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	x, y := randomBelow(r, P), randomBelow(r, P)
+	dst := new(big.Int).Lsh(big.NewInt(1), 300)
+	seven := big.NewInt(7)
+	allocs := testing.AllocsPerRun(100, func() {
+		Fp.MulInto(dst, x, y)
+		Fp.SquareInto(dst, dst)
+		Fp.PowerInto(dst, dst, seven)
+		Fp.AddInto(dst, dst, x)
+		Fp.SubInto(dst, dst, y)
+	})
+	if allocs != 0 {
+		t.Errorf("Into operations allocated %v times per run, want 0", allocs)
+	}
+}
@@ -0,0 +1,41 @@
+package field
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("entropy exhausted") }
+
+func TestRandomFrom(t *testing.T) {
+	for _, f := range []*FiniteField{Fp, Fq} {
+		for i := 0; i < 20; i++ {
+			x, err := f.RandomFrom(rand.Reader)
+			if err != nil {
+				t.Fatalf("RandomFrom failed: %v", err)
+			}
+			if x.Sign() < 0 || x.Cmp(f.Modulus) >= 0 {
+				t.Fatalf("RandomFrom returned %s, out of range", x)
+			}
+		}
+		if x := f.Random(); x.Cmp(f.Modulus) >= 0 {
+			t.Fatalf("Random returned %s, out of range", x)
+		}
+	}
+
+	if _, err := Fp.RandomFrom(failingReader{}); err == nil {
+		t.Error("RandomFrom ignored a reader error")
+	}
+	if _, err := Fp.RandomFrom(bytes.NewReader(make([]byte, 8))); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("RandomFrom on a short reader = %v, want io.ErrUnexpectedEOF", err)
+	}
+	ones := bytes.NewReader(bytes.Repeat([]byte{0xff}, 32*maxRandomAttempts))
+	if _, err := Fp.RandomFrom(ones); err == nil {
+		t.Error("RandomFrom accepted a reader that only yields out-of-range values")
+	}
+}
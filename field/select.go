@@ -0,0 +1,61 @@
+package field
+
+import "math/big"
+
+// Constant-time selection. The big.Int variants go through fixed-width
+// four-limb copies so the choice itself is made with masks rather than
+// branches; big.Int storage is still normalized afterwards, so they hide the
+// flag but not the magnitude of the values. The Element variants are
+// constant-time throughout.
+
+// ctMask returns all ones for flag == 1 and zero for flag == 0.
+func ctMask(flag int) uint64 {
+	return -uint64(flag & 1)
+}
+
+// Select returns a new integer equal to a if flag == 1 and to b if
+// flag == 0. a and b must be non-negative and below 2^256, which holds for
+// any canonical Fp or Fq value. flag must be 0 or 1.
+func Select(flag int, a, b *big.Int) *big.Int {
+	al, bl := limbsOf(a), limbsOf(b)
+	ctSelect(&al, ctMask(flag), &al, &bl)
+	return bigOf(&al)
+}
+
+// Swap exchanges the values of a and b if flag == 1 and leaves them
+// unchanged if flag == 0, with the same restrictions as Select.
+func Swap(flag int, a, b *big.Int) {
+	al, bl := limbsOf(a), limbsOf(b)
+	ctSwap(ctMask(flag), &al, &bl)
+	setLimbs(a, &al)
+	setLimbs(b, &bl)
+}
+
+// ctSelect sets z = x where mask is all ones and z = y where it is zero.
+func ctSelect(z *[Limbs]uint64, mask uint64, x, y *[Limbs]uint64) {
+	for i := range z {
+		z[i] = y[i] ^ (mask & (x[i] ^ y[i]))
+	}
+}
+
+// ctSwap exchanges x and y where mask is all ones.
+func ctSwap(mask uint64, x, y *[Limbs]uint64) {
+	for i := range x {
+		t := mask & (x[i] ^ y[i])
+		x[i] ^= t
+		y[i] ^= t
+	}
+}
+
+// Select sets z to a if flag == 1 and to b if flag == 0, in constant time.
+// flag must be 0 or 1.
+func (z *Element[F]) Select(flag int, a, b *Element[F]) *Element[F] {
+	ctSelect(z.limbs(), ctMask(flag), a.limbs(), b.limbs())
+	return z
+}
+
+// Swap exchanges the values of z and x if flag == 1, in constant time. flag
+// must be 0 or 1.
+func (z *Element[F]) Swap(flag int, x *Element[F]) {
+	ctSwap(ctMask(flag), z.limbs(), x.limbs())
+}
@@ -0,0 +1,43 @@
+package field
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSelectAndSwap(t *testing.T) {
+	a := new(big.Int).Sub(P, big.NewInt(1))
+	b := big.NewInt(12345)
+	if got := Select(1, a, b); got.Cmp(a) != 0 || got == a {
+		t.Errorf("Select(1) = %s, want a copy of %s", got, a)
+	}
+	if got := Select(0, a, b); got.Cmp(b) != 0 || got == b {
+		t.Errorf("Select(0) = %s, want a copy of %s", got, b)
+	}
+
+	x, y := new(big.Int).Set(a), new(big.Int).Set(b)
+	Swap(0, x, y)
+	if x.Cmp(a) != 0 || y.Cmp(b) != 0 {
+		t.Error("Swap(0) changed its arguments")
+	}
+	Swap(1, x, y)
+	if x.Cmp(b) != 0 || y.Cmp(a) != 0 {
+		t.Errorf("Swap(1) = (%s, %s), want (%s, %s)", x, y, b, a)
+	}
+
+	var ea, eb, z FpElement
+	ea.SetBigInt(a)
+	eb.SetBigInt(b)
+	if !z.Select(1, &ea, &eb).Equal(&ea) || !z.Select(0, &ea, &eb).Equal(&eb) {
+		t.Error("Element.Select picked the wrong operand")
+	}
+	ex, ey := ea, eb
+	ex.Swap(0, &ey)
+	if !ex.Equal(&ea) || !ey.Equal(&eb) {
+		t.Error("Element.Swap(0) changed its arguments")
+	}
+	ex.Swap(1, &ey)
+	if !ex.Equal(&eb) || !ey.Equal(&ea) {
+		t.Error("Element.Swap(1) did not exchange its arguments")
+	}
+}
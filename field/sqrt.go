@@ -0,0 +1,80 @@
+package field
+
+import (
+	"math/big"
+)
+
+// tonelliShanks holds the per-field precomputation for square roots modulo
+// p = oddFactor·2^twoadicity + 1. rootPowers[j] is root^(2^j) for the
+// primitive 2^twoadicity-th root of unity root, so every step of the
+// algorithm looks up its correction factor instead of exponentiating.
+type tonelliShanks struct {
+	p          *big.Int
+	halfOdd    *big.Int // (oddFactor-1)/2
+	twoadicity int
+	rootPowers []*big.Int
+	mul        func(x, y *big.Int) *big.Int
+	pow        func(x, n *big.Int) *big.Int
+}
+
+func newTonelliShanks(p, oddFactor, root *big.Int, twoadicity int, mul func(x, y *big.Int) *big.Int, pow func(x, n *big.Int) *big.Int) *tonelliShanks {
+	ts := &tonelliShanks{
+		p:          p,
+		halfOdd:    new(big.Int).Rsh(oddFactor, 1),
+		twoadicity: twoadicity,
+		rootPowers: make([]*big.Int, twoadicity+1),
+		mul:        mul,
+		pow:        pow,
+	}
+	ts.rootPowers[0] = Mod(root, p)
+	for j := 1; j <= twoadicity; j++ {
+		ts.rootPowers[j] = mul(ts.rootPowers[j-1], ts.rootPowers[j-1])
+	}
+	return ts
+}
+
+// sqrt returns a square root of n, or nil if n is not a square.
+func (ts *tonelliShanks) sqrt(n *big.Int) *big.Int {
+	n = Mod(n, ts.p)
+	if n.Sign() == 0 {
+		return big.NewInt(0)
+	}
+	// t = n^((Q-1)/2), R = n^((Q+1)/2), t = n^Q
+	t := ts.pow(n, ts.halfOdd)
+	R := ts.mul(t, n)
+	t = ts.mul(t, R)
+
+	M := ts.twoadicity
+	for t.Cmp(one) != 0 {
+		// Find the least i with t^(2^i) = 1.
+		i := 0
+		for s := t; s.Cmp(one) != 0; s = ts.mul(s, s) {
+			i++
+			if i == M {
+				return nil
+			}
+		}
+		// b = c^(2^(M-i-1)) for the current c = root^(2^(twoadicity-M)).
+		b := ts.rootPowers[ts.twoadicity-i-1]
+		c := ts.rootPowers[ts.twoadicity-i]
+		t = ts.mul(t, c)
+		R = ts.mul(R, b)
+		M = i
+	}
+	return R
+}
+
+// sqrtRatio implements SqrtRatio with nonResidue as the constant Z.
+func (ts *tonelliShanks) sqrtRatio(u, v *big.Int) (bool, *big.Int) {
+	vInv := Inverse(v, ts.p)
+	if vInv == nil {
+		return false, nil
+	}
+	r := ts.mul(u, vInv)
+	if y := ts.sqrt(r); y != nil {
+		return true, y
+	}
+	return false, ts.sqrt(ts.mul(ts.rootPowers[0], r))
+}
+
+var one = big.NewInt(1)
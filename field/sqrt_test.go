@@ -0,0 +1,63 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestSqrt(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	for _, f := range []*FiniteField{Fp, Fq} {
+		for i := 0; i < 50; i++ {
+			x := randomBelow(r, f.Modulus)
+			sq := f.Square(x)
+			y := f.Sqrt(sq)
+			if y == nil || f.Square(y).Cmp(sq) != 0 {
+				t.Fatalf("Sqrt(%s^2) = %v", x, y)
+			}
+			// The uncached function must agree and leave its arguments alone.
+			oddFactor := new(big.Int).Set(f.T)
+			if z := Sqrt(sq, f.Modulus, f.T, f.TwoadicRoot, f.M); z == nil || z.Cmp(y) != 0 {
+				t.Fatalf("Sqrt and FiniteField.Sqrt disagree for %s", sq)
+			}
+			if f.T.Cmp(oddFactor) != 0 {
+				t.Fatal("Sqrt modified its Q argument")
+			}
+		}
+		nonSquare := f.Mul(f.TwoadicRoot, f.Square(big.NewInt(12345)))
+		if f.Sqrt(nonSquare) != nil {
+			t.Error("Sqrt returned a root of a non-square")
+		}
+		if f.Sqrt(big.NewInt(0)).Sign() != 0 {
+			t.Error("Sqrt(0) != 0")
+		}
+	}
+}
+
+func TestSqrtRatio(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for _, f := range []*FiniteField{Fp, Fq} {
+		for i := 0; i < 50; i++ {
+			u, v := randomBelow(r, f.Modulus), randomBelow(r, f.Modulus)
+			if v.Sign() == 0 {
+				continue
+			}
+			ratio := f.Mul(u, f.Inverse(v))
+			isSquare, y := f.SqrtRatio(u, v)
+			if isSquare != f.IsSquare(ratio) {
+				t.Fatalf("SqrtRatio(%s, %s) reported isSquare = %v", u, v, isSquare)
+			}
+			want := ratio
+			if !isSquare {
+				want = f.Mul(f.TwoadicRoot, ratio)
+			}
+			if f.Square(y).Cmp(want) != 0 {
+				t.Fatalf("SqrtRatio(%s, %s) returned a wrong root", u, v)
+			}
+		}
+		if ok, y := f.SqrtRatio(big.NewInt(1), big.NewInt(0)); ok || y != nil {
+			t.Error("SqrtRatio accepted v = 0")
+		}
+	}
+}
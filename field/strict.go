@@ -0,0 +1,81 @@
+package field
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync/atomic"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// strictDecoding is the package-level decoding mode.
+var strictDecoding atomic.Bool
+
+// SetStrictDecoding selects strict decoding for every decoder of this
+// module that is not given a mode per call, including the UnmarshalJSON
+// and UnmarshalBytes methods, which have no room for one. It is safe to
+// call concurrently with decoding.
+//
+// All decoders reject values that are not below their modulus. Strict
+// decoding also rejects every encoding other than the one the module
+// writes, so that each value has exactly one accepted form:
+//
+//   - decimal strings with a sign, leading zeros or other digit forms;
+//   - hex strings other than "0x" and the full-width lowercase digits of
+//     FiniteField.ToHex;
+//   - JSON objects with unknown or repeated members or a missing
+//     coordinate;
+//   - upper-case digits in the hex formats of other packages.
+//
+// Byte and base58 encodings have fixed widths and flag values and are
+// always decoded strictly.
+func SetStrictDecoding(on bool) {
+	strictDecoding.Store(on)
+}
+
+// DecodeOption sets the decoding mode of one call, overriding
+// SetStrictDecoding.
+type DecodeOption func(strict *bool)
+
+// WithStrictDecoding selects strict or lenient decoding for one call.
+func WithStrictDecoding(on bool) DecodeOption {
+	return func(strict *bool) { *strict = on }
+}
+
+// IsStrict reports whether a decoder given opts decodes strictly: the
+// package-level mode, as changed by opts in order.
+func IsStrict(opts ...DecodeOption) bool {
+	strict := strictDecoding.Load()
+	for _, opt := range opts {
+		opt(&strict)
+	}
+	return strict
+}
+
+// ParseInt parses s as an integer in base, as big.Int.SetString does. In
+// strict mode it accepts only the form big.Int.Text writes: no sign, no
+// leading zeros, lowercase digits, and for base 0 no prefix other than
+// "0x". It does not check the range; see FiniteField.FromString.
+func ParseInt(s string, base int, opts ...DecodeOption) (*big.Int, error) {
+	x, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "field: invalid integer %q", s)
+	}
+	if IsStrict(opts...) && s != canonicalText(x, s, base) {
+		return nil, fmt.Errorf("%w: %q is not in minimal form", ErrNonCanonical, s)
+	}
+	return x, nil
+}
+
+// canonicalText returns the form of x that strict decoding accepts for s
+// in base.
+func canonicalText(x *big.Int, s string, base int) string {
+	if base == 0 {
+		if strings.HasPrefix(s, "0x") {
+			return "0x" + x.Text(16)
+		}
+		base = 10
+	}
+	return x.Text(base)
+}
@@ -0,0 +1,59 @@
+package field
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStrictDecoding(t *testing.T) {
+	t.Cleanup(func() { SetStrictDecoding(false) })
+	lenientOnly := []struct {
+		s    string
+		base int
+	}{
+		{"007", 10},
+		{"+7", 10},
+		{"-0", 10},
+		{"0X1f", 0},
+		{"0x1F", 0},
+		{"0x1_f", 0},
+		{"0b101", 0},
+	}
+	for _, c := range lenientOnly {
+		if _, err := ParseInt(c.s, c.base); err != nil {
+			t.Errorf("lenient ParseInt(%q, %d): %v", c.s, c.base, err)
+		}
+		if _, err := ParseInt(c.s, c.base, WithStrictDecoding(true)); !errors.Is(err, ErrNonCanonical) {
+			t.Errorf("strict ParseInt(%q, %d) = %v, want ErrNonCanonical", c.s, c.base, err)
+		}
+	}
+	for _, s := range []string{"0", "7", "123456789"} {
+		if _, err := Fp.FromString(s, 10, WithStrictDecoding(true)); err != nil {
+			t.Errorf("strict FromString(%q): %v", s, err)
+		}
+	}
+
+	full := Fp.ToHex(Fp.Mod(P))
+	for _, s := range []string{"0x2a", "0X" + full[2:], "0x" + full[2:len(full)-1] + "A"} {
+		if _, err := Fp.FromHex(s); err != nil {
+			t.Errorf("lenient FromHex(%q): %v", s, err)
+		}
+		if _, err := Fp.FromHex(s, WithStrictDecoding(true)); !errors.Is(err, ErrNonCanonical) {
+			t.Errorf("strict FromHex(%q) = %v, want ErrNonCanonical", s, err)
+		}
+	}
+	if _, err := Fp.FromHex(full, WithStrictDecoding(true)); err != nil {
+		t.Errorf("strict FromHex(ToHex output): %v", err)
+	}
+
+	SetStrictDecoding(true)
+	if !IsStrict() || IsStrict(WithStrictDecoding(false)) {
+		t.Error("IsStrict does not follow the package mode and its override")
+	}
+	if _, err := new(FpElement).SetString("0042"); !errors.Is(err, ErrNonCanonical) {
+		t.Errorf("SetString in strict mode = %v, want ErrNonCanonical", err)
+	}
+	if _, err := new(FpElement).SetString("0042", WithStrictDecoding(false)); err != nil {
+		t.Errorf("SetString with a lenient override: %v", err)
+	}
+}
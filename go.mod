@@ -2,10 +2,12 @@ module github.com/node101-io/mina-signer-go
 
 go 1.23.5
 
-require golang.org/x/crypto v0.38.0
-
 require (
-	github.com/decred/base58 v1.0.5 // indirect
-	github.com/decred/dcrd/crypto/blake256 v1.0.1 // indirect
-	golang.org/x/sys v0.33.0 // indirect
+	github.com/decred/base58 v1.0.5
+	github.com/miekg/pkcs11 v1.1.2
+	golang.org/x/crypto v0.38.0
+	golang.org/x/sys v0.33.0
+	google.golang.org/protobuf v1.36.9
 )
+
+require github.com/decred/dcrd/crypto/blake256 v1.0.1 // indirect
@@ -0,0 +1,269 @@
+// Package graphql broadcasts signed commands through the GraphQL API of a
+// Mina node, with the sendPayment, sendDelegation and sendZkapp mutations.
+//
+// Client only submits commands the caller has already signed, for example
+// with a signer.Client, and returns the id and hash the node assigns to
+// them. Errors reported by the node come back as *Error.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// MaxResponseSize bounds the response body Client reads.
+const MaxResponseSize = 1 << 20
+
+const sendPaymentMutation = `mutation($input: SendPaymentInput!, $signature: SignatureInput) {
+  sendPayment(input: $input, signature: $signature) { payment { id hash } }
+}`
+
+const sendDelegationMutation = `mutation($input: SendDelegationInput!, $signature: SignatureInput) {
+  sendDelegation(input: $input, signature: $signature) { delegation { id hash } }
+}`
+
+const sendZkappMutation = `mutation($input: SendZkappInput!) {
+  sendZkapp(input: $input) { zkapp { id hash } }
+}`
+
+// Result identifies a command accepted into the node's transaction pool.
+type Result struct {
+	// ID is the node's base64 encoding of the command.
+	ID string `json:"id"`
+	// Hash is the transaction hash, as shown by explorers.
+	Hash string `json:"hash"`
+}
+
+// Error holds the errors a node returned for a request.
+type Error struct {
+	Messages []string
+}
+
+func (e *Error) Error() string {
+	return "graphql: " + strings.Join(e.Messages, "; ")
+}
+
+// Client submits commands to one GraphQL endpoint.
+type Client struct {
+	endpoint string
+	http     *http.Client
+}
+
+// NewClient returns a Client for endpoint, e.g.
+// "http://localhost:3085/graphql". A nil httpClient means
+// http.DefaultClient.
+func NewClient(endpoint string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{endpoint: endpoint, http: httpClient}
+}
+
+// signatureInput is the SignatureInput of the node's schema.
+type signatureInput struct {
+	Field  string `json:"field"`
+	Scalar string `json:"scalar"`
+}
+
+// paymentInput is the SendPaymentInput of the node's schema, whose UInt64
+// and UInt32 scalars are decimal strings.
+type paymentInput struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Amount     string `json:"amount"`
+	Fee        string `json:"fee"`
+	Nonce      string `json:"nonce"`
+	ValidUntil string `json:"validUntil"`
+	Memo       string `json:"memo"`
+}
+
+// delegationInput is the SendDelegationInput of the node's schema.
+type delegationInput struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Fee        string `json:"fee"`
+	Nonce      string `json:"nonce"`
+	ValidUntil string `json:"validUntil"`
+	Memo       string `json:"memo"`
+}
+
+// SendPayment submits p with its signature.
+func (c *Client) SendPayment(ctx context.Context, p transaction.Payment, sig *signature.Signature) (Result, error) {
+	s, err := newSignatureInput(sig)
+	if err != nil {
+		return Result{}, err
+	}
+	from, to, err := addresses(p.From, p.To)
+	if err != nil {
+		return Result{}, err
+	}
+	input := paymentInput{
+		From: from, To: to,
+		Amount:     strconv.FormatUint(p.Amount, 10),
+		Fee:        strconv.FormatUint(p.Fee, 10),
+		Nonce:      strconv.FormatUint(uint64(p.Nonce), 10),
+		ValidUntil: strconv.FormatUint(uint64(validUntil(p.ValidUntil)), 10),
+		Memo:       p.Memo,
+	}
+	var data struct {
+		SendPayment struct {
+			Payment Result `json:"payment"`
+		} `json:"sendPayment"`
+	}
+	vars := map[string]any{"input": input, "signature": s}
+	if err := c.do(ctx, sendPaymentMutation, vars, &data); err != nil {
+		return Result{}, err
+	}
+	return data.SendPayment.Payment, nil
+}
+
+// SendStakeDelegation submits d with its signature.
+func (c *Client) SendStakeDelegation(ctx context.Context, d transaction.StakeDelegation, sig *signature.Signature) (Result, error) {
+	s, err := newSignatureInput(sig)
+	if err != nil {
+		return Result{}, err
+	}
+	from, to, err := addresses(d.From, d.To)
+	if err != nil {
+		return Result{}, err
+	}
+	input := delegationInput{
+		From: from, To: to,
+		Fee:        strconv.FormatUint(d.Fee, 10),
+		Nonce:      strconv.FormatUint(uint64(d.Nonce), 10),
+		ValidUntil: strconv.FormatUint(uint64(validUntil(d.ValidUntil)), 10),
+		Memo:       d.Memo,
+	}
+	var data struct {
+		SendDelegation struct {
+			Delegation Result `json:"delegation"`
+		} `json:"sendDelegation"`
+	}
+	vars := map[string]any{"input": input, "signature": s}
+	if err := c.do(ctx, sendDelegationMutation, vars, &data); err != nil {
+		return Result{}, err
+	}
+	return data.SendDelegation.Delegation, nil
+}
+
+// SendSigned submits the result of signer.Client.SignTransaction.
+func (c *Client) SendSigned(ctx context.Context, signed *signer.Signed[transaction.Command]) (Result, error) {
+	if signed == nil {
+		return Result{}, errcode.New(errcode.MissingValue, "graphql: nil signed command")
+	}
+	switch tx := signed.Data.(type) {
+	case transaction.Payment:
+		return c.SendPayment(ctx, tx, signed.Signature)
+	case transaction.StakeDelegation:
+		return c.SendStakeDelegation(ctx, tx, signed.Signature)
+	default:
+		return Result{}, errcode.Errorf(errcode.Unsupported, "graphql: unsupported command %T", signed.Data)
+	}
+}
+
+// SendZkapp submits a signed zkApp command given in the JSON form o1js
+// produces with toJSON, which the node accepts as its ZkappCommandInput.
+func (c *Client) SendZkapp(ctx context.Context, zkappCommand json.RawMessage) (Result, error) {
+	if !json.Valid(zkappCommand) {
+		return Result{}, errcode.New(errcode.InvalidEncoding, "graphql: zkApp command is not valid JSON")
+	}
+	var data struct {
+		SendZkapp struct {
+			Zkapp Result `json:"zkapp"`
+		} `json:"sendZkapp"`
+	}
+	vars := map[string]any{"input": map[string]json.RawMessage{"zkappCommand": zkappCommand}}
+	if err := c.do(ctx, sendZkappMutation, vars, &data); err != nil {
+		return Result{}, err
+	}
+	return data.SendZkapp.Zkapp, nil
+}
+
+// do posts query with vars and decodes the data of the response into data.
+func (c *Client) do(ctx context.Context, query string, vars map[string]any, data any) error {
+	body, err := json.Marshal(map[string]any{"query": query, "variables": vars})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, MaxResponseSize))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return errcode.Errorf(errcode.Upstream, "graphql: %s", resp.Status)
+		}
+		return errcode.Errorf(errcode.Upstream, "graphql: decoding response: %w", err)
+	}
+	if len(out.Errors) > 0 {
+		e := &Error{}
+		for _, m := range out.Errors {
+			e.Messages = append(e.Messages, m.Message)
+		}
+		return e
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errcode.Errorf(errcode.Upstream, "graphql: %s", resp.Status)
+	}
+	if len(out.Data) == 0 || string(out.Data) == "null" {
+		return errcode.New(errcode.Upstream, "graphql: response has no data")
+	}
+	return json.Unmarshal(out.Data, data)
+}
+
+func newSignatureInput(sig *signature.Signature) (signatureInput, error) {
+	if sig == nil || sig.R == nil || sig.S == nil {
+		return signatureInput{}, errcode.New(errcode.MissingValue, "graphql: signature R or S is nil")
+	}
+	return signatureInput{Field: sig.R.String(), Scalar: sig.S.String()}, nil
+}
+
+func addresses(from, to keys.PublicKey) (string, string, error) {
+	f, err := from.ToBase58()
+	if err != nil {
+		return "", "", fmt.Errorf("graphql: from: %w", err)
+	}
+	t, err := to.ToBase58()
+	if err != nil {
+		return "", "", fmt.Errorf("graphql: to: %w", err)
+	}
+	return f, t, nil
+}
+
+// validUntil maps the zero ValidUntil of transaction commands to
+// transaction.NoExpiry, which is what the signature covers.
+func validUntil(v uint32) uint32 {
+	if v == 0 {
+		return transaction.NoExpiry
+	}
+	return v
+}
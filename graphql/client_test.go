@@ -0,0 +1,134 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/graphql"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+const testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+
+type request struct {
+	Query     string                     `json:"query"`
+	Variables map[string]json.RawMessage `json:"variables"`
+}
+
+// node answers every request with reply and records the last request.
+func node(t *testing.T, reply string, last *request) *graphql.Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(last); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(reply))
+	}))
+	t.Cleanup(srv.Close)
+	return graphql.NewClient(srv.URL, srv.Client())
+}
+
+func signedPayment(t *testing.T) *signer.Signed[transaction.Command] {
+	t.Helper()
+	client := signer.NewClient(signer.NetworkTestnet)
+	sk, err := keys.PrivateKeyFromBase58(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := sk.ToPublicKey()
+	tx := transaction.Payment{From: pk, To: pk, Amount: 1_000_000_000, Fee: 10_000_000, Nonce: 3, Memo: "hello"}
+	signed, err := client.SignTransaction(tx, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestSendPayment(t *testing.T) {
+	var got request
+	c := node(t, `{"data":{"sendPayment":{"payment":{"id":"Ckp","hash":"5Ju"}}}}`, &got)
+	signed := signedPayment(t)
+
+	res, err := c.SendSigned(context.Background(), signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != (graphql.Result{ID: "Ckp", Hash: "5Ju"}) {
+		t.Errorf("SendSigned = %+v", res)
+	}
+	if !strings.Contains(got.Query, "sendPayment(") {
+		t.Errorf("query = %s", got.Query)
+	}
+	var input map[string]string
+	if err := json.Unmarshal(got.Variables["input"], &input); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"from": signed.PublicKey, "to": signed.PublicKey, "amount": "1000000000", "fee": "10000000",
+		"nonce": "3", "validUntil": "4294967295", "memo": "hello",
+	}
+	for k, v := range want {
+		if input[k] != v {
+			t.Errorf("input.%s = %q, want %q", k, input[k], v)
+		}
+	}
+	var sig map[string]string
+	if err := json.Unmarshal(got.Variables["signature"], &sig); err != nil {
+		t.Fatal(err)
+	}
+	if sig["field"] != signed.Signature.R.String() || sig["scalar"] != signed.Signature.S.String() {
+		t.Errorf("signature = %v", sig)
+	}
+}
+
+func TestSendStakeDelegationAndZkapp(t *testing.T) {
+	var got request
+	c := node(t, `{"data":{"sendDelegation":{"delegation":{"id":"d","hash":"h"}}}}`, &got)
+	sk, _ := keys.PrivateKeyFromBase58(testPrivateKey)
+	pk := sk.ToPublicKey()
+	signed, err := signer.NewClient(signer.NetworkTestnet).SignTransaction(transaction.StakeDelegation{From: pk, To: pk, Fee: 1}, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := c.SendSigned(context.Background(), signed); err != nil || res.Hash != "h" {
+		t.Errorf("SendSigned(delegation) = %+v, %v", res, err)
+	}
+	if !strings.Contains(got.Query, "sendDelegation(") {
+		t.Errorf("query = %s", got.Query)
+	}
+
+	c = node(t, `{"data":{"sendZkapp":{"zkapp":{"id":"z","hash":"5Jz"}}}}`, &got)
+	zkapp := json.RawMessage(`{"feePayer":{},"accountUpdates":[],"memo":"E4Yd"}`)
+	if res, err := c.SendZkapp(context.Background(), zkapp); err != nil || res.Hash != "5Jz" {
+		t.Errorf("SendZkapp = %+v, %v", res, err)
+	}
+	if !strings.Contains(string(got.Variables["input"]), `"zkappCommand":{"feePayer"`) {
+		t.Errorf("input = %s", got.Variables["input"])
+	}
+	if _, err := c.SendZkapp(context.Background(), json.RawMessage(`{`)); err == nil {
+		t.Error("SendZkapp accepted invalid JSON")
+	}
+}
+
+func TestNodeErrors(t *testing.T) {
+	var got request
+	c := node(t, `{"data":null,"errors":[{"message":"Couldn't send user command: Insufficient_funds"},{"message":"second"}]}`, &got)
+	_, err := c.SendSigned(context.Background(), signedPayment(t))
+	var gqlErr *graphql.Error
+	if !errors.As(err, &gqlErr) || len(gqlErr.Messages) != 2 || !strings.Contains(gqlErr.Messages[0], "Insufficient_funds") {
+		t.Errorf("SendSigned error = %v, want a *graphql.Error with both messages", err)
+	}
+
+	c = node(t, `not json`, &got)
+	if _, err := c.SendSigned(context.Background(), signedPayment(t)); err == nil {
+		t.Error("SendSigned accepted a malformed response")
+	}
+}
@@ -0,0 +1,316 @@
+// Package jsonrpc serves a signer.Client over HTTP as JSON-RPC 2.0, for
+// sidecar deployments next to wallet services written in other languages.
+//
+// Method names and JSON shapes follow mina-signer: addresses and private
+// keys are base58 strings, field elements, amounts and fees are decimal
+// strings, and signatures are {"field", "scalar"} objects. Signing methods
+// take the private key as a parameter, as the TypeScript client does, so the
+// handler must only be reachable by the services that own those keys.
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/remote"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// MaxRequestSize is the largest request body the handler reads.
+const MaxRequestSize = 1 << 20
+
+// JSON-RPC 2.0 error codes.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	// CodeSigner reports a failure of the signer itself, such as a private
+	// key that does not belong to the fee payer.
+	CodeSigner = -32000
+)
+
+// Error is a JSON-RPC error object.
+type Error struct {
+	Code    int        `json:"code"`
+	Message string     `json:"message"`
+	Data    *ErrorData `json:"data,omitempty"`
+}
+
+// ErrorData is the data member of an Error caused by an error of this
+// module. Code is the errcode.Code of that error, which unlike Message is
+// stable enough for clients to act on.
+type ErrorData struct {
+	Code errcode.Code `json:"code"`
+}
+
+// newError returns an Error with code reporting err.
+func newError(code int, err error) *Error {
+	e := &Error{Code: code, Message: err.Error()}
+	if c := errcode.CodeOf(err); c != errcode.Unknown {
+		e.Data = &ErrorData{Code: c}
+	}
+	return e
+}
+
+func (e *Error) Error() string { return e.Message }
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// Handler is an http.Handler that answers JSON-RPC calls with a
+// signer.Client.
+type Handler struct {
+	client *signer.Client
+}
+
+// NewHandler returns a Handler for client.
+func NewHandler(client *signer.Client) *Handler {
+	return &Handler{client: client}
+}
+
+// ServeHTTP answers a single JSON-RPC request sent with POST.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var req request
+	resp := response{JSONRPC: "2.0"}
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxRequestSize)).Decode(&req)
+	if err != nil {
+		resp.ID = json.RawMessage("null")
+		resp.Error = &Error{Code: CodeParseError, Message: err.Error()}
+	} else {
+		resp.ID = req.ID
+		if resp.ID == nil {
+			resp.ID = json.RawMessage("null")
+		}
+		result, rpcErr := h.call(req)
+		if rpcErr == nil {
+			// A marshalled result is never empty, so false and null
+			// results survive omitempty.
+			resp.Result, err = json.Marshal(result)
+			if err != nil {
+				rpcErr = newError(CodeSigner, err)
+			}
+		}
+		resp.Error = rpcErr
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// call dispatches req to its method.
+func (h *Handler) call(req request) (any, *Error) {
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return nil, &Error{Code: CodeInvalidRequest, Message: "expected a JSON-RPC 2.0 request"}
+	}
+	m, ok := methods[req.Method]
+	if !ok {
+		return nil, &Error{Code: CodeMethodNotFound, Message: "unknown method " + req.Method}
+	}
+	result, err := m(h.client, req.Params)
+	if err != nil {
+		var rpcErr *Error
+		if errors.As(err, &rpcErr) {
+			return nil, rpcErr
+		}
+		return nil, newError(CodeSigner, err)
+	}
+	return result, nil
+}
+
+var methods = map[string]func(c *signer.Client, params json.RawMessage) (any, error){
+	"derivePublicKey":       derivePublicKey,
+	"verifyKeypair":         verifyKeypair,
+	"signFields":            signFields,
+	"verifyFields":          verifyFields,
+	"signMessage":           signMessage,
+	"verifyMessage":         verifyMessage,
+	"signPayment":           signPayment,
+	"verifyPayment":         verifyPayment,
+	"signStakeDelegation":   signStakeDelegation,
+	"verifyStakeDelegation": verifyStakeDelegation,
+}
+
+// decode unmarshals params into v, reporting failures as invalid params.
+func decode(params json.RawMessage, v any) error {
+	if len(params) == 0 {
+		return &Error{Code: CodeInvalidParams, Message: "missing params"}
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return newError(CodeInvalidParams, err)
+	}
+	return nil
+}
+
+func invalidParams(err error) error {
+	return newError(CodeInvalidParams, err)
+}
+
+// signed is signer.Signed with the data in its JSON form.
+type signed[T any] struct {
+	Signature *signature.Signature `json:"signature"`
+	PublicKey string               `json:"publicKey"`
+	Data      T                    `json:"data"`
+}
+
+func derivePublicKey(c *signer.Client, params json.RawMessage) (any, error) {
+	var p struct {
+		PrivateKey string `json:"privateKey"`
+	}
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	return c.DerivePublicKey(p.PrivateKey)
+}
+
+func verifyKeypair(c *signer.Client, params json.RawMessage) (any, error) {
+	var p signer.Keypair
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	return c.VerifyKeypair(p.PrivateKey, p.PublicKey) == nil, nil
+}
+
+func signFields(c *signer.Client, params json.RawMessage) (any, error) {
+	var p struct {
+		Fields     []string `json:"fields"`
+		PrivateKey string   `json:"privateKey"`
+	}
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	fields, err := parseFields(p.Fields)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.SignFields(fields, p.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return signed[[]string]{Signature: s.Signature, PublicKey: s.PublicKey, Data: p.Fields}, nil
+}
+
+func verifyFields(c *signer.Client, params json.RawMessage) (any, error) {
+	var p signed[[]string]
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	fields, err := parseFields(p.Data)
+	if err != nil {
+		return nil, err
+	}
+	return c.VerifyFields(&signer.Signed[[]*big.Int]{Signature: p.Signature, PublicKey: p.PublicKey, Data: fields}), nil
+}
+
+func signMessage(c *signer.Client, params json.RawMessage) (any, error) {
+	var p struct {
+		Message    string `json:"message"`
+		PrivateKey string `json:"privateKey"`
+	}
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	return c.SignMessage(p.Message, p.PrivateKey)
+}
+
+func verifyMessage(c *signer.Client, params json.RawMessage) (any, error) {
+	var p signer.Signed[string]
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	return c.VerifyMessage(&p), nil
+}
+
+func signPayment(c *signer.Client, params json.RawMessage) (any, error) {
+	var p struct {
+		Payment    *remote.Payment `json:"payment"`
+		PrivateKey string          `json:"privateKey"`
+	}
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	return signTransaction(c, &remote.SignTransactionRequest{Payment: p.Payment}, p.Payment, p.PrivateKey)
+}
+
+func verifyPayment(c *signer.Client, params json.RawMessage) (any, error) {
+	var p signed[*remote.Payment]
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	return verifyTransaction(c, &remote.SignTransactionRequest{Payment: p.Data}, p.Signature, p.PublicKey)
+}
+
+func signStakeDelegation(c *signer.Client, params json.RawMessage) (any, error) {
+	var p struct {
+		StakeDelegation *remote.StakeDelegation `json:"stakeDelegation"`
+		PrivateKey      string                  `json:"privateKey"`
+	}
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	return signTransaction(c, &remote.SignTransactionRequest{StakeDelegation: p.StakeDelegation}, p.StakeDelegation, p.PrivateKey)
+}
+
+func verifyStakeDelegation(c *signer.Client, params json.RawMessage) (any, error) {
+	var p signed[*remote.StakeDelegation]
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	return verifyTransaction(c, &remote.SignTransactionRequest{StakeDelegation: p.Data}, p.Signature, p.PublicKey)
+}
+
+// signTransaction signs the command of req and returns it with data, its
+// JSON form.
+func signTransaction[T any](c *signer.Client, req *remote.SignTransactionRequest, data T, privateKey string) (any, error) {
+	tx, err := req.Command()
+	if err != nil {
+		return nil, invalidParams(err)
+	}
+	s, err := c.SignTransaction(tx, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return signed[T]{Signature: s.Signature, PublicKey: s.PublicKey, Data: data}, nil
+}
+
+func verifyTransaction(c *signer.Client, req *remote.SignTransactionRequest, sig *signature.Signature, publicKey string) (any, error) {
+	tx, err := req.Command()
+	if err != nil {
+		return nil, invalidParams(err)
+	}
+	return c.VerifyTransaction(&signer.Signed[transaction.Command]{Signature: sig, PublicKey: publicKey, Data: tx}), nil
+}
+
+// parseFields decodes decimal field elements, rejecting values not below p.
+func parseFields(in []string) ([]*big.Int, error) {
+	out := make([]*big.Int, len(in))
+	for i, s := range in {
+		f, err := field.Fp.FromString(s, 10)
+		if err != nil {
+			return nil, invalidParams(err)
+		}
+		out[i] = f
+	}
+	return out, nil
+}
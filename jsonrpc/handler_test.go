@@ -0,0 +1,103 @@
+package jsonrpc_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/jsonrpc"
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+const (
+	testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+	testPublicKey  = "B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg"
+)
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *jsonrpc.Error  `json:"error"`
+	ID     json.RawMessage `json:"id"`
+}
+
+func call(t *testing.T, url, method string, params any) rpcResponse {
+	t.Helper()
+	body, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(url, "application/json", strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var out rpcResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestHandler(t *testing.T) {
+	srv := httptest.NewServer(jsonrpc.NewHandler(signer.NewClient(signer.NetworkTestnet)))
+	defer srv.Close()
+
+	if r := call(t, srv.URL, "derivePublicKey", map[string]string{"privateKey": testPrivateKey}); string(r.Result) != `"`+testPublicKey+`"` {
+		t.Errorf("derivePublicKey = %s, %v", r.Result, r.Error)
+	}
+
+	signed := call(t, srv.URL, "signMessage", map[string]string{"message": "hello", "privateKey": testPrivateKey})
+	if signed.Error != nil {
+		t.Fatal(signed.Error)
+	}
+	if r := call(t, srv.URL, "verifyMessage", signed.Result); string(r.Result) != "true" {
+		t.Errorf("verifyMessage = %s, %v", r.Result, r.Error)
+	}
+
+	signed = call(t, srv.URL, "signFields", map[string]any{"fields": []string{"1", "2"}, "privateKey": testPrivateKey})
+	if signed.Error != nil {
+		t.Fatal(signed.Error)
+	}
+	var fields struct {
+		Signature struct{ Field, Scalar string }
+		PublicKey string
+		Data      []string
+	}
+	if err := json.Unmarshal(signed.Result, &fields); err != nil || fields.Signature.Field == "" || fields.Data[1] != "2" {
+		t.Errorf("signFields result = %s", signed.Result)
+	}
+	fields.Data[1] = "3"
+	if r := call(t, srv.URL, "verifyFields", fields); string(r.Result) != "false" {
+		t.Errorf("verifyFields on modified data = %s, %v", r.Result, r.Error)
+	}
+
+	payment := map[string]any{"from": testPublicKey, "to": testPublicKey, "amount": "1000", "fee": "10", "nonce": 1, "memo": "rpc"}
+	signed = call(t, srv.URL, "signPayment", map[string]any{"payment": payment, "privateKey": testPrivateKey})
+	if signed.Error != nil {
+		t.Fatal(signed.Error)
+	}
+	if r := call(t, srv.URL, "verifyPayment", signed.Result); string(r.Result) != "true" {
+		t.Errorf("verifyPayment = %s, %v", r.Result, r.Error)
+	}
+	if r := call(t, srv.URL, "verifyStakeDelegation", signed.Result); string(r.Result) != "false" {
+		t.Errorf("verifyStakeDelegation accepted a payment signature: %s, %v", r.Result, r.Error)
+	}
+
+	if r := call(t, srv.URL, "nope", nil); r.Error == nil || r.Error.Code != jsonrpc.CodeMethodNotFound {
+		t.Errorf("unknown method: %+v", r.Error)
+	}
+	if r := call(t, srv.URL, "signFields", map[string]any{"fields": []string{"x"}}); r.Error == nil || r.Error.Code != jsonrpc.CodeInvalidParams {
+		t.Errorf("invalid field: %+v", r.Error)
+	}
+	if r := call(t, srv.URL, "signMessage", map[string]string{"message": "hi", "privateKey": "EKbad"}); r.Error == nil || r.Error.Code != jsonrpc.CodeSigner {
+		t.Errorf("invalid key: %+v", r.Error)
+	}
+	badChecksum := testPrivateKey[:len(testPrivateKey)-1] + "x"
+	r := call(t, srv.URL, "signMessage", map[string]string{"message": "hi", "privateKey": badChecksum})
+	if r.Error == nil || r.Error.Data == nil || r.Error.Data.Code != errcode.InvalidChecksum {
+		t.Errorf("key with a bad checksum: %+v", r.Error)
+	}
+}
@@ -0,0 +1,318 @@
+// Package keyfile reads and writes the key files of the Mina node tooling,
+// as mina-generate-keypair and "mina advanced generate-keypair" write them:
+// the private key sealed with a password in a secret box, a JSON document,
+// and beside it the key's address in a file of the same name with ".pub"
+// appended.
+//
+// A secret box derives its key from the password with Argon2i and seals
+// with XSalsa20-Poly1305, as libsodium's crypto_pwhash and crypto_secretbox
+// do, and holds its binary fields as base58check strings.
+//
+// As the node does, the package refuses to read a private key file that
+// users other than its owner can access, or one in such a directory: the
+// file must have mode 0600 or stricter and its directory 0700 or stricter.
+// The checks are skipped on Windows, which has no such modes.
+package keyfile
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/nacl/secretbox"
+
+	"github.com/node101-io/mina-signer-go/base58check"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+const (
+	// BoxPrimitive is the cipher of the secret boxes the package reads and
+	// writes.
+	BoxPrimitive = "xsalsa20poly1305"
+	// PasswordPrimitive is the password hash they derive their key with.
+	PasswordPrimitive = "argon2i"
+	// PasswordEnv is the environment variable the Mina tooling reads the
+	// password of a key file from.
+	PasswordEnv = "MINA_PRIVKEY_PASS"
+	// PublicKeySuffix is appended to the name of a private key file to name
+	// the file holding its address.
+	PublicKeySuffix = ".pub"
+
+	// boxVersion is the base58check version byte of the nonce, salt and
+	// ciphertext of a secret box.
+	boxVersion byte = 0x02
+	// privateKeyBinable is the version number that precedes the 32
+	// little-endian bytes of a sealed private key, as in its base58 form.
+	privateKeyBinable byte = 0x01
+	saltSize               = 16
+	// maxMemory and maxPasses bound the difficulty Open accepts, so that a
+	// crafted file cannot make it exhaust memory or time.
+	maxMemory = 1 << 30
+	maxPasses = 64
+)
+
+// ErrPassword is returned when a secret box does not open: the password is
+// wrong or the file was modified.
+var ErrPassword = errcode.New(errcode.AuthenticationFailed, "keyfile: wrong password or corrupted secret box")
+
+// Difficulty is the cost of the password hash of a secret box: the memory
+// it uses, in bytes, and its number of passes. It is written as the
+// two-element pwdiff array.
+type Difficulty struct {
+	Memory uint64
+	Passes uint64
+}
+
+// DefaultDifficulty is libsodium's moderate setting, which the Mina tooling
+// uses: 128 MiB and 6 passes.
+var DefaultDifficulty = Difficulty{Memory: 128 << 20, Passes: 6}
+
+// MarshalJSON encodes d as [memory, passes].
+func (d Difficulty) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]uint64{d.Memory, d.Passes})
+}
+
+// UnmarshalJSON decodes a [memory, passes] array.
+func (d *Difficulty) UnmarshalJSON(data []byte) error {
+	var v [2]uint64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return errcode.Errorf(errcode.InvalidEncoding, "keyfile: pwdiff: %w", err)
+	}
+	*d = Difficulty{Memory: v[0], Passes: v[1]}
+	return nil
+}
+
+// SecretBox is the JSON document of a private key file.
+type SecretBox struct {
+	BoxPrimitive      string     `json:"box_primitive"`
+	PasswordPrimitive string     `json:"pw_primitive"`
+	Nonce             string     `json:"nonce"`
+	Salt              string     `json:"pwsalt"`
+	Difficulty        Difficulty `json:"pwdiff"`
+	Ciphertext        string     `json:"ciphertext"`
+}
+
+// Seal encrypts plaintext with a key derived from password at difficulty
+// d, with a fresh salt and nonce.
+func Seal(plaintext, password []byte, d Difficulty) (*SecretBox, error) {
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+	var salt [saltSize]byte
+	var nonce [24]byte
+	if _, err := rand.Read(salt[:]); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, err
+	}
+	key := d.key(password, salt[:])
+	defer clear(key[:])
+	return &SecretBox{
+		BoxPrimitive:      BoxPrimitive,
+		PasswordPrimitive: PasswordPrimitive,
+		Nonce:             base58check.Encode(boxVersion, nonce[:]),
+		Salt:              base58check.Encode(boxVersion, salt[:]),
+		Difficulty:        d,
+		Ciphertext:        base58check.Encode(boxVersion, secretbox.Seal(nil, plaintext, &nonce, key)),
+	}, nil
+}
+
+// Open decrypts b with password. It returns ErrPassword when the box does
+// not authenticate.
+func (b *SecretBox) Open(password []byte) ([]byte, error) {
+	if b.BoxPrimitive != BoxPrimitive || b.PasswordPrimitive != PasswordPrimitive {
+		return nil, errcode.Errorf(errcode.Unsupported, "keyfile: unsupported secret box %s with %s", b.BoxPrimitive, b.PasswordPrimitive)
+	}
+	if err := b.Difficulty.validate(); err != nil {
+		return nil, err
+	}
+	nonce, err := base58check.Decode(b.Nonce, boxVersion)
+	if err != nil {
+		return nil, fmt.Errorf("keyfile: nonce: %w", err)
+	}
+	salt, err := base58check.Decode(b.Salt, boxVersion)
+	if err != nil {
+		return nil, fmt.Errorf("keyfile: pwsalt: %w", err)
+	}
+	ciphertext, err := base58check.Decode(b.Ciphertext, boxVersion)
+	if err != nil {
+		return nil, fmt.Errorf("keyfile: ciphertext: %w", err)
+	}
+	if len(nonce) != 24 || len(salt) != saltSize {
+		return nil, errcode.Errorf(errcode.InvalidLength, "keyfile: nonce of %d bytes and salt of %d, want 24 and %d", len(nonce), len(salt), saltSize)
+	}
+	key := b.Difficulty.key(password, salt)
+	defer clear(key[:])
+	plaintext, ok := secretbox.Open(nil, ciphertext, (*[24]byte)(nonce), key)
+	if !ok {
+		return nil, ErrPassword
+	}
+	return plaintext, nil
+}
+
+func (d Difficulty) validate() error {
+	if d.Memory < 8<<10 || d.Memory > maxMemory || d.Passes < 1 || d.Passes > maxPasses {
+		return errcode.Errorf(errcode.OutOfRange, "keyfile: password hash difficulty %d bytes and %d passes, want 8 KiB to %d bytes and 1 to %d passes",
+			d.Memory, d.Passes, maxMemory, maxPasses)
+	}
+	return nil
+}
+
+// key derives the secret box key from password as crypto_pwhash does with
+// Argon2i: memory in KiB and a single lane.
+func (d Difficulty) key(password, salt []byte) *[32]byte {
+	return (*[32]byte)(argon2.Key(password, salt, uint32(d.Passes), uint32(d.Memory>>10), 1, 32))
+}
+
+// Write writes key to path, sealed with password at DefaultDifficulty, and
+// its address to path with PublicKeySuffix appended, both with mode 0600.
+// It creates the directory of path with mode 0700 if it is missing, and
+// fails if either file exists or the directory is accessible to others.
+func Write(path string, key keys.PrivateKey, password []byte) error {
+	return WriteWithDifficulty(path, key, password, DefaultDifficulty)
+}
+
+// WriteWithDifficulty is Write with the password hash difficulty d.
+func WriteWithDifficulty(path string, key keys.PrivateKey, password []byte, d Difficulty) error {
+	if key.Value == nil {
+		return fmt.Errorf("keyfile: %w", keys.ErrNilKey)
+	}
+	address, err := key.ToPublicKey().ToBase58()
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	if err := CheckPermissions(dir); err != nil {
+		return err
+	}
+	plaintext := append([]byte{privateKeyBinable}, key.Value.BytesLE()...)
+	defer clear(plaintext)
+	box, err := Seal(plaintext, password, d)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(box)
+	if err != nil {
+		return err
+	}
+	if err := create(path, data); err != nil {
+		return err
+	}
+	if err := create(path+PublicKeySuffix, []byte(address+"\n")); err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+// create writes data to a new file at path with mode 0600.
+func create(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, fs.ErrExist) {
+		return errcode.Errorf(errcode.AlreadyExists, "keyfile: %s already exists", path)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	return f.Close()
+}
+
+// Read reads the private key file at path and opens it with password. The
+// file and its directory must pass the permission checks. When the public
+// key file beside it exists, the key must match it.
+func Read(path string, password []byte) (keys.PrivateKey, error) {
+	if err := CheckPermissions(filepath.Dir(path)); err != nil {
+		return keys.PrivateKey{}, err
+	}
+	if err := CheckPermissions(path); err != nil {
+		return keys.PrivateKey{}, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return keys.PrivateKey{}, err
+	}
+	var box SecretBox
+	if err := json.Unmarshal(data, &box); err != nil {
+		return keys.PrivateKey{}, errcode.Errorf(errcode.InvalidEncoding, "keyfile: %s: %w", path, err)
+	}
+	plaintext, err := box.Open(password)
+	if err != nil {
+		return keys.PrivateKey{}, fmt.Errorf("%s: %w", path, err)
+	}
+	defer clear(plaintext)
+	if len(plaintext) != 33 || plaintext[0] != privateKeyBinable {
+		return keys.PrivateKey{}, errcode.Errorf(errcode.InvalidEncoding, "keyfile: %s does not hold a private key", path)
+	}
+	v, err := scalar.FromBytesLE(plaintext[1:])
+	if err != nil {
+		return keys.PrivateKey{}, fmt.Errorf("keyfile: %s: %w", path, err)
+	}
+	key := keys.PrivateKey{Value: v}
+
+	pk, err := ReadPublicKey(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return key, nil
+	}
+	if err != nil {
+		return keys.PrivateKey{}, err
+	}
+	if derived := key.ToPublicKey(); !derived.Equal(pk) {
+		return keys.PrivateKey{}, errcode.Errorf(errcode.KeyMismatch, "keyfile: %s does not hold the key of %s%s", path, path, PublicKeySuffix)
+	}
+	return key, nil
+}
+
+// ReadPublicKey reads the address of the private key file at path from the
+// file beside it, path with PublicKeySuffix appended.
+func ReadPublicKey(path string) (keys.PublicKey, error) {
+	data, err := os.ReadFile(path + PublicKeySuffix)
+	if err != nil {
+		return keys.PublicKey{}, err
+	}
+	pk, err := keys.PublicKeyFromBase58(strings.TrimSpace(string(data)))
+	if err != nil {
+		return keys.PublicKey{}, fmt.Errorf("keyfile: %s%s: %w", path, PublicKeySuffix, err)
+	}
+	return pk, nil
+}
+
+// CheckPermissions fails with errcode.InsecurePermissions if users other
+// than the owner can access path. Read applies it to key files and their
+// directory; tools reading other secrets, such as plaintext private keys,
+// can apply it too. It always succeeds on Windows, whose permissions are
+// not modes.
+func CheckPermissions(path string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if perm := fi.Mode().Perm(); perm&0o077 != 0 {
+		want := fs.FileMode(0o600)
+		if fi.IsDir() {
+			want = 0o700
+		}
+		return errcode.Errorf(errcode.InsecurePermissions, "keyfile: %s has mode %#o, want %#o or stricter", path, perm, want)
+	}
+	return nil
+}
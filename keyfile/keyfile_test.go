@@ -0,0 +1,215 @@
+package keyfile_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keyfile"
+	"github.com/node101-io/mina-signer-go/keys"
+)
+
+const testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+
+// fast keeps the password hash cheap in tests.
+var fast = keyfile.Difficulty{Memory: 64 << 10, Passes: 1}
+
+func TestWriteRead(t *testing.T) {
+	key, err := keys.PrivateKeyFromBase58(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "keys", "my-wallet")
+	password := []byte("naughty blue worm")
+	if err := keyfile.WriteWithDifficulty(path, key, password, fast); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["box_primitive"] != "xsalsa20poly1305" || fields["pw_primitive"] != "argon2i" || len(fields["pwdiff"].([]any)) != 2 {
+		t.Errorf("key file = %s, want the layout of mina-generate-keypair", data)
+	}
+
+	got, err := keyfile.Read(path, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Value.BigInt().Cmp(key.Value.BigInt()) != 0 {
+		t.Error("Read returned another key")
+	}
+	pk, err := keyfile.ReadPublicKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address, _ := pk.ToBase58(); address != "B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg" {
+		t.Errorf("ReadPublicKey() = %s", address)
+	}
+
+	if _, err := keyfile.Read(path, []byte("wrong")); !errors.Is(err, keyfile.ErrPassword) {
+		t.Errorf("Read with a wrong password: %v, want ErrPassword", err)
+	}
+	if err := keyfile.WriteWithDifficulty(path, key, password, fast); errcode.CodeOf(err) != errcode.AlreadyExists {
+		t.Errorf("writing over a key file: %v, want AlreadyExists", err)
+	}
+
+	// The address beside the key must be its own, but may be missing.
+	other, err := keys.NewPrivateKeyFromBytes([32]byte{1}).ToPublicKey().ToBase58()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path+keyfile.PublicKeySuffix, []byte(other), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := keyfile.Read(path, password); errcode.CodeOf(err) != errcode.KeyMismatch {
+		t.Errorf("Read with another address beside it: %v, want KeyMismatch", err)
+	}
+	if err := os.Remove(path + keyfile.PublicKeySuffix); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := keyfile.Read(path, password); err != nil {
+		t.Errorf("Read without a public key file: %v", err)
+	}
+}
+
+// TestReadLibsodiumFile reads a key file sealed with libsodium's
+// crypto_pwhash (Argon2i, 128 MiB, 6 passes) and crypto_secretbox_easy,
+// the calls mina-generate-keypair makes, rather than with this package.
+// testdata/libsodium-key holds the test key under the password
+// "naughty blue worm"; git does not keep its mode, so it is copied to a
+// private directory first.
+func TestReadLibsodiumFile(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "libsodium-key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "keys", "libsodium-key")
+	if err := os.Mkdir(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	key, err := keyfile.Read(path, []byte("naughty blue worm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := key.ToBase58(); got != testPrivateKey {
+		t.Errorf("Read() = %s, want %s", got, testPrivateKey)
+	}
+	if _, err := keyfile.Read(path, []byte("naughty blue worms")); !errors.Is(err, keyfile.ErrPassword) {
+		t.Errorf("Read with a wrong password: %v, want ErrPassword", err)
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("no file modes on Windows")
+	}
+	key, err := keys.PrivateKeyFromBase58(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(t.TempDir(), "keys")
+	path := filepath.Join(dir, "key")
+	if err := keyfile.WriteWithDifficulty(path, key, nil, fast); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{dir, path, path + keyfile.PublicKeySuffix} {
+		fi, err := os.Stat(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fi.Mode().Perm()&0o077 != 0 {
+			t.Errorf("%s has mode %#o, want no access for others", p, fi.Mode().Perm())
+		}
+	}
+
+	if err := os.Chmod(path, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := keyfile.Read(path, nil); errcode.CodeOf(err) != errcode.InsecurePermissions {
+		t.Errorf("Read of a readable file: %v, want InsecurePermissions", err)
+	}
+	if err := os.Chmod(path, 0o400); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := keyfile.Read(path, nil); err != nil {
+		t.Errorf("Read of a read-only file: %v", err)
+	}
+	if err := os.Chmod(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := keyfile.Read(path, nil); errcode.CodeOf(err) != errcode.InsecurePermissions {
+		t.Errorf("Read in an open directory: %v, want InsecurePermissions", err)
+	}
+	if err := keyfile.WriteWithDifficulty(filepath.Join(dir, "other"), key, nil, fast); errcode.CodeOf(err) != errcode.InsecurePermissions {
+		t.Errorf("Write into an open directory: %v, want InsecurePermissions", err)
+	}
+}
+
+func TestCheckPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("no file modes on Windows")
+	}
+	dir := filepath.Join(t.TempDir(), "keys")
+	if err := os.Mkdir(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "key.txt")
+	if err := os.WriteFile(path, []byte(testPrivateKey), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		path string
+		mode os.FileMode
+		want errcode.Code
+	}{
+		{path, 0o600, errcode.Unknown},
+		{path, 0o640, errcode.InsecurePermissions},
+		{dir, 0o700, errcode.Unknown},
+		{dir, 0o750, errcode.InsecurePermissions},
+	} {
+		if err := os.Chmod(tt.path, tt.mode); err != nil {
+			t.Fatal(err)
+		}
+		if err := keyfile.CheckPermissions(tt.path); errcode.CodeOf(err) != tt.want {
+			t.Errorf("CheckPermissions(%s) with mode %#o = %v, want code %q", filepath.Base(tt.path), tt.mode, err, tt.want)
+		}
+	}
+}
+
+func TestOpenRejects(t *testing.T) {
+	box, err := keyfile.Seal([]byte("secret"), []byte("pw"), fast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	huge := *box
+	huge.Difficulty = keyfile.Difficulty{Memory: 1 << 40, Passes: 6}
+	if _, err := huge.Open([]byte("pw")); errcode.CodeOf(err) != errcode.OutOfRange {
+		t.Errorf("Open with a huge difficulty: %v, want OutOfRange", err)
+	}
+	other := *box
+	other.PasswordPrimitive = "scrypt"
+	if _, err := other.Open([]byte("pw")); errcode.CodeOf(err) != errcode.Unsupported {
+		t.Errorf("Open with another password hash: %v, want Unsupported", err)
+	}
+	tampered := *box
+	tampered.Nonce = box.Salt
+	if _, err := tampered.Open([]byte("pw")); errcode.CodeOf(err) != errcode.InvalidLength {
+		t.Errorf("Open with a short nonce: %v, want InvalidLength", err)
+	}
+	if plaintext, err := box.Open([]byte("pw")); err != nil || string(plaintext) != "secret" {
+		t.Errorf("Open() = %q, %v", plaintext, err)
+	}
+}
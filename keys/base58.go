@@ -0,0 +1,112 @@
+package keys
+
+import (
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/base58check"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+const (
+	// PublicKeyBase58Version is the base58check version byte of Mina
+	// addresses, which start with "B62".
+	PublicKeyBase58Version byte = 0xcb
+	// publicKeyBinable is the version number of the compressed point's
+	// binable encoding, which wraps a versioned record of x as 32
+	// little-endian bytes followed by the parity byte.
+	publicKeyBinable byte = 0x01
+)
+
+// publicKeyHeader is the pair of version numbers that precedes x in the
+// base58 payload of a public key.
+var publicKeyHeader = [2]byte{publicKeyBinable, publicKeyBinable}
+
+// ToBase58 returns the Mina address of pk, the "B62..." string used by
+// wallets, nodes and o1js. Unlike ToAddress it carries a version and a
+// checksum.
+func (pk PublicKey) ToBase58() (string, error) {
+	return pk.ToBase58WithVersion(PublicKeyBase58Version)
+}
+
+// ToBase58WithVersion is ToBase58 with a custom version byte, for networks
+// whose addresses do not use the Mina prefix.
+func (pk PublicKey) ToBase58WithVersion(version byte) (string, error) {
+	if pk.X == nil {
+		return "", fmt.Errorf("cannot encode PublicKey: %w", ErrNilKey)
+	}
+	h := len(publicKeyHeader)
+	payload := make([]byte, h+PublicKeyTotalByteSize)
+	copy(payload, publicKeyHeader[:])
+	be := pk.X.BigInt().FillBytes(make([]byte, PublicKeyXByteSize))
+	for i, b := range be {
+		payload[h+PublicKeyXByteSize-1-i] = b
+	}
+	if pk.IsOdd {
+		payload[h+PublicKeyXByteSize] = 0x01
+	}
+	return base58check.Encode(version, payload), nil
+}
+
+// PublicKeyFromBase58 decodes a Mina address produced by ToBase58. The key
+// must be a valid curve point, as for UnmarshalBytes.
+func PublicKeyFromBase58(address string) (PublicKey, error) {
+	return PublicKeyFromBase58WithVersion(address, PublicKeyBase58Version)
+}
+
+// PublicKeyFromBase58WithVersion decodes an address produced by
+// ToBase58WithVersion with the same version byte.
+func PublicKeyFromBase58WithVersion(address string, version byte) (PublicKey, error) {
+	payload, err := base58check.Decode(address, version)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("invalid public key: %w", err)
+	}
+	h := len(publicKeyHeader)
+	if len(payload) != h+PublicKeyTotalByteSize {
+		return PublicKey{}, fmt.Errorf("invalid public key: %w: unexpected payload of %d bytes", ErrInvalidLength, len(payload))
+	}
+	if [2]byte(payload[:h]) != publicKeyHeader {
+		return PublicKey{}, errcode.Errorf(errcode.InvalidVersion, "invalid public key: unexpected header %x", payload[:h])
+	}
+	le := payload[h : h+PublicKeyXByteSize]
+	be := make([]byte, PublicKeyXByteSize)
+	for i, b := range le {
+		be[PublicKeyXByteSize-1-i] = b
+	}
+	x, err := new(field.FpElement).SetBytesCanonical(be)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("invalid public key: x coordinate: %w", err)
+	}
+	var pk PublicKey
+	switch payload[h+PublicKeyXByteSize] {
+	case 0x00:
+		pk = PublicKey{X: x}
+	case 0x01:
+		pk = PublicKey{X: x, IsOdd: true}
+	default:
+		return PublicKey{}, fmt.Errorf("invalid public key: %w: parity byte 0x%02x", ErrNonCanonical, payload[h+PublicKeyXByteSize])
+	}
+	if err := pk.Validate(); err != nil {
+		return PublicKey{}, err
+	}
+	return pk, nil
+}
+
+// ToBase58 returns the Mina encoding of sk, the "EK..." string produced by
+// mina-signer and the node's key tooling.
+func (sk PrivateKey) ToBase58() (string, error) {
+	if !sk.isSet() {
+		return "", fmt.Errorf("cannot encode PrivateKey: %w", ErrNilKey)
+	}
+	return sk.Value.ToBase58(), nil
+}
+
+// PrivateKeyFromBase58 decodes a private key produced by ToBase58.
+func PrivateKeyFromBase58(s string) (PrivateKey, error) {
+	v, err := scalar.FromBase58(s)
+	if err != nil {
+		return PrivateKey{}, fmt.Errorf("invalid private key: %w", err)
+	}
+	return PrivateKey{Value: v}, nil
+}
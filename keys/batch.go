@@ -0,0 +1,213 @@
+package keys
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+// batchWeightBytes is the size of the random weight of each signature in a
+// batch. A forged signature passes a batch with probability 2^-128.
+const batchWeightBytes = 16
+
+// batchCurve is Pallas with multi-scalar multiplication in XYZZ
+// coordinates, which suits the affine keys and nonce commitments of a
+// batch. It shares the generator table of curve.Pallas.
+var batchCurve = func() *curve.Curve {
+	c := *curve.Pallas()
+	c.Coordinates = curve.CoordinatesXYZZ
+	return &c
+}()
+
+// BatchVerifier checks many signatures, kimchi and legacy, under any
+// networks, faster than verifying them one by one. It is meant for whole
+// blocks or mempools: the signatures of a batch share a single
+// multi-scalar multiplication, and each distinct public key is
+// decompressed once however many signatures it has.
+//
+// A BatchVerifier is not safe for concurrent use. The zero value is ready
+// to use.
+type BatchVerifier struct {
+	// Rand is the source of the random weights that make the batch check
+	// sound. Nil means crypto/rand. It must not be predictable by whoever
+	// produced the signatures.
+	Rand io.Reader
+
+	entries []batchEntry
+}
+
+// batchEntry is a signature added to a BatchVerifier.
+type batchEntry struct {
+	pk            PublicKey
+	sig           *signature.Signature
+	network       Network
+	legacy        bool
+	message       poseidonbigint.HashInput
+	messageLegacy poseidonbigint.HashInputLegacy
+}
+
+// Add queues a check of sig by pk over message with the kimchi scheme, as
+// VerifyForNetwork would do.
+func (b *BatchVerifier) Add(pk PublicKey, sig *signature.Signature, message poseidonbigint.HashInput, network Network) {
+	b.entries = append(b.entries, batchEntry{pk: pk, sig: sig, network: network, message: message})
+}
+
+// AddLegacy queues a check of sig by pk over message with the legacy
+// scheme of payments and delegations, as VerifyLegacyForNetwork would do.
+func (b *BatchVerifier) AddLegacy(pk PublicKey, sig *signature.Signature, message poseidonbigint.HashInputLegacy, network Network) {
+	b.entries = append(b.entries, batchEntry{pk: pk, sig: sig, network: network, legacy: true, messageLegacy: message})
+}
+
+// Len returns the number of signatures queued.
+func (b *BatchVerifier) Len() int {
+	return len(b.entries)
+}
+
+// Reset removes the queued signatures, keeping the allocated memory.
+func (b *BatchVerifier) Reset() {
+	clear(b.entries)
+	b.entries = b.entries[:0]
+}
+
+// batchTerm holds what a queued signature contributes to the batch
+// equation s·G = e·P + R.
+type batchTerm struct {
+	key int // index of the public key in the distinct keys
+	e   *big.Int
+	s   *big.Int
+	r   *curve.GroupProjective
+}
+
+// Verify checks the queued signatures and reports whether all are valid,
+// with the result of each in the order they were added. An entry is valid
+// exactly when the single-signature method would accept it.
+//
+// A batch of valid signatures costs one multi-scalar multiplication. When
+// the batch fails, Verify checks the signatures one by one to tell which
+// are invalid, reusing their hashes, so an invalid signature costs about
+// as much as verifying the batch twice.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	valid := make([]bool, len(b.entries))
+	terms := make([]*batchTerm, len(b.entries))
+	var points []*curve.GroupProjective
+	index := make(map[string]int)
+	for i := range b.entries {
+		terms[i] = b.term(&b.entries[i], index, &points)
+	}
+
+	// With random weights z, the signatures are all valid, except with
+	// negligible probability, when Σ z·s·G - Σ z·e·P - Σ z·R is zero. The
+	// weights of one key add up, so it appears in the sum once.
+	order := batchCurve.Order
+	base := new(big.Int)
+	keyScalars := make([]*big.Int, len(points))
+	for k := range keyScalars {
+		keyScalars[k] = new(big.Int)
+	}
+	var nonces []*curve.GroupProjective
+	var nonceScalars []*big.Int
+	weight := make([]byte, batchWeightBytes)
+	for _, t := range terms {
+		if t == nil {
+			continue
+		}
+		if _, err := io.ReadFull(b.random(), weight); err != nil {
+			// Without randomness the batch check would be unsound.
+			return verifyEach(terms, points, valid)
+		}
+		z := new(big.Int).SetBytes(weight)
+		base.Add(base, new(big.Int).Mul(z, t.s))
+		keyScalars[t.key].Sub(keyScalars[t.key], new(big.Int).Mul(z, t.e))
+		nonces = append(nonces, t.r)
+		nonceScalars = append(nonceScalars, z.Neg(z))
+	}
+	for _, k := range keyScalars {
+		k.Mod(k, order)
+	}
+	sum, err := batchCurve.MultiScalarMul(append(points[:len(points):len(points)], nonces...), append(keyScalars, nonceScalars...))
+	if err != nil || !batchCurve.Equal(batchCurve.Add(sum, batchCurve.ScaleBase(base.Mod(base, order))), batchCurve.Zero) {
+		return verifyEach(terms, points, valid)
+	}
+	for i, t := range terms {
+		valid[i] = t != nil
+	}
+	return all(valid), valid
+}
+
+// term hashes entry and returns its batch term, or nil when the entry is
+// invalid on its own. The public key is looked up in index and added to
+// points when new.
+func (b *BatchVerifier) term(entry *batchEntry, index map[string]int, points *[]*curve.GroupProjective) *batchTerm {
+	pk, sig := entry.pk, entry.sig
+	if pk.X == nil || !sig.IsCanonical() || entry.network.Validate() != nil {
+		return nil
+	}
+	id := "even:" + pk.X.BigInt().Text(16)
+	if pk.IsOdd {
+		id = "odd:" + pk.X.BigInt().Text(16)
+	}
+	key, ok := index[id]
+	if !ok {
+		g, err := pk.decompress()
+		if err != nil {
+			return nil
+		}
+		key = len(*points)
+		index[id] = key
+		*points = append(*points, g)
+	}
+	g := (*points)[key]
+	// A valid signature has R = s·G - e·P with an even y, so R is the
+	// point of x-coordinate rx with an even y.
+	rx := sig.R.BigInt()
+	r, err := curve.DecompressGeneric(batchCurve, rx, false)
+	if err != nil {
+		return nil
+	}
+	p := Point{X: g.X, Y: g.Y}
+	var e *big.Int
+	if entry.legacy {
+		e = hashMessageLegacy(entry.messageLegacy, p, rx, entry.network)
+	} else {
+		e = hashMessage(entry.message, p, rx, entry.network)
+	}
+	return &batchTerm{key: key, e: e, s: sig.S.BigInt(), r: r}
+}
+
+// verifyEach checks every term on its own against the key points,
+// filling valid.
+func verifyEach(terms []*batchTerm, points []*curve.GroupProjective, valid []bool) (bool, []bool) {
+	for i, t := range terms {
+		if t == nil {
+			continue
+		}
+		eP, err := batchCurve.ScaleWNAFChecked(points[t.key], t.e)
+		if err != nil {
+			continue
+		}
+		valid[i] = batchCurve.Equal(batchCurve.Sub(batchCurve.ScaleBase(t.s), eP), t.r)
+	}
+	return all(valid), valid
+}
+
+// random returns the source of batch weights.
+func (b *BatchVerifier) random() io.Reader {
+	if b.Rand != nil {
+		return b.Rand
+	}
+	return rand.Reader
+}
+
+// all reports whether every element of valid is true.
+func all(valid []bool) bool {
+	for _, v := range valid {
+		if !v {
+			return false
+		}
+	}
+	return true
+}
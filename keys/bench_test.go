@@ -0,0 +1,128 @@
+package keys_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+var benchMessage = poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}}
+
+func BenchmarkSign(b *testing.B) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(123456789))}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := priv.Sign(benchMessage, "mainnet"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(123456789))}
+	pub := priv.ToPublicKey()
+	sig, err := priv.Sign(benchMessage, "mainnet")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !pub.Verify(sig, benchMessage, "mainnet") {
+			b.Fatal("Verify rejected a valid signature")
+		}
+	}
+}
+
+func BenchmarkSignMessageLegacy(b *testing.B) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(123456789))}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := priv.SignMessageLegacy("benchmark message", "mainnet"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyMessageLegacy(b *testing.B) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(123456789))}
+	pub := priv.ToPublicKey()
+	sig, err := priv.SignMessageLegacy("benchmark message", "mainnet")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !pub.VerifyMessageLegacy(sig, "benchmark message", "mainnet") {
+			b.Fatal("VerifyMessageLegacy rejected a valid signature")
+		}
+	}
+}
+
+// BenchmarkVerifyParallel measures verification throughput with all CPUs,
+// the setting of a node checking the signatures of a block.
+func BenchmarkVerifyParallel(b *testing.B) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(123456789))}
+	pub := priv.ToPublicKey()
+	sig, err := priv.Sign(benchMessage, "mainnet")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if !pub.Verify(sig, benchMessage, "mainnet") {
+				b.Error("Verify rejected a valid signature")
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkBatchVerify measures a batch of 64 signatures by 8 keys, the
+// shape of a block's payments. Divide by 64 to compare with BenchmarkVerify.
+func BenchmarkBatchVerify(b *testing.B) {
+	network := keys.NetworkFromID("mainnet")
+	var batch keys.BatchVerifier
+	for i := 0; i < 64; i++ {
+		priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(int64(123456789 + i%8)))}
+		sig, err := priv.Sign(benchMessage, "mainnet")
+		if err != nil {
+			b.Fatal(err)
+		}
+		batch.Add(priv.ToPublicKey(), sig, benchMessage, network)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if ok, _ := batch.Verify(); !ok {
+			b.Fatal("Verify rejected valid signatures")
+		}
+	}
+}
+
+// BenchmarkVerifyWithContext is BenchmarkVerify with the key precomputed.
+func BenchmarkVerifyWithContext(b *testing.B) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(123456789))}
+	x, err := priv.ToPublicKey().Precompute()
+	if err != nil {
+		b.Fatal(err)
+	}
+	sig, err := priv.Sign(benchMessage, "mainnet")
+	if err != nil {
+		b.Fatal(err)
+	}
+	network := keys.NetworkFromID("mainnet")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !x.VerifyWithContext(sig, benchMessage, network) {
+			b.Fatal("VerifyWithContext rejected a valid signature")
+		}
+	}
+}
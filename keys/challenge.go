@@ -0,0 +1,54 @@
+package keys
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+// Challenge returns the scalar e of a kimchi signature by pk over message
+// whose nonce commitment has x-coordinate rx: the signature is (rx, k + e·x)
+// for the nonce k and private key x. It lets signers that hold the private
+// key outside this process, and cannot compute Poseidon themselves, finish
+// a signature from a nonce commitment.
+func (pk PublicKey) Challenge(message poseidonbigint.HashInput, rx *big.Int, network Network) (*scalar.Scalar, error) {
+	p, err := pk.challengePoint(network)
+	if err != nil {
+		return nil, err
+	}
+	return challengeScalar(hashMessage(message, p, rx, network))
+}
+
+// ChallengeLegacy is Challenge for the legacy scheme used by payments,
+// delegations and string messages.
+func (pk PublicKey) ChallengeLegacy(message poseidonbigint.HashInputLegacy, rx *big.Int, network Network) (*scalar.Scalar, error) {
+	p, err := pk.challengePoint(network)
+	if err != nil {
+		return nil, err
+	}
+	return challengeScalar(hashMessageLegacy(message, p, rx, network))
+}
+
+// challengePoint validates network and returns the point of pk.
+func (pk PublicKey) challengePoint(network Network) (Point, error) {
+	if err := network.Validate(); err != nil {
+		return Point{}, err
+	}
+	g, err := pk.decompress()
+	if err != nil {
+		return Point{}, err
+	}
+	return Point{X: g.X, Y: g.Y}, nil
+}
+
+// challengeScalar converts the Fp hash e to the scalar it is used as.
+func challengeScalar(e *big.Int) (*scalar.Scalar, error) {
+	eFq, err := field.ConvertFpToFq(e)
+	if err != nil {
+		return nil, fmt.Errorf("challenge: %w", err)
+	}
+	return scalar.NewScalarErr(eFq)
+}
@@ -0,0 +1,176 @@
+package keys
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/scalar"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Domain tags of the child key derivation.
+const (
+	childTweakLabel     = "MinaChildKeyTweak"
+	childChainCodeLabel = "MinaChildChainCode"
+)
+
+const (
+	// ChainCodeSize is the length of a chain code.
+	ChainCodeSize = 32
+	// HardenedIndex is the first hardened child index. Hardened children
+	// need the parent private key and are not supported by Child.
+	HardenedIndex uint32 = 1 << 31
+	// ExtendedPublicKeyByteSize is the size of a marshaled
+	// ExtendedPublicKey.
+	ExtendedPublicKeyByteSize = PublicKeyTotalByteSize + ChainCodeSize
+)
+
+// ErrInvalidChild is returned for the negligible fraction of indices whose
+// child key would be zero or the point at infinity. As in BIP32, callers
+// should skip to the next index.
+var ErrInvalidChild = errcode.New(errcode.InvalidArgument, "keys: invalid child key, use the next index")
+
+// ExtendedPublicKey is a public key with a chain code, from which child
+// public keys are derived without any private key. A watch-only service,
+// such as the deposit address generator of an exchange, holds only the
+// ExtendedPublicKey; the matching ExtendedPrivateKey derives the private
+// keys of the same children.
+//
+// Derivation follows BIP32's non-hardened path adapted to Pallas. For
+// index i < HardenedIndex and parent key K with chain code c:
+//
+//	t  = blake2b-512(key c, "MinaChildKeyTweak" || K || i) mod q
+//	c' = blake2b-256(key c, "MinaChildChainCode" || K || i)
+//	K' = K + t·G, k' = k + t
+//
+// where K is the 33-byte MarshalBytes encoding and i is 4 bytes
+// big-endian. The tweak is reduced from 512 bits so that it is uniform
+// modulo q, which BIP32's 256-bit IL is not for Pallas.
+//
+// As in BIP32, a child private key together with the parent extended
+// public key reveals the parent private key. Never expose both.
+type ExtendedPublicKey struct {
+	PublicKey PublicKey
+	ChainCode [ChainCodeSize]byte
+}
+
+// ExtendedPrivateKey is a private key with a chain code.
+type ExtendedPrivateKey struct {
+	PrivateKey PrivateKey
+	ChainCode  [ChainCodeSize]byte
+}
+
+// Public returns the extended public key of k.
+func (k ExtendedPrivateKey) Public() ExtendedPublicKey {
+	return ExtendedPublicKey{PublicKey: k.PrivateKey.ToPublicKey(), ChainCode: k.ChainCode}
+}
+
+// Child derives the non-hardened child public key at index.
+func (k ExtendedPublicKey) Child(index uint32) (ExtendedPublicKey, error) {
+	tweak, chainCode, err := childTweak(k.PublicKey, k.ChainCode, index)
+	if err != nil {
+		return ExtendedPublicKey{}, err
+	}
+	child, err := k.PublicKey.Tweak(tweak)
+	if errors.Is(err, ErrInvalidTweak) {
+		return ExtendedPublicKey{}, ErrInvalidChild
+	}
+	if err != nil {
+		return ExtendedPublicKey{}, fmt.Errorf("ExtendedPublicKey.Child: %w", err)
+	}
+	return ExtendedPublicKey{PublicKey: child, ChainCode: chainCode}, nil
+}
+
+// Child derives the non-hardened child private key at index. Its public
+// key is the one k.Public().Child(index) derives.
+func (k ExtendedPrivateKey) Child(index uint32) (ExtendedPrivateKey, error) {
+	if !k.PrivateKey.isSet() || k.PrivateKey.Value.BigInt().Sign() == 0 {
+		return ExtendedPrivateKey{}, fmt.Errorf("ExtendedPrivateKey.Child: %w", ErrNilKey)
+	}
+	tweak, chainCode, err := childTweak(k.PrivateKey.ToPublicKey(), k.ChainCode, index)
+	if err != nil {
+		return ExtendedPrivateKey{}, err
+	}
+	child, err := k.PrivateKey.Tweak(tweak)
+	if err != nil {
+		return ExtendedPrivateKey{}, ErrInvalidChild
+	}
+	return ExtendedPrivateKey{PrivateKey: child, ChainCode: chainCode}, nil
+}
+
+// Derive applies Child for each index of path in turn.
+func (k ExtendedPublicKey) Derive(path []uint32) (ExtendedPublicKey, error) {
+	var err error
+	for _, index := range path {
+		if k, err = k.Child(index); err != nil {
+			return ExtendedPublicKey{}, err
+		}
+	}
+	return k, nil
+}
+
+// Derive applies Child for each index of path in turn.
+func (k ExtendedPrivateKey) Derive(path []uint32) (ExtendedPrivateKey, error) {
+	var err error
+	for _, index := range path {
+		if k, err = k.Child(index); err != nil {
+			return ExtendedPrivateKey{}, err
+		}
+	}
+	return k, nil
+}
+
+// childTweak returns the tweak and chain code of the child at index of
+// the parent pub with chain code chainCode.
+func childTweak(pub PublicKey, chainCode [ChainCodeSize]byte, index uint32) (*scalar.Scalar, [ChainCodeSize]byte, error) {
+	var childChainCode [ChainCodeSize]byte
+	if index >= HardenedIndex {
+		return nil, childChainCode, errcode.Errorf(errcode.InvalidArgument, "keys: child index %d is hardened", index)
+	}
+	data, err := pub.MarshalBytes()
+	if err != nil {
+		return nil, childChainCode, err
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	h, _ := blake2b.New512(chainCode[:])
+	h.Write([]byte(childTweakLabel))
+	h.Write(data)
+	tweak := scalar.ScalarFromBytesLE(h.Sum(nil))
+	if tweak.BigInt().Sign() == 0 {
+		return nil, childChainCode, ErrInvalidChild
+	}
+
+	h, _ = blake2b.New256(chainCode[:])
+	h.Write([]byte(childChainCodeLabel))
+	h.Write(data)
+	copy(childChainCode[:], h.Sum(nil))
+	return tweak, childChainCode, nil
+}
+
+// MarshalBytes serializes k as the public key's MarshalBytes encoding
+// followed by the chain code, ExtendedPublicKeyByteSize bytes.
+func (k *ExtendedPublicKey) MarshalBytes() ([]byte, error) {
+	out, err := k.PublicKey.MarshalBytes()
+	if err != nil {
+		return nil, err
+	}
+	return append(out, k.ChainCode[:]...), nil
+}
+
+// UnmarshalBytes deserializes data written by MarshalBytes into k.
+func (k *ExtendedPublicKey) UnmarshalBytes(data []byte) error {
+	if len(data) != ExtendedPublicKeyByteSize {
+		return fmt.Errorf("invalid data length for ExtendedPublicKey: %w: expected %d bytes, got %d bytes", ErrInvalidLength, ExtendedPublicKeyByteSize, len(data))
+	}
+	var decoded ExtendedPublicKey
+	if err := decoded.PublicKey.UnmarshalBytes(data[:PublicKeyTotalByteSize]); err != nil {
+		return err
+	}
+	copy(decoded.ChainCode[:], data[PublicKeyTotalByteSize:])
+	*k = decoded
+	return nil
+}
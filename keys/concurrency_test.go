@@ -0,0 +1,63 @@
+package keys_test
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+// TestConcurrentVerify verifies shared keys, signatures and messages from
+// many goroutines. Run it with -race to check that verification touches no
+// shared mutable state.
+func TestConcurrentVerify(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(987654321))}
+	pub := priv.ToPublicKey()
+	message := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(1), big.NewInt(2)}}
+	sig, err := priv.Sign(message, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy, err := priv.SignMessageLegacy("concurrent", "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := priv.SignMessage("concurrent", "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const goroutines, rounds = 16, 3
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				if !pub.Verify(sig, message, "testnet") {
+					t.Error("Verify rejected a valid signature")
+				}
+				if pub.Verify(sig, message, "mainnet") {
+					t.Error("Verify accepted a signature for another network")
+				}
+				if !pub.VerifyMessageLegacy(legacy, "concurrent", "testnet") {
+					t.Error("VerifyMessageLegacy rejected a valid signature")
+				}
+				if !pub.VerifyMessage(text, "concurrent", "testnet") {
+					t.Error("VerifyMessage rejected a valid signature")
+				}
+				// Signing shares the hash helpers with verification.
+				if _, err := priv.Sign(message, "testnet"); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if message.Fields[0].Int64() != 1 || message.Fields[1].Int64() != 2 {
+		t.Error("verification modified the message")
+	}
+}
@@ -0,0 +1,32 @@
+package keys
+
+import "golang.org/x/crypto/blake2b"
+
+// contextLabel separates the digest WithContext derives from other blake2b
+// uses.
+const contextLabel = "MinaSignatureContext"
+
+// WithContext returns n bound to an application context, so signatures one
+// application makes with a key do not verify for another application using
+// the same key and network. An empty context returns n unchanged.
+//
+// The derived network replaces both the signature prefix, which salts the
+// challenge, and the id, which is mixed into the nonce, with the first 31
+// bytes of blake2b-256 over "MinaSignatureContext", the length-prefixed
+// prefix and id of n, and the context. Changing the nonce along with the
+// challenge matters: two signatures of one message sharing a nonce under
+// different challenges would reveal the private key.
+func (n Network) WithContext(context []byte) Network {
+	if len(context) == 0 {
+		return n
+	}
+	h, _ := blake2b.New256(nil)
+	h.Write([]byte(contextLabel))
+	h.Write([]byte{byte(len(n.SignaturePrefix))})
+	h.Write([]byte(n.SignaturePrefix))
+	h.Write([]byte{byte(len(n.ID))})
+	h.Write(n.ID)
+	h.Write(context)
+	digest := h.Sum(nil)[:31]
+	return Network{Name: n.Name, SignaturePrefix: string(digest), ID: digest}
+}
@@ -0,0 +1,29 @@
+package keys
+
+import (
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// Errors returned, usually wrapped, by the key and address functions of
+// this package. Check them with errors.Is. Several are the sentinels of the
+// field and curve packages under a second name, so that an error matches
+// both; for example errors.Is(err, ErrNotOnCurve) and errors.Is(err,
+// curve.ErrNotOnCurve) agree.
+var (
+	// ErrInvalidLength is returned for an encoding of the wrong size.
+	ErrInvalidLength = field.ErrInvalidLength
+	// ErrNonCanonical is returned for a coordinate, scalar or flag byte
+	// that is out of range instead of being reduced.
+	ErrNonCanonical = field.ErrNonCanonical
+	// ErrNotOnCurve is returned for a public key whose x coordinate is not
+	// on Pallas.
+	ErrNotOnCurve = curve.ErrNotOnCurve
+	// ErrPointAtInfinity is returned where a key or nonce commitment
+	// would be the point at infinity.
+	ErrPointAtInfinity = curve.ErrAtInfinity
+	// ErrNilKey is returned when a private key's value or a public key's x
+	// coordinate is nil, or a private key is zero where it must not be.
+	ErrNilKey = errcode.New(errcode.MissingValue, "keys: key is not set")
+)
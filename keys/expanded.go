@@ -0,0 +1,87 @@
+package keys
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/curvebigint"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+// expandedWindowWidth is the window width of the table of an
+// ExpandedPublicKey.
+const expandedWindowWidth = 5
+
+// ExpandedPublicKey is a public key prepared for many verifications: its
+// point is decompressed and validated once, and a curve.FixedBaseTable of
+// its multiples turns the multiplication by the challenge into about 50
+// additions, as the precomputed generator table does for s·G. It costs
+// about as much as a few verifications to build and some 270 KiB to hold,
+// so it pays off for a key that signs much of what a service verifies,
+// such as a sequencer's.
+//
+// An ExpandedPublicKey is read-only and safe for concurrent use.
+type ExpandedPublicKey struct {
+	pk    PublicKey
+	point Point
+	table *curve.FixedBaseTable
+}
+
+// Precompute returns the verification context of pk. It fails for a key
+// that Validate rejects.
+func (pk PublicKey) Precompute() (*ExpandedPublicKey, error) {
+	g, err := pk.decompress()
+	if err != nil {
+		return nil, err
+	}
+	table, err := curve.Pallas().NewFixedBaseTable(g, expandedWindowWidth)
+	if err != nil {
+		return nil, err
+	}
+	return &ExpandedPublicKey{pk: pk, point: Point{X: g.X, Y: g.Y}, table: table}, nil
+}
+
+// PublicKey returns the key x was computed from.
+func (x *ExpandedPublicKey) PublicKey() PublicKey {
+	return x.pk
+}
+
+// VerifyWithContext is PublicKey.VerifyForNetwork using the precomputed
+// context x. The context is that of the key and unrelated to
+// Network.WithContext, whose networks x verifies for like any other.
+func (x *ExpandedPublicKey) VerifyWithContext(sig *signature.Signature, message poseidonbigint.HashInput, network Network) bool {
+	if !sig.IsCanonical() || network.Validate() != nil {
+		return false
+	}
+	return x.verify(sig, hashMessage(message, x.point, sig.R.BigInt(), network))
+}
+
+// VerifyLegacyWithContext is PublicKey.VerifyLegacyForNetwork using the
+// precomputed context x.
+func (x *ExpandedPublicKey) VerifyLegacyWithContext(sig *signature.Signature, message poseidonbigint.HashInputLegacy, network Network) bool {
+	if !sig.IsCanonical() || network.Validate() != nil {
+		return false
+	}
+	return x.verify(sig, hashMessageLegacy(message, x.point, sig.R.BigInt(), network))
+}
+
+// verify checks that s·G - e·P has the x-coordinate R of sig and an even
+// y, for the challenge e.
+func (x *ExpandedPublicKey) verify(sig *signature.Signature, e *big.Int) bool {
+	pallas := curve.Pallas()
+	eP, err := pallas.ScaleFixedBase(x.table, e)
+	if err != nil {
+		return false
+	}
+	rPrime, err := pallas.AddChecked(pallas.ScaleBase(sig.S.BigInt()), pallas.Negate(eP))
+	if err != nil {
+		return false
+	}
+	affine, err := curvebigint.GroupFromProjective(rPrime)
+	if err != nil {
+		return false
+	}
+	return field.Fp.IsEven(affine.Y) && field.Fp.Equal(affine.X, sig.R.BigInt())
+}
@@ -0,0 +1,126 @@
+package keys_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+const (
+	fuzzAddress    = "B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg"
+	fuzzPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+)
+
+func fuzzPublicKeyBytes(f *testing.F) []byte {
+	pk, err := keys.PublicKeyFromBase58(fuzzAddress)
+	if err != nil {
+		f.Fatal(err)
+	}
+	data, err := pk.MarshalBytes()
+	if err != nil {
+		f.Fatal(err)
+	}
+	return data
+}
+
+func FuzzPublicKeyUnmarshalBytes(f *testing.F) {
+	f.Add(fuzzPublicKeyBytes(f))
+	f.Add(make([]byte, keys.PublicKeyTotalByteSize))
+	f.Add(bytes.Repeat([]byte{0xff}, keys.PublicKeyTotalByteSize))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var pk keys.PublicKey
+		if err := pk.UnmarshalBytes(data); err != nil {
+			return
+		}
+		if err := pk.Validate(); err != nil {
+			t.Fatalf("decoded an invalid key: %v", err)
+		}
+		out, err := pk.MarshalBytes()
+		if err != nil || !bytes.Equal(out, data) {
+			t.Fatalf("round trip: %x, %v", out, err)
+		}
+	})
+}
+
+func FuzzPrivateKeyUnmarshalBytes(f *testing.F) {
+	f.Add(make([]byte, keys.PrivateKeyByteSize))
+	f.Add(bytes.Repeat([]byte{0xff}, keys.PrivateKeyByteSize))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var sk keys.PrivateKey
+		if err := sk.UnmarshalBytes(data); err != nil {
+			return
+		}
+		out, err := sk.MarshalBytes()
+		if err != nil || !bytes.Equal(out, data) {
+			t.Fatalf("round trip: %x, %v", out, err)
+		}
+	})
+}
+
+func FuzzExtendedPublicKeyUnmarshalBytes(f *testing.F) {
+	f.Add(append(fuzzPublicKeyBytes(f), make([]byte, keys.ChainCodeSize)...))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var k keys.ExtendedPublicKey
+		if err := k.UnmarshalBytes(data); err != nil {
+			return
+		}
+		if _, err := k.Child(0); err != nil && err != keys.ErrInvalidChild {
+			t.Fatalf("child of a decoded key: %v", err)
+		}
+	})
+}
+
+func FuzzPublicKeyFromBase58(f *testing.F) {
+	f.Add(fuzzAddress)
+	f.Add("B62")
+	f.Add("")
+	f.Fuzz(func(t *testing.T, address string) {
+		pk, err := keys.PublicKeyFromBase58(address)
+		if err != nil {
+			return
+		}
+		out, err := pk.ToBase58()
+		if err != nil || out != address {
+			t.Fatalf("round trip: %q, %v", out, err)
+		}
+	})
+}
+
+func FuzzPrivateKeyFromBase58(f *testing.F) {
+	f.Add(fuzzPrivateKey)
+	f.Add("EK")
+	f.Fuzz(func(t *testing.T, s string) {
+		sk, err := keys.PrivateKeyFromBase58(s)
+		if err != nil {
+			return
+		}
+		out, err := sk.ToBase58()
+		if err != nil || out != s {
+			t.Fatalf("round trip: %q, %v", out, err)
+		}
+	})
+}
+
+// FuzzVerify checks that verification of arbitrary keys, which need not
+// be on the curve, and arbitrary signatures reports a result instead of
+// panicking.
+func FuzzVerify(f *testing.F) {
+	f.Add([]byte{0}, false, make([]byte, signature.TotalSignatureSize), []byte{1}, "hello")
+	f.Fuzz(func(t *testing.T, x []byte, isOdd bool, sigData, message []byte, text string) {
+		pk := keys.PublicKey{X: new(field.FpElement).SetBigInt(new(big.Int).SetBytes(x)), IsOdd: isOdd}
+		sig := &signature.Signature{}
+		if err := sig.UnmarshalBytes(sigData); err != nil {
+			sig = nil
+		}
+		input := poseidonbigint.HashInput{Fields: []*big.Int{new(big.Int).SetBytes(message)}}
+		pk.Verify(sig, input, "mainnet")
+		pk.VerifyMessage(sig, text, "testnet")
+		pk.VerifyMessageLegacy(sig, text, "testnet")
+		pk.VerifyFieldElement(sig, new(big.Int).SetBytes(message), "mainnet")
+	})
+}
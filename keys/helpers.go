@@ -2,7 +2,6 @@ package keys
 
 import (
 	"math/big"
-	"strconv"
 	"strings"
 
 	"github.com/node101-io/mina-signer-go/constants"
@@ -17,18 +16,21 @@ import (
 	"golang.org/x/crypto/blake2b"
 )
 
+// Poseidon hash helpers shared by all signatures. They hold only constants,
+// so concurrent signers and verifiers can use them without locking.
 var (
-	// These are unexported and used internally by helper functions.
-	networkIdMainnet = big.NewInt(0x01)
-	networkIdDevnet  = big.NewInt(0x00)
+	kimchiHash = hashgeneric.CreateHashHelpers(field.Fp, poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp))
+	legacyHash = hashgeneric.CreateHashHelpers(field.Fp, poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsLegacyFp))
 )
 
 // deriveNonce derives a nonce for Schnorr signature generation.
-// It takes the message, the public key point (as keys.Point), the private key value, and network ID.
-func deriveNonce(message poseidonbigint.HashInput, publicKeyPoint Point, privValue *big.Int, networkId string) *big.Int {
+// It takes the message, the public key point (as keys.Point), the private key value, and network.
+func deriveNonce(message poseidonbigint.HashInput, publicKeyPoint Point, privValue *big.Int, network Network) *big.Int {
 	x, y := publicKeyPoint.X, publicKeyPoint.Y // Using X, Y from keys.Point
+	// The private key is an Fq value; reducing it into Fp wraps keys in
+	// [p, q) on purpose, matching o1js (see the notes in field/convert.go).
 	d := field.FromBigInt(privValue)
-	idx, idy := getNetworkIdHashInput(networkId)
+	idx, idy := network.idInput()
 
 	helper := poseidonbigint.HashInputHelpers{}
 	input := helper.Append(message, poseidonbigint.HashInput{
@@ -47,93 +49,43 @@ func deriveNonce(message poseidonbigint.HashInput, publicKeyPoint Point, privVal
 		inputBits = append(inputBits, bits...)
 	}
 	inputBytes := bitsToBytes(inputBits)
-	bytes := blake2b256(inputBytes)
+	return nonceFromDigest(blake2b256(inputBytes))
+}
+
+// nonceFromDigest turns a 32-byte blake2b digest into a nonce below 2^254.
+func nonceFromDigest(bytes []byte) *big.Int {
 	bytes[31] &= 0x3f // Clear the top two bits
 
-	// scalar.ScalarFromBytes is a public function
-	result := scalar.ScalarFromBytes(bytes).BigInt()
-	return result
+	// The digest is read little-endian, as in o1js.
+	return scalar.ScalarFromBytesLE(bytes).BigInt()
 }
 
 // hashMessage computes the hash used in Schnorr signature, combining the message, public key, and a nonce component (r).
-// It takes the message, public key point (as keys.Point), the R value of the signature, and network ID.
-func hashMessage(message poseidonbigint.HashInput, pubPoint Point, r_val *big.Int, networkId string) *big.Int {
+// It takes the message, public key point (as keys.Point), the R value of the signature, and network.
+func hashMessage(message poseidonbigint.HashInput, pubPoint Point, r_val *big.Int, network Network) *big.Int {
 	x, y := pubPoint.X, pubPoint.Y // Using X, Y from keys.Point
 	helper := poseidonbigint.HashInputHelpers{}
-	// poseidon.CreatePoseidon and constants.PoseidonParamsKimchiFp are public
-	hashGeneric := hashgeneric.CreateHashHelpers(field.Fp, poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp))
 	input := helper.Append(message, poseidonbigint.HashInput{Fields: []*big.Int{x, y, r_val}})
 
-	prefix := signaturePrefix(networkId)
-	// hashGeneric.HashWithPrefix is a public method of the hashGeneric helper instance.
-	return hashGeneric.HashWithPrefix(prefix, poseidonbigint.PackToFields(input))
+	prefix := network.SignaturePrefix
+	return kimchiHash.HashWithPrefix(prefix, poseidonbigint.PackToFields(input))
 }
 
 // hashMessageLegacy computes the hash used in Schnorr signature, combining the message, public key, and a nonce component (r).
-// It takes the message, public key point (as keys.Point), the R value of the signature, and network ID.
-func hashMessageLegacy(message poseidonbigint.HashInputLegacy, pubPoint Point, r_val *big.Int, networkId string) *big.Int {
+// It takes the message, public key point (as keys.Point), the R value of the signature, and network.
+func hashMessageLegacy(message poseidonbigint.HashInputLegacy, pubPoint Point, r_val *big.Int, network Network) *big.Int {
 	x, y := pubPoint.X, pubPoint.Y // Using X, Y from keys.Point
 	helper := poseidonbigint.HashInputLegacyHelpers{}
-	// poseidon.CreatePoseidon and constants.PoseidonParamsLegacyFp are public
-	hashGeneric := hashgeneric.CreateHashHelpers(field.Fp, poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsLegacyFp))
 	input := helper.Append(message, poseidonbigint.HashInputLegacy{Fields: []*big.Int{x, y, r_val}})
 
-	prefix := signaturePrefix(networkId)
-	// hashGeneric.HashWithPrefix is a public method of the hashGeneric helper instance.
-	return hashGeneric.HashWithPrefix(prefix, poseidonbigint.PackToFieldsLegacy(input))
-}
-
-// -- Helper functions for network ID and prefixes (mostly as they were, made unexported) --
-
-func getNetworkIdHashInput(network string) (*big.Int, int) {
-	switch network {
-	case "mainnet":
-		return networkIdMainnet, 8
-	case "devnet", "testnet":
-		return networkIdDevnet, 8
-	default:
-		return networkIdOfString(network)
-	}
-}
-
-func networkIdOfString(n string) (*big.Int, int) {
-	l := len(n)
-	acc := ""
-	for i := l - 1; i >= 0; i-- {
-		b := n[i]
-		padded := numberToBytePadded(int(b))
-		acc += padded
-	}
-	val, _ := new(big.Int).SetString("0b"+acc, 0) // Error ignored as in original
-	return val, len(acc)
-}
-
-func numberToBytePadded(b int) string {
-	return leftPad(strconv.FormatInt(int64(b), 2), "0", 8)
-}
-
-func leftPad(s, pad string, length int) string {
-	for len(s) < length {
-		s = pad + s
-	}
-	return s
-}
-
-func signaturePrefix(network string) string {
-	switch network {
-	case "mainnet":
-		return constants.Prefixes["signatureMainnet"]
-	case "devnet", "testnet":
-		return constants.Prefixes["signatureTestnet"]
-	default:
-		// constants.CreateCustomPrefix was not defined, assuming it was a typo for CreateCustomPrefix in signature pkg
-		// For now, let's use the local createCustomPrefix
-		return createCustomPrefix(network + "Signature")
-	}
+	prefix := network.SignaturePrefix
+	return legacyHash.HashWithPrefix(prefix, poseidonbigint.PackToFieldsLegacy(input))
 }
 
-// This was originally in signature.go, moved here and made unexported.
-func createCustomPrefix(prefix string) string {
+// CustomPrefix returns prefix padded with '*' or cut to the 20 characters
+// of a Poseidon domain prefix, as o1js builds the prefixes of custom
+// networks.
+func CustomPrefix(prefix string) string {
 	const maxLength = 20    // Keep this internal to the helper
 	const paddingChar = "*" // Keep this internal
 	length := len(prefix)
@@ -0,0 +1,62 @@
+//go:build !tinygo && !minasigner_core
+
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// MarshalJSON implements the json.Marshaler interface for PublicKey.
+func (pk PublicKey) MarshalJSON() ([]byte, error) {
+	// Guard against nil pk.X if it can occur, as pk.X.String() would panic.
+	var xStr string
+	if pk.X != nil {
+		xStr = pk.X.String()
+	}
+	return json.Marshal(struct {
+		X     string `json:"x"`
+		IsOdd bool   `json:"isOdd"`
+	}{
+		X:     xStr,
+		IsOdd: pk.IsOdd,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PublicKey.
+// Under strict decoding x must be present and in minimal decimal form.
+func (pk *PublicKey) UnmarshalJSON(data []byte) error {
+	var temp struct {
+		X     string `json:"x"`
+		IsOdd bool   `json:"isOdd"`
+	}
+	if err := field.DecodeJSONObject(data, &temp); err != nil {
+		return err
+	}
+	if temp.X == "" && field.IsStrict() {
+		return fmt.Errorf("invalid PublicKey JSON: %w: missing x", ErrNilKey)
+	}
+
+	var x *field.FpElement
+	if temp.X != "" { // Handle case where X might be an empty string in JSON
+		v, err := field.Fp.FromString(temp.X, 10)
+		if err != nil {
+			return fmt.Errorf("failed to parse X '%s' from JSON for PublicKey: %w", temp.X, err)
+		}
+		x = new(field.FpElement).SetBigInt(v)
+	} else {
+		// Decide how to handle empty X string: treat as nil, zero, or error.
+		// Assuming nil for now if X can be legitimately nil.
+		x = nil
+	}
+	decoded := PublicKey{X: x, IsOdd: temp.IsOdd}
+	if x != nil {
+		if err := decoded.Validate(); err != nil {
+			return err
+		}
+	}
+	*pk = decoded
+	return nil
+}
@@ -0,0 +1,77 @@
+//go:build !tinygo && !minasigner_core
+
+package keys_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+func TestPublicKeyUnmarshalJSONRejectsInvalid(t *testing.T) {
+	var pk keys.PublicKey
+	if err := pk.UnmarshalJSON([]byte(`{"x":"0","isOdd":false}`)); !errors.Is(err, curve.ErrNotOnCurve) {
+		t.Errorf("UnmarshalJSON(x=0) error = %v, want curve.ErrNotOnCurve", err)
+	}
+
+	pub := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(13579))}.ToPublicKey()
+	highJSON := `{"x":"` + new(big.Int).Add(pub.X.BigInt(), field.P).String() + `","isOdd":false}`
+	if err := pk.UnmarshalJSON([]byte(highJSON)); !errors.Is(err, field.ErrNonCanonical) {
+		t.Errorf("UnmarshalJSON(x+p) error = %v, want field.ErrNonCanonical", err)
+	}
+}
+
+func FuzzPublicKeyUnmarshalJSON(f *testing.F) {
+	f.Add([]byte(`{"x":"1","isOdd":false}`))
+	f.Add([]byte(`{"x":"","isOdd":true}`))
+	f.Add([]byte(`{"x":"-1"}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var pk keys.PublicKey
+		if err := pk.UnmarshalJSON(data); err != nil || pk.X == nil {
+			return
+		}
+		if err := pk.Validate(); err != nil {
+			t.Fatalf("decoded an invalid key: %v", err)
+		}
+	})
+}
+
+func TestPublicKeyUnmarshalJSONStrict(t *testing.T) {
+	t.Cleanup(func() { field.SetStrictDecoding(false) })
+	pub := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(13579))}.ToPublicKey()
+	x := pub.X.BigInt().String()
+	isOdd := "false"
+	if pub.IsOdd {
+		isOdd = "true"
+	}
+	inputs := []string{
+		`{"x":"0` + x + `","isOdd":` + isOdd + `}`,
+		`{"x":"` + x + `","isOdd":` + isOdd + `,"extra":1}`,
+		`{"isOdd":false}`,
+		`{"x":"1","x":"` + x + `","isOdd":` + isOdd + `}`,
+	}
+	var pk keys.PublicKey
+	for _, in := range inputs {
+		if err := pk.UnmarshalJSON([]byte(in)); err != nil {
+			t.Errorf("lenient UnmarshalJSON(%s): %v", in, err)
+		}
+	}
+	field.SetStrictDecoding(true)
+	for _, in := range inputs {
+		if err := pk.UnmarshalJSON([]byte(in)); err == nil {
+			t.Errorf("strict UnmarshalJSON(%s) succeeded", in)
+		}
+	}
+	canonical := `{"x":"` + x + `","isOdd":` + isOdd + `}`
+	if err := pk.UnmarshalJSON([]byte(canonical)); err != nil {
+		t.Errorf("strict UnmarshalJSON(canonical): %v", err)
+	}
+	if err := pk.UnmarshalJSON([]byte(canonical + " garbage")); err == nil {
+		t.Error("UnmarshalJSON accepted data after the object")
+	}
+}
@@ -2,10 +2,19 @@ package keys_test
 
 import (
 	"crypto/sha256"
+	"errors"
 	"math/big"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
 	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signature"
 )
 
 func TestPrivateKey_MarshalUnmarshalBytes(t *testing.T) {
@@ -33,7 +42,7 @@ func TestPrivateKey_MarshalUnmarshalBytes(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			privKey := keys.PrivateKey{Value: tt.original}
+			privKey := keys.PrivateKey{Value: scalar.NewScalar(tt.original)}
 
 			marshaledBytes, err := privKey.MarshalBytes()
 			if (err != nil) != tt.wantErr {
@@ -55,7 +64,7 @@ func TestPrivateKey_MarshalUnmarshalBytes(t *testing.T) {
 				return
 			}
 
-			if privKey.Value.Cmp(newPrivKey.Value) != 0 {
+			if privKey.Value.BigInt().Cmp(newPrivKey.Value.BigInt()) != 0 {
 				t.Errorf("Unmarshaled PrivateKey.Value = %v, want %v", newPrivKey.Value, privKey.Value)
 			}
 		})
@@ -109,7 +118,7 @@ func TestPublicKey_MarshalUnmarshalBytes(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			pubKey := keys.PublicKey{X: tt.originalX, IsOdd: tt.originalIsOdd}
+			pubKey := keys.PublicKey{X: new(field.FpElement).SetBigInt(tt.originalX), IsOdd: tt.originalIsOdd}
 
 			marshaledBytes, err := pubKey.MarshalBytes()
 			if (err != nil) != tt.wantErr {
@@ -131,7 +140,7 @@ func TestPublicKey_MarshalUnmarshalBytes(t *testing.T) {
 				return
 			}
 
-			if pubKey.X.Cmp(newPubKey.X) != 0 {
+			if !pubKey.X.Equal(newPubKey.X) {
 				t.Errorf("Unmarshaled PublicKey.X = %v, want %v", newPubKey.X, pubKey.X)
 			}
 			if pubKey.IsOdd != newPubKey.IsOdd {
@@ -179,7 +188,7 @@ func TestPublicKey_MarshalUnmarshalBytes(t *testing.T) {
 // back to its public key, and the result is the same as deriving the public key directly.
 func TestMarshalUnmarshalSymmetry(t *testing.T) {
 	privVal := big.NewInt(1234567891011121314)
-	originalPrivKey := keys.PrivateKey{Value: privVal}
+	originalPrivKey := keys.PrivateKey{Value: scalar.NewScalar(privVal)}
 
 	originalPubKey := originalPrivKey.ToPublicKey()
 
@@ -284,7 +293,7 @@ func TestNewPrivateKeyFromBytes(t *testing.T) {
 			}
 
 			// Verify that the value is within the valid range and non-zero
-			if privKey.Value.Cmp(big.NewInt(0)) <= 0 {
+			if privKey.Value.BigInt().Cmp(big.NewInt(0)) <= 0 {
 				t.Error("NewPrivateKeyFromBytes() returned PrivateKey with zero or negative Value")
 			}
 
@@ -298,7 +307,7 @@ func TestNewPrivateKeyFromBytes(t *testing.T) {
 			}
 			if allZeros {
 				// Should be a valid private key, not necessarily 1 anymore
-				if privKey.Value.Cmp(big.NewInt(0)) == 0 {
+				if privKey.Value.BigInt().Cmp(big.NewInt(0)) == 0 {
 					t.Error("NewPrivateKeyFromBytes() with all zeros input should not result in zero value")
 				}
 			}
@@ -398,9 +407,778 @@ func TestNewPrivateKeyFromBytes_Determinism(t *testing.T) {
 			}
 
 			// All should be valid (non-zero)
-			if privKey1.Value.Cmp(big.NewInt(0)) == 0 {
+			if privKey1.Value.BigInt().Cmp(big.NewInt(0)) == 0 {
 				t.Error("NewPrivateKeyFromBytes() returned zero value")
 			}
 		})
 	}
 }
+
+func TestVerifyInvalidPublicKeyDoesNotPanic(t *testing.T) {
+	// Find an x for which x^3 + 5 has no square root, i.e. no curve point.
+	x := big.NewInt(0)
+	for {
+		pk := keys.PublicKey{X: new(field.FpElement).SetBigInt(x)}
+		if !pk.IsValid() {
+			break
+		}
+		x = new(big.Int).Add(x, big.NewInt(1))
+	}
+	pk := keys.PublicKey{X: new(field.FpElement).SetBigInt(x)}
+
+	if _, err := pk.ToGroup(); err == nil {
+		t.Error("PublicKey.ToGroup() expected error for x off the curve, got nil")
+	}
+
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(123456789))}
+	sig, err := priv.SignFieldElement(big.NewInt(42), "testnet")
+	if err != nil {
+		t.Fatalf("SignFieldElement failed: %v", err)
+	}
+	if pk.VerifyFieldElement(sig, big.NewInt(42), "testnet") {
+		t.Error("Verify succeeded for a public key that is not on the curve")
+	}
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(987654321))}
+	pub := priv.ToPublicKey()
+	for _, network := range []string{"mainnet", "testnet"} {
+		sig, err := priv.SignMessage("hello mina", network)
+		if err != nil {
+			t.Fatalf("SignMessage(%s) failed: %v", network, err)
+		}
+		if !pub.VerifyMessage(sig, "hello mina", network) {
+			t.Errorf("VerifyMessage(%s) rejected a valid signature", network)
+		}
+		if pub.VerifyMessage(sig, "hello mina!", network) {
+			t.Errorf("VerifyMessage(%s) accepted a signature for a different message", network)
+		}
+	}
+}
+
+func TestSignFields(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(246813579))}
+	pub := priv.ToPublicKey()
+	fields := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	sig, err := priv.SignFields("testnet", fields...)
+	if err != nil {
+		t.Fatalf("SignFields failed: %v", err)
+	}
+	want, err := priv.Sign(poseidonbigint.HashInput{Fields: fields}, "testnet")
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if !sig.R.Equal(want.R) || sig.S.BigInt().Cmp(want.S.BigInt()) != 0 {
+		t.Error("SignFields differs from Sign over the same HashInput")
+	}
+	if !pub.VerifyFields(sig, "testnet", big.NewInt(1), big.NewInt(2), big.NewInt(3)) {
+		t.Error("VerifyFields rejected a valid signature")
+	}
+	if pub.VerifyFields(sig, "testnet", big.NewInt(1), big.NewInt(3), big.NewInt(2)) {
+		t.Error("VerifyFields accepted reordered fields")
+	}
+	if pub.VerifyFields(sig, "mainnet", fields...) {
+		t.Error("VerifyFields accepted a signature for another network")
+	}
+}
+
+func TestEncodeMessage(t *testing.T) {
+	hex := func(s string) *big.Int {
+		x, _ := new(big.Int).SetString(s, 16)
+		return x
+	}
+	tests := []struct {
+		name string
+		msg  string
+		opts keys.EncodingOptions
+		want []*big.Int
+	}{
+		{"v0 empty", "", keys.EncodingOptions{}, []*big.Int{}},
+		{"v0", "ab", keys.EncodingOptions{}, []*big.Int{hex("6162")}},
+		{"v0 two chunks", strings.Repeat("a", 33), keys.EncodingOptions{}, []*big.Int{hex(strings.Repeat("61", 32)), hex("61")}},
+		{"v1 empty", "", keys.EncodingOptions{Version: keys.MessageEncodingV1}, []*big.Int{big.NewInt(0)}},
+		{"v1", "ab", keys.EncodingOptions{Version: keys.MessageEncodingV1}, []*big.Int{hex("6261"), big.NewInt(2)}},
+		{"v1 big-endian", "ab", keys.EncodingOptions{Version: keys.MessageEncodingV1, ByteOrder: keys.BigEndian}, []*big.Int{hex("6162" + strings.Repeat("00", 29)), big.NewInt(2)}},
+		{"v0 zero padding", "abc", keys.EncodingOptions{ChunkSize: 2, Padding: keys.PadZero}, []*big.Int{hex("6162"), hex("6300")}},
+	}
+	for _, tt := range tests {
+		got, err := keys.EncodeMessage(tt.msg, tt.opts)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: %d fields, want %d", tt.name, len(got), len(tt.want))
+			continue
+		}
+		for i := range got {
+			if got[i].Cmp(tt.want[i]) != 0 {
+				t.Errorf("%s: field %d = %x, want %x", tt.name, i, got[i], tt.want[i])
+			}
+		}
+	}
+
+	for _, opts := range []keys.EncodingOptions{{ChunkSize: 33}, {ChunkSize: -1}, {Version: 2}, {Padding: 4}} {
+		if _, err := keys.EncodeMessage("x", opts); err == nil {
+			t.Errorf("EncodeMessage accepted %+v", opts)
+		}
+	}
+}
+
+func TestSignMessageWithEncoding(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(1122334455))}
+	pub := priv.ToPublicKey()
+	msg := "an encoding both sides agree on"
+	v0, err := priv.SignMessage(msg, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pub.VerifyMessageWithEncoding(v0, msg, "testnet", keys.EncodingOptions{Version: keys.MessageEncodingV0}) {
+		t.Error("SignMessage does not use MessageEncodingV0")
+	}
+	v1 := keys.EncodingOptions{Version: keys.MessageEncodingV1}
+	sig, err := priv.SignMessageWithEncoding(msg, "testnet", v1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pub.VerifyMessageWithEncoding(sig, msg, "testnet", v1) {
+		t.Error("VerifyMessageWithEncoding rejected a valid signature")
+	}
+	if pub.VerifyMessage(sig, msg, "testnet") {
+		t.Error("a v1 signature verified under v0")
+	}
+	if pub.VerifyMessageWithEncoding(sig, msg+"\x00", "testnet", v1) {
+		t.Error("v1 does not distinguish trailing zero bytes")
+	}
+}
+
+func TestSignWithBlinding(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(555555555))}
+	msg := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(1), big.NewInt(2)}}
+	want, err := priv.Sign(msg, "mainnet")
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	got, err := priv.SignWithOptions(msg, "mainnet", keys.SignOptions{
+		Blinding: curve.BlindingOptions{Scalar: true, Projective: true},
+	})
+	if err != nil {
+		t.Fatalf("SignWithOptions failed: %v", err)
+	}
+	if !got.R.Equal(want.R) || got.S.BigInt().Cmp(want.S.BigInt()) != 0 {
+		t.Error("blinded signing produced a different signature")
+	}
+}
+
+func TestPublicKeyUnmarshalRejectsInvalidPoints(t *testing.T) {
+	// x = 0 gives y^2 = 5, which is not a square mod p.
+	offCurve := make([]byte, keys.PublicKeyTotalByteSize)
+	var pk keys.PublicKey
+	err := pk.UnmarshalBytes(offCurve)
+	if !errors.Is(err, curve.ErrNotOnCurve) {
+		t.Errorf("UnmarshalBytes(x=0) error = %v, want curve.ErrNotOnCurve", err)
+	}
+	if pk.X != nil {
+		t.Error("UnmarshalBytes modified the key on error")
+	}
+
+	valid := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(424242))}.ToPublicKey()
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate rejected a derived public key: %v", err)
+	}
+}
+
+func TestVerifyRejectsNonCanonicalSignature(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(13579))}
+	pub := priv.ToPublicKey()
+	sig, err := priv.SignFieldElement(big.NewInt(7), "testnet")
+	if err != nil {
+		t.Fatalf("SignFieldElement failed: %v", err)
+	}
+	if !pub.VerifyFieldElement(sig, big.NewInt(7), "testnet") {
+		t.Fatal("VerifyFieldElement rejected a valid signature")
+	}
+
+	// s + q and r + p act like s and r in the group and field arithmetic,
+	// but must not decode as a second encoding of the same signature.
+	data, err := sig.MarshalBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sPlusQ := append([]byte(nil), data...)
+	new(big.Int).Add(sig.S.BigInt(), field.Q).FillBytes(sPlusQ[signature.BigIntSize:])
+	rPlusP := append([]byte(nil), data...)
+	new(big.Int).Add(sig.R.BigInt(), field.P).FillBytes(rPlusP[:signature.BigIntSize])
+	for name, encoded := range map[string][]byte{"s+q": sPlusQ, "r+p": rPlusP} {
+		var malleated signature.Signature
+		if err := malleated.UnmarshalBytes(encoded); !errors.Is(err, field.ErrNonCanonical) {
+			t.Errorf("UnmarshalBytes(%s) error = %v, want field.ErrNonCanonical", name, err)
+		}
+	}
+	if pub.VerifyFieldElement(&signature.Signature{R: sig.R}, big.NewInt(7), "testnet") {
+		t.Error("VerifyFieldElement accepted a signature without S")
+	}
+
+	pubBytes, err := pub.MarshalBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	new(big.Int).Add(pub.X.BigInt(), field.P).FillBytes(pubBytes[:keys.PublicKeyXByteSize])
+	var highX keys.PublicKey
+	if err := highX.UnmarshalBytes(pubBytes); !errors.Is(err, field.ErrNonCanonical) {
+		t.Errorf("UnmarshalBytes(x+p) error = %v, want field.ErrNonCanonical", err)
+	}
+}
+
+func TestPrivateKeyUnmarshalRejectsNonCanonical(t *testing.T) {
+	data := field.Q.FillBytes(make([]byte, keys.PrivateKeyByteSize))
+	var sk keys.PrivateKey
+	if err := sk.UnmarshalBytes(data); !errors.Is(err, field.ErrNonCanonical) {
+		t.Errorf("UnmarshalBytes(q) error = %v, want field.ErrNonCanonical", err)
+	}
+	if sk.Value != nil {
+		t.Error("UnmarshalBytes modified the key on error")
+	}
+}
+
+func TestBase58Keys(t *testing.T) {
+	// Keypair from the mina-signer documentation.
+	sk, err := keys.PrivateKeyFromBase58("EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const address = "B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg"
+	pub := sk.ToPublicKey()
+	if got, err := pub.ToBase58(); err != nil || got != address {
+		t.Errorf("ToBase58 = %s, %v, want %s", got, err, address)
+	}
+	decoded, err := keys.PublicKeyFromBase58(address)
+	if err != nil || !decoded.Equal(pub) {
+		t.Errorf("PublicKeyFromBase58(%s) = %v, %v", address, decoded, err)
+	}
+	if enc, err := sk.ToBase58(); err != nil || enc != "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw" {
+		t.Errorf("PrivateKey.ToBase58 = %s, %v", enc, err)
+	}
+	if _, err := keys.PublicKeyFromBase58("EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"); err == nil {
+		t.Error("PublicKeyFromBase58 accepted a private key")
+	}
+}
+
+func TestSignLegacyRoundTrip(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(24680))}
+	pub := priv.ToPublicKey()
+	msg := poseidonbigint.HashInputLegacy{Fields: []*big.Int{big.NewInt(9)}, Bits: []bool{true, false, true}}
+	for _, network := range []string{"mainnet", "testnet", "mynet"} {
+		sig, err := priv.SignLegacy(msg, network)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !pub.VerifyLegacy(sig, msg, network) {
+			t.Errorf("VerifyLegacy(%s) rejected a valid signature", network)
+		}
+		if pub.Verify(sig, poseidonbigint.HashInput{Fields: msg.Fields}, network) {
+			t.Errorf("Verify(%s) accepted a legacy signature", network)
+		}
+	}
+}
+
+func TestBatchVerifier(t *testing.T) {
+	alice := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(1357))}
+	bob := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(2468))}
+	mainnet, testnet := keys.NetworkFromID("mainnet"), keys.NetworkFromID("testnet")
+
+	var b keys.BatchVerifier
+	for i := int64(0); i < 6; i++ {
+		sk, network := alice, mainnet
+		if i%2 == 1 {
+			sk, network = bob, testnet
+		}
+		fields := []*big.Int{big.NewInt(i)}
+		if i < 3 {
+			sig, err := sk.Sign(poseidonbigint.HashInput{Fields: fields}, network.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b.Add(sk.ToPublicKey(), sig, poseidonbigint.HashInput{Fields: fields}, network)
+		} else {
+			msg := poseidonbigint.HashInputLegacy{Fields: fields, Bits: []bool{true}}
+			sig, err := sk.SignLegacy(msg, network.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b.AddLegacy(sk.ToPublicKey(), sig, msg, network)
+		}
+	}
+	if ok, valid := b.Verify(); !ok || len(valid) != 6 {
+		t.Fatalf("Verify() = %v, %v for valid signatures", ok, valid)
+	}
+
+	sig, err := alice.Sign(poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(7)}}, "mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Add(alice.ToPublicKey(), sig, poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(8)}}, mainnet)
+	b.Add(bob.ToPublicKey(), sig, poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(7)}}, mainnet)
+	b.Add(keys.PublicKey{}, sig, poseidonbigint.HashInput{}, mainnet)
+	b.Add(alice.ToPublicKey(), nil, poseidonbigint.HashInput{}, mainnet)
+	b.Add(alice.ToPublicKey(), sig, poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(7)}}, mainnet)
+	ok, valid := b.Verify()
+	want := []bool{true, true, true, true, true, true, false, false, false, false, true}
+	if ok || !slices.Equal(valid, want) {
+		t.Errorf("Verify() = %v, %v, want false, %v", ok, valid, want)
+	}
+
+	b.Reset()
+	if ok, valid := b.Verify(); !ok || len(valid) != 0 || b.Len() != 0 {
+		t.Errorf("Verify() after Reset = %v, %v", ok, valid)
+	}
+}
+
+func TestExpandedPublicKey(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(97531))}
+	pub := priv.ToPublicKey()
+	x, err := pub.Precompute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := x.PublicKey(); !got.Equal(pub) {
+		t.Error("PublicKey() differs from the precomputed key")
+	}
+	msg := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(5)}}
+	legacy := poseidonbigint.HashInputLegacy{Fields: []*big.Int{big.NewInt(5)}, Bits: []bool{false, true}}
+	for _, network := range []keys.Network{keys.NetworkFromID("mainnet"), keys.NetworkFromID("testnet").WithContext([]byte("app"))} {
+		sig, err := priv.SignForNetwork(msg, network, keys.SignOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		legacySig, err := priv.SignLegacyForNetwork(legacy, network, keys.SignOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !x.VerifyWithContext(sig, msg, network) || !x.VerifyLegacyWithContext(legacySig, legacy, network) {
+			t.Errorf("%s: a valid signature was rejected", network.Name)
+		}
+		other := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(6)}}
+		if x.VerifyWithContext(sig, other, network) != pub.VerifyForNetwork(sig, other, network) {
+			t.Errorf("%s: VerifyWithContext and VerifyForNetwork disagree on another message", network.Name)
+		}
+		if x.VerifyWithContext(legacySig, msg, network) || x.VerifyLegacyWithContext(sig, legacy, network) {
+			t.Errorf("%s: a signature verified under the other scheme", network.Name)
+		}
+		if x.VerifyWithContext(nil, msg, network) {
+			t.Errorf("%s: a nil signature verified", network.Name)
+		}
+	}
+	if _, err := (keys.PublicKey{}).Precompute(); !errors.Is(err, keys.ErrNilKey) {
+		t.Errorf("Precompute() of an unset key: %v, want ErrNilKey", err)
+	}
+}
+
+func TestSignMessageLegacy(t *testing.T) {
+	// mina-signer feeds each byte most significant bit first.
+	if bits := poseidonbigint.StringToInput("a").Bits; len(bits) != 8 || bits[0] || !bits[1] || !bits[2] || !bits[7] {
+		t.Fatalf("StringToInput(\"a\") = %v, want 0x61 most significant bit first", bits)
+	}
+	// The bits of the UTF-8 bytes are packed 254 to a field, the first bit
+	// least significant, so a 40-byte message spans two fields.
+	msg := "héllo mina, a message over thirty bytes"
+	var want [2]*big.Int
+	want[0], want[1] = new(big.Int), new(big.Int)
+	for i, c := range []byte(msg) {
+		for j := range 8 {
+			if n := 8*i + j; c>>(7-j)&1 == 1 {
+				want[n/254].SetBit(want[n/254], n%254, 1)
+			}
+		}
+	}
+	if got := poseidonbigint.PackToFieldsLegacy(poseidonbigint.StringToInput(msg)); len(got) != 2 || got[0].Cmp(want[0]) != 0 || got[1].Cmp(want[1]) != 0 {
+		t.Errorf("packed message = %v, want %v", got, want)
+	}
+
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(97531))}
+	pub := priv.ToPublicKey()
+	sig, err := priv.SignMessageLegacy("hello mina", "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pub.VerifyMessageLegacy(sig, "hello mina", "testnet") {
+		t.Error("VerifyMessageLegacy rejected a valid signature")
+	}
+	if pub.VerifyMessageLegacy(sig, "hello mina!", "testnet") {
+		t.Error("VerifyMessageLegacy accepted a different message")
+	}
+	if pub.VerifyMessage(sig, "hello mina", "testnet") {
+		t.Error("VerifyMessage accepted a legacy message signature")
+	}
+}
+
+func TestROInput(t *testing.T) {
+	in := keys.ROInput{}.AppendFields(big.NewInt(7)).AppendUint(6, 3)
+	appended := in.Append(keys.ROInput{Fields: []*big.Int{big.NewInt(8)}, Bits: []bool{true}})
+	if len(in.Fields) != 1 || len(in.Bits) != 3 {
+		t.Fatalf("Append modified its receiver: %+v", in)
+	}
+	if len(appended.Fields) != 2 || !slices.Equal(appended.Bits, []bool{false, true, true, true}) {
+		t.Fatalf("Append() = %+v, want fields then bits in order, least significant first", appended)
+	}
+	// 300 bits pack into two fields after the two given ones.
+	long := appended.AppendBits(make([]bool, 296)...)
+	if packed := long.Pack(); len(packed) != 4 || packed[2].Cmp(big.NewInt(0b1110)) != 0 {
+		t.Errorf("Pack() = %v, want 4 fields with the bits 0111 in the third", packed)
+	}
+
+	// An input converted from a legacy hash input signs like it.
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(97531))}
+	pub := priv.ToPublicKey()
+	msg := keys.ROInput(poseidonbigint.StringToInput("hello mina"))
+	sig, err := priv.SignROInput(msg, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := priv.SignMessageLegacy("hello mina", "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.R.Equal(want.R) || sig.S.BigInt().Cmp(want.S.BigInt()) != 0 {
+		t.Error("SignROInput of a message input differs from SignMessageLegacy")
+	}
+	if !pub.VerifyROInput(sig, msg, "testnet") || pub.VerifyROInput(sig, msg.AppendBits(true), "testnet") {
+		t.Error("VerifyROInput does not check the input")
+	}
+
+	tooLarge := keys.ROInput{}.AppendFields(field.Fp.Modulus)
+	if _, err := priv.SignROInput(tooLarge, "testnet"); errcode.CodeOf(err) != errcode.OutOfRange {
+		t.Errorf("SignROInput with a field out of range: %v, want OutOfRange", err)
+	}
+	if pub.VerifyROInput(sig, tooLarge, "testnet") {
+		t.Error("VerifyROInput accepted a field out of range")
+	}
+}
+
+func TestSignWithScheme(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(97531))}
+	pub := priv.ToPublicKey()
+	in := keys.ROInput{}.AppendFields(big.NewInt(5)).AppendBits(true, false, true)
+	if got := in.Kimchi(); len(got.Fields) != 1 || len(got.Packed) != 3 || got.Packed[0].Size != 1 || got.Packed[0].Field.Int64() != 1 {
+		t.Fatalf("Kimchi() = %+v, want one field and three 1-bit values", got)
+	}
+
+	kimchi, err := priv.SignWithScheme(keys.SchemeKimchi, in, keys.NetworkMainnet, keys.SignOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, err := priv.SignForNetwork(in.Kimchi(), keys.NetworkMainnet, keys.SignOptions{}); err != nil || !kimchi.R.Equal(want.R) {
+		t.Errorf("SignWithScheme(SchemeKimchi) differs from SignForNetwork: %v", err)
+	}
+	legacy, err := priv.SignWithScheme(keys.SchemeLegacy, in, keys.NetworkMainnet, keys.SignOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, err := priv.SignROInput(in, "mainnet"); err != nil || !legacy.R.Equal(want.R) {
+		t.Errorf("SignWithScheme(SchemeLegacy) differs from SignROInput: %v", err)
+	}
+	for _, tc := range []struct {
+		scheme keys.Scheme
+		sig    *signature.Signature
+		want   bool
+	}{
+		{keys.SchemeKimchi, kimchi, true},
+		{keys.SchemeLegacy, legacy, true},
+		{keys.SchemeKimchi, legacy, false},
+		{keys.SchemeLegacy, kimchi, false},
+		{keys.Scheme(2), kimchi, false},
+	} {
+		if got := pub.VerifyWithScheme(tc.scheme, tc.sig, in, keys.NetworkMainnet); got != tc.want {
+			t.Errorf("VerifyWithScheme(%v) = %v, want %v", tc.scheme, got, tc.want)
+		}
+	}
+
+	if _, err := priv.SignWithScheme(keys.Scheme(2), in, keys.NetworkMainnet, keys.SignOptions{}); errcode.CodeOf(err) != errcode.Unsupported {
+		t.Errorf("SignWithScheme with an unknown scheme: %v, want Unsupported", err)
+	}
+	for _, s := range []keys.Scheme{keys.SchemeKimchi, keys.SchemeLegacy} {
+		if got, err := keys.ParseScheme(s.String()); err != nil || got != s {
+			t.Errorf("ParseScheme(%q) = %v, %v", s, got, err)
+		}
+	}
+	if _, err := keys.ParseScheme("pasta"); err == nil {
+		t.Error("ParseScheme accepted an unknown name")
+	}
+}
+
+func TestNetworkFromID(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(13579))}
+	pub := priv.ToPublicKey()
+	msg := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(5)}}
+
+	// A custom id string is its bytes, with a padded "<id>Signature" prefix.
+	custom := keys.Network{Name: "mynet", SignaturePrefix: "mynetSignature******", ID: []byte("mynet")}
+	if got := keys.NetworkFromID("mynet"); got.SignaturePrefix != custom.SignaturePrefix || string(got.ID) != "mynet" {
+		t.Errorf("NetworkFromID(mynet) = %+v, want %+v", got, custom)
+	}
+	sig, err := priv.SignForNetwork(msg, custom, keys.SignOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName, err := priv.Sign(msg, "mynet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.R.Equal(byName.R) || sig.S.BigInt().Cmp(byName.S.BigInt()) != 0 {
+		t.Error("SignForNetwork and Sign disagree for the same network")
+	}
+	if !pub.VerifyForNetwork(sig, msg, custom) || pub.VerifyForNetwork(sig, msg, keys.NetworkMainnet) {
+		t.Error("VerifyForNetwork did not bind the signature to its network")
+	}
+
+	for _, bad := range []keys.Network{
+		{Name: "no id", SignaturePrefix: "x"},
+		{Name: "no prefix", ID: []byte{1}},
+		{Name: "long prefix", SignaturePrefix: "0123456789012345678901234567890123", ID: []byte{1}},
+	} {
+		if _, err := priv.SignForNetwork(msg, bad, keys.SignOptions{}); err == nil {
+			t.Errorf("SignForNetwork accepted network %q", bad.Name)
+		}
+		if pub.VerifyForNetwork(sig, msg, bad) {
+			t.Errorf("VerifyForNetwork accepted network %q", bad.Name)
+		}
+	}
+}
+
+func TestSharedSecret(t *testing.T) {
+	a := keys.PrivateKey{Value: scalar.NewScalar(12345)}
+	b := keys.PrivateKey{Value: scalar.NewScalar(67890)}
+	ab, err := keys.SharedSecret(a, b.ToPublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ba, err := keys.SharedSecret(b, a.ToPublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ab) != keys.SharedSecretSize || string(ab) != string(ba) {
+		t.Fatalf("secrets differ: %x, %x", ab, ba)
+	}
+	c := keys.PrivateKey{Value: scalar.NewScalar(13579)}
+	ac, err := keys.SharedSecret(a, c.ToPublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(ab) == string(ac) {
+		t.Error("different peers give the same secret")
+	}
+
+	if _, err := keys.SharedSecret(keys.PrivateKey{}, b.ToPublicKey()); err == nil {
+		t.Error("SharedSecret accepted an unset private key")
+	}
+	invalid := keys.PublicKey{X: new(field.FpElement).SetBigInt(big.NewInt(0))}
+	if _, err := keys.SharedSecret(a, invalid); err == nil {
+		t.Error("SharedSecret accepted an invalid public key")
+	}
+}
+
+func TestSignPrehashed(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(11223))}
+	pub := priv.ToPublicKey()
+	digest := sha256.Sum256([]byte("a large payload"))
+	sig, err := priv.SignPrehashed(keys.PrehashSHA256, digest[:], "mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pub.VerifyPrehashed(sig, keys.PrehashSHA256, digest[:], "mainnet") {
+		t.Fatal("VerifyPrehashed rejected a valid signature")
+	}
+	if pub.VerifyPrehashed(sig, keys.PrehashBLAKE2b256, digest[:], "mainnet") {
+		t.Error("VerifyPrehashed accepted the digest as another algorithm")
+	}
+	other := digest
+	other[31] ^= 1
+	if pub.VerifyPrehashed(sig, keys.PrehashSHA256, other[:], "mainnet") {
+		t.Error("VerifyPrehashed accepted another digest")
+	}
+	if pub.VerifyPrehashed(sig, keys.PrehashSHA256, digest[:], "testnet") {
+		t.Error("VerifyPrehashed accepted another network")
+	}
+	// Signing the same fields directly must not give a prehashed signature.
+	input, err := keys.PrehashInput(keys.PrehashSHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := priv.Sign(input, "mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub.VerifyPrehashed(forged, keys.PrehashSHA256, digest[:], "mainnet") {
+		t.Error("VerifyPrehashed accepted a signature of PrehashInput made with Sign")
+	}
+	if !pub.VerifyForNetwork(sig, input, keys.PrehashNetwork(keys.NetworkMainnet)) {
+		t.Error("a prehashed signature does not verify for PrehashNetwork")
+	}
+	if _, err := priv.SignPrehashed(keys.PrehashSHA256, digest[:31], "mainnet"); err == nil {
+		t.Error("SignPrehashed accepted a short digest")
+	}
+	if _, err := priv.SignPrehashed(keys.PrehashAlgorithm(0), digest[:], "mainnet"); err == nil {
+		t.Error("SignPrehashed accepted an unknown algorithm")
+	}
+}
+
+func TestNetworkWithContext(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(424242))}
+	pub := priv.ToPublicKey()
+	msg := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(7)}}
+	appA := keys.NetworkMainnet.WithContext([]byte("app-a"))
+	appB := keys.NetworkMainnet.WithContext([]byte("app-b"))
+	if err := appA.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	sig, err := priv.SignForNetwork(msg, appA, keys.SignOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pub.VerifyForNetwork(sig, msg, appA) {
+		t.Fatal("signature does not verify in its context")
+	}
+	if pub.VerifyForNetwork(sig, msg, appB) || pub.VerifyForNetwork(sig, msg, keys.NetworkMainnet) {
+		t.Error("signature verifies outside its context")
+	}
+	plain, err := priv.SignForNetwork(msg, keys.NetworkMainnet, keys.SignOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plain.R.Equal(sig.R) {
+		t.Error("context does not change the nonce")
+	}
+	if pub.VerifyForNetwork(sig, msg, keys.NetworkTestnet.WithContext([]byte("app-a"))) {
+		t.Error("context signature verifies on another network")
+	}
+	if got := keys.NetworkMainnet.WithContext(nil); got.SignaturePrefix != keys.NetworkMainnet.SignaturePrefix {
+		t.Error("empty context changed the network")
+	}
+}
+
+func TestChildKeyDerivation(t *testing.T) {
+	master := keys.ExtendedPrivateKey{PrivateKey: keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(777))}}
+	copy(master.ChainCode[:], "chain code of the test master key")
+	watchOnly := master.Public()
+
+	data, err := watchOnly.MarshalBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored keys.ExtendedPublicKey
+	if err := restored.UnmarshalBytes(data); err != nil {
+		t.Fatal(err)
+	}
+
+	path := []uint32{0, 7, 1<<31 - 1}
+	childPub, err := restored.Derive(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	childPriv, err := master.Derive(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := childPriv.PrivateKey.ToPublicKey(); !got.Equal(childPub.PublicKey) {
+		t.Error("child private key does not match the watch-only child public key")
+	}
+	if childPriv.ChainCode != childPub.ChainCode {
+		t.Error("chain codes differ")
+	}
+
+	a, _ := watchOnly.Child(0)
+	b, _ := watchOnly.Child(1)
+	if a.PublicKey.Equal(b.PublicKey) || a.PublicKey.Equal(watchOnly.PublicKey) {
+		t.Error("children are not distinct")
+	}
+	if _, err := watchOnly.Child(keys.HardenedIndex); err == nil {
+		t.Error("derived a hardened child from a public key")
+	}
+	if _, err := master.Child(keys.HardenedIndex); err == nil {
+		t.Error("derived a hardened child")
+	}
+}
+
+func TestKeyTweak(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(31337))}
+	pub := priv.ToPublicKey()
+	data := []byte("script root")
+	tweak, err := keys.DeriveTweak("example/commitment", pub, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tweakedPriv, err := priv.Tweak(tweak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tweakedPub, err := pub.Tweak(tweak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tweakedPriv.ToPublicKey(); !got.Equal(tweakedPub) {
+		t.Fatal("tweaked private key does not match the tweaked public key")
+	}
+	msg := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(5)}}
+	sig, err := tweakedPriv.Sign(msg, "mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tweakedPub.Verify(sig, msg, "mainnet") {
+		t.Error("tweaked key does not sign for its public key")
+	}
+
+	other, _ := keys.DeriveTweak("example/other", pub, data)
+	if other.BigInt().Cmp(tweak.BigInt()) == 0 {
+		t.Error("domain does not separate tweaks")
+	}
+	if _, err := priv.Tweak(priv.Value.Neg()); !errors.Is(err, keys.ErrInvalidTweak) {
+		t.Errorf("cancelling private tweak: got %v", err)
+	}
+	if _, err := pub.Tweak(priv.Value.Neg()); !errors.Is(err, keys.ErrInvalidTweak) {
+		t.Errorf("cancelling public tweak: got %v", err)
+	}
+}
+
+func TestSentinelErrors(t *testing.T) {
+	var pk keys.PublicKey
+	if err := pk.UnmarshalBytes(make([]byte, 5)); !errors.Is(err, keys.ErrInvalidLength) {
+		t.Errorf("short public key: got %v", err)
+	}
+	bad := make([]byte, keys.PublicKeyTotalByteSize)
+	bad[keys.PublicKeyXByteSize] = 0x02
+	if err := pk.UnmarshalBytes(bad); !errors.Is(err, keys.ErrNonCanonical) {
+		t.Errorf("parity byte: got %v", err)
+	}
+	var sk keys.PrivateKey
+	if err := sk.UnmarshalBytes(make([]byte, 31)); !errors.Is(err, keys.ErrInvalidLength) {
+		t.Errorf("short private key: got %v", err)
+	}
+	if _, err := sk.MarshalBytes(); !errors.Is(err, keys.ErrNilKey) {
+		t.Errorf("nil private key: got %v", err)
+	}
+	if _, err := sk.Sign(poseidonbigint.HashInput{}, "mainnet"); !errors.Is(err, keys.ErrNilKey) {
+		t.Errorf("signing with a nil key: got %v", err)
+	}
+	if err := pk.Validate(); !errors.Is(err, keys.ErrNilKey) {
+		t.Errorf("nil public key: got %v", err)
+	}
+
+	// x = 0 has y^2 = 5, which is not a square in Fp.
+	offCurve := keys.PublicKey{X: new(field.FpElement).SetBigInt(big.NewInt(0))}
+	if err := offCurve.Validate(); !errors.Is(err, keys.ErrNotOnCurve) || !errors.Is(err, curve.ErrNotOnCurve) {
+		t.Errorf("off-curve key: got %v", err)
+	}
+	if _, err := offCurve.ToGroup(); !errors.Is(err, keys.ErrNotOnCurve) {
+		t.Errorf("off-curve ToGroup: got %v", err)
+	}
+
+	var sig signature.Signature
+	if err := sig.UnmarshalBytes(make([]byte, 10)); !errors.Is(err, keys.ErrInvalidLength) {
+		t.Errorf("short signature: got %v", err)
+	}
+	if _, err := sig.MarshalBytes(); !errors.Is(err, signature.ErrNilSignature) {
+		t.Errorf("nil signature: got %v", err)
+	}
+}
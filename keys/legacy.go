@@ -0,0 +1,63 @@
+package keys
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+// SignLegacy generates a Schnorr signature over a legacy (fields and bits)
+// hash input, the scheme Mina uses for payments, stake delegations and
+// mina-signer string messages. It pairs with VerifyLegacy.
+func (sk PrivateKey) SignLegacy(message poseidonbigint.HashInputLegacy, networkId string) (*signature.Signature, error) {
+	return sk.SignLegacyWithOptions(message, networkId, SignOptions{})
+}
+
+// SignLegacyWithOptions is SignLegacy with the behaviour selected in opts.
+func (sk PrivateKey) SignLegacyWithOptions(message poseidonbigint.HashInputLegacy, networkId string, opts SignOptions) (*signature.Signature, error) {
+	return sk.SignLegacyForNetwork(message, NetworkFromID(networkId), opts)
+}
+
+// SignLegacyForNetwork is SignLegacyWithOptions for a Network, which may be
+// a custom chain.
+func (sk PrivateKey) SignLegacyForNetwork(message poseidonbigint.HashInputLegacy, network Network, opts SignOptions) (*signature.Signature, error) {
+	if err := network.Validate(); err != nil {
+		return nil, err
+	}
+	return sk.sign(opts,
+		func(pub Point) (*big.Int, error) {
+			return opts.Nonce.nonceLegacy(message, pub, sk.Value.BigInt(), network)
+		},
+		func(pub Point, rx *big.Int) *big.Int { return hashMessageLegacy(message, pub, rx, network) },
+	)
+}
+
+// SignMessageLegacy signs a string the way mina-signer's signMessage does:
+// each byte contributes its bits most significant first, and the result is
+// signed with the legacy scheme. Signatures from it verify in o1js and with
+// VerifyMessageLegacy, unlike those from SignMessage.
+func (sk PrivateKey) SignMessageLegacy(msg string, networkId string) (*signature.Signature, error) {
+	return sk.SignLegacy(poseidonbigint.StringToInput(msg), networkId)
+}
+
+// deriveNonceLegacy is the legacy counterpart of deriveNonce. The public key
+// coordinates become fields, while the private key and the network id are
+// appended as bits; every field is expanded to 255 bits before hashing.
+func deriveNonceLegacy(message poseidonbigint.HashInputLegacy, publicKeyPoint Point, privValue *big.Int, network Network) *big.Int {
+	id, idSize := network.idInput()
+	bits := curve.BigIntToBits(privValue)
+	bits = append(bits, curve.Bits(id, idSize)...)
+	input := (poseidonbigint.HashInputLegacyHelpers{}).Append(message, poseidonbigint.HashInputLegacy{
+		Fields: []*big.Int{publicKeyPoint.X, publicKeyPoint.Y},
+		Bits:   bits,
+	})
+
+	var inputBits []bool
+	for _, f := range input.Fields {
+		inputBits = append(inputBits, curve.BigIntToBits(f)...)
+	}
+	inputBits = append(inputBits, input.Bits...)
+	return nonceFromDigest(blake2b256(bitsToBytes(inputBits)))
+}
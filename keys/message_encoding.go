@@ -0,0 +1,149 @@
+package keys
+
+import (
+	"math/big"
+	"slices"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+// MessageEncodingVersion names a set of defaults for EncodingOptions.
+// Signer and verifier agree on a scheme by agreeing on a version and any
+// fields they override.
+type MessageEncodingVersion int
+
+const (
+	// MessageEncodingV0 is the encoding of SignMessage and VerifyMessage:
+	// 32-byte big-endian chunks, the last one shorter and unpadded. A
+	// 32-byte chunk may exceed the field modulus and is then reduced by
+	// the hash, so distinct messages can share an encoding.
+	MessageEncodingV0 MessageEncodingVersion = iota
+	// MessageEncodingV1 uses 31-byte little-endian chunks, which are always
+	// below the modulus, zero-pads the last one and appends the message
+	// length in bytes as a final field, so that the encoding is injective.
+	MessageEncodingV1
+)
+
+// ByteOrder is the order in which the bytes of a chunk are read as an
+// integer.
+type ByteOrder int
+
+const (
+	// DefaultByteOrder takes the order of the version.
+	DefaultByteOrder ByteOrder = iota
+	// BigEndian reads the first byte of a chunk as the most significant.
+	BigEndian
+	// LittleEndian reads the first byte of a chunk as the least
+	// significant.
+	LittleEndian
+)
+
+// MessagePadding is the treatment of a last chunk shorter than ChunkSize.
+type MessagePadding int
+
+const (
+	// DefaultPadding takes the padding of the version.
+	DefaultPadding MessagePadding = iota
+	// PadNone encodes the last chunk as it is.
+	PadNone
+	// PadZero fills the last chunk with zero bytes up to ChunkSize.
+	PadZero
+	// PadLength is PadZero followed by a field holding the length of the
+	// message in bytes.
+	PadLength
+)
+
+// EncodingOptions selects how EncodeMessage turns a string into field
+// elements. Zero fields take the defaults of Version, so the zero value is
+// MessageEncodingV0.
+type EncodingOptions struct {
+	Version MessageEncodingVersion
+	// ChunkSize is the number of message bytes per field, from 1 to
+	// field.Fp.SizeInBytes().
+	ChunkSize int
+	ByteOrder ByteOrder
+	Padding   MessagePadding
+}
+
+// resolve returns opts with the defaults of its version filled in.
+func (opts EncodingOptions) resolve() (EncodingOptions, error) {
+	var def EncodingOptions
+	switch opts.Version {
+	case MessageEncodingV0:
+		def = EncodingOptions{ChunkSize: field.Fp.SizeInBytes(), ByteOrder: BigEndian, Padding: PadNone}
+	case MessageEncodingV1:
+		def = EncodingOptions{ChunkSize: field.Fp.SizeInBytes() - 1, ByteOrder: LittleEndian, Padding: PadLength}
+	default:
+		return EncodingOptions{}, errcode.Errorf(errcode.Unsupported, "keys: unknown message encoding version %d", opts.Version)
+	}
+	def.Version = opts.Version
+	if opts.ChunkSize != 0 {
+		def.ChunkSize = opts.ChunkSize
+	}
+	if opts.ByteOrder != DefaultByteOrder {
+		def.ByteOrder = opts.ByteOrder
+	}
+	if opts.Padding != DefaultPadding {
+		def.Padding = opts.Padding
+	}
+	if def.ChunkSize < 1 || def.ChunkSize > field.Fp.SizeInBytes() {
+		return EncodingOptions{}, errcode.Errorf(errcode.OutOfRange, "keys: message chunk size %d, want 1 to %d", def.ChunkSize, field.Fp.SizeInBytes())
+	}
+	if def.ByteOrder < BigEndian || def.ByteOrder > LittleEndian {
+		return EncodingOptions{}, errcode.Errorf(errcode.Unsupported, "keys: unknown byte order %d", def.ByteOrder)
+	}
+	if def.Padding < PadNone || def.Padding > PadLength {
+		return EncodingOptions{}, errcode.Errorf(errcode.Unsupported, "keys: unknown message padding %d", def.Padding)
+	}
+	return def, nil
+}
+
+// EncodeMessage returns the field elements that SignMessageWithEncoding
+// signs for msg: its bytes split into chunks of opts.ChunkSize, each read
+// as an integer in opts.ByteOrder, padded as opts.Padding says. An empty
+// message has no chunks.
+func EncodeMessage(msg string, opts EncodingOptions) ([]*big.Int, error) {
+	opts, err := opts.resolve()
+	if err != nil {
+		return nil, err
+	}
+	fields := []*big.Int{}
+	for i := 0; i < len(msg); i += opts.ChunkSize {
+		chunk := []byte(msg[i:min(i+opts.ChunkSize, len(msg))])
+		if opts.Padding != PadNone {
+			chunk = append(chunk, make([]byte, opts.ChunkSize-len(chunk))...)
+		}
+		if opts.ByteOrder == LittleEndian {
+			slices.Reverse(chunk)
+		}
+		fields = append(fields, new(big.Int).SetBytes(chunk))
+	}
+	if opts.Padding == PadLength {
+		fields = append(fields, big.NewInt(int64(len(msg))))
+	}
+	return fields, nil
+}
+
+// SignMessageWithEncoding signs msg encoded with EncodeMessage under opts.
+// SignMessage is SignMessageWithEncoding with the zero EncodingOptions.
+func (sk PrivateKey) SignMessageWithEncoding(msg string, networkId string, opts EncodingOptions) (*signature.Signature, error) {
+	fields, err := EncodeMessage(msg, opts)
+	if err != nil {
+		return nil, err
+	}
+	return sk.Sign(poseidonbigint.HashInput{Fields: fields}, networkId)
+}
+
+// VerifyMessageWithEncoding checks a signature produced by
+// SignMessageWithEncoding with the same options. It returns false for
+// invalid options.
+func (pk PublicKey) VerifyMessageWithEncoding(sig *signature.Signature, msg string, networkId string, opts EncodingOptions) bool {
+	fields, err := EncodeMessage(msg, opts)
+	if err != nil {
+		return false
+	}
+	return pk.Verify(sig, poseidonbigint.HashInput{Fields: fields}, networkId)
+}
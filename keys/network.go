@@ -0,0 +1,72 @@
+package keys
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/constants"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// Network describes the chain a signature is bound to. Its signature prefix
+// salts the challenge hash and its ID is mixed into the nonce, so a
+// signature made for one network does not verify on another.
+type Network struct {
+	// Name identifies the network in messages; it does not affect hashing.
+	Name string
+	// SignaturePrefix is the Poseidon domain prefix of the challenge hash.
+	// It must be shorter than a field element (31 bytes at most).
+	SignaturePrefix string
+	// ID is hashed into the nonce, read as a little-endian integer of
+	// 8*len(ID) bits. It must hold between 1 and 31 bytes.
+	ID []byte
+}
+
+var (
+	// NetworkMainnet is the Mina mainnet.
+	NetworkMainnet = Network{Name: "mainnet", SignaturePrefix: constants.Prefixes["signatureMainnet"], ID: []byte{0x01}}
+	// NetworkDevnet is the Mina devnet, which signs like testnet.
+	NetworkDevnet = Network{Name: "devnet", SignaturePrefix: constants.Prefixes["signatureTestnet"], ID: []byte{0x00}}
+	// NetworkTestnet is the Mina testnet.
+	NetworkTestnet = Network{Name: "testnet", SignaturePrefix: constants.Prefixes["signatureTestnet"], ID: []byte{0x00}}
+)
+
+// NetworkFromID returns the Network a networkId string selects in the
+// string-based signing methods: "mainnet", "devnet" and "testnet" map to the
+// predefined networks, and any other id becomes a custom network whose ID is
+// the id's bytes and whose prefix is the id followed by "Signature", padded
+// with '*' or cut to 20 characters, as in o1js.
+func NetworkFromID(id string) Network {
+	switch id {
+	case "mainnet":
+		return NetworkMainnet
+	case "devnet":
+		return NetworkDevnet
+	case "testnet":
+		return NetworkTestnet
+	default:
+		return Network{Name: id, SignaturePrefix: CustomPrefix(id + "Signature"), ID: []byte(id)}
+	}
+}
+
+// Validate reports whether n can be used for signing and verification.
+func (n Network) Validate() error {
+	size := field.Fp.SizeInBytes()
+	if n.SignaturePrefix == "" || len(n.SignaturePrefix) >= size {
+		return errcode.Errorf(errcode.InvalidArgument, "invalid network %q: signature prefix must hold 1 to %d bytes", n.Name, size-1)
+	}
+	if len(n.ID) == 0 || len(n.ID) >= size {
+		return errcode.Errorf(errcode.InvalidArgument, "invalid network %q: id must hold 1 to %d bytes", n.Name, size-1)
+	}
+	return nil
+}
+
+// idInput returns the network id as the value and bit length hashed into
+// nonces.
+func (n Network) idInput() (*big.Int, int) {
+	be := make([]byte, len(n.ID))
+	for i, b := range n.ID {
+		be[len(n.ID)-1-i] = b
+	}
+	return new(big.Int).SetBytes(be), 8 * len(n.ID)
+}
@@ -0,0 +1,89 @@
+package keys
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+)
+
+// NonceProfile identifies how the signing nonce is derived from the message
+// and the key. Signatures are deterministic, so two implementations produce
+// the same signature only if they use the same profile; verification does
+// not depend on it.
+type NonceProfile int
+
+const (
+	// NonceBlake2bV1 is the derivation of o1js and mina-signer, and the
+	// default. For the kimchi scheme the message is extended with the
+	// fields x and y of the public key and the private key reduced into
+	// the base field, and with the network id packed in 8·len(ID) bits;
+	// the input is packed to fields. For the legacy scheme the fields x and
+	// y are appended to the message fields, and the private key and the
+	// network id to its bits. Either way every field is expanded to 255
+	// bits, least significant first, followed by any bits, and the bits
+	// are grouped into bytes least significant first. The nonce is the
+	// blake2b-256 digest of those bytes with the top two bits of its last
+	// byte cleared, read little-endian.
+	NonceBlake2bV1 NonceProfile = iota
+)
+
+func (p NonceProfile) String() string {
+	switch p {
+	case NonceBlake2bV1:
+		return "blake2b-v1"
+	default:
+		return fmt.Sprintf("NonceProfile(%d)", int(p))
+	}
+}
+
+// DeriveNonce returns the nonce profile derives to sign message with sk on
+// network, before sign negates it for an odd R.y. Sign uses the same
+// function, so it lets other implementations check their nonces in
+// isolation.
+func DeriveNonce(profile NonceProfile, message poseidonbigint.HashInput, sk PrivateKey, network Network) (*big.Int, error) {
+	pub, err := noncePublicKey(sk, network)
+	if err != nil {
+		return nil, err
+	}
+	return profile.nonce(message, pub, sk.Value.BigInt(), network)
+}
+
+// DeriveNonceLegacy is DeriveNonce for the legacy scheme.
+func DeriveNonceLegacy(profile NonceProfile, message poseidonbigint.HashInputLegacy, sk PrivateKey, network Network) (*big.Int, error) {
+	pub, err := noncePublicKey(sk, network)
+	if err != nil {
+		return nil, err
+	}
+	return profile.nonceLegacy(message, pub, sk.Value.BigInt(), network)
+}
+
+func noncePublicKey(sk PrivateKey, network Network) (Point, error) {
+	if !sk.isSet() {
+		return Point{}, fmt.Errorf("DeriveNonce: %w", ErrNilKey)
+	}
+	if err := network.Validate(); err != nil {
+		return Point{}, err
+	}
+	pub := sk.ToPublicKey()
+	return pub.ToGroup()
+}
+
+func (p NonceProfile) nonce(message poseidonbigint.HashInput, pub Point, priv *big.Int, network Network) (*big.Int, error) {
+	switch p {
+	case NonceBlake2bV1:
+		return deriveNonce(message, pub, priv, network), nil
+	default:
+		return nil, errcode.Errorf(errcode.Unsupported, "unknown nonce profile %v", p)
+	}
+}
+
+func (p NonceProfile) nonceLegacy(message poseidonbigint.HashInputLegacy, pub Point, priv *big.Int, network Network) (*big.Int, error) {
+	switch p {
+	case NonceBlake2bV1:
+		return deriveNonceLegacy(message, pub, priv, network), nil
+	default:
+		return nil, errcode.Errorf(errcode.Unsupported, "unknown nonce profile %v", p)
+	}
+}
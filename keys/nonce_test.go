@@ -0,0 +1,96 @@
+package keys_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+// nonceX returns the x coordinate of k·G, which is the r of a signature
+// with nonce k.
+func nonceX(k *big.Int) *big.Int {
+	pallas := curve.Pallas()
+	return pallas.ToAffine(pallas.Scale(pallas.One, k)).X
+}
+
+// TestDeriveNonceVectors checks NonceBlake2bV1 against signatures o1js made
+// for testnet: the r of each signature is the x coordinate of the nonce
+// times the generator.
+func TestDeriveNonceVectors(t *testing.T) {
+	data, err := os.ReadFile("../signature/testJSON/1.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cases []struct {
+		PrivateKey struct {
+			S string `json:"s"`
+		} `json:"privateKey"`
+		Message   []string `json:"message"`
+		Signature struct {
+			R string `json:"r"`
+		} `json:"signature"`
+	}
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) > 20 {
+		cases = cases[:20]
+	}
+	for i, tc := range cases {
+		sk, err := scalar.NewScalarErr(tc.PrivateKey.S)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fields := make([]*big.Int, len(tc.Message))
+		for j, m := range tc.Message {
+			fields[j], _ = new(big.Int).SetString(m, 10)
+		}
+		k, err := keys.DeriveNonce(keys.NonceBlake2bV1, poseidonbigint.HashInput{Fields: fields}, keys.PrivateKey{Value: sk}, keys.NetworkTestnet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if k.BitLen() > 254 {
+			t.Errorf("case %d: nonce has %d bits", i, k.BitLen())
+		}
+		if got := nonceX(k); got.String() != tc.Signature.R {
+			t.Errorf("case %d: x(k·G) = %s, o1js r = %s", i, got, tc.Signature.R)
+		}
+	}
+}
+
+func TestDeriveNonceLegacyMatchesSignLegacy(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(24680))}
+	msg := poseidonbigint.HashInputLegacy{Fields: []*big.Int{big.NewInt(9)}, Bits: []bool{true, false, true}}
+	k, err := keys.DeriveNonceLegacy(keys.NonceBlake2bV1, msg, priv, keys.NetworkMainnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := priv.SignLegacy(msg, "mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonceX(k).Cmp(sig.R.BigInt()) != 0 {
+		t.Error("legacy nonce does not give the signature's r")
+	}
+}
+
+func TestUnknownNonceProfile(t *testing.T) {
+	priv := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(24680))}
+	msg := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(9)}}
+	profile := keys.NonceProfile(99)
+	if _, err := priv.SignWithOptions(msg, "mainnet", keys.SignOptions{Nonce: profile}); err == nil {
+		t.Error("SignWithOptions accepted an unknown nonce profile")
+	}
+	if _, err := keys.DeriveNonce(profile, msg, priv, keys.NetworkMainnet); err == nil {
+		t.Error("DeriveNonce accepted an unknown nonce profile")
+	}
+	if keys.NonceBlake2bV1.String() != "blake2b-v1" || profile.String() != "NonceProfile(99)" {
+		t.Errorf("String() = %q, %q", keys.NonceBlake2bV1, profile)
+	}
+}
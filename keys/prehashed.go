@@ -0,0 +1,104 @@
+package keys
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/hashgeneric"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+// PrehashAlgorithm names the hash function that produced a digest passed to
+// SignPrehashed.
+type PrehashAlgorithm int
+
+const (
+	// PrehashSHA256 is a SHA-256 digest.
+	PrehashSHA256 PrehashAlgorithm = iota + 1
+	// PrehashBLAKE2b256 is a BLAKE2b-256 digest.
+	PrehashBLAKE2b256
+)
+
+// PrehashSize is the length of the digests SignPrehashed accepts.
+const PrehashSize = 32
+
+func (a PrehashAlgorithm) String() string {
+	switch a {
+	case PrehashSHA256:
+		return "SHA-256"
+	case PrehashBLAKE2b256:
+		return "BLAKE2b-256"
+	default:
+		return fmt.Sprintf("PrehashAlgorithm(%d)", int(a))
+	}
+}
+
+// tag returns the domain tag of a, the field element whose little-endian
+// bytes are the ASCII of "MinaPrehash" and the algorithm name.
+func (a PrehashAlgorithm) tag() (*big.Int, error) {
+	switch a {
+	case PrehashSHA256:
+		return hashgeneric.PrefixToField(field.Fp, "MinaPrehashSHA256"), nil
+	case PrehashBLAKE2b256:
+		return hashgeneric.PrefixToField(field.Fp, "MinaPrehashBLAKE2b256"), nil
+	default:
+		return nil, errcode.Errorf(errcode.Unsupported, "unknown prehash algorithm %v", a)
+	}
+}
+
+// prehashContext is the Network.WithContext context of prehashed
+// signatures.
+const prehashContext = "MinaPrehash"
+
+// PrehashNetwork returns the network SignPrehashed signs for in place of
+// network: network bound with Network.WithContext to a context of its own.
+// Anyone can sign the three fields of a PrehashInput with Sign, so the
+// domain of prehashed signatures must differ from that of ordinary field
+// signatures, not only their fields.
+func PrehashNetwork(network Network) Network {
+	return network.WithContext([]byte(prehashContext))
+}
+
+// PrehashInput returns the hash input SignPrehashed signs for digest: the
+// domain tag of alg followed by the two 128-bit halves of digest, read
+// big-endian, as three fields. The tag keeps digests of different
+// algorithms apart; PrehashNetwork keeps them away from inputs signed with
+// Sign.
+func PrehashInput(alg PrehashAlgorithm, digest []byte) (poseidonbigint.HashInput, error) {
+	tag, err := alg.tag()
+	if err != nil {
+		return poseidonbigint.HashInput{}, err
+	}
+	if len(digest) != PrehashSize {
+		return poseidonbigint.HashInput{}, errcode.Errorf(errcode.InvalidLength, "%v digest is %d bytes, want %d", alg, len(digest), PrehashSize)
+	}
+	half := PrehashSize / 2
+	return poseidonbigint.HashInput{Fields: []*big.Int{
+		tag,
+		new(big.Int).SetBytes(digest[:half]),
+		new(big.Int).SetBytes(digest[half:]),
+	}}, nil
+}
+
+// SignPrehashed signs a digest computed upstream with alg, for payloads
+// too large to hash into fields directly. It pairs with VerifyPrehashed.
+func (sk PrivateKey) SignPrehashed(alg PrehashAlgorithm, digest []byte, networkId string) (*signature.Signature, error) {
+	input, err := PrehashInput(alg, digest)
+	if err != nil {
+		return nil, err
+	}
+	return sk.SignForNetwork(input, PrehashNetwork(NetworkFromID(networkId)), SignOptions{})
+}
+
+// VerifyPrehashed checks a signature from SignPrehashed. It returns false
+// for an unknown algorithm or a digest of the wrong length.
+func (pk PublicKey) VerifyPrehashed(sig *signature.Signature, alg PrehashAlgorithm, digest []byte, networkId string) bool {
+	input, err := PrehashInput(alg, digest)
+	if err != nil {
+		return false
+	}
+	return pk.VerifyForNetwork(sig, input, PrehashNetwork(NetworkFromID(networkId)))
+}
@@ -2,13 +2,15 @@ package keys
 
 import (
 	"crypto/sha256"
-	"errors" // For Sign method
 	"fmt"
 	"math/big"
 
+	"github.com/node101-io/mina-signer-go/curve"          // For blinded scalar multiplication in Sign
 	"github.com/node101-io/mina-signer-go/curvebigint"    // For GroupScale and GeneratorMina
+	"github.com/node101-io/mina-signer-go/errcode"        // For the codes of returned errors
 	"github.com/node101-io/mina-signer-go/field"          // For Fp, Fq operations in Sign
 	"github.com/node101-io/mina-signer-go/poseidonbigint" // For HashInput type
+	"github.com/node101-io/mina-signer-go/scalar"         // For constant-time scalar arithmetic in Sign
 	"github.com/node101-io/mina-signer-go/signature"      // For returning *signature.Signature
 )
 
@@ -18,9 +20,10 @@ const (
 	PrivateKeyByteSize = 32 // Public
 )
 
-// PrivateKey wraps a big.Int to represent a private key.
+// PrivateKey wraps the scalar that represents a private key. Use
+// Value.BigInt for the integer value.
 type PrivateKey struct {
-	Value *big.Int
+	Value *scalar.Scalar
 }
 
 // Scalar is an alias for *big.Int, typically used for scalar multiplication in cryptographic operations.
@@ -52,7 +55,7 @@ func NewPrivateKeyFromBytes(data [32]byte) PrivateKey {
 		value = new(big.Int).SetBytes(currentData[:])
 	}
 
-	return PrivateKey{Value: value}
+	return PrivateKey{Value: scalar.NewScalar(value)}
 }
 
 // ToPublicKey derives the corresponding PublicKey from the PrivateKey.
@@ -62,8 +65,7 @@ func (sk PrivateKey) ToPublicKey() PublicKey {
 	genGroup := curvebigint.GeneratorMina() // This is of type curvebigint.Group
 
 	// 2. Scale the generator by the private key's value.
-	// sk.Value is the *big.Int for scalar multiplication.
-	pkGroup := curvebigint.GroupScale(genGroup, sk.Value) // This is also of type curvebigint.Group
+	pkGroup := curvebigint.GroupScale(genGroup, sk.Value.BigInt()) // This is also of type curvebigint.Group
 
 	// 3. Convert the resulting curvebigint.Group to keys.Point.
 	//    keys.Point and curvebigint.Group share the same structure (X, Y *big.Int).
@@ -73,17 +75,55 @@ func (sk PrivateKey) ToPublicKey() PublicKey {
 	return PublicKeyFromPoint(pointForPublicKey)
 }
 
+// SignOptions configures optional behaviour of SignWithOptions. The zero
+// value produces exactly the same signatures as Sign.
+type SignOptions struct {
+	// Blinding enables side-channel countermeasures for the scalar
+	// multiplications by the private key and the nonce. Signatures are
+	// unaffected; only the computation is randomized.
+	Blinding curve.BlindingOptions
+	// Nonce selects the nonce derivation. The zero value is
+	// NonceBlake2bV1, the derivation of o1js.
+	Nonce NonceProfile
+}
+
 // Sign generates a Schnorr signature for the given message input.
 // It uses helper functions from the keys package (deriveNonce, hashMessage).
 func (sk PrivateKey) Sign(message poseidonbigint.HashInput, networkId string) (*signature.Signature, error) {
-	if sk.Value == nil {
-		return nil, errors.New("cannot sign with a nil private key value")
+	return sk.SignWithOptions(message, networkId, SignOptions{})
+}
+
+// SignWithOptions generates a Schnorr signature for the given message input
+// using the behaviour selected in opts.
+func (sk PrivateKey) SignWithOptions(message poseidonbigint.HashInput, networkId string, opts SignOptions) (*signature.Signature, error) {
+	return sk.SignForNetwork(message, NetworkFromID(networkId), opts)
+}
+
+// SignForNetwork generates a Schnorr signature for the given message input
+// bound to network, which may be a custom chain.
+func (sk PrivateKey) SignForNetwork(message poseidonbigint.HashInput, network Network, opts SignOptions) (*signature.Signature, error) {
+	if err := network.Validate(); err != nil {
+		return nil, err
+	}
+	return sk.sign(opts,
+		func(pub Point) (*big.Int, error) { return opts.Nonce.nonce(message, pub, sk.Value.BigInt(), network) },
+		func(pub Point, rx *big.Int) *big.Int { return hashMessage(message, pub, rx, network) },
+	)
+}
+
+// sign runs the Schnorr signing steps shared by the kimchi and legacy
+// schemes, which differ only in how the nonce and the challenge are hashed.
+func (sk PrivateKey) sign(opts SignOptions, nonce func(pub Point) (*big.Int, error), challenge func(pub Point, rx *big.Int) *big.Int) (*signature.Signature, error) {
+	if !sk.isSet() {
+		return nil, fmt.Errorf("cannot sign: %w", ErrNilKey)
 	}
 
 	// 1. Derive the public key point corresponding to this private key.
-	// ToPublicKey() returns keys.PublicKey, then ToGroup() returns keys.Point and an error.
-	// Note: ToPublicKey internally uses curvebigint.GroupScale and GeneratorMina.
-	pubKey := sk.ToPublicKey()
+	pubGroup, err := scaleGenerator(sk.Value.BigInt(), opts.Blinding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive public key for signing: %w", err)
+	}
+	pubKey := PublicKeyFromPoint(pubGroup)
 	publicKeyPoint, err := pubKey.ToGroup() // publicKeyPoint is keys.Point
 	if err != nil {
 		// This might happen if pubKey.X is such that Sqrt results in nil (invalid point)
@@ -92,31 +132,56 @@ func (sk PrivateKey) Sign(message poseidonbigint.HashInput, networkId string) (*
 	}
 
 	// 2. Derive nonce (k')
-	kPrime := deriveNonce(message, publicKeyPoint, sk.Value, networkId)
+	kPrime, err := nonce(publicKeyPoint)
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
 	if kPrime.Cmp(big.NewInt(0)) == 0 {
-		return nil, errors.New("sign: derived nonce kPrime is 0")
+		return nil, errcode.New(errcode.Internal, "sign: derived nonce kPrime is 0")
 	}
 
 	// 3. Calculate R = k' * G
-	// We need curvebigint.GroupScale and GeneratorMina for this.
-	rGroupPoint := curvebigint.GroupScale(curvebigint.GeneratorMina(), kPrime) // rGroupPoint is curvebigint.Group
+	rGroupPoint, err := scaleGenerator(kPrime, opts.Blinding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute nonce commitment: %w", err)
+	}
 	rx := rGroupPoint.X
 	ry := rGroupPoint.Y
 
-	// 4. Adjust k based on R_y's parity
-	k := new(big.Int).Set(kPrime)
-	if !field.Fp.IsEven(ry) { // field.Fp must be accessible or this op moved to a place with access
-		k = field.Fq.Negate(kPrime)
+	// 4. Adjust k based on R_y's parity. The nonce is secret, so it is
+	// negated unconditionally and selected with a mask.
+	kScalar, err := scalar.NewScalarErr(kPrime)
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
 	}
+	k := scalar.Select(int(ry.Bit(0)), kScalar.Neg(), kScalar)
 
 	// 5. Calculate  e = Hash(message || pubKey_x || pubKey_y || R_x)
-	// hashMessage expects keys.Point for the public key part.
-	e := hashMessage(message, publicKeyPoint, rx, networkId)
+	// The challenge is an Fp value used as a scalar.
+	eScalar, err := challengeScalar(challenge(publicKeyPoint, rx))
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
 
-	// 6. Calculate s = k + e * priv
-	sVal := field.Fq.Add(k, field.Fq.Mul(e, sk.Value))
+	// 6. Calculate s = k + e * priv with constant-time scalar arithmetic.
+	sVal := k.Add(eScalar.Mul(sk.Value))
 
-	return &signature.Signature{R: rx, S: sVal}, nil
+	return &signature.Signature{R: new(field.FpElement).SetBigInt(rx), S: sVal}, nil
+}
+
+// scaleGenerator returns k·G as an affine point, applying the requested
+// blinding countermeasures.
+func scaleGenerator(k *big.Int, blinding curve.BlindingOptions) (Point, error) {
+	pallas := curve.Pallas()
+	g, err := pallas.ScaleBlinded(pallas.One, k, blinding)
+	if err != nil {
+		return Point{}, err
+	}
+	aff := pallas.ToAffine(g)
+	if aff.Infinity {
+		return Point{}, fmt.Errorf("scalar multiple of the generator: %w", ErrPointAtInfinity)
+	}
+	return Point{X: aff.X, Y: aff.Y}, nil
 }
 
 // SignFieldElement generates a Schnorr signature for a single field element message.
@@ -127,90 +192,66 @@ func (sk PrivateKey) SignFieldElement(message *big.Int, networkId string) (*sign
 	return sk.Sign(msgInput, networkId)
 }
 
+// SignFields signs the field elements fields, in order, as the message
+// poseidonbigint.HashInput{Fields: fields}:
+//
+//	sig, err := sk.SignFields("testnet", amount, nonce, recipient)
+//
+// Verify the signature with VerifyFields.
+func (sk PrivateKey) SignFields(networkId string, fields ...*big.Int) (*signature.Signature, error) {
+	return sk.Sign(poseidonbigint.HashInput{Fields: fields}, networkId)
+}
+
 // SignMessage generates a Schnorr signature for an arbitrary string message.
-// The message is split into field elements of size equal to the underlying field byte size.
-// Each chunk is converted to a big.Int, collected into a poseidonbigint.HashInput and
-// then the existing Sign method is invoked.
+// The message is split into field elements of size equal to the underlying field byte size,
+// each read big-endian; see MessageEncodingV0. It is SignMessageWithEncoding
+// with the zero EncodingOptions.
+// This chunking is specific to this package; use SignMessageLegacy for
+// signatures that mina-signer and o1js accept.
 func (sk PrivateKey) SignMessage(msg string, networkId string) (*signature.Signature, error) {
-	// Determine the chunk size (in bytes) for each field element.
-	// This corresponds to the size, in bytes, of elements in the base field Fp.
-	chunkSize := field.Fp.SizeInBytes()
-
-	// Convert the incoming string message to a byte slice.
-	msgBytes := []byte(msg)
-
-	// Convert the message into field elements for Poseidon hash.
-	var fields []*big.Int
-
-	if len(msgBytes) == 0 {
-		// Empty message results in an empty slice of field elements.
-		fields = []*big.Int{}
-	} else {
-		for i := 0; i < len(msgBytes); i += chunkSize {
-			end := i + chunkSize
-			if end > len(msgBytes) {
-				end = len(msgBytes)
-			}
-			chunk := msgBytes[i:end]
-
-			fieldElement := new(big.Int)
-			fieldElement.SetBytes(chunk)
-			fields = append(fields, fieldElement)
-		}
-	}
-
-	hashInput := poseidonbigint.HashInput{
-		Fields: fields,
-	}
+	return sk.SignMessageWithEncoding(msg, networkId, EncodingOptions{})
+}
 
-	// Delegate to the existing Sign implementation.
-	return sk.Sign(hashInput, networkId)
+// isSet reports whether sk holds a scalar value.
+func (sk PrivateKey) isSet() bool {
+	return sk.Value != nil && sk.Value.BigInt() != nil
 }
 
 // Equal checks if two PrivateKeys are identical.
 func (sk PrivateKey) Equal(other PrivateKey) bool {
 	// If both values are nil
-	if sk.Value == nil && other.Value == nil {
+	if !sk.isSet() && !other.isSet() {
 		return true
 	}
 	// If one value is nil, the other is not
-	if sk.Value == nil || other.Value == nil {
+	if !sk.isSet() || !other.isSet() {
 		return false
 	}
 	// If both values are non-nil, compare them
-	return sk.Value.Cmp(other.Value) == 0
+	return sk.Value.BigInt().Cmp(other.Value.BigInt()) == 0
 }
 
 // MarshalBytes serializes the PrivateKey into a byte slice.
 // The format is [Value (PrivateKeyByteSize bytes)].
 func (sk *PrivateKey) MarshalBytes() ([]byte, error) {
-	if sk == nil || sk.Value == nil {
-		return nil, fmt.Errorf("cannot marshal PrivateKey: sk or sk.Value is nil")
+	if sk == nil || !sk.isSet() {
+		return nil, fmt.Errorf("cannot marshal PrivateKey: %w", ErrNilKey)
 	}
-
-	out := make([]byte, PrivateKeyByteSize)
-
-	valueBytes := sk.Value.Bytes()
-	if len(valueBytes) > PrivateKeyByteSize {
-		return nil, fmt.Errorf("PrivateKey.Value is too large: got %d bytes, max %d bytes", len(valueBytes), PrivateKeyByteSize)
-	}
-	offset := PrivateKeyByteSize - len(valueBytes)
-	copy(out[offset:PrivateKeyByteSize], valueBytes)
-
-	return out, nil
+	return sk.Value.BytesBE(), nil
 }
 
 // UnmarshalBytes deserializes data into the PrivateKey.
-// data is expected to be PrivateKeyByteSize bytes long.
+// data is expected to be PrivateKeyByteSize bytes long and encode a value
+// below the scalar field modulus.
 func (sk *PrivateKey) UnmarshalBytes(data []byte) error {
 	if len(data) != PrivateKeyByteSize {
-		return fmt.Errorf("invalid data length for PrivateKey: expected %d bytes, got %d bytes", PrivateKeyByteSize, len(data))
+		return fmt.Errorf("invalid data length for PrivateKey: %w: expected %d bytes, got %d bytes", ErrInvalidLength, PrivateKeyByteSize, len(data))
 	}
 
-	if sk.Value == nil {
-		sk.Value = new(big.Int)
+	v, err := scalar.FromBytesBE(data)
+	if err != nil {
+		return fmt.Errorf("invalid PrivateKey: %w", err)
 	}
-	sk.Value.SetBytes(data)
-
+	sk.Value = v
 	return nil
 }
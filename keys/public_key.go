@@ -1,7 +1,6 @@
 package keys
 
 import (
-	"encoding/json"
 	"fmt"
 	"math/big"
 
@@ -24,9 +23,14 @@ const (
 )
 
 // PublicKey represents a public key with an X coordinate and a boolean indicating if Y is odd.
+// X is a base field element; use X.BigInt for the integer value.
+//
+// The verification methods only read the key, the signature and the
+// message, so they may be called from many goroutines at once, including
+// on the same values.
 type PublicKey struct {
-	X     *big.Int `json:"x" protobuf:"bytes,1,opt,name=x,proto3"`
-	IsOdd bool     `json:"isOdd" protobuf:"varint,2,opt,name=isOdd,proto3"`
+	X     *field.FpElement `json:"x" protobuf:"bytes,1,opt,name=x,proto3"`
+	IsOdd bool             `json:"isOdd" protobuf:"varint,2,opt,name=isOdd,proto3"`
 }
 
 // HashInputLegacy is a legacy structure used for hashing PublicKey.
@@ -38,8 +42,9 @@ type HashInputLegacy struct {
 
 // IsValid checks if the PublicKey is a valid point on the Pallas curve.
 func (pk *PublicKey) IsValid() bool {
-	curveB := curve.NewPallasCurve().B
-	xCubed := field.Mod(new(big.Int).Mul(pk.X, new(big.Int).Mul(pk.X, pk.X)), field.P)
+	curveB := curve.Pallas().B
+	x := pk.X.BigInt()
+	xCubed := field.Mod(new(big.Int).Mul(x, new(big.Int).Mul(x, x)), field.P)
 	ySquared := field.Mod(new(big.Int).Add(xCubed, curveB), field.P)
 	return field.IsSquare(ySquared, field.P)
 }
@@ -56,26 +61,44 @@ type Point struct {
 // ToGroup reconstructs the full curve point (Group) from a compressed PublicKey.
 // It returns an error if the x-coordinate is invalid.
 func (pk *PublicKey) ToGroup() (Point, error) {
-	x := pk.X
-	x2 := field.Fp.Mul(x, x)
-	x3 := field.Fp.Mul(x2, x)
-	ySquared := field.Fp.Add(x3, curve.NewPallasCurve().B)
-	y := field.Fp.Sqrt(ySquared)
-	if y == nil {
-		// Original code panics here. Consider returning an error instead for robust handling.
-		panic("PublicKey.ToGroup: invalid x coordinate")
+	if pk.X == nil {
+		return Point{}, fmt.Errorf("PublicKey.ToGroup: %w", ErrNilKey)
+	}
+	g, err := curve.DecompressGeneric(curve.Pallas(), pk.X.BigInt(), pk.IsOdd)
+	if err != nil {
+		return Point{}, fmt.Errorf("PublicKey.ToGroup: invalid x coordinate: %w", err)
+	}
+	return Point{X: g.X, Y: g.Y}, nil
+}
+
+// Validate checks that the PublicKey decompresses to a finite point of the
+// prime-order subgroup of Pallas. The returned error wraps one of the curve
+// package's sentinel errors (curve.ErrNotOnCurve, curve.ErrWrongSubgroup,
+// curve.ErrAtInfinity) when the point itself is rejected.
+func (pk *PublicKey) Validate() error {
+	_, err := pk.decompress()
+	return err
+}
+
+// decompress returns the validated curve point of pk with Z = 1.
+func (pk *PublicKey) decompress() (*curve.GroupProjective, error) {
+	if pk.X == nil {
+		return nil, fmt.Errorf("PublicKey.Validate: %w", ErrNilKey)
+	}
+	g, err := curve.DecompressGeneric(curve.Pallas(), pk.X.BigInt(), pk.IsOdd)
+	if err != nil {
+		return nil, fmt.Errorf("PublicKey.Validate: %w", err)
 	}
-	yIsOdd := y.Bit(0) == 1
-	if pk.IsOdd != yIsOdd {
-		y = field.Fp.Negate(y)
+	if err := curve.ValidatePoint(g); err != nil {
+		return nil, fmt.Errorf("PublicKey.Validate: %w", err)
 	}
-	return Point{X: x, Y: y}, nil
+	return g, nil
 }
 
 // PublicKeyFromPoint creates a PublicKey from a curve Point (X, Y coordinates).
 func PublicKeyFromPoint(p Point) PublicKey {
 	return PublicKey{
-		X:     p.X,
+		X:     new(field.FpElement).SetBigInt(p.X),
 		IsOdd: isOdd(p.Y), // isOdd is an internal helper
 	}
 }
@@ -88,29 +111,23 @@ func (pk *PublicKey) Equal(other PublicKey) bool {
 	if pk.X == nil || other.X == nil {
 		return false // One is nil, the other is not.
 	}
-	return pk.X.Cmp(other.X) == 0 && pk.IsOdd == other.IsOdd
+	return pk.X.Equal(other.X) && pk.IsOdd == other.IsOdd
 }
 
 // ToInputLegacy converts the PublicKey to a legacy format for hashing.
 func (pk *PublicKey) ToInputLegacy() HashInputLegacy {
-	return HashInputLegacy{Fields: []*big.Int{pk.X}, Bits: []bool{pk.IsOdd}}
+	return HashInputLegacy{Fields: []*big.Int{pk.X.BigInt()}, Bits: []bool{pk.IsOdd}}
 }
 
 // MarshalBytes serializes the PublicKey into a byte slice.
 // The format is [X (PublicKeyXByteSize bytes)][IsOdd (PublicKeyIsOddByteSize byte)], totaling PublicKeyTotalByteSize bytes.
 func (pk *PublicKey) MarshalBytes() ([]byte, error) {
 	if pk == nil || pk.X == nil {
-		return nil, fmt.Errorf("cannot marshal PublicKey: pk or pk.X is nil")
+		return nil, fmt.Errorf("cannot marshal PublicKey: %w", ErrNilKey)
 	}
 
 	out := make([]byte, PublicKeyTotalByteSize)
-
-	xBytes := pk.X.Bytes()
-	if len(xBytes) > PublicKeyXByteSize {
-		return nil, fmt.Errorf("PublicKey.X is too large: got %d bytes, max %d bytes", len(xBytes), PublicKeyXByteSize)
-	}
-	offset := PublicKeyXByteSize - len(xBytes)
-	copy(out[offset:PublicKeyXByteSize], xBytes)
+	pk.X.BigInt().FillBytes(out[:PublicKeyXByteSize])
 
 	if pk.IsOdd {
 		out[PublicKeyXByteSize] = 0x01
@@ -125,66 +142,29 @@ func (pk *PublicKey) MarshalBytes() ([]byte, error) {
 // data is expected to be PublicKeyTotalByteSize bytes long.
 func (pk *PublicKey) UnmarshalBytes(data []byte) error {
 	if len(data) != PublicKeyTotalByteSize {
-		return fmt.Errorf("invalid data length for PublicKey: expected %d bytes, got %d bytes", PublicKeyTotalByteSize, len(data))
+		return fmt.Errorf("invalid data length for PublicKey: %w: expected %d bytes, got %d bytes", ErrInvalidLength, PublicKeyTotalByteSize, len(data))
 	}
 
-	if pk.X == nil {
-		pk.X = new(big.Int)
+	x, err := new(field.FpElement).SetBytesCanonical(data[0:PublicKeyXByteSize])
+	if err != nil {
+		return fmt.Errorf("PublicKey.UnmarshalBytes: x coordinate: %w", err)
 	}
-	pk.X.SetBytes(data[0:PublicKeyXByteSize])
+	decoded := PublicKey{X: x}
 
 	isOddByte := data[PublicKeyXByteSize] // Accessing the byte after X part
 	if isOddByte == 0x01 {
-		pk.IsOdd = true
+		decoded.IsOdd = true
 	} else if isOddByte == 0x00 {
-		pk.IsOdd = false
+		decoded.IsOdd = false
 	} else {
-		return fmt.Errorf("invalid byte for IsOdd flag: expected 0x00 or 0x01, got 0x%02x", isOddByte)
+		return fmt.Errorf("invalid byte for IsOdd flag: %w: expected 0x00 or 0x01, got 0x%02x", ErrNonCanonical, isOddByte)
 	}
 
-	return nil
-}
-
-// MarshalJSON implements the json.Marshaler interface for PublicKey.
-func (pk PublicKey) MarshalJSON() ([]byte, error) {
-	// Guard against nil pk.X if it can occur, as pk.X.String() would panic.
-	var xStr string
-	if pk.X != nil {
-		xStr = pk.X.String()
-	}
-	return json.Marshal(struct {
-		X     string `json:"x"`
-		IsOdd bool   `json:"isOdd"`
-	}{
-		X:     xStr,
-		IsOdd: pk.IsOdd,
-	})
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface for PublicKey.
-func (pk *PublicKey) UnmarshalJSON(data []byte) error {
-	var temp struct {
-		X     string `json:"x"`
-		IsOdd bool   `json:"isOdd"`
-	}
-	if err := json.Unmarshal(data, &temp); err != nil {
+	// Reject keys that are not valid curve points before touching pk.
+	if err := decoded.Validate(); err != nil {
 		return err
 	}
-
-	x := new(big.Int)
-	if temp.X != "" { // Handle case where X might be an empty string in JSON
-		var success bool
-		x, success = new(big.Int).SetString(temp.X, 10)
-		if !success {
-			return fmt.Errorf("failed to parse X '%s' from JSON for PublicKey", temp.X)
-		}
-	} else {
-		// Decide how to handle empty X string: treat as nil, zero, or error.
-		// Assuming nil for now if X can be legitimately nil.
-		x = nil
-	}
-	pk.X = x
-	pk.IsOdd = temp.IsOdd
+	*pk = decoded
 	return nil
 }
 
@@ -200,36 +180,49 @@ func isOdd(x *big.Int) bool {
 // Verify checks a Schnorr signature against the public key and message.
 // It uses helper functions from the keys package (hashMessage).
 func (pk PublicKey) Verify(sig *signature.Signature, message poseidonbigint.HashInput, networkId string) bool {
-	if pk.X == nil || sig == nil || sig.R == nil || sig.S == nil {
+	return pk.VerifyForNetwork(sig, message, NetworkFromID(networkId))
+}
+
+// VerifyForNetwork is Verify for a Network, which may be a custom chain. It
+// returns false for an invalid network.
+func (pk PublicKey) VerifyForNetwork(sig *signature.Signature, message poseidonbigint.HashInput, network Network) bool {
+	// Out-of-range R or S would give the same signature several encodings.
+	if pk.X == nil || !sig.IsCanonical() || network.Validate() != nil {
 		// TODO: Log error or handle more gracefully? For now, mimic original behavior of just returning false.
 		return false
 	}
 
 	// 1. Convert public key to a point (group element)
-	pkPoint, err := pk.ToGroup() // pkPoint is keys.Point
+	// Decompress and validate once; the projective point has Z = 1, so its
+	// coordinates double as the affine point used for hashing.
+	pkProjective, err := pk.decompress()
 	if err != nil {
 		return false // If public key can't be converted to a point, verification fails
 	}
+	pkPoint := Point{X: pkProjective.X, Y: pkProjective.Y} // pkPoint is keys.Point
 
 	// 2. Calculate e = Hash(message || pubKey_x || pubKey_y || R_x)
 	// hashMessage expects keys.Point
-	e := hashMessage(message, pkPoint, sig.R, networkId)
+	e := hashMessage(message, pkPoint, sig.R.BigInt(), network)
 
 	// 3. Calculate R' = sG - eP
-	//    sG = s * G (NewPallasCurve().One is G)
-	//    eP = e * pkGroup (pkPoint needs to be in projective form for scaling)
-
-	// Convert pkPoint (keys.Point which is affine-like) to curve.GroupProjective for scaling
-	// curvebigint.Group is also affine-like. We need GroupToProjective.
-	// Create a temporary curvebigint.Group from pkPoint to use GroupToProjective
-	pkCurveBigintGroup := curvebigint.Group{X: pkPoint.X, Y: pkPoint.Y}
-	pkProjective := curvebigint.GroupToProjective(pkCurveBigintGroup)
-
-	pallas := curve.NewPallasCurve()
-	sG := pallas.Scale(pallas.One, sig.S) // sG is GroupProjective
-	eP := pallas.Scale(pkProjective, e)   // eP is GroupProjective
+	//    sG = s * G (curve.Pallas().One is G)
+	//    eP = e * pkGroup
+
+	// The checked variants turn malformed keys or signatures into a failed
+	// verification instead of a panic.
+	pallas := curve.Pallas()
+	// Both scalars are public, so variable-time multiplication is safe here.
+	sG := pallas.ScaleBase(sig.S.BigInt())              // sG is GroupProjective
+	eP, err := pallas.ScaleWNAFChecked(pkProjective, e) // eP is GroupProjective
+	if err != nil {
+		return false
+	}
 
-	rPrimeProjective := pallas.Sub(sG, eP) // rPrimeProjective is GroupProjective
+	rPrimeProjective, err := pallas.AddChecked(sG, pallas.Negate(eP)) // rPrimeProjective is GroupProjective
+	if err != nil {
+		return false
+	}
 
 	// 4. Convert R' back to affine and check if R'_x == R and R'_y is even.
 	rPrimeAffine, err := curvebigint.GroupFromProjective(rPrimeProjective) // rPrimeAffine is curvebigint.Group
@@ -240,42 +233,55 @@ func (pk PublicKey) Verify(sig *signature.Signature, message poseidonbigint.Hash
 	rxPrime, ryPrime := rPrimeAffine.X, rPrimeAffine.Y
 
 	// Check R'_x == R (sig.R)
-	return field.Fp.IsEven(ryPrime) && (rxPrime.Cmp(sig.R) == 0)
+	return field.Fp.IsEven(ryPrime) && (rxPrime.Cmp(sig.R.BigInt()) == 0)
 }
 
 // Verify checks a Schnorr signature against the public key and message.
 // It uses helper functions from the keys package (hashMessage).
 func (pk PublicKey) VerifyLegacy(sig *signature.Signature, message poseidonbigint.HashInputLegacy, networkId string) bool {
-	if pk.X == nil || sig == nil || sig.R == nil || sig.S == nil {
+	return pk.VerifyLegacyForNetwork(sig, message, NetworkFromID(networkId))
+}
+
+// VerifyLegacyForNetwork is VerifyLegacy for a Network, which may be a custom chain. It
+// returns false for an invalid network.
+func (pk PublicKey) VerifyLegacyForNetwork(sig *signature.Signature, message poseidonbigint.HashInputLegacy, network Network) bool {
+	// Out-of-range R or S would give the same signature several encodings.
+	if pk.X == nil || !sig.IsCanonical() || network.Validate() != nil {
 		// TODO: Log error or handle more gracefully? For now, mimic original behavior of just returning false.
 		return false
 	}
 
 	// 1. Convert public key to a point (group element)
-	pkPoint, err := pk.ToGroup() // pkPoint is keys.Point
+	// Decompress and validate once; the projective point has Z = 1, so its
+	// coordinates double as the affine point used for hashing.
+	pkProjective, err := pk.decompress()
 	if err != nil {
 		return false // If public key can't be converted to a point, verification fails
 	}
+	pkPoint := Point{X: pkProjective.X, Y: pkProjective.Y} // pkPoint is keys.Point
 
 	// 2. Calculate e = Hash(message || pubKey_x || pubKey_y || R_x)
 	// hashMessageLegacy expects keys.Point
-	e := hashMessageLegacy(message, pkPoint, sig.R, networkId)
+	e := hashMessageLegacy(message, pkPoint, sig.R.BigInt(), network)
 
 	// 3. Calculate R' = sG - eP
-	//    sG = s * G (NewPallasCurve().One is G)
-	//    eP = e * pkGroup (pkPoint needs to be in projective form for scaling)
-
-	// Convert pkPoint (keys.Point which is affine-like) to curve.GroupProjective for scaling
-	// curvebigint.Group is also affine-like. We need GroupToProjective.
-	// Create a temporary curvebigint.Group from pkPoint to use GroupToProjective
-	pkCurveBigintGroup := curvebigint.Group{X: pkPoint.X, Y: pkPoint.Y}
-	pkProjective := curvebigint.GroupToProjective(pkCurveBigintGroup)
-
-	pallas := curve.NewPallasCurve()
-	sG := pallas.Scale(pallas.One, sig.S) // sG is GroupProjective
-	eP := pallas.Scale(pkProjective, e)   // eP is GroupProjective
+	//    sG = s * G (curve.Pallas().One is G)
+	//    eP = e * pkGroup
+
+	// The checked variants turn malformed keys or signatures into a failed
+	// verification instead of a panic.
+	pallas := curve.Pallas()
+	// Both scalars are public, so variable-time multiplication is safe here.
+	sG := pallas.ScaleBase(sig.S.BigInt())              // sG is GroupProjective
+	eP, err := pallas.ScaleWNAFChecked(pkProjective, e) // eP is GroupProjective
+	if err != nil {
+		return false
+	}
 
-	rPrimeProjective := pallas.Sub(sG, eP) // rPrimeProjective is GroupProjective
+	rPrimeProjective, err := pallas.AddChecked(sG, pallas.Negate(eP)) // rPrimeProjective is GroupProjective
+	if err != nil {
+		return false
+	}
 
 	// 4. Convert R' back to affine and check if R'_x == R and R'_y is even.
 	rPrimeAffine, err := curvebigint.GroupFromProjective(rPrimeProjective) // rPrimeAffine is curvebigint.Group
@@ -285,15 +291,8 @@ func (pk PublicKey) VerifyLegacy(sig *signature.Signature, message poseidonbigin
 
 	rxPrime, ryPrime := rPrimeAffine.X, rPrimeAffine.Y
 
-	fmt.Println("IsEven", field.Fp.IsEven(ryPrime))
-	fmt.Println("Equal", field.Fp.Equal(rxPrime, sig.R))
-	if rxPrime.Cmp(sig.R) != 0 {
-		fmt.Println("rxPrime", rxPrime.String())
-		fmt.Println("sig.R", sig.R.String())
-	}
-
 	// Check R'_x == R (sig.R)
-	return field.Fp.IsEven(ryPrime) && field.Fp.Equal(rxPrime, sig.R)
+	return field.Fp.IsEven(ryPrime) && field.Fp.Equal(rxPrime, sig.R.BigInt())
 }
 
 // VerifyFieldElement checks a Schnorr signature for a single field element message.
@@ -304,6 +303,12 @@ func (pk PublicKey) VerifyFieldElement(sig *signature.Signature, message *big.In
 	return pk.Verify(sig, msgInput, networkId)
 }
 
+// VerifyFields checks a signature produced by SignFields over the same
+// field elements, in the same order.
+func (pk PublicKey) VerifyFields(sig *signature.Signature, networkId string, fields ...*big.Int) bool {
+	return pk.Verify(sig, poseidonbigint.HashInput{Fields: fields}, networkId)
+}
+
 func (pk PublicKey) ToAddress() (string, error) {
 	pkBytes, err := pk.MarshalBytes()
 	if err != nil {
@@ -328,38 +333,14 @@ func (pk PublicKey) FromAddress(address string) (PublicKey, error) {
 
 // VerifyMessage checks a Schnorr signature against an arbitrary string message.
 // The message is split into field elements whose byte length equals the base field size.
-// After constructing a poseidonbigint.HashInput from these elements, it delegates to Verify.
+// It is VerifyMessageWithEncoding with MessageEncodingV0, and pairs with
+// SignMessage; use VerifyMessageLegacy for o1js signatures.
 func (pk PublicKey) VerifyMessage(sig *signature.Signature, msg string, networkId string) bool {
-	// Determine the chunk size (in bytes) for each field element.
-	chunkSize := field.Fp.SizeInBytes()
-
-	msgBytes := []byte(msg)
-
-	// Convert the message into field elements for Poseidon hash.
-	var fields []*big.Int
-	if len(msgBytes) == 0 {
-		fields = []*big.Int{}
-	} else {
-		for i := 0; i < len(msgBytes); i += chunkSize {
-			end := i + chunkSize
-			if end > len(msgBytes) {
-				end = len(msgBytes)
-			}
-			chunk := msgBytes[i:end]
-
-			fieldElement := new(big.Int)
-			fieldElement.SetBytes(chunk)
-			fields = append(fields, fieldElement)
-		}
-	}
-
-	hashInput := poseidonbigint.HashInput{
-		Fields: fields,
-	}
-
-	return pk.Verify(sig, hashInput, networkId)
+	return pk.VerifyMessageWithEncoding(sig, msg, networkId, EncodingOptions{})
 }
 
+// VerifyMessageLegacy checks a signature produced by SignMessageLegacy or by
+// mina-signer's signMessage.
 func (pk PublicKey) VerifyMessageLegacy(sig *signature.Signature, msg string, networkId string) bool {
 	// Convert message to legacy hash input
 	hashInput := poseidonbigint.StringToInput(msg)
@@ -378,26 +359,15 @@ func (pk PublicKey) Marshal() ([]byte, error) {
 // Returns the number of bytes written and any error encountered.
 func (pk *PublicKey) MarshalTo(data []byte) (n int, err error) {
 	if len(data) < PublicKeyTotalByteSize {
-		return 0, fmt.Errorf("insufficient buffer size: need %d bytes, got %d bytes", PublicKeyTotalByteSize, len(data))
+		return 0, fmt.Errorf("insufficient buffer size: %w: need %d bytes, got %d bytes", ErrInvalidLength, PublicKeyTotalByteSize, len(data))
 	}
 
 	if pk == nil || pk.X == nil {
-		return 0, fmt.Errorf("cannot marshal PublicKey: pk or pk.X is nil")
-	}
-
-	xBytes := pk.X.Bytes()
-	if len(xBytes) > PublicKeyXByteSize {
-		return 0, fmt.Errorf("PublicKey.X is too large: got %d bytes, max %d bytes", len(xBytes), PublicKeyXByteSize)
-	}
-
-	// Clear the buffer first
-	for i := 0; i < PublicKeyTotalByteSize; i++ {
-		data[i] = 0
+		return 0, fmt.Errorf("cannot marshal PublicKey: %w", ErrNilKey)
 	}
 
-	// Copy X coordinate with proper padding
-	offset := PublicKeyXByteSize - len(xBytes)
-	copy(data[offset:PublicKeyXByteSize], xBytes)
+	// Write the X coordinate with left padding
+	pk.X.BigInt().FillBytes(data[:PublicKeyXByteSize])
 
 	// Set IsOdd flag
 	if pk.IsOdd {
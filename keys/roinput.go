@@ -0,0 +1,85 @@
+package keys
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+// ROInput is a random-oracle input as Mina's Random_oracle_input.Legacy
+// builds it: field elements, which are hashed as they are, followed by a
+// string of bits, which Pack cuts into field elements of 254 bits, least
+// significant bit first. It lets a caller that already has the exact input
+// of a protocol message, such as one written from the OCaml definitions,
+// sign it without going through a transaction type.
+//
+// ROInput has the layout of poseidonbigint.HashInputLegacy and converts to
+// and from it. The Append methods return a new input and leave their
+// receiver and arguments unchanged.
+type ROInput poseidonbigint.HashInputLegacy
+
+// AppendFields returns in followed by the field elements fs.
+func (in ROInput) AppendFields(fs ...*big.Int) ROInput {
+	return in.Append(ROInput{Fields: fs})
+}
+
+// AppendBits returns in followed by bits.
+func (in ROInput) AppendBits(bits ...bool) ROInput {
+	return in.Append(ROInput{Bits: bits})
+}
+
+// AppendUint returns in followed by the n low bits of v, least significant
+// first, as Mina encodes amounts, nonces and slots.
+func (in ROInput) AppendUint(v uint64, n int) ROInput {
+	bits := make([]bool, n)
+	for i := range bits {
+		bits[i] = i < 64 && v>>i&1 == 1
+	}
+	return in.AppendBits(bits...)
+}
+
+// Append returns the concatenation of in and other: the fields of both,
+// then the bits of both.
+func (in ROInput) Append(other ROInput) ROInput {
+	return ROInput((poseidonbigint.HashInputLegacyHelpers{}).Append(
+		poseidonbigint.HashInputLegacy(in), poseidonbigint.HashInputLegacy(other)))
+}
+
+// Pack returns the field elements in is hashed as: its fields followed by
+// its bits in chunks of 254.
+func (in ROInput) Pack() []*big.Int {
+	return poseidonbigint.PackToFieldsLegacy(poseidonbigint.HashInputLegacy(in))
+}
+
+// Validate reports whether every field of in is an element of the base
+// field. Pack and hashing would reduce a larger value silently.
+func (in ROInput) Validate() error {
+	for i, f := range in.Fields {
+		if f == nil || !field.Fp.IsCanonical(f) {
+			return errcode.Errorf(errcode.OutOfRange, "keys: input field %d is not an element of the base field", i)
+		}
+	}
+	return nil
+}
+
+// SignROInput signs input with the legacy scheme, the one of Mina's
+// Random_oracle_input.Legacy, for the network networkId selects. It fails
+// for an input that Validate rejects. It pairs with VerifyROInput.
+func (sk PrivateKey) SignROInput(input ROInput, networkId string) (*signature.Signature, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	return sk.SignLegacy(poseidonbigint.HashInputLegacy(input), networkId)
+}
+
+// VerifyROInput reports whether sig is a signature of input made with
+// SignROInput for the network networkId selects.
+func (pk PublicKey) VerifyROInput(sig *signature.Signature, input ROInput, networkId string) bool {
+	if input.Validate() != nil {
+		return false
+	}
+	return pk.VerifyLegacy(sig, poseidonbigint.HashInputLegacy(input), networkId)
+}
@@ -0,0 +1,99 @@
+package keys
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+// Scheme is a Mina signature scheme: the Poseidon parameters of the
+// challenge hash and the rule that packs a message input into field
+// elements. A signature verifies only under the scheme it was made with.
+// The zero value is SchemeKimchi.
+type Scheme int
+
+const (
+	// SchemeKimchi hashes with the kimchi Poseidon parameters and packs
+	// inputs with poseidonbigint.PackToFields. It signs zkApp commands and
+	// the field messages of o1js and mina-signer's signFields.
+	SchemeKimchi Scheme = iota
+	// SchemeLegacy hashes with the legacy Poseidon parameters and packs
+	// bits into field elements of 254 with poseidonbigint.PackToFieldsLegacy.
+	// It signs payments, stake delegations and mina-signer's string
+	// messages, and all data of the networks before Berkeley.
+	SchemeLegacy
+)
+
+// String returns "kimchi" or "legacy".
+func (s Scheme) String() string {
+	switch s {
+	case SchemeKimchi:
+		return "kimchi"
+	case SchemeLegacy:
+		return "legacy"
+	}
+	return "unknown"
+}
+
+// ParseScheme returns the Scheme that String names.
+func ParseScheme(name string) (Scheme, error) {
+	switch name {
+	case "kimchi":
+		return SchemeKimchi, nil
+	case "legacy":
+		return SchemeLegacy, nil
+	}
+	return 0, errcode.Errorf(errcode.Unsupported, "keys: unknown signature scheme %q", name)
+}
+
+// Validate reports whether s is SchemeKimchi or SchemeLegacy.
+func (s Scheme) Validate() error {
+	if s != SchemeKimchi && s != SchemeLegacy {
+		return errcode.Errorf(errcode.Unsupported, "keys: unknown signature scheme %d", int(s))
+	}
+	return nil
+}
+
+// Kimchi returns in as a kimchi hash input: its fields, then each of its
+// bits as a packed value of one bit, as o1js packs booleans.
+func (in ROInput) Kimchi() poseidonbigint.HashInput {
+	out := poseidonbigint.HashInput{Fields: append([]*big.Int(nil), in.Fields...)}
+	for _, b := range in.Bits {
+		v := big.NewInt(0)
+		if b {
+			v.SetInt64(1)
+		}
+		out.Packed = append(out.Packed, poseidonbigint.PackedField{Field: v, Size: 1})
+	}
+	return out
+}
+
+// SignWithScheme signs input with scheme for network: as SignForNetwork
+// signs in.Kimchi() for SchemeKimchi, and as SignROInput for SchemeLegacy.
+// It fails for an unknown scheme and for an input that Validate rejects.
+func (sk PrivateKey) SignWithScheme(scheme Scheme, input ROInput, network Network, opts SignOptions) (*signature.Signature, error) {
+	if err := scheme.Validate(); err != nil {
+		return nil, err
+	}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	if scheme == SchemeLegacy {
+		return sk.SignLegacyForNetwork(poseidonbigint.HashInputLegacy(input), network, opts)
+	}
+	return sk.SignForNetwork(input.Kimchi(), network, opts)
+}
+
+// VerifyWithScheme reports whether sig is a signature of input made with
+// SignWithScheme under scheme for network.
+func (pk PublicKey) VerifyWithScheme(scheme Scheme, sig *signature.Signature, input ROInput, network Network) bool {
+	if scheme.Validate() != nil || input.Validate() != nil {
+		return false
+	}
+	if scheme == SchemeLegacy {
+		return pk.VerifyLegacyForNetwork(sig, poseidonbigint.HashInputLegacy(input), network)
+	}
+	return pk.VerifyForNetwork(sig, input.Kimchi(), network)
+}
@@ -0,0 +1,36 @@
+package keys
+
+import (
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// sharedSecretDomain separates SharedSecret digests from other blake2b
+// uses of the same point.
+const sharedSecretDomain = "MinaSharedSecret"
+
+// SharedSecretSize is the length of the secret SharedSecret returns.
+const SharedSecretSize = 32
+
+// SharedSecret returns the Diffie-Hellman secret of sk and pub: blake2b-256
+// of a domain tag and the x coordinate of sk·pub, 32 bytes little-endian.
+// SharedSecret(a, B) equals SharedSecret(b, A) for key pairs (a, A) and
+// (b, B), so two accounts can derive a symmetric key for encrypted memos
+// or a channel between them. pub must be a valid point.
+func SharedSecret(sk PrivateKey, pub PublicKey) ([]byte, error) {
+	if !sk.isSet() || sk.Value.BigInt().Sign() == 0 {
+		return nil, fmt.Errorf("SharedSecret: private key: %w", ErrNilKey)
+	}
+	g, err := pub.decompress()
+	if err != nil {
+		return nil, fmt.Errorf("SharedSecret: %w", err)
+	}
+	c := curve.Pallas()
+	shared := c.ToAffine(c.ScaleConstantTime(g, sk.Value.BigInt()))
+	if shared.Infinity {
+		return nil, fmt.Errorf("SharedSecret: shared point: %w", ErrPointAtInfinity)
+	}
+	return blake2b256(append([]byte(sharedSecretDomain), field.Fp.ToBytesLE(shared.X)...)), nil
+}
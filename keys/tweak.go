@@ -0,0 +1,77 @@
+package keys
+
+import (
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/scalar"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// tweakLabel separates DeriveTweak digests from other blake2b uses.
+const tweakLabel = "MinaKeyTweak"
+
+// ErrInvalidTweak is returned when a tweak would produce the zero private
+// key or the point at infinity.
+var ErrInvalidTweak = errcode.New(errcode.InvalidArgument, "keys: tweak cancels the key")
+
+// Tweak returns the private key sk + t. Its public key is
+// sk.ToPublicKey().Tweak(t).
+func (sk PrivateKey) Tweak(t *scalar.Scalar) (PrivateKey, error) {
+	if !sk.isSet() {
+		return PrivateKey{}, fmt.Errorf("PrivateKey.Tweak: %w", ErrNilKey)
+	}
+	if t == nil {
+		return PrivateKey{}, errcode.New(errcode.MissingValue, "PrivateKey.Tweak: tweak is not set")
+	}
+	v := sk.Value.Add(t)
+	if v.BigInt().Sign() == 0 {
+		return PrivateKey{}, ErrInvalidTweak
+	}
+	return PrivateKey{Value: v}, nil
+}
+
+// Tweak returns the public key pk + t·G. pk must be a valid point.
+func (pk PublicKey) Tweak(t *scalar.Scalar) (PublicKey, error) {
+	if t == nil {
+		return PublicKey{}, errcode.New(errcode.MissingValue, "PublicKey.Tweak: tweak is not set")
+	}
+	g, err := pk.decompress()
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("PublicKey.Tweak: %w", err)
+	}
+	c := curve.Pallas()
+	p := c.ToAffine(c.Add(g, c.ScaleBase(t.BigInt())))
+	if p.Infinity {
+		return PublicKey{}, ErrInvalidTweak
+	}
+	return PublicKeyFromPoint(Point{X: p.X, Y: p.Y}), nil
+}
+
+// DeriveTweak hashes data to a tweak of pk under an application domain:
+// blake2b-512 over "MinaKeyTweak", the length-prefixed domain, pk's
+// MarshalBytes encoding and data, reduced modulo q.
+//
+// pk.Tweak(DeriveTweak(domain, pk, data)) is a key that commits to data,
+// as Taproot commits to a script tree: the owner of pk can sign for it
+// with the tweaked private key, and anyone given pk and data can check
+// the commitment. Hashing pk into the tweak keeps a party from choosing a
+// key that cancels another's. domain must be at most 255 bytes.
+func DeriveTweak(domain string, pk PublicKey, data []byte) (*scalar.Scalar, error) {
+	if len(domain) > 255 {
+		return nil, errcode.Errorf(errcode.InvalidLength, "DeriveTweak: domain is %d bytes, at most 255 allowed", len(domain))
+	}
+	encoded, err := pk.MarshalBytes()
+	if err != nil {
+		return nil, fmt.Errorf("DeriveTweak: %w", err)
+	}
+	h, _ := blake2b.New512(nil)
+	h.Write([]byte(tweakLabel))
+	h.Write([]byte{byte(len(domain))})
+	h.Write([]byte(domain))
+	h.Write(encoded)
+	h.Write(data)
+	return scalar.ScalarFromBytesLE(h.Sum(nil)), nil
+}
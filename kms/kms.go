@@ -0,0 +1,138 @@
+// Package kms implements signer.Signer for private keys that never leave a
+// key management service or HSM.
+//
+// Such a backend can multiply and add scalars but cannot hash with Poseidon,
+// so a signature is produced in two rounds:
+//
+//  1. Commit: the backend draws a fresh secret nonce k, negates it if needed
+//     so that R = k·G has an even y-coordinate, and returns R with a session
+//     handle.
+//  2. Respond: the Signer computes the challenge e from the message, the
+//     public key and R.x, and the backend returns s = k + e·x for its private
+//     key x, then forgets k.
+//
+// A backend must answer at most one Respond per session: two responses
+// with the same nonce and different challenges reveal the private key.
+// Signer checks every signature against the public key before returning it.
+package kms
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// ErrSessionUsed is returned by a backend asked to respond twice to the
+// same nonce commitment.
+var ErrSessionUsed = errcode.New(errcode.InvalidState, "kms: nonce session already used or unknown")
+
+// Commitment is the public half of a nonce held by a backend.
+type Commitment struct {
+	// Session identifies the nonce in the following Respond call.
+	Session string
+	// R is the nonce point k·G. Its y-coordinate must be even, so R.IsOdd
+	// is false.
+	R keys.PublicKey
+}
+
+// Backend is a key store that signs without exporting private keys.
+type Backend interface {
+	// PublicKey returns the public key of keyID.
+	PublicKey(ctx context.Context, keyID string) (keys.PublicKey, error)
+	// Commit draws a fresh nonce for keyID and returns its commitment.
+	Commit(ctx context.Context, keyID string) (Commitment, error)
+	// Respond returns k + e·x for the nonce of session and the private key
+	// of keyID, and discards the nonce. It must fail with ErrSessionUsed
+	// for a session it has already answered.
+	Respond(ctx context.Context, keyID, session string, e *scalar.Scalar) (*scalar.Scalar, error)
+}
+
+// Signer is a signer.Signer for one key of a Backend.
+type Signer struct {
+	backend Backend
+	keyID   string
+	network signer.Network
+}
+
+// NewSigner returns a Signer for keyID on backend, signing for network.
+func NewSigner(backend Backend, keyID string, network signer.Network) *Signer {
+	return &Signer{backend: backend, keyID: keyID, network: network}
+}
+
+// PublicKey returns the public key of the backend key.
+func (s *Signer) PublicKey(ctx context.Context) (keys.PublicKey, error) {
+	return s.backend.PublicKey(ctx, s.keyID)
+}
+
+// SignFields signs fields with the kimchi scheme.
+func (s *Signer) SignFields(ctx context.Context, fields []*big.Int) (*signature.Signature, error) {
+	msg := poseidonbigint.HashInput{Fields: fields}
+	n := s.network.Network
+	return s.sign(ctx,
+		func(pk keys.PublicKey, rx *big.Int) (*scalar.Scalar, error) { return pk.Challenge(msg, rx, n) },
+		func(pk keys.PublicKey, sig *signature.Signature) bool { return pk.VerifyForNetwork(sig, msg, n) },
+	)
+}
+
+// SignTransaction signs a payment or stake delegation with the legacy
+// scheme. The backend key must belong to the fee payer.
+func (s *Signer) SignTransaction(ctx context.Context, tx transaction.Command) (*signature.Signature, error) {
+	msg, err := tx.InputLegacy()
+	if err != nil {
+		return nil, err
+	}
+	pk, err := s.PublicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !pk.Equal(tx.FeePayer()) {
+		return nil, errcode.New(errcode.KeyMismatch, "kms: key does not belong to the fee payer")
+	}
+	n := s.network.Network
+	return s.sign(ctx,
+		func(pk keys.PublicKey, rx *big.Int) (*scalar.Scalar, error) { return pk.ChallengeLegacy(msg, rx, n) },
+		func(pk keys.PublicKey, sig *signature.Signature) bool { return pk.VerifyLegacyForNetwork(sig, msg, n) },
+	)
+}
+
+// sign runs the commit and respond rounds with the backend.
+func (s *Signer) sign(ctx context.Context,
+	challenge func(pk keys.PublicKey, rx *big.Int) (*scalar.Scalar, error),
+	verify func(pk keys.PublicKey, sig *signature.Signature) bool,
+) (*signature.Signature, error) {
+	pk, err := s.PublicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.backend.Commit(ctx, s.keyID)
+	if err != nil {
+		return nil, errcode.Errorf(errcode.Upstream, "kms: commit: %w", err)
+	}
+	if c.R.IsOdd {
+		return nil, errcode.New(errcode.InvalidPoint, "kms: nonce commitment has an odd y-coordinate")
+	}
+	if err := c.R.Validate(); err != nil {
+		return nil, fmt.Errorf("kms: nonce commitment: %w", err)
+	}
+	e, err := challenge(pk, c.R.X.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	sv, err := s.backend.Respond(ctx, s.keyID, c.Session, e)
+	if err != nil {
+		return nil, errcode.Errorf(errcode.Upstream, "kms: respond: %w", err)
+	}
+	sig := &signature.Signature{R: c.R.X, S: sv}
+	if !verify(pk, sig) {
+		return nil, errcode.New(errcode.InvalidSignature, "kms: backend returned an invalid signature")
+	}
+	return sig, nil
+}
@@ -0,0 +1,91 @@
+package kms_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/kms"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+func TestSigner(t *testing.T) {
+	ctx := context.Background()
+	b := kms.NewMemoryBackend()
+	pk, err := b.Generate("hot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := kms.NewSigner(b, "hot", signer.NetworkMainnet)
+
+	fields := []*big.Int{big.NewInt(3), big.NewInt(4)}
+	sig, err := s.SignFields(ctx, fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pk.Verify(sig, poseidonbigint.HashInput{Fields: fields}, "mainnet") {
+		t.Error("SignFields signature does not verify")
+	}
+
+	to := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(9))}.ToPublicKey()
+	tx := transaction.StakeDelegation{From: pk, To: to, Fee: 1, Nonce: 2}
+	sig, err = s.SignTransaction(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, _ := tx.InputLegacy()
+	if !pk.VerifyLegacy(sig, input, "mainnet") {
+		t.Error("SignTransaction signature does not verify")
+	}
+	if _, err := s.SignTransaction(ctx, transaction.StakeDelegation{From: to, To: pk}); err == nil {
+		t.Error("SignTransaction signed for another fee payer")
+	}
+	if _, err := kms.NewSigner(b, "missing", signer.NetworkMainnet).SignFields(ctx, fields); err == nil {
+		t.Error("SignFields succeeded with an unknown key")
+	}
+}
+
+func TestMemoryBackendSessionsAreSingleUse(t *testing.T) {
+	ctx := context.Background()
+	b := kms.NewMemoryBackend()
+	b.Import("k", keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(1234))})
+	c, err := b.Commit(ctx, "k")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.R.IsOdd {
+		t.Error("Commit returned a point with odd y")
+	}
+	e := scalar.NewScalar(5)
+	if _, err := b.Respond(ctx, "k", c.Session, e); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Respond(ctx, "k", c.Session, scalar.NewScalar(6)); !errors.Is(err, kms.ErrSessionUsed) {
+		t.Errorf("second Respond: err = %v, want ErrSessionUsed", err)
+	}
+}
+
+// oddBackend returns commitments with an odd y-coordinate.
+type oddBackend struct{ *kms.MemoryBackend }
+
+func (o oddBackend) Commit(ctx context.Context, keyID string) (kms.Commitment, error) {
+	c, err := o.MemoryBackend.Commit(ctx, keyID)
+	c.R.IsOdd = true
+	return c, err
+}
+
+func TestSignerRejectsOddCommitment(t *testing.T) {
+	b := kms.NewMemoryBackend()
+	if _, err := b.Generate("k"); err != nil {
+		t.Fatal(err)
+	}
+	s := kms.NewSigner(oddBackend{b}, "k", signer.NetworkMainnet)
+	if _, err := s.SignFields(context.Background(), []*big.Int{big.NewInt(1)}); err == nil {
+		t.Error("SignFields accepted an odd nonce commitment")
+	}
+}
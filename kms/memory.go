@@ -0,0 +1,121 @@
+package kms
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+// MemoryBackend is a Backend that keeps keys in process memory. It is the
+// reference for what a KMS or HSM integration must do, and serves tests.
+type MemoryBackend struct {
+	mu       sync.Mutex
+	keys     map[string]keys.PrivateKey
+	sessions map[string]nonce
+}
+
+type nonce struct {
+	keyID string
+	k     *scalar.Scalar
+}
+
+// NewMemoryBackend returns an empty MemoryBackend.
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{keys: make(map[string]keys.PrivateKey), sessions: make(map[string]nonce)}
+}
+
+// Import stores sk under keyID, replacing any previous key.
+func (b *MemoryBackend) Import(keyID string, sk keys.PrivateKey) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.keys[keyID] = sk
+}
+
+// Generate stores a fresh random key under keyID and returns its public key.
+func (b *MemoryBackend) Generate(keyID string) (keys.PublicKey, error) {
+	k, err := randomNonZero()
+	if err != nil {
+		return keys.PublicKey{}, err
+	}
+	sk := keys.PrivateKey{Value: k}
+	b.Import(keyID, sk)
+	return sk.ToPublicKey(), nil
+}
+
+func (b *MemoryBackend) key(keyID string) (keys.PrivateKey, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sk, ok := b.keys[keyID]
+	if !ok {
+		return keys.PrivateKey{}, errcode.Errorf(errcode.NotFound, "kms: unknown key %q", keyID)
+	}
+	return sk, nil
+}
+
+// PublicKey returns the public key of keyID.
+func (b *MemoryBackend) PublicKey(_ context.Context, keyID string) (keys.PublicKey, error) {
+	sk, err := b.key(keyID)
+	if err != nil {
+		return keys.PublicKey{}, err
+	}
+	return sk.ToPublicKey(), nil
+}
+
+// Commit draws a random nonce for keyID.
+func (b *MemoryBackend) Commit(_ context.Context, keyID string) (Commitment, error) {
+	if _, err := b.key(keyID); err != nil {
+		return Commitment{}, err
+	}
+	k, err := randomNonZero()
+	if err != nil {
+		return Commitment{}, err
+	}
+	r := keys.PrivateKey{Value: k}.ToPublicKey()
+	if r.IsOdd {
+		// -k·G has the same x and the opposite y.
+		k = k.Neg()
+		r.IsOdd = false
+	}
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return Commitment{}, err
+	}
+	session := hex.EncodeToString(id[:])
+	b.mu.Lock()
+	b.sessions[session] = nonce{keyID: keyID, k: k}
+	b.mu.Unlock()
+	return Commitment{Session: session, R: r}, nil
+}
+
+// Respond returns k + e·x and forgets the nonce of session.
+func (b *MemoryBackend) Respond(_ context.Context, keyID, session string, e *scalar.Scalar) (*scalar.Scalar, error) {
+	b.mu.Lock()
+	n, ok := b.sessions[session]
+	delete(b.sessions, session)
+	sk, known := b.keys[keyID]
+	b.mu.Unlock()
+	if !ok || n.keyID != keyID {
+		return nil, ErrSessionUsed
+	}
+	if !known {
+		return nil, errcode.Errorf(errcode.NotFound, "kms: unknown key %q", keyID)
+	}
+	return n.k.Add(e.Mul(sk.Value)), nil
+}
+
+func randomNonZero() (*scalar.Scalar, error) {
+	for {
+		k, err := scalar.RandomScalar()
+		if err != nil {
+			return nil, err
+		}
+		if k.BigInt().Sign() != 0 {
+			return k, nil
+		}
+	}
+}
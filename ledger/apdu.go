@@ -0,0 +1,199 @@
+// Package ledger encodes the APDU commands of the Mina Ledger app and parses
+// its responses. It does not talk to the device: callers send the bytes with
+// the HID or BLE transport of their choice.
+package ledger
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// CLA is the instruction class of the Mina app.
+const CLA byte = 0xe0
+
+// Instructions understood by the Mina app.
+const (
+	InsGetVersion byte = 0x01
+	InsGetAddress byte = 0x02
+	InsSignTx     byte = 0x03
+)
+
+// CoinType is the SLIP-44 coin type of Mina.
+const CoinType = 12586
+
+// Transaction types as the app encodes them.
+const (
+	txTypePayment    byte = 0x00
+	txTypeDelegation byte = 0x04
+)
+
+// StatusOK is the status word of a successful command.
+const StatusOK uint16 = 0x9000
+
+// Sizes of the fields in the sign payload.
+const (
+	addressSize = 55
+	memoSize    = 32
+	// SignTxDataSize is the length of the data of a sign command.
+	SignTxDataSize = 4 + 2*addressSize + 8 + 8 + 4 + 4 + memoSize + 1 + 1
+	// signatureSize is the length of a signature response without the
+	// status word: r and s, 32 big-endian bytes each.
+	signatureSize = 64
+)
+
+const hardened = 0x80000000
+
+// Path returns the BIP44 derivation path the app uses for account:
+// m/44'/12586'/account'/0/0.
+func Path(account uint32) []uint32 {
+	return []uint32{44 | hardened, CoinType | hardened, account | hardened, 0, 0}
+}
+
+// APDU is a command for the device.
+type APDU struct {
+	CLA, INS, P1, P2 byte
+	Data             []byte
+}
+
+// Bytes returns the encoded command: the four header bytes, the data
+// length and the data.
+func (a APDU) Bytes() ([]byte, error) {
+	if len(a.Data) > 255 {
+		return nil, errcode.Errorf(errcode.InvalidLength, "ledger: APDU data is %d bytes, max 255", len(a.Data))
+	}
+	out := make([]byte, 0, 5+len(a.Data))
+	out = append(out, a.CLA, a.INS, a.P1, a.P2, byte(len(a.Data)))
+	return append(out, a.Data...), nil
+}
+
+// GetVersionAPDU returns the command that asks for the app version.
+func GetVersionAPDU() APDU {
+	return APDU{CLA: CLA, INS: InsGetVersion}
+}
+
+// GetAddressAPDU returns the command that asks the device for the address
+// of account, after the user confirms it on screen.
+func GetAddressAPDU(account uint32) APDU {
+	return APDU{CLA: CLA, INS: InsGetAddress, Data: binary.BigEndian.AppendUint32(nil, account)}
+}
+
+// SignTransactionAPDU returns the command that asks the device to sign tx
+// with account. The payload is the account, the sender and receiver
+// addresses, amount, fee, nonce, expiry slot, the memo padded to 32 bytes,
+// the transaction type and the network id. The app signs for mainnet and
+// devnet (or testnet) only; other networks are rejected.
+func SignTransactionAPDU(account uint32, tx transaction.Command, network keys.Network) (APDU, error) {
+	var (
+		txType            byte
+		from, to          keys.PublicKey
+		amount, fee       uint64
+		nonce, validUntil uint32
+		memo              string
+	)
+	switch t := tx.(type) {
+	case transaction.Payment:
+		txType, from, to, amount = txTypePayment, t.From, t.To, t.Amount
+		fee, nonce, validUntil, memo = t.Fee, t.Nonce, t.ValidUntil, t.Memo
+	case transaction.StakeDelegation:
+		txType, from, to = txTypeDelegation, t.From, t.To
+		fee, nonce, validUntil, memo = t.Fee, t.Nonce, t.ValidUntil, t.Memo
+	default:
+		return APDU{}, errcode.Errorf(errcode.Unsupported, "ledger: unsupported command %T", tx)
+	}
+	if len(network.ID) != 1 || network.ID[0] > 1 {
+		return APDU{}, errcode.Errorf(errcode.Unsupported, "ledger: network %q is not supported by the app", network.Name)
+	}
+	if len(memo) > memoSize {
+		return APDU{}, errcode.Errorf(errcode.InvalidLength, "ledger: memo is %d bytes, max %d", len(memo), memoSize)
+	}
+	if validUntil == 0 {
+		validUntil = transaction.NoExpiry
+	}
+
+	data := make([]byte, 0, SignTxDataSize)
+	data = binary.BigEndian.AppendUint32(data, account)
+	for _, pk := range []keys.PublicKey{from, to} {
+		addr, err := pk.ToBase58()
+		if err != nil {
+			return APDU{}, fmt.Errorf("ledger: %w", err)
+		}
+		data = append(data, addr...)
+	}
+	data = binary.BigEndian.AppendUint64(data, amount)
+	data = binary.BigEndian.AppendUint64(data, fee)
+	data = binary.BigEndian.AppendUint32(data, nonce)
+	data = binary.BigEndian.AppendUint32(data, validUntil)
+	var m [memoSize]byte
+	copy(m[:], memo)
+	data = append(data, m[:]...)
+	data = append(data, txType, network.ID[0])
+	return APDU{CLA: CLA, INS: InsSignTx, Data: data}, nil
+}
+
+// StatusError is a status word other than StatusOK returned by the device,
+// for example when the user rejects the request.
+type StatusError uint16
+
+func (e StatusError) Error() string {
+	return fmt.Sprintf("ledger: device returned status 0x%04x", uint16(e))
+}
+
+// splitResponse separates a response into its data and checks the
+// trailing status word.
+func splitResponse(resp []byte) ([]byte, error) {
+	if len(resp) < 2 {
+		return nil, errcode.New(errcode.InvalidLength, "ledger: response is shorter than a status word")
+	}
+	n := len(resp) - 2
+	if sw := binary.BigEndian.Uint16(resp[n:]); sw != StatusOK {
+		return nil, StatusError(sw)
+	}
+	return resp[:n], nil
+}
+
+// ParseVersionResponse returns the major, minor and patch version in the
+// response to GetVersionAPDU.
+func ParseVersionResponse(resp []byte) (major, minor, patch byte, err error) {
+	data, err := splitResponse(resp)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if len(data) < 3 {
+		return 0, 0, 0, errcode.Errorf(errcode.InvalidLength, "ledger: version response is %d bytes, want 3", len(data))
+	}
+	return data[0], data[1], data[2], nil
+}
+
+// ParseAddressResponse decodes the address in the response to
+// GetAddressAPDU.
+func ParseAddressResponse(resp []byte) (keys.PublicKey, error) {
+	data, err := splitResponse(resp)
+	if err != nil {
+		return keys.PublicKey{}, err
+	}
+	if len(data) != addressSize {
+		return keys.PublicKey{}, errcode.Errorf(errcode.InvalidLength, "ledger: address response is %d bytes, want %d", len(data), addressSize)
+	}
+	return keys.PublicKeyFromBase58(string(data))
+}
+
+// ParseSignatureResponse decodes the signature in the response to
+// SignTransactionAPDU: r and s as 32 big-endian bytes each.
+func ParseSignatureResponse(resp []byte) (*signature.Signature, error) {
+	data, err := splitResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != signatureSize {
+		return nil, errcode.Errorf(errcode.InvalidLength, "ledger: signature response is %d bytes, want %d", len(data), signatureSize)
+	}
+	r := new(big.Int).SetBytes(data[:signatureSize/2])
+	s := new(big.Int).SetBytes(data[signatureSize/2:])
+	return signature.New(r, s)
+}
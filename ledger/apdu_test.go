@@ -0,0 +1,104 @@
+package ledger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+func testKey(v int64) keys.PublicKey {
+	return keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(v))}.ToPublicKey()
+}
+
+func TestSignTransactionAPDU(t *testing.T) {
+	from, to := testKey(1), testKey(2)
+	tx := transaction.Payment{From: from, To: to, Amount: 1_000_000_000, Fee: 10_000_000, Nonce: 7, Memo: "hello"}
+	apdu, err := SignTransactionAPDU(3, tx, keys.NetworkMainnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := apdu.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b[:5], []byte{CLA, InsSignTx, 0, 0, SignTxDataSize}) || len(b) != 5+SignTxDataSize {
+		t.Fatalf("header = % x, length %d", b[:5], len(b))
+	}
+	d := b[5:]
+	fromAddr, _ := from.ToBase58()
+	toAddr, _ := to.ToBase58()
+	if binary.BigEndian.Uint32(d) != 3 || string(d[4:59]) != fromAddr || string(d[59:114]) != toAddr {
+		t.Error("account or addresses misplaced")
+	}
+	if binary.BigEndian.Uint64(d[114:]) != tx.Amount || binary.BigEndian.Uint64(d[122:]) != tx.Fee {
+		t.Error("amount or fee misplaced")
+	}
+	if binary.BigEndian.Uint32(d[130:]) != 7 || binary.BigEndian.Uint32(d[134:]) != transaction.NoExpiry {
+		t.Error("nonce or expiry misplaced")
+	}
+	if string(bytes.TrimRight(d[138:170], "\x00")) != "hello" || d[170] != txTypePayment || d[171] != 0x01 {
+		t.Errorf("memo, type or network = %q %x %x", d[138:170], d[170], d[171])
+	}
+
+	del := transaction.StakeDelegation{From: from, To: to, Fee: 1, Nonce: 1}
+	apdu, err = SignTransactionAPDU(0, del, keys.NetworkDevnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := apdu.Data; binary.BigEndian.Uint64(d[114:]) != 0 || d[170] != txTypeDelegation || d[171] != 0x00 {
+		t.Error("delegation amount, type or network misplaced")
+	}
+
+	if _, err := SignTransactionAPDU(0, tx, keys.NetworkFromID("mynet")); err == nil {
+		t.Error("SignTransactionAPDU accepted a custom network")
+	}
+}
+
+func TestParseResponses(t *testing.T) {
+	sk := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(5))}
+	sig, err := sk.SignFieldElement(big.NewInt(1), "mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := append(sig.R.BigInt().FillBytes(make([]byte, 32)), sig.S.BytesBE()...)
+	got, err := ParseSignatureResponse(append(resp, 0x90, 0x00))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.R.Equal(sig.R) || got.S.BigInt().Cmp(sig.S.BigInt()) != 0 {
+		t.Error("ParseSignatureResponse did not round-trip")
+	}
+
+	var status StatusError
+	if _, err := ParseSignatureResponse([]byte{0x69, 0x86}); !errors.As(err, &status) || status != 0x6986 {
+		t.Errorf("rejected request: err = %v", err)
+	}
+	if _, err := ParseSignatureResponse(append(resp[:10], 0x90, 0x00)); err == nil {
+		t.Error("ParseSignatureResponse accepted a short signature")
+	}
+
+	pk := sk.ToPublicKey()
+	addr, _ := pk.ToBase58()
+	if got, err := ParseAddressResponse(append([]byte(addr), 0x90, 0x00)); err != nil || !got.Equal(pk) {
+		t.Errorf("ParseAddressResponse = %v, %v", got, err)
+	}
+	if major, minor, patch, err := ParseVersionResponse([]byte{1, 3, 0, 0x90, 0x00}); err != nil || major != 1 || minor != 3 || patch != 0 {
+		t.Errorf("ParseVersionResponse = %d.%d.%d, %v", major, minor, patch, err)
+	}
+}
+
+func TestPath(t *testing.T) {
+	want := []uint32{0x8000002c, 0x8000312a, 0x80000002, 0, 0}
+	got := Path(2)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Path(2) = %x, want %x", got, want)
+		}
+	}
+}
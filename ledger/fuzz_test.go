@@ -0,0 +1,49 @@
+package ledger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// withStatus appends the status word sw to data.
+func withStatus(data []byte, sw uint16) []byte {
+	return binary.BigEndian.AppendUint16(append([]byte(nil), data...), sw)
+}
+
+func FuzzParseAddressResponse(f *testing.F) {
+	addr, err := testKey(1).ToBase58()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(withStatus([]byte(addr), StatusOK))
+	f.Add(withStatus(nil, 0x6985))
+	f.Fuzz(func(t *testing.T, resp []byte) {
+		pk, err := ParseAddressResponse(resp)
+		if err != nil {
+			return
+		}
+		out, err := pk.ToBase58()
+		if err != nil || !bytes.Equal(withStatus([]byte(out), StatusOK), resp) {
+			t.Fatalf("round trip: %q, %v", out, err)
+		}
+	})
+}
+
+func FuzzParseSignatureResponse(f *testing.F) {
+	f.Add(withStatus(bytes.Repeat([]byte{1}, signatureSize), StatusOK))
+	f.Add(withStatus(bytes.Repeat([]byte{0xff}, signatureSize), StatusOK))
+	f.Add(withStatus(nil, 0x6985))
+	f.Fuzz(func(t *testing.T, resp []byte) {
+		sig, err := ParseSignatureResponse(resp)
+		if err != nil {
+			return
+		}
+		out := make([]byte, signatureSize)
+		sig.R.BigInt().FillBytes(out[:signatureSize/2])
+		sig.S.BigInt().FillBytes(out[signatureSize/2:])
+		if !bytes.Equal(withStatus(out, StatusOK), resp) {
+			t.Fatalf("round trip: % x", out)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+// Package metrics exposes the signing and verification activity of the
+// signer package as Prometheus metrics.
+//
+// Nothing is measured until a Collector is installed as the signer hook:
+//
+//	c := metrics.NewCollector("myservice")
+//	prometheus.MustRegister(c)
+//	signer.SetHook(c)
+//
+// or, for a single client, client.WithHook(c). The Collector counts
+// signatures issued and failed, verifications by outcome, and records the
+// latency of each operation. signer.Batch reports its batch sizes, and
+// services that batch other operations can report theirs with ObserveBatch.
+//
+// The package is a module of its own, so that only programs using it
+// depend on the Prometheus client; the root module provides just the
+// signer.Hook interface it implements.
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+// Collector is a prometheus.Collector and a signer.Hook. It is safe for
+// concurrent use.
+type Collector struct {
+	signatures    *prometheus.CounterVec
+	signErrors    *prometheus.CounterVec
+	verifications *prometheus.CounterVec
+	latency       *prometheus.HistogramVec
+	batchSizes    *prometheus.HistogramVec
+
+	cacheHits      *prometheus.Desc
+	cacheMisses    *prometheus.Desc
+	cacheEvictions *prometheus.Desc
+	cacheEntries   *prometheus.Desc
+
+	mu     sync.Mutex
+	caches map[string]*signer.VerifyCache
+}
+
+var (
+	_ prometheus.Collector = (*Collector)(nil)
+	_ signer.Hook          = (*Collector)(nil)
+	_ signer.BatchObserver = (*Collector)(nil)
+)
+
+// NewCollector returns a Collector whose metric names start with
+// namespace, which may be empty:
+//
+//	<namespace>_mina_signer_signatures_total{operation}
+//	<namespace>_mina_signer_sign_errors_total{operation,code}
+//	<namespace>_mina_signer_verifications_total{operation,result}
+//	<namespace>_mina_signer_operation_duration_seconds{operation}
+//	<namespace>_mina_signer_batch_size{operation}
+//	<namespace>_mina_signer_verify_cache_hits_total{cache}
+//	<namespace>_mina_signer_verify_cache_misses_total{cache}
+//	<namespace>_mina_signer_verify_cache_evictions_total{cache}
+//	<namespace>_mina_signer_verify_cache_entries{cache}
+//
+// operation is the span name of the method, such as
+// signer.SpanSignFields, code is the errcode.Code of the error, or
+// "unknown" for an error without one, and result is "valid" or "invalid".
+// The cache metrics are those of the caches passed to WatchVerifyCache,
+// labeled with their names.
+func NewCollector(namespace string) *Collector {
+	const subsystem = "mina_signer"
+	cacheDesc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, name), help, []string{"cache"}, nil)
+	}
+	return &Collector{
+		signatures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "signatures_total",
+			Help:      "Signatures issued.",
+		}, []string{"operation"}),
+		signErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "sign_errors_total",
+			Help:      "Signing operations that returned an error, by error code.",
+		}, []string{"operation", "code"}),
+		verifications: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "verifications_total",
+			Help:      "Signature verifications by result.",
+		}, []string{"operation", "result"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "operation_duration_seconds",
+			Help:      "Latency of signing and verification operations.",
+			// A signature or verification takes about a millisecond;
+			// remote signers take longer.
+			Buckets: prometheus.ExponentialBuckets(0.0001, 4, 10),
+		}, []string{"operation"}),
+		batchSizes: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "batch_size",
+			Help:      "Number of items in batched operations.",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+		}, []string{"operation"}),
+		cacheHits:      cacheDesc("verify_cache_hits_total", "Verifications answered by the cache."),
+		cacheMisses:    cacheDesc("verify_cache_misses_total", "Verifications the cache did not hold."),
+		cacheEvictions: cacheDesc("verify_cache_evictions_total", "Cache entries evicted to make room."),
+		cacheEntries:   cacheDesc("verify_cache_entries", "Results held by the cache."),
+		caches:         make(map[string]*signer.VerifyCache),
+	}
+}
+
+// OnSign implements signer.Hook.
+func (c *Collector) OnSign(e signer.SignEvent) {
+	if e.Err != nil {
+		c.signErrors.WithLabelValues(e.Operation, errorCode(e.Err)).Inc()
+	} else {
+		c.signatures.WithLabelValues(e.Operation).Inc()
+	}
+	c.latency.WithLabelValues(e.Operation).Observe(e.Duration.Seconds())
+}
+
+// errorCode returns the code label of err.
+func errorCode(err error) string {
+	if code := errcode.CodeOf(err); code != errcode.Unknown {
+		return string(code)
+	}
+	return "unknown"
+}
+
+// OnVerify implements signer.Hook.
+func (c *Collector) OnVerify(e signer.VerifyEvent) {
+	result := "invalid"
+	if e.Valid {
+		result = "valid"
+	}
+	c.verifications.WithLabelValues(e.Operation, result).Inc()
+	c.latency.WithLabelValues(e.Operation).Observe(e.Duration.Seconds())
+}
+
+// ObserveBatch records a batched operation of size items.
+func (c *Collector) ObserveBatch(operation string, size int) {
+	c.batchSizes.WithLabelValues(operation).Observe(float64(size))
+}
+
+// WatchVerifyCache exports the statistics of cache under name, replacing
+// any cache watched under the same name. A nil cache stops watching name.
+func (c *Collector) WatchVerifyCache(name string, cache *signer.VerifyCache) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cache == nil {
+		delete(c.caches, name)
+		return
+	}
+	c.caches[name] = cache
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.signatures.Describe(ch)
+	c.signErrors.Describe(ch)
+	c.verifications.Describe(ch)
+	c.latency.Describe(ch)
+	c.batchSizes.Describe(ch)
+	ch <- c.cacheHits
+	ch <- c.cacheMisses
+	ch <- c.cacheEvictions
+	ch <- c.cacheEntries
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.signatures.Collect(ch)
+	c.signErrors.Collect(ch)
+	c.verifications.Collect(ch)
+	c.latency.Collect(ch)
+	c.batchSizes.Collect(ch)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name, cache := range c.caches {
+		stats := cache.Stats()
+		ch <- prometheus.MustNewConstMetric(c.cacheHits, prometheus.CounterValue, float64(stats.Hits), name)
+		ch <- prometheus.MustNewConstMetric(c.cacheMisses, prometheus.CounterValue, float64(stats.Misses), name)
+		ch <- prometheus.MustNewConstMetric(c.cacheEvictions, prometheus.CounterValue, float64(stats.Evictions), name)
+		ch <- prometheus.MustNewConstMetric(c.cacheEntries, prometheus.GaugeValue, float64(stats.Len), name)
+	}
+}
@@ -0,0 +1,75 @@
+package metrics_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/node101-io/mina-signer-go/metrics"
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+const testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+
+func TestCollector(t *testing.T) {
+	c := metrics.NewCollector("test")
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(c)
+
+	cache := signer.NewVerifyCache(1)
+	c.WatchVerifyCache("gossip", cache)
+	client := signer.NewClient(signer.NetworkTestnet).WithHook(c).WithVerifyCache(cache)
+	signed, err := client.SignFields([]*big.Int{big.NewInt(1)}, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.VerifyFields(signed)
+	signed.Data = []*big.Int{big.NewInt(2)}
+	client.VerifyFields(signed)
+	client.VerifyFields(signed)
+	if _, err := client.SignFields(nil, "not a key"); err == nil {
+		t.Fatal("SignFields accepted an invalid key")
+	}
+	c.ObserveBatch("verify", 16)
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := make(map[string]float64)
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			key := f.GetName()
+			for _, l := range m.GetLabel() {
+				key += "," + l.GetValue()
+			}
+			switch {
+			case m.GetCounter() != nil:
+				counts[key] = m.GetCounter().GetValue()
+			case m.GetGauge() != nil:
+				counts[key] = m.GetGauge().GetValue()
+			case m.GetHistogram() != nil:
+				counts[key] = float64(m.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	want := map[string]float64{
+		"test_mina_signer_signatures_total," + signer.SpanSignFields:                   1,
+		"test_mina_signer_sign_errors_total,invalid_encoding," + signer.SpanSignFields: 1,
+		"test_mina_signer_verifications_total," + signer.SpanVerifyFields + ",valid":   1,
+		"test_mina_signer_verifications_total," + signer.SpanVerifyFields + ",invalid": 2,
+		"test_mina_signer_operation_duration_seconds," + signer.SpanSignFields:         2,
+		"test_mina_signer_operation_duration_seconds," + signer.SpanVerifyFields:       3,
+		"test_mina_signer_batch_size,verify":                                           1,
+		"test_mina_signer_verify_cache_hits_total,gossip":                              1,
+		"test_mina_signer_verify_cache_misses_total,gossip":                            2,
+		"test_mina_signer_verify_cache_evictions_total,gossip":                         1,
+		"test_mina_signer_verify_cache_entries,gossip":                                 1,
+	}
+	for k, v := range want {
+		if counts[k] != v {
+			t.Errorf("%s = %v, want %v", k, counts[k], v)
+		}
+	}
+}
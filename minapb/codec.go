@@ -0,0 +1,247 @@
+package minapb
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// Marshal returns the wire encoding of m.
+func (m *PublicKey) Marshal() ([]byte, error) {
+	return m.appendTo(nil), nil
+}
+
+func (m *PublicKey) appendTo(b []byte) []byte {
+	b = appendBytes(b, 1, m.X)
+	return appendBool(b, 2, m.IsOdd)
+}
+
+// Unmarshal decodes the wire encoding of a PublicKey into m.
+func (m *PublicKey) Unmarshal(b []byte) error {
+	*m = PublicKey{}
+	return decode(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case 1:
+			return consumeBytes(b, typ, &m.X)
+		case 2:
+			return consumeBool(b, typ, &m.IsOdd)
+		}
+		return -1, nil
+	})
+}
+
+// Marshal returns the wire encoding of m.
+func (m *Signature) Marshal() ([]byte, error) {
+	b := appendBytes(nil, 1, m.Field)
+	return appendBytes(b, 2, m.Scalar), nil
+}
+
+// Unmarshal decodes the wire encoding of a Signature into m.
+func (m *Signature) Unmarshal(b []byte) error {
+	*m = Signature{}
+	return decode(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case 1:
+			return consumeBytes(b, typ, &m.Field)
+		case 2:
+			return consumeBytes(b, typ, &m.Scalar)
+		}
+		return -1, nil
+	})
+}
+
+// Marshal returns the wire encoding of m.
+func (m *Payment) Marshal() ([]byte, error) {
+	b := appendMessage(nil, 1, m.From)
+	b = appendMessage(b, 2, m.To)
+	b = appendVarint(b, 3, m.Amount)
+	b = appendVarint(b, 4, m.Fee)
+	b = appendVarint(b, 5, uint64(m.Nonce))
+	b = appendVarint(b, 6, uint64(m.ValidUntil))
+	return appendBytes(b, 7, []byte(m.Memo)), nil
+}
+
+// Unmarshal decodes the wire encoding of a Payment into m.
+func (m *Payment) Unmarshal(b []byte) error {
+	*m = Payment{}
+	return decode(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case 1:
+			return consumeMessage(b, typ, &m.From)
+		case 2:
+			return consumeMessage(b, typ, &m.To)
+		case 3:
+			return consumeVarint(b, typ, &m.Amount)
+		case 4:
+			return consumeVarint(b, typ, &m.Fee)
+		case 5:
+			return consumeUint32(b, typ, &m.Nonce)
+		case 6:
+			return consumeUint32(b, typ, &m.ValidUntil)
+		case 7:
+			return consumeString(b, typ, &m.Memo)
+		}
+		return -1, nil
+	})
+}
+
+// Marshal returns the wire encoding of m.
+func (m *StakeDelegation) Marshal() ([]byte, error) {
+	b := appendMessage(nil, 1, m.From)
+	b = appendMessage(b, 2, m.To)
+	b = appendVarint(b, 3, m.Fee)
+	b = appendVarint(b, 4, uint64(m.Nonce))
+	b = appendVarint(b, 5, uint64(m.ValidUntil))
+	return appendBytes(b, 6, []byte(m.Memo)), nil
+}
+
+// Unmarshal decodes the wire encoding of a StakeDelegation into m.
+func (m *StakeDelegation) Unmarshal(b []byte) error {
+	*m = StakeDelegation{}
+	return decode(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case 1:
+			return consumeMessage(b, typ, &m.From)
+		case 2:
+			return consumeMessage(b, typ, &m.To)
+		case 3:
+			return consumeVarint(b, typ, &m.Fee)
+		case 4:
+			return consumeUint32(b, typ, &m.Nonce)
+		case 5:
+			return consumeUint32(b, typ, &m.ValidUntil)
+		case 6:
+			return consumeString(b, typ, &m.Memo)
+		}
+		return -1, nil
+	})
+}
+
+// Proto3 omits fields holding their zero value.
+
+func appendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendBool(b []byte, num protowire.Number, v bool) []byte {
+	return appendVarint(b, num, protowire.EncodeBool(v))
+}
+
+func appendMessage(b []byte, num protowire.Number, m *PublicKey) []byte {
+	if m == nil {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, m.appendTo(nil))
+}
+
+// decode walks the fields of b. field consumes the value of a known field
+// and returns its length, or -1 for a field it does not know, which is
+// skipped.
+func decode(b []byte, field func(num protowire.Number, typ protowire.Type, b []byte) (int, error)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return errcode.Errorf(errcode.InvalidEncoding, "minapb: %w", protowire.ParseError(n))
+		}
+		b = b[n:]
+		n, err := field(num, typ, b)
+		if err != nil {
+			return fmt.Errorf("minapb: field %d: %w", num, err)
+		}
+		if n < 0 {
+			if n = protowire.ConsumeFieldValue(num, typ, b); n < 0 {
+				return errcode.Errorf(errcode.InvalidEncoding, "minapb: field %d: %w", num, protowire.ParseError(n))
+			}
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+func wireType(got, want protowire.Type) error {
+	if got != want {
+		return errcode.Errorf(errcode.InvalidEncoding, "wire type %d, want %d", got, want)
+	}
+	return nil
+}
+
+func consumeBytes(b []byte, typ protowire.Type, dst *[]byte) (int, error) {
+	if err := wireType(typ, protowire.BytesType); err != nil {
+		return 0, err
+	}
+	v, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	*dst = append([]byte(nil), v...)
+	return n, nil
+}
+
+func consumeString(b []byte, typ protowire.Type, dst *string) (int, error) {
+	var v []byte
+	n, err := consumeBytes(b, typ, &v)
+	*dst = string(v)
+	return n, err
+}
+
+func consumeVarint(b []byte, typ protowire.Type, dst *uint64) (int, error) {
+	if err := wireType(typ, protowire.VarintType); err != nil {
+		return 0, err
+	}
+	v, n := protowire.ConsumeVarint(b)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	*dst = v
+	return n, nil
+}
+
+func consumeUint32(b []byte, typ protowire.Type, dst *uint32) (int, error) {
+	var v uint64
+	n, err := consumeVarint(b, typ, &v)
+	if err != nil {
+		return 0, err
+	}
+	if v > math.MaxUint32 {
+		return 0, errcode.Errorf(errcode.OutOfRange, "value %d overflows uint32", v)
+	}
+	*dst = uint32(v)
+	return n, nil
+}
+
+func consumeBool(b []byte, typ protowire.Type, dst *bool) (int, error) {
+	var v uint64
+	n, err := consumeVarint(b, typ, &v)
+	*dst = protowire.DecodeBool(v)
+	return n, err
+}
+
+func consumeMessage(b []byte, typ protowire.Type, dst **PublicKey) (int, error) {
+	var v []byte
+	n, err := consumeBytes(b, typ, &v)
+	if err != nil {
+		return 0, err
+	}
+	m := new(PublicKey)
+	if err := m.Unmarshal(v); err != nil {
+		return 0, err
+	}
+	*dst = m
+	return n, nil
+}
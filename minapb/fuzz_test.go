@@ -0,0 +1,89 @@
+package minapb
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// message is implemented by the messages of the package.
+type message interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// fuzzRoundTrip checks that a message m decodes from data re-encodes to
+// bytes that decode to the same message and encode to themselves.
+func fuzzRoundTrip(t *testing.T, data []byte, m, back message) {
+	if err := m.Unmarshal(data); err != nil {
+		return
+	}
+	out, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := back.Unmarshal(out); err != nil {
+		t.Fatalf("Unmarshal(Marshal()) = %v", err)
+	}
+	if !reflect.DeepEqual(m, back) {
+		t.Fatalf("round trip: %+v, want %+v", back, m)
+	}
+	again, _ := back.Marshal()
+	if !bytes.Equal(again, out) {
+		t.Fatalf("Marshal is not stable: % x, then % x", out, again)
+	}
+}
+
+func seedCommands(f *testing.F) {
+	from, to := testKey(1).ToPublicKey(), testKey(2).ToPublicKey()
+	p, err := NewPayment(transaction.Payment{From: from, To: to, Amount: 1, Fee: 2, Nonce: 3, ValidUntil: 4, Memo: "m"})
+	if err != nil {
+		f.Fatal(err)
+	}
+	d, err := NewStakeDelegation(transaction.StakeDelegation{From: from, To: to, Fee: 2, Nonce: 3, Memo: "m"})
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, m := range []message{p, d} {
+		b, _ := m.Marshal()
+		f.Add(b)
+	}
+	f.Add([]byte{})
+}
+
+func FuzzPaymentUnmarshal(f *testing.F) {
+	seedCommands(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzRoundTrip(t, data, new(Payment), new(Payment))
+	})
+}
+
+func FuzzStakeDelegationUnmarshal(f *testing.F) {
+	seedCommands(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzRoundTrip(t, data, new(StakeDelegation), new(StakeDelegation))
+	})
+}
+
+func FuzzPublicKeyUnmarshal(f *testing.F) {
+	m, err := NewPublicKey(testKey(3).ToPublicKey())
+	if err != nil {
+		f.Fatal(err)
+	}
+	b, _ := m.Marshal()
+	f.Add(b)
+	f.Add([]byte{0x0a, 0x00, 0x10, 0x01, 0x18, 0x05})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzRoundTrip(t, data, new(PublicKey), new(PublicKey))
+	})
+}
+
+func FuzzSignatureUnmarshal(f *testing.F) {
+	f.Add([]byte{0x0a, 0x01, 0x01, 0x12, 0x01, 0x02})
+	f.Add([]byte{0x12, 0x00})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzRoundTrip(t, data, new(Signature), new(Signature))
+	})
+}
@@ -0,0 +1,162 @@
+// Package minapb defines the protobuf messages of mina.proto and converts
+// them to and from the module's types, so services can exchange keys,
+// signatures and signed commands as protobuf.
+//
+// The messages implement the proto3 wire format directly with protowire
+// rather than through protoc-generated code, which keeps the package free
+// of reflection. Fields are encoded in field-number order, zero values are
+// omitted and unknown fields are skipped, so the bytes interoperate with
+// generated code for mina.proto in any language.
+package minapb
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// PublicKey is the mina.v1.PublicKey message.
+type PublicKey struct {
+	X     []byte
+	IsOdd bool
+}
+
+// Signature is the mina.v1.Signature message.
+type Signature struct {
+	Field  []byte
+	Scalar []byte
+}
+
+// Payment is the mina.v1.Payment message.
+type Payment struct {
+	From       *PublicKey
+	To         *PublicKey
+	Amount     uint64
+	Fee        uint64
+	Nonce      uint32
+	ValidUntil uint32
+	Memo       string
+}
+
+// StakeDelegation is the mina.v1.StakeDelegation message.
+type StakeDelegation struct {
+	From       *PublicKey
+	To         *PublicKey
+	Fee        uint64
+	Nonce      uint32
+	ValidUntil uint32
+	Memo       string
+}
+
+// NewPublicKey converts pk.
+func NewPublicKey(pk keys.PublicKey) (*PublicKey, error) {
+	b, err := pk.MarshalBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &PublicKey{X: b[:keys.PublicKeyXByteSize], IsOdd: pk.IsOdd}, nil
+}
+
+// PublicKey converts m, checking that it is a valid curve point.
+func (m *PublicKey) PublicKey() (keys.PublicKey, error) {
+	if m == nil {
+		return keys.PublicKey{}, errcode.New(errcode.MissingValue, "minapb: missing public key")
+	}
+	if len(m.X) != keys.PublicKeyXByteSize {
+		return keys.PublicKey{}, errcode.Errorf(errcode.InvalidLength, "minapb: public key x is %d bytes, want %d", len(m.X), keys.PublicKeyXByteSize)
+	}
+	b := make([]byte, keys.PublicKeyTotalByteSize)
+	copy(b, m.X)
+	if m.IsOdd {
+		b[keys.PublicKeyXByteSize] = 1
+	}
+	var pk keys.PublicKey
+	if err := pk.UnmarshalBytes(b); err != nil {
+		return keys.PublicKey{}, err
+	}
+	return pk, nil
+}
+
+// NewSignature converts sig.
+func NewSignature(sig *signature.Signature) (*Signature, error) {
+	b, err := sig.MarshalBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &Signature{Field: b[:signature.BigIntSize], Scalar: b[signature.BigIntSize:]}, nil
+}
+
+// Signature converts m, rejecting non-canonical components.
+func (m *Signature) Signature() (*signature.Signature, error) {
+	if m == nil {
+		return nil, errcode.New(errcode.MissingValue, "minapb: missing signature")
+	}
+	if len(m.Field) > signature.BigIntSize || len(m.Scalar) > signature.BigIntSize {
+		return nil, errcode.New(errcode.InvalidLength, "minapb: signature component longer than 32 bytes")
+	}
+	return signature.New(new(big.Int).SetBytes(m.Field), new(big.Int).SetBytes(m.Scalar))
+}
+
+// NewPayment converts p.
+func NewPayment(p transaction.Payment) (*Payment, error) {
+	from, to, err := newPublicKeys(p.From, p.To)
+	if err != nil {
+		return nil, err
+	}
+	return &Payment{From: from, To: to, Amount: p.Amount, Fee: p.Fee, Nonce: p.Nonce, ValidUntil: p.ValidUntil, Memo: p.Memo}, nil
+}
+
+// Payment converts m.
+func (m *Payment) Payment() (transaction.Payment, error) {
+	from, to, err := publicKeys(m.From, m.To)
+	if err != nil {
+		return transaction.Payment{}, err
+	}
+	return transaction.Payment{From: from, To: to, Amount: m.Amount, Fee: m.Fee, Nonce: m.Nonce, ValidUntil: m.ValidUntil, Memo: m.Memo}, nil
+}
+
+// NewStakeDelegation converts d.
+func NewStakeDelegation(d transaction.StakeDelegation) (*StakeDelegation, error) {
+	from, to, err := newPublicKeys(d.From, d.To)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeDelegation{From: from, To: to, Fee: d.Fee, Nonce: d.Nonce, ValidUntil: d.ValidUntil, Memo: d.Memo}, nil
+}
+
+// StakeDelegation converts m.
+func (m *StakeDelegation) StakeDelegation() (transaction.StakeDelegation, error) {
+	from, to, err := publicKeys(m.From, m.To)
+	if err != nil {
+		return transaction.StakeDelegation{}, err
+	}
+	return transaction.StakeDelegation{From: from, To: to, Fee: m.Fee, Nonce: m.Nonce, ValidUntil: m.ValidUntil, Memo: m.Memo}, nil
+}
+
+func newPublicKeys(from, to keys.PublicKey) (*PublicKey, *PublicKey, error) {
+	f, err := NewPublicKey(from)
+	if err != nil {
+		return nil, nil, fmt.Errorf("minapb: from: %w", err)
+	}
+	t, err := NewPublicKey(to)
+	if err != nil {
+		return nil, nil, fmt.Errorf("minapb: to: %w", err)
+	}
+	return f, t, nil
+}
+
+func publicKeys(from, to *PublicKey) (keys.PublicKey, keys.PublicKey, error) {
+	f, err := from.PublicKey()
+	if err != nil {
+		return keys.PublicKey{}, keys.PublicKey{}, fmt.Errorf("minapb: from: %w", err)
+	}
+	t, err := to.PublicKey()
+	if err != nil {
+		return keys.PublicKey{}, keys.PublicKey{}, fmt.Errorf("minapb: to: %w", err)
+	}
+	return f, t, nil
+}
@@ -0,0 +1,106 @@
+package minapb
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+func testKey(v int64) keys.PrivateKey {
+	return keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(v))}
+}
+
+func TestPublicKeyWireFormat(t *testing.T) {
+	pk := testKey(3).ToPublicKey()
+	m, err := NewPublicKey(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := m.Marshal()
+	// field 1 (bytes, 32 long), then field 2 (varint) only when set.
+	want := append([]byte{0x0a, 0x20}, m.X...)
+	if pk.IsOdd {
+		want = append(want, 0x10, 0x01)
+	}
+	if !bytes.Equal(b, want) {
+		t.Errorf("Marshal = % x, want % x", b, want)
+	}
+
+	// Unknown fields are skipped.
+	var got PublicKey
+	if err := got.Unmarshal(append(b, 0x18, 0x05, 0x22, 0x01, 0xff)); err != nil {
+		t.Fatal(err)
+	}
+	back, err := got.PublicKey()
+	if err != nil || !back.Equal(pk) {
+		t.Errorf("round trip = %v, %v", back, err)
+	}
+	if err := got.Unmarshal([]byte{0x0a, 0x05, 0x01}); err == nil {
+		t.Error("Unmarshal accepted truncated bytes")
+	}
+	if err := got.Unmarshal([]byte{0x08, 0x01}); err == nil {
+		t.Error("Unmarshal accepted a varint for bytes field x")
+	}
+}
+
+func TestCommandsAndSignature(t *testing.T) {
+	sk := testKey(11)
+	from, to := sk.ToPublicKey(), testKey(12).ToPublicKey()
+	p := transaction.Payment{From: from, To: to, Amount: 1 << 40, Fee: 2_000_000, Nonce: 9, ValidUntil: 100, Memo: "pb"}
+	m, err := NewPayment(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := m.Marshal()
+	var dm Payment
+	if err := dm.Unmarshal(b); err != nil {
+		t.Fatal(err)
+	}
+	got, err := dm.Payment()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.From.Equal(p.From) || !got.To.Equal(p.To) || got.Amount != p.Amount || got.Fee != p.Fee ||
+		got.Nonce != p.Nonce || got.ValidUntil != p.ValidUntil || got.Memo != p.Memo {
+		t.Errorf("Payment round trip = %+v, want %+v", got, p)
+	}
+
+	d := transaction.StakeDelegation{From: from, To: to, Fee: 1, Nonce: 2}
+	md, err := NewStakeDelegation(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ = md.Marshal()
+	var dd StakeDelegation
+	if err := dd.Unmarshal(b); err != nil {
+		t.Fatal(err)
+	}
+	if gd, err := dd.StakeDelegation(); err != nil || !gd.To.Equal(to) || gd.Fee != 1 || gd.ValidUntil != 0 {
+		t.Errorf("StakeDelegation round trip = %+v, %v", gd, err)
+	}
+	if _, err := (&Payment{From: m.From}).Payment(); err == nil {
+		t.Error("Payment accepted a missing receiver")
+	}
+
+	sig, err := sk.SignFieldElement(big.NewInt(1), "mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms, err := NewSignature(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ = ms.Marshal()
+	var ds Signature
+	if err := ds.Unmarshal(b); err != nil {
+		t.Fatal(err)
+	}
+	gs, err := ds.Signature()
+	if err != nil || !gs.R.Equal(sig.R) || gs.S.BigInt().Cmp(sig.S.BigInt()) != 0 {
+		t.Errorf("Signature round trip = %v, %v", gs, err)
+	}
+}
@@ -0,0 +1,171 @@
+// Package minasigner is the stable, version 1 API of the module: the types
+// and functions an application needs to create keys, sign and verify
+// messages and transactions, and submit them, under one import path.
+//
+//	c := minasigner.NewClient(minasigner.NetworkMainnet)
+//	signed, err := c.SignTransaction(payment, privateKey)
+//
+// # Compatibility
+//
+// The names declared here follow the Go 1 compatibility promise for the
+// lifetime of major version 1: none is removed or changes meaning, and new
+// names and methods may be added. The declarations are aliases of the
+// packages that implement them, so values pass freely between this package
+// and signer, keys, signature and transaction, and code written against
+// those import paths keeps working.
+//
+// The packages aliased here, signer, keys, signature and transaction, carry
+// the same promise for the names they export, with one exception: where
+// they expose a type of the arithmetic packages below them (field, curve,
+// scalar, poseidon, poseidonbigint, hashgeneric, curvebigint and
+// constants), only the name is stable. These are the PublicKey.X,
+// PrivateKey.Value, Signature.R and Signature.S fields, the underlying
+// type of ROInput, and the functions that take or return a
+// poseidonbigint.HashInput. The arithmetic packages are implementation
+// details exposed for advanced use, such as building other protocols on
+// Pallas, and may change in minor releases as their representations are
+// optimized; so may the types above. Code that needs the promise should
+// go through the base58, byte and JSON encodings and the methods of the
+// aliased types, and depend on the arithmetic packages only with a pinned
+// version.
+//
+// signer.Signed is generic and can only be aliased once the module
+// requires Go 1.24; until then the signing methods return the signer type.
+package minasigner
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// Signing and verification.
+type (
+	// Client signs and verifies for one network. See signer.Client.
+	Client = signer.Client
+	// Network is the chain a Client signs for. See signer.Network.
+	Network = signer.Network
+	// Keypair is a base58-encoded private key and its address.
+	Keypair = signer.Keypair
+	// Signer signs for a key that may be held outside the process, such as
+	// in a hardware module. See signer.Signer.
+	Signer = signer.Signer
+	// KeySigner is a Signer for a private key held in memory.
+	KeySigner = signer.KeySigner
+	// Hook receives an event after each signing and verification.
+	Hook = signer.Hook
+	// SignEvent describes a signing operation.
+	SignEvent = signer.SignEvent
+	// VerifyEvent describes a verification.
+	VerifyEvent = signer.VerifyEvent
+	// Batch verifies many signatures at once. See signer.Batch.
+	Batch = signer.Batch
+	// VerifyCache remembers recent verification results. See
+	// signer.VerifyCache.
+	VerifyCache = signer.VerifyCache
+)
+
+// Keys and signatures.
+type (
+	// PublicKey is a compressed Pallas point. See keys.PublicKey.
+	PublicKey = keys.PublicKey
+	// PrivateKey is a Pallas scalar. See keys.PrivateKey.
+	PrivateKey = keys.PrivateKey
+	// ExpandedPublicKey is a public key prepared for many verifications.
+	// See keys.ExpandedPublicKey.
+	ExpandedPublicKey = keys.ExpandedPublicKey
+	// Signature is a Schnorr signature. See signature.Signature.
+	Signature = signature.Signature
+	// EncodingOptions selects how a string message becomes field
+	// elements. See keys.EncodingOptions.
+	EncodingOptions = keys.EncodingOptions
+	// ROInput is a random-oracle input in the legacy layout of fields and
+	// bits. See keys.ROInput.
+	ROInput = keys.ROInput
+	// Scheme selects the legacy or kimchi signature scheme. See
+	// keys.Scheme.
+	Scheme = keys.Scheme
+)
+
+// The signature schemes. See keys.SchemeKimchi.
+const (
+	SchemeKimchi = keys.SchemeKimchi
+	SchemeLegacy = keys.SchemeLegacy
+)
+
+// The versions of the message encoding. See keys.MessageEncodingV0.
+const (
+	MessageEncodingV0 = keys.MessageEncodingV0
+	MessageEncodingV1 = keys.MessageEncodingV1
+)
+
+// Transactions.
+type (
+	// Command is a payment or stake delegation to sign.
+	Command = transaction.Command
+	// Payment transfers nanomina between accounts.
+	Payment = transaction.Payment
+	// StakeDelegation delegates the stake of an account.
+	StakeDelegation = transaction.StakeDelegation
+)
+
+// The predefined networks.
+var (
+	NetworkMainnet = signer.NetworkMainnet
+	NetworkDevnet  = signer.NetworkDevnet
+	NetworkTestnet = signer.NetworkTestnet
+)
+
+// NewClient returns a Client for network.
+func NewClient(network Network) *Client { return signer.NewClient(network) }
+
+// NetworkFromName returns the Network for a networkId string such as
+// "mainnet" or "testnet".
+func NetworkFromName(name string) Network { return signer.NetworkFromName(name) }
+
+// NewKeySigner returns a Signer that signs with key for network.
+func NewKeySigner(key PrivateKey, network Network) *KeySigner {
+	return signer.NewKeySigner(key, network)
+}
+
+// NewLockedKeySigner is NewKeySigner with the key moved to locked memory.
+// See signer.NewLockedKeySigner.
+func NewLockedKeySigner(key PrivateKey, network Network) (*KeySigner, error) {
+	return signer.NewLockedKeySigner(key, network)
+}
+
+// NewVerifyCache returns a cache holding up to size verification results.
+func NewVerifyCache(size int) *VerifyCache { return signer.NewVerifyCache(size) }
+
+// SetHook installs h for all Clients and KeySigners without a hook of
+// their own.
+func SetHook(h Hook) { signer.SetHook(h) }
+
+// PublicKeyFromBase58 decodes a "B62" address.
+func PublicKeyFromBase58(address string) (PublicKey, error) {
+	return keys.PublicKeyFromBase58(address)
+}
+
+// PrivateKeyFromBase58 decodes an "EK" private key.
+func PrivateKeyFromBase58(s string) (PrivateKey, error) {
+	return keys.PrivateKeyFromBase58(s)
+}
+
+// EncodeMessage returns the field elements a message is signed as under
+// opts. See keys.EncodeMessage.
+func EncodeMessage(msg string, opts EncodingOptions) ([]*big.Int, error) {
+	return keys.EncodeMessage(msg, opts)
+}
+
+// SignatureFromBase58 decodes a signature in the base58 form of
+// mina-signer.
+func SignatureFromBase58(s string) (*Signature, error) {
+	return signature.FromBase58(s)
+}
+
+// TransactionHash returns the base58 hash a node reports for tx. See
+// transaction.Hash.
+func TransactionHash(tx Command) (string, error) { return transaction.Hash(tx) }
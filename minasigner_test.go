@@ -0,0 +1,49 @@
+package minasigner_test
+
+import (
+	"testing"
+
+	minasigner "github.com/node101-io/mina-signer-go"
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+const (
+	testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+	testPublicKey  = "B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg"
+)
+
+func TestSignVerifyTransaction(t *testing.T) {
+	c := minasigner.NewClient(minasigner.NetworkTestnet)
+	from, err := minasigner.PublicKeyFromBase58(testPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payment := minasigner.Payment{From: from, To: from, Amount: 1, Fee: 10_000_000, Nonce: 1}
+	signed, err := c.SignTransaction(payment, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The aliases are the implementing types, so results mix with code
+	// that imports signer directly.
+	var viaSigner *signer.Client = c
+	if !viaSigner.VerifyTransaction(signed) {
+		t.Error("VerifyTransaction rejected a valid signature")
+	}
+	encoded, err := signed.Signature.ToBase58()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := minasigner.SignatureFromBase58(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.R.BigInt().Cmp(signed.Signature.R.BigInt()) != 0 || sig.S.BigInt().Cmp(signed.Signature.S.BigInt()) != 0 {
+		t.Error("signature did not round-trip through base58")
+	}
+	if _, err := minasigner.TransactionHash(payment); err != nil {
+		t.Error(err)
+	}
+	if _, err := minasigner.PrivateKeyFromBase58(testPrivateKey); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,182 @@
+// Package offline carries transactions to an air-gapped signing machine and
+// back, for keys that never touch a networked host:
+//
+//  1. Online, New builds an unsigned Envelope from a payment or stake
+//     delegation, with the nonce and fee the online node reports.
+//  2. The envelope moves to the offline machine, on removable media or as
+//     a QR code, where Sign signs it with a local key and returns a
+//     Detached signature.
+//  3. The detached signature moves back online, where Merge checks it
+//     against the envelope that was sent and returns the signed envelope,
+//     ready for Signed and a node's sendPayment or sendDelegation.
+//
+// Envelopes and detached signatures are JSON. The transaction fields use
+// the names and encodings of mina-signer, so other tools can produce and
+// read them. A detached signature names the envelope it covers by Digest,
+// so a signature for a modified envelope does not merge.
+package offline
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/canonicaljson"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/remote"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// Version is the format version of envelopes and detached signatures.
+const Version = 1
+
+// Envelope is a transaction for a network, unsigned or signed. Exactly one
+// of Payment and StakeDelegation is set.
+type Envelope struct {
+	Version int `json:"version"`
+	// Network is the networkId the signature is bound to, as accepted by
+	// signer.NetworkFromName.
+	Network         string                  `json:"network"`
+	Payment         *remote.Payment         `json:"payment,omitempty"`
+	StakeDelegation *remote.StakeDelegation `json:"stakeDelegation,omitempty"`
+	// Signature is set once the envelope has been merged with a Detached
+	// signature.
+	Signature *signature.Signature `json:"signature,omitempty"`
+}
+
+// Detached is the output of the offline machine: a signature by PublicKey
+// over the transaction of the envelope with the given Digest.
+type Detached struct {
+	Version   int                  `json:"version"`
+	Digest    string               `json:"digest"`
+	PublicKey string               `json:"publicKey"`
+	Signature *signature.Signature `json:"signature"`
+}
+
+// New returns the unsigned envelope of tx for network. The envelope names
+// the network by its networkId, so network must be the one
+// signer.NetworkFromName returns for its name.
+func New(network signer.Network, tx transaction.Command) (*Envelope, error) {
+	named := signer.NetworkFromName(network.Name)
+	if network.SignaturePrefix != named.SignaturePrefix || !bytes.Equal(network.ID, named.ID) || network.AddressVersion != named.AddressVersion {
+		return nil, errcode.Errorf(errcode.Unsupported, "offline: network %q cannot be named by its networkId", network.Name)
+	}
+	req, err := remote.NewSignTransactionRequest(tx)
+	if err != nil {
+		return nil, fmt.Errorf("offline: %w", err)
+	}
+	return &Envelope{
+		Version:         Version,
+		Network:         network.Name,
+		Payment:         req.Payment,
+		StakeDelegation: req.StakeDelegation,
+	}, nil
+}
+
+// Command decodes the transaction of e.
+func (e *Envelope) Command() (transaction.Command, error) {
+	if e.Version != Version {
+		return nil, errcode.Errorf(errcode.InvalidVersion, "offline: envelope version %d, want %d", e.Version, Version)
+	}
+	tx, err := (&remote.SignTransactionRequest{Payment: e.Payment, StakeDelegation: e.StakeDelegation}).Command()
+	if err != nil {
+		return nil, fmt.Errorf("offline: %w", err)
+	}
+	return tx, nil
+}
+
+// Digest returns the hex SHA-256 digest of the canonical JSON (RFC 8785)
+// of e without its signature, which identifies the transaction and network
+// a detached signature is for.
+func (e *Envelope) Digest() (string, error) {
+	unsigned := *e
+	unsigned.Signature = nil
+	data, err := json.Marshal(unsigned)
+	if err != nil {
+		return "", errcode.Errorf(errcode.InvalidArgument, "offline: %w", err)
+	}
+	canonical, err := canonicaljson.Canonicalize(data)
+	if err != nil {
+		return "", fmt.Errorf("offline: %w", err)
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Sign signs the transaction of e with key, which must belong to its fee
+// payer. It runs on the offline machine.
+func Sign(e *Envelope, key keys.PrivateKey) (*Detached, error) {
+	if e.Signature != nil {
+		return nil, errcode.New(errcode.InvalidState, "offline: envelope is already signed")
+	}
+	tx, err := e.Command()
+	if err != nil {
+		return nil, err
+	}
+	digest, err := e.Digest()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.NewKeySigner(key, signer.NetworkFromName(e.Network)).SignTransaction(context.Background(), tx)
+	if err != nil {
+		return nil, fmt.Errorf("offline: %w", err)
+	}
+	pub, err := key.ToPublicKey().ToBase58()
+	if err != nil {
+		return nil, fmt.Errorf("offline: %w", err)
+	}
+	return &Detached{Version: Version, Digest: digest, PublicKey: pub, Signature: sig}, nil
+}
+
+// Merge returns e signed with d, after checking that d is for e and that
+// its signature verifies. e is not modified.
+func Merge(e *Envelope, d *Detached) (*Envelope, error) {
+	if d.Version != Version {
+		return nil, errcode.Errorf(errcode.InvalidVersion, "offline: detached signature version %d, want %d", d.Version, Version)
+	}
+	digest, err := e.Digest()
+	if err != nil {
+		return nil, err
+	}
+	if d.Digest != digest {
+		return nil, errcode.New(errcode.InvalidSignature, "offline: detached signature is for another envelope")
+	}
+	signed := *e
+	signed.Signature = d.Signature
+	tx, err := signed.Signed()
+	if err != nil {
+		return nil, err
+	}
+	if tx.PublicKey != d.PublicKey {
+		return nil, errcode.New(errcode.KeyMismatch, "offline: detached signature is not by the fee payer")
+	}
+	return &signed, nil
+}
+
+// Signed returns the transaction of a signed envelope with its signature,
+// as signer.Client.SignTransaction would, after checking the signature.
+func (e *Envelope) Signed() (*signer.Signed[transaction.Command], error) {
+	if e.Signature == nil {
+		return nil, errcode.New(errcode.MissingValue, "offline: envelope is not signed")
+	}
+	tx, err := e.Command()
+	if err != nil {
+		return nil, err
+	}
+	network := signer.NetworkFromName(e.Network)
+	pub, err := tx.FeePayer().ToBase58()
+	if err != nil {
+		return nil, fmt.Errorf("offline: %w", err)
+	}
+	signed := &signer.Signed[transaction.Command]{Signature: e.Signature, PublicKey: pub, Data: tx}
+	if !signer.NewClient(network).VerifyTransaction(signed) {
+		return nil, errcode.New(errcode.InvalidSignature, "offline: signature does not verify for the fee payer")
+	}
+	return signed, nil
+}
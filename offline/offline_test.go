@@ -0,0 +1,105 @@
+package offline_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/offline"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+const testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+
+func testEnvelope(t *testing.T) (*offline.Envelope, keys.PrivateKey) {
+	t.Helper()
+	sk, err := keys.PrivateKeyFromBase58(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := sk.ToPublicKey()
+	tx := transaction.Payment{From: pk, To: pk, Amount: 1_500_000_000, Fee: 10_000_000, Nonce: 7, Memo: "offline"}
+	e, err := offline.New(signer.NetworkFromName("testnet"), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e, sk
+}
+
+// roundTrip carries v through JSON, as the files between machines do.
+func roundTrip[T any](t *testing.T, v *T) *T {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := new(T)
+	if err := json.Unmarshal(data, out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestSignMerge(t *testing.T) {
+	e, sk := testEnvelope(t)
+	if _, err := e.Signed(); errcode.CodeOf(err) != errcode.MissingValue {
+		t.Fatalf("Signed() on an unsigned envelope: %v, want MissingValue", err)
+	}
+
+	d, err := offline.Sign(roundTrip(t, e), sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := offline.Merge(e, roundTrip(t, d))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Signature != nil {
+		t.Error("Merge modified its envelope")
+	}
+	tx, err := roundTrip(t, signed).Signed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.PublicKey != d.PublicKey {
+		t.Errorf("signed by %s, want %s", tx.PublicKey, d.PublicKey)
+	}
+	if _, err := offline.Sign(signed, sk); errcode.CodeOf(err) != errcode.InvalidState {
+		t.Errorf("Sign() on a signed envelope: %v, want InvalidState", err)
+	}
+}
+
+func TestMergeRejects(t *testing.T) {
+	e, sk := testEnvelope(t)
+	d, err := offline.Sign(e, sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := *e
+	payment := *e.Payment
+	payment.Nonce++
+	tampered.Payment = &payment
+	if _, err := offline.Merge(&tampered, d); errcode.CodeOf(err) != errcode.InvalidSignature {
+		t.Errorf("Merge() with a modified envelope: %v, want InvalidSignature", err)
+	}
+
+	other := *e
+	other.Network = "mainnet"
+	if _, err := offline.Merge(&other, d); errcode.CodeOf(err) != errcode.InvalidSignature {
+		t.Errorf("Merge() on another network: %v, want InvalidSignature", err)
+	}
+
+	wrongKey := keys.NewPrivateKeyFromBytes([32]byte{1})
+	if _, err := offline.Sign(e, wrongKey); errcode.CodeOf(err) != errcode.KeyMismatch {
+		t.Errorf("Sign() with another key: %v, want KeyMismatch", err)
+	}
+
+	old := *d
+	old.Version = 0
+	if _, err := offline.Merge(e, &old); errcode.CodeOf(err) != errcode.InvalidVersion {
+		t.Errorf("Merge() with version 0: %v, want InvalidVersion", err)
+	}
+}
@@ -0,0 +1,157 @@
+// Package paymenturi builds and parses mina: payment request URIs, the
+// payloads wallets put in QR codes:
+//
+//	mina:B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg?amount=1.5&memo=invoice%2042
+//
+// The format follows BIP 21. The path is the recipient address. The
+// optional amount is in MINA as a decimal with at most nine fractional
+// digits, and the optional memo is percent-encoded. Other parameters are
+// ignored, except that a parameter whose name starts with "req-" is a
+// requirement this package does not understand and makes Parse fail. The
+// scheme is matched case-insensitively, so that QR codes can use the
+// upper-case alphanumeric mode.
+package paymenturi
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// Scheme is the URI scheme of payment requests.
+const Scheme = "mina"
+
+// nanominaDigits is the number of fractional digits of an amount in MINA.
+const nanominaDigits = 9
+
+// Request is a payment request.
+type Request struct {
+	// Address is the recipient.
+	Address keys.PublicKey
+	// Amount is the requested amount in nanomina, or 0 to let the payer
+	// choose.
+	Amount uint64
+	// Memo is the memo the payment should carry, at most
+	// transaction.MaxMemoLength bytes.
+	Memo string
+}
+
+// URI returns the mina: URI of r. It fails for an unset address or a memo
+// that does not fit a transaction.
+func (r Request) URI() (string, error) {
+	address, err := r.Address.ToBase58()
+	if err != nil {
+		return "", fmt.Errorf("paymenturi: address: %w", err)
+	}
+	if _, err := transaction.EncodeMemo(r.Memo); err != nil {
+		return "", fmt.Errorf("paymenturi: %w", err)
+	}
+	var query []string
+	if r.Amount != 0 {
+		query = append(query, "amount="+FormatAmount(r.Amount))
+	}
+	if r.Memo != "" {
+		// QueryEscape writes spaces as "+", which some wallets read
+		// literally; "%20" means a space to every decoder.
+		query = append(query, "memo="+strings.ReplaceAll(url.QueryEscape(r.Memo), "+", "%20"))
+	}
+	uri := Scheme + ":" + address
+	if len(query) > 0 {
+		uri += "?" + strings.Join(query, "&")
+	}
+	return uri, nil
+}
+
+// Parse decodes a mina: URI. It checks the address with the base58check
+// codec and that it is a point of Pallas, and that the amount and memo fit
+// a payment.
+func Parse(uri string) (Request, error) {
+	rest, ok := cutPrefixFold(uri, Scheme+":")
+	if !ok {
+		return Request{}, errcode.Errorf(errcode.InvalidEncoding, "paymenturi: %q is not a %s: URI", uri, Scheme)
+	}
+	address, rawQuery, _ := strings.Cut(rest, "?")
+	var r Request
+	var err error
+	if r.Address, err = keys.PublicKeyFromBase58(address); err != nil {
+		return Request{}, fmt.Errorf("paymenturi: address: %w", err)
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return Request{}, errcode.Errorf(errcode.InvalidEncoding, "paymenturi: %w", err)
+	}
+	for name, values := range query {
+		if len(values) > 1 {
+			return Request{}, errcode.Errorf(errcode.InvalidEncoding, "paymenturi: parameter %q repeated", name)
+		}
+		switch {
+		case name == "amount":
+			if r.Amount, err = ParseAmount(values[0]); err != nil {
+				return Request{}, err
+			}
+		case name == "memo":
+			r.Memo = values[0]
+			if _, err := transaction.EncodeMemo(r.Memo); err != nil {
+				return Request{}, fmt.Errorf("paymenturi: %w", err)
+			}
+		case strings.HasPrefix(name, "req-"):
+			return Request{}, errcode.Errorf(errcode.Unsupported, "paymenturi: required parameter %q is not supported", name)
+		}
+	}
+	return r, nil
+}
+
+// FormatAmount writes nanomina as a decimal amount of MINA without
+// trailing zeros, such as "1.5" for 1500000000.
+func FormatAmount(nanomina uint64) string {
+	const unit = 1_000_000_000
+	s := strconv.FormatUint(nanomina/unit, 10)
+	if frac := nanomina % unit; frac != 0 {
+		digits := strconv.FormatUint(frac+unit, 10)[1:]
+		s += "." + strings.TrimRight(digits, "0")
+	}
+	return s
+}
+
+// ParseAmount reads a decimal amount of MINA, such as "1.5", as nanomina.
+// It rejects signs, exponents, more than nine fractional digits and
+// amounts that overflow a uint64.
+func ParseAmount(s string) (uint64, error) {
+	whole, frac, hasPoint := strings.Cut(s, ".")
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) || hasPoint && frac == "" {
+		return 0, errcode.Errorf(errcode.InvalidEncoding, "paymenturi: invalid amount %q", s)
+	}
+	if len(frac) > nanominaDigits {
+		return 0, errcode.Errorf(errcode.OutOfRange, "paymenturi: amount %q has more than %d fractional digits", s, nanominaDigits)
+	}
+	frac += strings.Repeat("0", nanominaDigits-len(frac))
+	n, err := strconv.ParseUint(whole+frac, 10, 64)
+	if err != nil {
+		return 0, errcode.Errorf(errcode.OutOfRange, "paymenturi: amount %q overflows", s)
+	}
+	return n, nil
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// cutPrefixFold is strings.CutPrefix with the prefix matched
+// case-insensitively.
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
@@ -0,0 +1,95 @@
+package paymenturi_test
+
+import (
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/paymenturi"
+)
+
+const testPublicKey = "B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg"
+
+func TestRoundTrip(t *testing.T) {
+	pk, err := keys.PublicKeyFromBase58(testPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		req  paymenturi.Request
+		want string
+	}{
+		{paymenturi.Request{Address: pk}, "mina:" + testPublicKey},
+		{paymenturi.Request{Address: pk, Amount: 1_500_000_000}, "mina:" + testPublicKey + "?amount=1.5"},
+		{paymenturi.Request{Address: pk, Amount: 1, Memo: "invoice 42 & more+"}, "mina:" + testPublicKey + "?amount=0.000000001&memo=invoice%2042%20%26%20more%2B"},
+	}
+	for _, tt := range tests {
+		uri, err := tt.req.URI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if uri != tt.want {
+			t.Errorf("URI() = %q, want %q", uri, tt.want)
+		}
+		got, err := paymenturi.Parse(uri)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", uri, err)
+		}
+		if !got.Address.Equal(pk) || got.Amount != tt.req.Amount || got.Memo != tt.req.Memo {
+			t.Errorf("Parse(%q) = %+v", uri, got)
+		}
+	}
+
+	got, err := paymenturi.Parse("MINA:" + testPublicKey + "?memo=a+b&label=shop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Memo != "a b" {
+		t.Errorf("memo = %q, want %q", got.Memo, "a b")
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	badChecksum := testPublicKey[:len(testPublicKey)-1] + "h"
+	tests := []struct {
+		uri  string
+		want errcode.Code
+	}{
+		{"bitcoin:" + testPublicKey, errcode.InvalidEncoding},
+		{"mina:" + badChecksum, errcode.InvalidChecksum},
+		{"mina:" + testPublicKey + "?amount=-1", errcode.InvalidEncoding},
+		{"mina:" + testPublicKey + "?amount=1e9", errcode.InvalidEncoding},
+		{"mina:" + testPublicKey + "?amount=1.", errcode.InvalidEncoding},
+		{"mina:" + testPublicKey + "?amount=0.0000000001", errcode.OutOfRange},
+		{"mina:" + testPublicKey + "?amount=18446744073.709551616", errcode.OutOfRange},
+		{"mina:" + testPublicKey + "?amount=1&amount=2", errcode.InvalidEncoding},
+		{"mina:" + testPublicKey + "?memo=0123456789012345678901234567890123", errcode.InvalidLength},
+		{"mina:" + testPublicKey + "?req-expiry=10", errcode.Unsupported},
+	}
+	for _, tt := range tests {
+		_, err := paymenturi.Parse(tt.uri)
+		if got := errcode.CodeOf(err); err == nil || got != tt.want {
+			t.Errorf("Parse(%q) = %v (%q), want code %q", tt.uri, err, got, tt.want)
+		}
+	}
+}
+
+func TestAmount(t *testing.T) {
+	for _, tt := range []struct {
+		nanomina uint64
+		text     string
+	}{
+		{0, "0"},
+		{1, "0.000000001"},
+		{1_000_000_000, "1"},
+		{1_230_000_000, "1.23"},
+		{18446744073709551615, "18446744073.709551615"},
+	} {
+		if got := paymenturi.FormatAmount(tt.nanomina); got != tt.text {
+			t.Errorf("FormatAmount(%d) = %q, want %q", tt.nanomina, got, tt.text)
+		}
+		if got, err := paymenturi.ParseAmount(tt.text); err != nil || got != tt.nanomina {
+			t.Errorf("ParseAmount(%q) = %d, %v", tt.text, got, err)
+		}
+	}
+}
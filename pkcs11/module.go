@@ -0,0 +1,109 @@
+//go:build pkcs11
+
+package pkcs11
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// Module is a Token backed by a PKCS#11 library and a logged-in session on
+// one of its slots.
+type Module struct {
+	mu      sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+}
+
+// Open loads the PKCS#11 library at path, opens a read-write session on
+// slot and logs in as the user with pin.
+func Open(path string, slot uint, pin string) (*Module, error) {
+	ctx := pkcs11.New(path)
+	if ctx == nil {
+		return nil, errcode.Errorf(errcode.Upstream, "pkcs11: cannot load %s", path)
+	}
+	if err := ctx.Initialize(); err != nil {
+		ctx.Destroy()
+		return nil, errcode.Errorf(errcode.Upstream, "pkcs11: initialize: %w", err)
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, errcode.Errorf(errcode.Upstream, "pkcs11: open session: %w", err)
+	}
+	if err := ctx.Login(session, pkcs11.CKU_USER, pin); err != nil {
+		ctx.CloseSession(session)
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, errcode.Errorf(errcode.Upstream, "pkcs11: login: %w", err)
+	}
+	return &Module{ctx: ctx, session: session}, nil
+}
+
+// Close logs out, closes the session and unloads the library.
+func (m *Module) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := errors.Join(m.ctx.Logout(m.session), m.ctx.CloseSession(m.session), m.ctx.Finalize())
+	m.ctx.Destroy()
+	return err
+}
+
+// StoreSecret creates a token object for value: a private generic secret
+// key that can be read back by a logged-in user, since no HSM mechanism can
+// sign with it in place.
+func (m *Module) StoreSecret(label string, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err := m.ctx.CreateObject(m.session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_GENERIC_SECRET),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_MODIFIABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, value),
+	})
+	if err != nil {
+		return errcode.Errorf(errcode.Upstream, "pkcs11: store %q: %w", label, err)
+	}
+	return nil
+}
+
+// LoadSecret reads the value of the secret key labelled label.
+func (m *Module) LoadSecret(label string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := m.ctx.FindObjectsInit(m.session, template); err != nil {
+		return nil, errcode.Errorf(errcode.Upstream, "pkcs11: find %q: %w", label, err)
+	}
+	objects, _, err := m.ctx.FindObjects(m.session, 1)
+	if ferr := m.ctx.FindObjectsFinal(m.session); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		return nil, errcode.Errorf(errcode.Upstream, "pkcs11: find %q: %w", label, err)
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrNotFound, label)
+	}
+	attrs, err := m.ctx.GetAttributeValue(m.session, objects[0], []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return nil, errcode.Errorf(errcode.Upstream, "pkcs11: read %q: %w", label, err)
+	}
+	return attrs[0].Value, nil
+}
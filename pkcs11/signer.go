@@ -0,0 +1,115 @@
+// Package pkcs11 keeps Mina private keys on a PKCS#11 token.
+//
+// HSMs have no mechanism for Pallas signatures, so the token stores the raw
+// 32-byte scalar as a private secret-key object and the key is read into
+// memory only for the duration of a signature. The scalar never touches
+// disk, and reading it requires a logged-in session.
+//
+// Signer works with any Token. The Module implementation that talks to a
+// PKCS#11 library through github.com/miekg/pkcs11 needs cgo and is built
+// only with the pkcs11 build tag:
+//
+//	go build -tags pkcs11
+package pkcs11
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// ErrNotFound is returned by a Token that holds no secret with the
+// requested label.
+var ErrNotFound = errcode.New(errcode.NotFound, "pkcs11: secret not found")
+
+// Token stores secrets by label.
+type Token interface {
+	// StoreSecret stores value as a private secret object labelled label.
+	StoreSecret(label string, value []byte) error
+	// LoadSecret returns the value of the secret labelled label, or an
+	// error wrapping ErrNotFound.
+	LoadSecret(label string) ([]byte, error)
+}
+
+// GenerateKey stores a fresh random private key on token under label and
+// returns its public key.
+func GenerateKey(token Token, label string) (keys.PublicKey, error) {
+	var k *scalar.Scalar
+	for k == nil || k.BigInt().Sign() == 0 {
+		var err error
+		if k, err = scalar.RandomScalar(); err != nil {
+			return keys.PublicKey{}, fmt.Errorf("pkcs11: generating key: %w", err)
+		}
+	}
+	value := k.BytesBE()
+	defer clear(value)
+	if err := token.StoreSecret(label, value); err != nil {
+		return keys.PublicKey{}, err
+	}
+	return keys.PrivateKey{Value: k}.ToPublicKey(), nil
+}
+
+// Signer is a signer.Signer for a key stored on a Token.
+type Signer struct {
+	token   Token
+	label   string
+	network signer.Network
+	public  keys.PublicKey
+}
+
+// NewSigner returns a Signer for the key labelled label, signing for
+// network. It reads the key once to derive the public key.
+func NewSigner(token Token, label string, network signer.Network) (*Signer, error) {
+	s := &Signer{token: token, label: label, network: network}
+	sk, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	s.public = sk.ToPublicKey()
+	return s, nil
+}
+
+// load reads the private key from the token.
+func (s *Signer) load() (keys.PrivateKey, error) {
+	value, err := s.token.LoadSecret(s.label)
+	if err != nil {
+		return keys.PrivateKey{}, err
+	}
+	defer clear(value)
+	var sk keys.PrivateKey
+	if err := sk.UnmarshalBytes(value); err != nil {
+		return keys.PrivateKey{}, fmt.Errorf("pkcs11: secret %q: %w", s.label, err)
+	}
+	return sk, nil
+}
+
+// PublicKey returns the public key of the stored key.
+func (s *Signer) PublicKey(context.Context) (keys.PublicKey, error) {
+	return s.public, nil
+}
+
+// SignFields signs fields with the stored key.
+func (s *Signer) SignFields(ctx context.Context, fields []*big.Int) (*signature.Signature, error) {
+	sk, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewKeySigner(sk, s.network).SignFields(ctx, fields)
+}
+
+// SignTransaction signs tx with the stored key, which must belong to the
+// fee payer.
+func (s *Signer) SignTransaction(ctx context.Context, tx transaction.Command) (*signature.Signature, error) {
+	sk, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewKeySigner(sk, s.network).SignTransaction(ctx, tx)
+}
@@ -0,0 +1,61 @@
+package pkcs11_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/pkcs11"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+// memoryToken is a Token that keeps secrets in a map.
+type memoryToken map[string][]byte
+
+func (m memoryToken) StoreSecret(label string, value []byte) error {
+	m[label] = append([]byte(nil), value...)
+	return nil
+}
+
+func (m memoryToken) LoadSecret(label string) ([]byte, error) {
+	v, ok := m[label]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", pkcs11.ErrNotFound, label)
+	}
+	return append([]byte(nil), v...), nil
+}
+
+func TestSigner(t *testing.T) {
+	token := memoryToken{}
+	pk, err := pkcs11.GenerateKey(token, "mina")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := pkcs11.NewSigner(token, "mina", signer.NetworkDevnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := s.PublicKey(context.Background())
+	if !got.Equal(pk) {
+		t.Error("PublicKey does not match the generated key")
+	}
+	fields := []*big.Int{big.NewInt(8)}
+	sig, err := s.SignFields(context.Background(), fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pk.Verify(sig, poseidonbigint.HashInput{Fields: fields}, "devnet") {
+		t.Error("SignFields signature does not verify")
+	}
+
+	if _, err := pkcs11.NewSigner(token, "other", signer.NetworkDevnet); !errors.Is(err, pkcs11.ErrNotFound) {
+		t.Errorf("NewSigner for a missing label: err = %v, want ErrNotFound", err)
+	}
+	token["bad"] = make([]byte, 31)
+	if _, err := pkcs11.NewSigner(token, "bad", signer.NetworkDevnet); err == nil {
+		t.Error("NewSigner accepted a malformed secret")
+	}
+}
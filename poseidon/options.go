@@ -0,0 +1,19 @@
+package poseidon
+
+// Option configures a Poseidon built by CreatePoseidon.
+type Option func(*config)
+
+// config holds the settings options can change, initialized from the
+// PoseidonParams.
+type config struct {
+	rate int
+}
+
+// WithRate overrides the rate of the parameters: the number of field
+// elements absorbed per permutation. It must be positive and less than the
+// state size, which leaves at least one element of capacity. A different
+// rate gives a different hash function; Mina's hashes use the rate of their
+// parameters.
+func WithRate(rate int) Option {
+	return func(c *config) { c.rate = rate }
+}
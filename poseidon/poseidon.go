@@ -1,8 +1,8 @@
 package poseidon
 
 import (
-	"errors"
 	"github.com/node101-io/mina-signer-go/constants"
+	"github.com/node101-io/mina-signer-go/curve"
 	"github.com/node101-io/mina-signer-go/field"
 	"math/big"
 )
@@ -26,8 +26,14 @@ func strMatrixToBigInt(m [][]string) [][]*big.Int {
 	return out
 }
 
+// fieldToGroup maps x to a Pallas point with the group map of o1js and
+// Kimchi.
 func fieldToGroup(x *big.Int) (*ECPoint, error) {
-	return nil, errors.New("fieldToGroup: not implemented")
+	g, err := curve.Pallas().GroupMap(x)
+	if err != nil {
+		return nil, err
+	}
+	return &ECPoint{X: g.X, Y: g.Y}, nil
 }
 
 type ECPoint struct {
@@ -50,30 +56,45 @@ func makeHashToGroup(hash func([]*big.Int) *big.Int) func([]*big.Int) *ECPoint {
 	}
 }
 
+// Poseidon is a hash function built by CreatePoseidon. Its functions keep
+// no state between calls and never modify their arguments, so one Poseidon
+// is safe for concurrent use. Sponges created from it are not.
 type Poseidon struct {
 	InitialState func() []*big.Int
 	Update       func(state []*big.Int, input []*big.Int) []*big.Int
 	Hash         func(input []*big.Int) *big.Int
 	HashToGroup  func(input []*big.Int) *ECPoint
+
+	fp   field.FiniteField
+	rate int
 }
 
-func dot(Fp field.FiniteField, v1, v2 []*big.Int) *big.Int {
+// dot sets dst to the inner product of v1 and v2, using tmp as scratch, and
+// returns dst.
+func dot(Fp field.FiniteField, dst, tmp *big.Int, v1, v2 []*big.Int) *big.Int {
 	if len(v1) != len(v2) {
 		panic("dot: mismatched lengths")
 	}
-	acc := new(big.Int)
+	dst.SetInt64(0)
 	for i := range v1 {
-		acc = Fp.Add(acc, Fp.Mul(v1[i], v2[i]))
+		Fp.AddInto(dst, dst, Fp.MulInto(tmp, v1[i], v2[i]))
 	}
-	return acc
+	return dst
 }
 
-func CreatePoseidon(Fp field.FiniteField, params constants.PoseidonParams) *Poseidon {
+// CreatePoseidon builds the Poseidon hash of params over Fp, configured by
+// opts. It panics on invalid parameters.
+func CreatePoseidon(Fp field.FiniteField, params constants.PoseidonParams, opts ...Option) *Poseidon {
+	cfg := config{rate: params.Rate}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	fullRounds := params.FullRounds
 	partialRounds := params.PartialRounds
 	hasInitialRoundConstant := params.HasInitialRoundConstant
 	stateSize := params.StateSize
-	rate := params.Rate
+	rate := cfg.rate
 	power := params.Power
 	roundConstants := strMatrixToBigInt(params.RoundConstants)
 	mds := strMatrixToBigInt(params.MDS)
@@ -82,6 +103,9 @@ func CreatePoseidon(Fp field.FiniteField, params constants.PoseidonParams) *Pose
 		panic("partialRounds not supported")
 	}
 	assertPositiveInteger(rate, "rate")
+	if rate >= stateSize {
+		panic("rate must be less than the state size")
+	}
 	assertPositiveInteger(fullRounds, "fullRounds")
 	assertPositiveInteger(power, "power")
 
@@ -95,46 +119,53 @@ func CreatePoseidon(Fp field.FiniteField, params constants.PoseidonParams) *Pose
 		return state
 	}
 
-	permutation := func(state []*big.Int) {
+	// permutation updates state in place. scratch holds stateSize+1 integers
+	// owned by the caller: the next state and a product temporary.
+	permutation := func(state, scratch []*big.Int) {
 		offset := 0
 		if hasInitialRoundConstant {
 			for i := 0; i < stateSize; i++ {
-				state[i] = Fp.Add(state[i], roundConstants[0][i])
+				Fp.AddInto(state[i], state[i], roundConstants[0][i])
 			}
 			offset = 1
 		}
+		next, tmp := scratch[:stateSize], scratch[stateSize]
 		for round := 0; round < fullRounds; round++ {
 			for i := 0; i < stateSize; i++ {
-				state[i] = Fp.Power(state[i], powerBig)
+				Fp.PowerInto(state[i], state[i], powerBig)
 			}
-			oldState := make([]*big.Int, len(state))
-			copy(oldState, state)
 			for i := 0; i < stateSize; i++ {
-				state[i] = dot(Fp, mds[i], oldState)
-				state[i] = Fp.Add(state[i], roundConstants[round+offset][i])
+				dot(Fp, next[i], tmp, mds[i], state)
+				Fp.AddInto(next[i], next[i], roundConstants[round+offset][i])
+			}
+			for i := 0; i < stateSize; i++ {
+				state[i], next[i] = next[i], state[i]
 			}
 		}
 	}
 
 	update := func(state []*big.Int, input []*big.Int) []*big.Int {
+		// The permutation works in place, so the caller's integers are copied
+		// rather than shared.
 		newState := make([]*big.Int, len(state))
-		copy(newState, state)
+		for i := range state {
+			newState[i] = new(big.Int).Set(state[i])
+		}
+		scratch := make([]*big.Int, stateSize+1)
+		for i := range scratch {
+			scratch[i] = new(big.Int)
+		}
 
 		if len(input) == 0 {
-			permutation(newState)
+			permutation(newState, scratch)
 			return newState
 		}
 		n := ((len(input) + rate - 1) / rate) * rate
-		paddedInput := make([]*big.Int, n)
-		copy(paddedInput, input)
-		for i := len(input); i < n; i++ {
-			paddedInput[i] = big.NewInt(0)
-		}
 		for blockIdx := 0; blockIdx < n; blockIdx += rate {
-			for i := 0; i < rate; i++ {
-				newState[i] = Fp.Add(newState[i], paddedInput[blockIdx+i])
+			for i := 0; i < rate && blockIdx+i < len(input); i++ {
+				Fp.AddInto(newState[i], newState[i], input[blockIdx+i])
 			}
-			permutation(newState)
+			permutation(newState, scratch)
 		}
 		return newState
 	}
@@ -148,6 +179,8 @@ func CreatePoseidon(Fp field.FiniteField, params constants.PoseidonParams) *Pose
 		InitialState: initialState,
 		Update:       update,
 		Hash:         hash,
+		fp:           Fp,
+		rate:         rate,
 	}
 	ps.HashToGroup = makeHashToGroup(hash)
 	return ps
@@ -1,6 +1,7 @@
 package poseidon
 
 import (
+	"fmt"
 	"github.com/node101-io/mina-signer-go/constants"
 	"github.com/node101-io/mina-signer-go/field"
 	"math/big"
@@ -29,3 +30,125 @@ func TestPoseidonHash(t *testing.T) {
 		t.Errorf("Poseidon hash failed for input2: got %s, expected %s", hashResult2.String(), expected2.String())
 	}
 }
+
+func TestPoseidonUpdateLeavesInputsUntouched(t *testing.T) {
+	poseidon := CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp)
+	state := poseidon.Update(poseidon.InitialState(), []*big.Int{big.NewInt(5)})
+	before := make([]*big.Int, len(state))
+	for i := range state {
+		before[i] = new(big.Int).Set(state[i])
+	}
+	input := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+
+	first := poseidon.Update(state, input)
+	second := poseidon.Update(state, input)
+	for i := range state {
+		if state[i].Cmp(before[i]) != 0 {
+			t.Fatalf("Update modified state[%d]", i)
+		}
+		if first[i].Cmp(second[i]) != 0 {
+			t.Fatalf("Update is not deterministic at %d: %s != %s", i, first[i], second[i])
+		}
+	}
+	for i, want := range []int64{1, 2, 3} {
+		if input[i].Int64() != want {
+			t.Fatalf("Update modified input[%d]", i)
+		}
+	}
+}
+
+func BenchmarkPoseidonHash(b *testing.B) {
+	poseidon := CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp)
+	input := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		poseidon.Hash(input)
+	}
+}
+
+func BenchmarkPoseidonHashLength(b *testing.B) {
+	for _, bm := range []struct {
+		name   string
+		params constants.PoseidonParams
+	}{
+		{"Kimchi", constants.PoseidonParamsKimchiFp},
+		{"Legacy", constants.PoseidonParamsLegacyFp},
+	} {
+		poseidon := CreatePoseidon(*field.Fp, bm.params)
+		for _, n := range []int{1, 8, 64} {
+			input := make([]*big.Int, n)
+			for i := range input {
+				input[i] = big.NewInt(int64(i + 1))
+			}
+			b.Run(fmt.Sprintf("%s/%d", bm.name, n), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					poseidon.Hash(input)
+				}
+			})
+		}
+	}
+}
+
+func TestSpongeMatchesHash(t *testing.T) {
+	poseidon := CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp)
+	for n := 1; n <= 5; n++ {
+		input := make([]*big.Int, n)
+		sponge := poseidon.NewSponge()
+		for i := range input {
+			input[i] = big.NewInt(int64(i + 7))
+			sponge.Absorb(input[i])
+		}
+		if got, want := sponge.Squeeze(), poseidon.Hash(input); got.Cmp(want) != 0 {
+			t.Errorf("%d inputs: squeezed %s, hash %s", n, got, want)
+		}
+	}
+}
+
+func TestSpongeSqueezeAbsorb(t *testing.T) {
+	poseidon := CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp)
+	sponge := poseidon.NewSponge()
+	sponge.Absorb(big.NewInt(1))
+	first := sponge.Squeeze()
+	second := sponge.Squeeze()
+
+	// The first two outputs come from one permutation; absorbing after a
+	// squeeze adds to that state without a permutation in between.
+	state := poseidon.Update(poseidon.InitialState(), []*big.Int{big.NewInt(1)})
+	if first.Cmp(state[0]) != 0 || second.Cmp(state[1]) != 0 {
+		t.Fatal("squeezes do not read the permuted state")
+	}
+	sponge.Absorb(big.NewInt(2))
+	state[0] = field.Fp.Add(state[0], big.NewInt(2))
+	state = poseidon.Update(state, nil)
+	if got := sponge.Squeeze(); got.Cmp(state[0]) != 0 {
+		t.Errorf("squeeze after absorb = %s, want %s", got, state[0])
+	}
+}
+
+func TestWithRate(t *testing.T) {
+	p2 := CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp)
+	p1 := CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp, WithRate(1))
+	a, b := big.NewInt(11), big.NewInt(12)
+	if got, want := p1.Hash([]*big.Int{a}), p2.Hash([]*big.Int{a}); got.Cmp(want) != 0 {
+		t.Errorf("one element: rate 1 hash %s, rate 2 hash %s", got, want)
+	}
+	// At rate 1 each element gets its own permutation.
+	want := p2.Update(p2.Update(p2.InitialState(), []*big.Int{a}), []*big.Int{b})[0]
+	if got := p1.Hash([]*big.Int{a, b}); got.Cmp(want) != 0 {
+		t.Errorf("two elements: rate 1 hash %s, want %s", got, want)
+	}
+	sponge := p1.NewSponge()
+	sponge.Absorb(a)
+	sponge.Absorb(b)
+	if got := sponge.Squeeze(); got.Cmp(want) != 0 {
+		t.Errorf("rate 1 sponge squeezed %s, want %s", got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("CreatePoseidon accepted a rate equal to the state size")
+		}
+	}()
+	CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp, WithRate(3))
+}
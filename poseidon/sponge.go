@@ -0,0 +1,51 @@
+package poseidon
+
+import "math/big"
+
+// Sponge is the duplex sponge of o1js's Poseidon.Sponge and snarky: field
+// elements are absorbed rate at a time between permutations and squeezed
+// out of the state in the same way. Absorbing after a squeeze adds to the
+// squeezed state without permuting it first.
+//
+// A Sponge is not safe for concurrent use.
+type Sponge struct {
+	p        *Poseidon
+	state    []*big.Int
+	squeezed bool
+	// n is the number of elements absorbed into or squeezed from the
+	// current block.
+	n int
+}
+
+// NewSponge returns a sponge with the initial state of p.
+func (p *Poseidon) NewSponge() *Sponge {
+	return &Sponge{p: p, state: p.InitialState()}
+}
+
+func (s *Sponge) permute() {
+	s.state = s.p.Update(s.state, nil)
+}
+
+// Absorb adds x to the sponge.
+func (s *Sponge) Absorb(x *big.Int) {
+	switch {
+	case s.squeezed:
+		s.squeezed, s.n = false, 0
+	case s.n == s.p.rate:
+		s.permute()
+		s.n = 0
+	}
+	s.state[s.n] = s.p.fp.Add(s.state[s.n], x)
+	s.n++
+}
+
+// Squeeze returns the next output of the sponge.
+func (s *Sponge) Squeeze() *big.Int {
+	if !s.squeezed || s.n == s.p.rate {
+		s.permute()
+		s.squeezed, s.n = true, 0
+	}
+	out := new(big.Int).Set(s.state[s.n])
+	s.n++
+	return out
+}
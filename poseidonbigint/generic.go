@@ -0,0 +1,88 @@
+package poseidonbigint
+
+import (
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// GenericPackedField is PackedField over the limb-based field elements: a
+// value that takes Size bits when packed.
+type GenericPackedField[F field.FieldParams] struct {
+	Field field.Element[F]
+	Size  int
+}
+
+// GenericHashInput is HashInput over field.Element[F], so inputs in Fp or
+// Fq are built and packed without converting through big.Int. It is the
+// GenericHashInput<Field> of o1js; HashInput is its big.Int instance.
+type GenericHashInput[F field.FieldParams] struct {
+	Fields []field.Element[F]
+	Packed []GenericPackedField[F]
+}
+
+// Append returns the concatenation of in and other. Neither is modified.
+func (in GenericHashInput[F]) Append(other GenericHashInput[F]) GenericHashInput[F] {
+	fields := append([]field.Element[F]{}, in.Fields...)
+	fields = append(fields, other.Fields...)
+
+	packed := append([]GenericPackedField[F]{}, in.Packed...)
+	packed = append(packed, other.Packed...)
+
+	return GenericHashInput[F]{Fields: fields, Packed: packed}
+}
+
+// PackToElements is PackToFields for a GenericHashInput: the fields followed
+// by the packed values, as many per element as fit in 254 bits.
+func PackToElements[F field.FieldParams](in GenericHashInput[F]) []field.Element[F] {
+	fields := append([]field.Element[F]{}, in.Fields...)
+
+	if len(in.Packed) == 0 {
+		return fields
+	}
+	var current, shift field.Element[F]
+	currentSize := 0
+	for _, p := range in.Packed {
+		currentSize += p.Size
+		if currentSize < 255 {
+			// The packed total stays below 255 bits, so arithmetic in the
+			// field matches the integer shift and add of PackToFields.
+			shift.SetOne()
+			for i := 0; i < p.Size; i++ {
+				shift.Double(&shift)
+			}
+			current.Mul(&current, &shift)
+			current.Add(&current, &p.Field)
+		} else {
+			fields = append(fields, current)
+			currentSize = p.Size
+			current = p.Field
+		}
+	}
+	return append(fields, current)
+}
+
+// BigInt returns in as a HashInput.
+func (in GenericHashInput[F]) BigInt() HashInput {
+	out := HashInput{}
+	for i := range in.Fields {
+		out.Fields = append(out.Fields, in.Fields[i].BigInt())
+	}
+	for i := range in.Packed {
+		out.Packed = append(out.Packed, PackedField{Field: in.Packed[i].Field.BigInt(), Size: in.Packed[i].Size})
+	}
+	return out
+}
+
+// GenericHashInputFrom returns in as a GenericHashInput over F, reducing
+// each value modulo the field.
+func GenericHashInputFrom[F field.FieldParams](in HashInput) GenericHashInput[F] {
+	out := GenericHashInput[F]{}
+	for _, x := range in.Fields {
+		var e field.Element[F]
+		out.Fields = append(out.Fields, *e.SetBigInt(x))
+	}
+	for _, p := range in.Packed {
+		var e field.Element[F]
+		out.Packed = append(out.Packed, GenericPackedField[F]{Field: *e.SetBigInt(p.Field), Size: p.Size})
+	}
+	return out
+}
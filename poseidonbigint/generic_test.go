@@ -0,0 +1,61 @@
+package poseidonbigint
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+func TestPackToElementsMatchesPackToFields(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20; n++ {
+		in := HashInput{}
+		for i := r.Intn(3); i > 0; i-- {
+			in.Fields = append(in.Fields, new(big.Int).Rand(r, field.P))
+		}
+		for i := r.Intn(12); i > 0; i-- {
+			size := 1 + r.Intn(128)
+			v := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(size)))
+			in.Packed = append(in.Packed, PackedField{Field: v, Size: size})
+		}
+		want := PackToFields(in)
+
+		fp := PackToElements(GenericHashInputFrom[field.FpParams](in))
+		fq := PackToElements(GenericHashInputFrom[field.FqParams](in))
+		if len(fp) != len(want) || len(fq) != len(want) {
+			t.Fatalf("input %d: packed to %d and %d elements, want %d", n, len(fp), len(fq), len(want))
+		}
+		for i := range want {
+			if fp[i].BigInt().Cmp(want[i]) != 0 {
+				t.Errorf("input %d, Fp element %d: got %s, want %s", n, i, fp[i].BigInt(), want[i])
+			}
+			// The fields of in are Fp values and may exceed q.
+			if i >= len(in.Fields) && fq[i].BigInt().Cmp(want[i]) != 0 {
+				t.Errorf("input %d, Fq element %d: got %s, want %s", n, i, fq[i].BigInt(), want[i])
+			}
+		}
+
+		back := GenericHashInputFrom[field.FpParams](in).BigInt()
+		if len(back.Fields) != len(in.Fields) || len(back.Packed) != len(in.Packed) {
+			t.Fatalf("input %d: BigInt changed the shape of the input", n)
+		}
+	}
+}
+
+func TestGenericAppend(t *testing.T) {
+	var one, two field.FpElement
+	one.SetUint64(1)
+	two.SetUint64(2)
+	a := GenericHashInput[field.FpParams]{Fields: []field.FpElement{one}}
+	b := GenericHashInput[field.FpParams]{Fields: []field.FpElement{two}, Packed: []GenericPackedField[field.FpParams]{{Field: one, Size: 1}}}
+	got := a.Append(b).BigInt()
+	want := HashInputHelpers{}.Append(a.BigInt(), b.BigInt())
+	if len(got.Fields) != 2 || got.Fields[1].Cmp(want.Fields[1]) != 0 || len(got.Packed) != 1 {
+		t.Errorf("Append = %v, want %v", got, want)
+	}
+	if len(a.Fields) != 1 {
+		t.Error("Append modified its receiver")
+	}
+}
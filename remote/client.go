@@ -0,0 +1,67 @@
+package remote
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// Invoker sends a request to method and decodes the reply into resp.
+// grpcremote.NewInvoker returns one for a gRPC connection; *Server
+// satisfies it in process.
+type Invoker interface {
+	Invoke(ctx context.Context, method string, req, resp any) error
+}
+
+// Client is a signer.Signer whose key lives behind a Signer service.
+type Client struct {
+	conn Invoker
+}
+
+// NewClient returns a Client that calls the service through conn.
+func NewClient(conn Invoker) *Client {
+	return &Client{conn: conn}
+}
+
+// PublicKey asks the service for the address of its key.
+func (c *Client) PublicKey(ctx context.Context) (keys.PublicKey, error) {
+	var resp GetPublicKeyResponse
+	if err := c.conn.Invoke(ctx, MethodGetPublicKey, &GetPublicKeyRequest{}, &resp); err != nil {
+		return keys.PublicKey{}, err
+	}
+	return keys.PublicKeyFromBase58(resp.PublicKey)
+}
+
+// SignFields asks the service to sign fields.
+func (c *Client) SignFields(ctx context.Context, fields []*big.Int) (*signature.Signature, error) {
+	var resp SignatureResponse
+	if err := c.conn.Invoke(ctx, MethodSignFields, NewSignFieldsRequest(fields), &resp); err != nil {
+		return nil, err
+	}
+	return resp.Signature()
+}
+
+// SignTransaction asks the service to sign tx. The service must hold the
+// fee payer's key.
+func (c *Client) SignTransaction(ctx context.Context, tx transaction.Command) (*signature.Signature, error) {
+	req, err := NewSignTransactionRequest(tx)
+	if err != nil {
+		return nil, err
+	}
+	var resp SignatureResponse
+	if err := c.conn.Invoke(ctx, MethodSignTransaction, req, &resp); err != nil {
+		return nil, err
+	}
+	pk, err := keys.PublicKeyFromBase58(resp.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !pk.Equal(tx.FeePayer()) {
+		return nil, errcode.New(errcode.KeyMismatch, "remote: signature is not by the fee payer")
+	}
+	return resp.Signature()
+}
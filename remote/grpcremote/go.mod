@@ -0,0 +1,21 @@
+module github.com/node101-io/mina-signer-go/remote/grpcremote
+
+go 1.23.5
+
+require (
+	github.com/node101-io/mina-signer-go v0.0.0
+	google.golang.org/grpc v1.75.1
+)
+
+require (
+	github.com/decred/base58 v1.0.5 // indirect
+	github.com/decred/dcrd/crypto/blake256 v1.0.1 // indirect
+	golang.org/x/crypto v0.39.0 // indirect
+	golang.org/x/net v0.41.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	golang.org/x/text v0.26.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250707201910-8d1bb00bc6a7 // indirect
+	google.golang.org/protobuf v1.36.9 // indirect
+)
+
+replace github.com/node101-io/mina-signer-go => ../..
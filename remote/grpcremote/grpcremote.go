@@ -0,0 +1,104 @@
+// Package grpcremote serves and calls the mina.signer.v1.Signer service of
+// package remote over gRPC, with its messages encoded as JSON.
+//
+// The messages of package remote are plain Go structs rather than
+// protoc-generated types, so they travel with Codec, a gRPC codec for the
+// "json" content subtype, instead of the protobuf wire format. Any gRPC
+// client can call the service by sending the proto3 JSON form of the
+// messages in signer.proto with the content type "application/grpc+json".
+//
+// The package is a module of its own so that the signer does not depend on
+// gRPC.
+package grpcremote
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/node101-io/mina-signer-go/remote"
+)
+
+// CodecName is the content subtype Codec is registered under.
+const CodecName = "json"
+
+func init() {
+	encoding.RegisterCodec(Codec{})
+}
+
+// Codec encodes messages with encoding/json. The package registers it with
+// gRPC, so a server picks it for requests with the "json" content subtype.
+type Codec struct{}
+
+// Marshal encodes v as JSON.
+func (Codec) Marshal(v any) ([]byte, error) { return json.Marshal(v) }
+
+// Unmarshal decodes the JSON data into v.
+func (Codec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+// Name returns CodecName.
+func (Codec) Name() string { return CodecName }
+
+// SignerServer is the server API of the Signer service. *remote.Server
+// implements it.
+type SignerServer interface {
+	GetPublicKey(context.Context, *remote.GetPublicKeyRequest) (*remote.GetPublicKeyResponse, error)
+	SignFields(context.Context, *remote.SignFieldsRequest) (*remote.SignatureResponse, error)
+	SignTransaction(context.Context, *remote.SignTransactionRequest) (*remote.SignatureResponse, error)
+}
+
+// ServiceDesc describes the Signer service of signer.proto for
+// grpc.Server.RegisterService.
+var ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mina.signer.v1.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "GetPublicKey", Handler: handler(remote.MethodGetPublicKey, SignerServer.GetPublicKey)},
+		{MethodName: "SignFields", Handler: handler(remote.MethodSignFields, SignerServer.SignFields)},
+		{MethodName: "SignTransaction", Handler: handler(remote.MethodSignTransaction, SignerServer.SignTransaction)},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "remote/signer.proto",
+}
+
+// RegisterSignerServer registers srv, usually a *remote.Server, with s.
+func RegisterSignerServer(s grpc.ServiceRegistrar, srv SignerServer) {
+	s.RegisterService(&ServiceDesc, srv)
+}
+
+// handler returns the unary handler that decodes a Req and calls call,
+// through the server's interceptor if it has one.
+func handler[Req, Resp any](method string, call func(SignerServer, context.Context, *Req) (*Resp, error)) grpc.MethodHandler {
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		req := new(Req)
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv.(SignerServer), ctx, req)
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: method}
+		return interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return call(srv.(SignerServer), ctx, req.(*Req))
+		})
+	}
+}
+
+// Invoker calls the Signer service over a gRPC connection with Codec. It
+// implements remote.Invoker, so remote.NewClient(grpcremote.NewInvoker(cc))
+// is a signer.Signer backed by the service.
+type Invoker struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewInvoker returns an Invoker that calls through cc.
+func NewInvoker(cc grpc.ClientConnInterface) *Invoker {
+	return &Invoker{cc: cc}
+}
+
+// Invoke calls method with req and decodes the reply into resp.
+func (i *Invoker) Invoke(ctx context.Context, method string, req, resp any) error {
+	return i.cc.Invoke(ctx, method, req, resp, grpc.CallContentSubtype(CodecName))
+}
@@ -0,0 +1,63 @@
+package grpcremote_test
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/remote"
+	"github.com/node101-io/mina-signer-go/remote/grpcremote"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+func TestSignerService(t *testing.T) {
+	ctx := context.Background()
+	sk := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(424242))}
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	grpcremote.RegisterSignerServer(s, remote.NewServer(signer.NewKeySigner(sk, signer.NetworkTestnet)))
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	cc, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cc.Close() })
+	c := remote.NewClient(grpcremote.NewInvoker(cc))
+
+	pk, err := c.PublicKey(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := sk.ToPublicKey(); !pk.Equal(want) {
+		t.Error("PublicKey does not match the served key")
+	}
+	fields := []*big.Int{big.NewInt(1), big.NewInt(2)}
+	sig, err := c.SignFields(ctx, fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pk.Verify(sig, poseidonbigint.HashInput{Fields: fields}, "testnet") {
+		t.Error("SignFields signature does not verify")
+	}
+	to := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(7))}.ToPublicKey()
+	payment := transaction.Payment{From: pk, To: to, Amount: 1, Fee: 1, Nonce: 3}
+	if _, err := c.SignTransaction(ctx, payment); err != nil {
+		t.Errorf("SignTransaction: %v", err)
+	}
+	if _, err := c.SignFields(ctx, []*big.Int{new(big.Int).Neg(big.NewInt(1))}); err == nil {
+		t.Error("SignFields accepted a field outside the base field")
+	}
+}
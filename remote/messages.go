@@ -0,0 +1,199 @@
+// Package remote exposes a signer.Signer as the mina.signer.v1.Signer
+// service defined in signer.proto, so keys can live in a hardened process
+// while applications sign over the network.
+//
+// Server implements the service and Client implements signer.Signer on top
+// of any Invoker. The message types below are plain Go structs, not
+// protoc-generated proto.Message types: they mirror signer.proto field for
+// field and carry the proto3 JSON names, so they travel with a JSON codec.
+// The package itself depends on no RPC framework. Package grpcremote, a
+// module of its own, registers Server with a gRPC server and turns a gRPC
+// connection into an Invoker, with such a codec; Server is itself an
+// in-process Invoker.
+package remote
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// Full method names of the service, as gRPC routes them.
+const (
+	MethodGetPublicKey    = "/mina.signer.v1.Signer/GetPublicKey"
+	MethodSignFields      = "/mina.signer.v1.Signer/SignFields"
+	MethodSignTransaction = "/mina.signer.v1.Signer/SignTransaction"
+)
+
+type GetPublicKeyRequest struct{}
+
+type GetPublicKeyResponse struct {
+	PublicKey string `json:"publicKey"`
+}
+
+// SignFieldsRequest carries base field elements as decimal strings.
+type SignFieldsRequest struct {
+	Fields []string `json:"fields"`
+}
+
+// Payment is transaction.Payment with base58 addresses.
+type Payment struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Amount     uint64 `json:"amount,string"`
+	Fee        uint64 `json:"fee,string"`
+	Nonce      uint32 `json:"nonce"`
+	ValidUntil uint32 `json:"validUntil"`
+	Memo       string `json:"memo"`
+}
+
+// StakeDelegation is transaction.StakeDelegation with base58 addresses.
+type StakeDelegation struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Fee        uint64 `json:"fee,string"`
+	Nonce      uint32 `json:"nonce"`
+	ValidUntil uint32 `json:"validUntil"`
+	Memo       string `json:"memo"`
+}
+
+// SignTransactionRequest carries exactly one command.
+type SignTransactionRequest struct {
+	Payment         *Payment         `json:"payment,omitempty"`
+	StakeDelegation *StakeDelegation `json:"stakeDelegation,omitempty"`
+}
+
+// SignatureResponse is a signature as o1js encodes it in JSON, with the
+// address of the key that produced it.
+type SignatureResponse struct {
+	Field     string `json:"field"`
+	Scalar    string `json:"scalar"`
+	PublicKey string `json:"publicKey"`
+}
+
+// NewSignTransactionRequest encodes a payment or stake delegation.
+func NewSignTransactionRequest(tx transaction.Command) (*SignTransactionRequest, error) {
+	switch t := tx.(type) {
+	case transaction.Payment:
+		from, to, err := addresses(t.From, t.To)
+		if err != nil {
+			return nil, err
+		}
+		return &SignTransactionRequest{Payment: &Payment{
+			From: from, To: to, Amount: t.Amount, Fee: t.Fee,
+			Nonce: t.Nonce, ValidUntil: t.ValidUntil, Memo: t.Memo,
+		}}, nil
+	case transaction.StakeDelegation:
+		from, to, err := addresses(t.From, t.To)
+		if err != nil {
+			return nil, err
+		}
+		return &SignTransactionRequest{StakeDelegation: &StakeDelegation{
+			From: from, To: to, Fee: t.Fee,
+			Nonce: t.Nonce, ValidUntil: t.ValidUntil, Memo: t.Memo,
+		}}, nil
+	default:
+		return nil, errcode.Errorf(errcode.Unsupported, "remote: unsupported command %T", tx)
+	}
+}
+
+// Command decodes the command of r.
+func (r *SignTransactionRequest) Command() (transaction.Command, error) {
+	switch {
+	case r == nil || (r.Payment == nil) == (r.StakeDelegation == nil):
+		return nil, errcode.New(errcode.InvalidArgument, "remote: request must carry exactly one command")
+	case r.Payment != nil:
+		p := r.Payment
+		from, to, err := publicKeys(p.From, p.To)
+		if err != nil {
+			return nil, err
+		}
+		return transaction.Payment{
+			From: from, To: to, Amount: p.Amount, Fee: p.Fee,
+			Nonce: p.Nonce, ValidUntil: p.ValidUntil, Memo: p.Memo,
+		}, nil
+	default:
+		d := r.StakeDelegation
+		from, to, err := publicKeys(d.From, d.To)
+		if err != nil {
+			return nil, err
+		}
+		return transaction.StakeDelegation{
+			From: from, To: to, Fee: d.Fee,
+			Nonce: d.Nonce, ValidUntil: d.ValidUntil, Memo: d.Memo,
+		}, nil
+	}
+}
+
+// NewSignFieldsRequest encodes fields as decimal strings.
+func NewSignFieldsRequest(fields []*big.Int) *SignFieldsRequest {
+	out := make([]string, len(fields))
+	for i, f := range fields {
+		out[i] = f.String()
+	}
+	return &SignFieldsRequest{Fields: out}
+}
+
+// BigFields decodes the fields of r, rejecting values that are not below p.
+func (r *SignFieldsRequest) BigFields() ([]*big.Int, error) {
+	out := make([]*big.Int, len(r.Fields))
+	for i, s := range r.Fields {
+		f, err := field.Fp.FromString(s, 10)
+		if err != nil {
+			return nil, fmt.Errorf("remote: field %d: %w", i, err)
+		}
+		out[i] = f
+	}
+	return out, nil
+}
+
+// newSignatureResponse encodes sig and the signer's public key.
+func newSignatureResponse(sig *signature.Signature, pk keys.PublicKey) (*SignatureResponse, error) {
+	addr, err := pk.ToBase58()
+	if err != nil {
+		return nil, err
+	}
+	return &SignatureResponse{Field: sig.R.BigInt().String(), Scalar: sig.S.String(), PublicKey: addr}, nil
+}
+
+// Signature decodes the signature of r, rejecting non-canonical values.
+func (r *SignatureResponse) Signature() (*signature.Signature, error) {
+	rv, ok := new(big.Int).SetString(r.Field, 10)
+	if !ok {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "remote: invalid signature field %q", r.Field)
+	}
+	sv, ok := new(big.Int).SetString(r.Scalar, 10)
+	if !ok {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "remote: invalid signature scalar %q", r.Scalar)
+	}
+	return signature.New(rv, sv)
+}
+
+func addresses(from, to keys.PublicKey) (string, string, error) {
+	f, err := from.ToBase58()
+	if err != nil {
+		return "", "", fmt.Errorf("remote: from: %w", err)
+	}
+	t, err := to.ToBase58()
+	if err != nil {
+		return "", "", fmt.Errorf("remote: to: %w", err)
+	}
+	return f, t, nil
+}
+
+func publicKeys(from, to string) (keys.PublicKey, keys.PublicKey, error) {
+	f, err := keys.PublicKeyFromBase58(from)
+	if err != nil {
+		return keys.PublicKey{}, keys.PublicKey{}, fmt.Errorf("remote: from: %w", err)
+	}
+	t, err := keys.PublicKeyFromBase58(to)
+	if err != nil {
+		return keys.PublicKey{}, keys.PublicKey{}, fmt.Errorf("remote: to: %w", err)
+	}
+	return f, t, nil
+}
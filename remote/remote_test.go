@@ -0,0 +1,94 @@
+package remote_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/remote"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// jsonInvoker round-trips requests and responses through JSON, as a JSON
+// codec on the wire would.
+type jsonInvoker struct{ server *remote.Server }
+
+func (j jsonInvoker) Invoke(ctx context.Context, method string, req, resp any) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	var decoded, out any
+	switch method {
+	case remote.MethodGetPublicKey:
+		decoded, out = new(remote.GetPublicKeyRequest), new(remote.GetPublicKeyResponse)
+	case remote.MethodSignFields:
+		decoded, out = new(remote.SignFieldsRequest), new(remote.SignatureResponse)
+	default:
+		decoded, out = new(remote.SignTransactionRequest), new(remote.SignatureResponse)
+	}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return err
+	}
+	if err := j.server.Invoke(ctx, method, decoded, out); err != nil {
+		return err
+	}
+	if data, err = json.Marshal(out); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, resp)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ctx := context.Background()
+	sk := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(424242))}
+	server := remote.NewServer(signer.NewKeySigner(sk, signer.NetworkTestnet))
+
+	for name, conn := range map[string]remote.Invoker{"in process": server, "json": jsonInvoker{server}} {
+		c := remote.NewClient(conn)
+		pk, err := c.PublicKey(ctx)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if want := sk.ToPublicKey(); !pk.Equal(want) {
+			t.Errorf("%s: PublicKey does not match the served key", name)
+		}
+
+		fields := []*big.Int{big.NewInt(1), big.NewInt(2)}
+		sig, err := c.SignFields(ctx, fields)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !pk.Verify(sig, poseidonbigint.HashInput{Fields: fields}, "testnet") {
+			t.Errorf("%s: SignFields signature does not verify", name)
+		}
+
+		other := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(7))}.ToPublicKey()
+		tx := transaction.Payment{From: pk, To: other, Amount: 5, Fee: 1, Nonce: 3, Memo: "remote"}
+		sig, err = c.SignTransaction(ctx, tx)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		input, _ := tx.InputLegacy()
+		if !pk.VerifyLegacy(sig, input, "testnet") {
+			t.Errorf("%s: SignTransaction signature does not verify", name)
+		}
+		if _, err := c.SignTransaction(ctx, transaction.StakeDelegation{From: other, To: pk}); err == nil {
+			t.Errorf("%s: signed for a fee payer the server does not hold", name)
+		}
+	}
+}
+
+func TestSignTransactionRequest(t *testing.T) {
+	if _, err := (&remote.SignTransactionRequest{}).Command(); err == nil {
+		t.Error("Command accepted an empty request")
+	}
+	if _, err := (&remote.SignFieldsRequest{Fields: []string{"-1"}}).BigFields(); err == nil {
+		t.Error("BigFields accepted a negative field")
+	}
+}
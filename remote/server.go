@@ -0,0 +1,121 @@
+package remote
+
+import (
+	"context"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+// Server serves a signer.Signer. It implements grpcremote.SignerServer.
+type Server struct {
+	signer signer.Signer
+}
+
+// NewServer returns a Server that signs with s.
+func NewServer(s signer.Signer) *Server {
+	return &Server{signer: s}
+}
+
+// GetPublicKey returns the address of the served key.
+func (s *Server) GetPublicKey(ctx context.Context, _ *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
+	pk, err := s.signer.PublicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := pk.ToBase58()
+	if err != nil {
+		return nil, err
+	}
+	return &GetPublicKeyResponse{PublicKey: addr}, nil
+}
+
+// SignFields signs the fields of req.
+func (s *Server) SignFields(ctx context.Context, req *SignFieldsRequest) (*SignatureResponse, error) {
+	fields, err := req.BigFields()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := s.signer.SignFields(ctx, fields)
+	if err != nil {
+		return nil, err
+	}
+	return s.response(ctx, sig)
+}
+
+// SignTransaction signs the command of req.
+func (s *Server) SignTransaction(ctx context.Context, req *SignTransactionRequest) (*SignatureResponse, error) {
+	tx, err := req.Command()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := s.signer.SignTransaction(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return s.response(ctx, sig)
+}
+
+// response encodes sig with the address of the served key.
+func (s *Server) response(ctx context.Context, sig *signature.Signature) (*SignatureResponse, error) {
+	pk, err := s.signer.PublicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newSignatureResponse(sig, pk)
+}
+
+// Invoke calls the handler for method in process, so a Server can back a
+// Client directly. req and resp must be the request and response pointer
+// types of the method.
+func (s *Server) Invoke(ctx context.Context, method string, req, resp any) error {
+	var (
+		out any
+		err error
+	)
+	switch method {
+	case MethodGetPublicKey:
+		r, ok := req.(*GetPublicKeyRequest)
+		if !ok {
+			return errcode.Errorf(errcode.InvalidArgument, "remote: %s: unexpected request %T", method, req)
+		}
+		out, err = s.GetPublicKey(ctx, r)
+	case MethodSignFields:
+		r, ok := req.(*SignFieldsRequest)
+		if !ok {
+			return errcode.Errorf(errcode.InvalidArgument, "remote: %s: unexpected request %T", method, req)
+		}
+		out, err = s.SignFields(ctx, r)
+	case MethodSignTransaction:
+		r, ok := req.(*SignTransactionRequest)
+		if !ok {
+			return errcode.Errorf(errcode.InvalidArgument, "remote: %s: unexpected request %T", method, req)
+		}
+		out, err = s.SignTransaction(ctx, r)
+	default:
+		return errcode.Errorf(errcode.Unsupported, "remote: unknown method %s", method)
+	}
+	if err != nil {
+		return err
+	}
+	return assign(resp, out)
+}
+
+// assign copies the response out into resp, which must point to the same
+// type.
+func assign(resp, out any) error {
+	switch o := out.(type) {
+	case *GetPublicKeyResponse:
+		if r, ok := resp.(*GetPublicKeyResponse); ok {
+			*r = *o
+			return nil
+		}
+	case *SignatureResponse:
+		if r, ok := resp.(*SignatureResponse); ok {
+			*r = *o
+			return nil
+		}
+	}
+	return errcode.Errorf(errcode.Upstream, "remote: unexpected response %T", resp)
+}
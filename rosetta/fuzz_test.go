@@ -0,0 +1,58 @@
+package rosetta_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/rosetta"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+func FuzzPublicKeyFromHex(f *testing.F) {
+	sk, err := keys.PrivateKeyFromBase58("EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw")
+	if err != nil {
+		f.Fatal(err)
+	}
+	h, err := rosetta.PublicKeyToHex(sk.ToPublicKey())
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(h)
+	f.Add(strings.ToUpper(h))
+	f.Add(strings.Repeat("0", 64))
+	f.Fuzz(func(t *testing.T, h string) {
+		pk, err := rosetta.PublicKeyFromHex(h)
+		if err != nil {
+			return
+		}
+		out, err := rosetta.PublicKeyToHex(pk)
+		if err != nil || out != strings.ToLower(h) {
+			t.Fatalf("round trip: %q, %v", out, err)
+		}
+	})
+}
+
+func FuzzSignatureFromHex(f *testing.F) {
+	sig, err := signature.New(big.NewInt(0x12), big.NewInt(0xab01))
+	if err != nil {
+		f.Fatal(err)
+	}
+	h, err := rosetta.SignatureToHex(sig)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(h)
+	f.Add(strings.Repeat("f", 128))
+	f.Fuzz(func(t *testing.T, h string) {
+		sig, err := rosetta.SignatureFromHex(h)
+		if err != nil {
+			return
+		}
+		out, err := rosetta.SignatureToHex(sig)
+		if err != nil || out != strings.ToLower(h) {
+			t.Fatalf("round trip: %q, %v", out, err)
+		}
+	})
+}
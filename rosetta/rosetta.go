@@ -0,0 +1,168 @@
+// Package rosetta converts keys and signatures to and from the hex formats
+// of the Mina Rosetta API: public keys of curve_type "pallas" and signatures
+// of signature_type "schnorr_poseidon".
+//
+// Both formats write each 32-byte value little-endian with the two hex
+// digits of every byte swapped, as the Rosetta implementation and
+// mina-signer do. A public key is the x coordinate with the parity of y in
+// the top bit of the last byte; a signature is r followed by s.
+package rosetta
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+const (
+	// CurveType is the Rosetta curve_type of Mina public keys.
+	CurveType = "pallas"
+	// SignatureType is the Rosetta signature_type of Mina signatures.
+	SignatureType = "schnorr_poseidon"
+)
+
+// valueSize is the size of a field element or scalar in bytes.
+const valueSize = 32
+
+// PublicKey is the Rosetta PublicKey object.
+type PublicKey struct {
+	HexBytes  string `json:"hex_bytes"`
+	CurveType string `json:"curve_type"`
+}
+
+// NewPublicKey returns the Rosetta object for pk.
+func NewPublicKey(pk keys.PublicKey) (*PublicKey, error) {
+	h, err := PublicKeyToHex(pk)
+	if err != nil {
+		return nil, err
+	}
+	return &PublicKey{HexBytes: h, CurveType: CurveType}, nil
+}
+
+// PublicKey decodes p, which must be of CurveType.
+func (p *PublicKey) PublicKey() (keys.PublicKey, error) {
+	if p == nil {
+		return keys.PublicKey{}, errcode.New(errcode.MissingValue, "rosetta: missing public key")
+	}
+	if p.CurveType != CurveType {
+		return keys.PublicKey{}, errcode.Errorf(errcode.Unsupported, "rosetta: curve_type %q, want %q", p.CurveType, CurveType)
+	}
+	return PublicKeyFromHex(p.HexBytes)
+}
+
+// PublicKeyToHex returns the Rosetta hex encoding of pk.
+func PublicKeyToHex(pk keys.PublicKey) (string, error) {
+	if pk.X == nil {
+		return "", errcode.New(errcode.MissingValue, "rosetta: public key x is nil")
+	}
+	return toHex(pk.X.BigInt(), pk.IsOdd), nil
+}
+
+// PublicKeyFromHex decodes a Rosetta public key, rejecting an x that is
+// not canonical or not on the curve.
+func PublicKeyFromHex(h string) (keys.PublicKey, error) {
+	x, isOdd, err := fromHex(h)
+	if err != nil {
+		return keys.PublicKey{}, fmt.Errorf("rosetta: public key: %w", err)
+	}
+	b := make([]byte, keys.PublicKeyTotalByteSize)
+	x.FillBytes(b[:keys.PublicKeyXByteSize])
+	if isOdd {
+		b[keys.PublicKeyXByteSize] = 1
+	}
+	var pk keys.PublicKey
+	if err := pk.UnmarshalBytes(b); err != nil {
+		return keys.PublicKey{}, fmt.Errorf("rosetta: public key: %w", err)
+	}
+	return pk, nil
+}
+
+// SignatureToHex returns the Rosetta hex encoding of sig.
+func SignatureToHex(sig *signature.Signature) (string, error) {
+	if sig == nil || sig.R == nil || sig.S == nil {
+		return "", errcode.New(errcode.MissingValue, "rosetta: signature R or S is nil")
+	}
+	return toHex(sig.R.BigInt(), false) + toHex(sig.S.BigInt(), false), nil
+}
+
+// SignatureFromHex decodes a Rosetta signature, rejecting components that
+// are not below their moduli.
+func SignatureFromHex(h string) (*signature.Signature, error) {
+	if len(h) != 4*valueSize {
+		return nil, errcode.Errorf(errcode.InvalidLength, "rosetta: signature is %d hex digits, want %d", len(h), 4*valueSize)
+	}
+	r, rTop, err := fromHex(h[:2*valueSize])
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: signature r: %w", err)
+	}
+	s, sTop, err := fromHex(h[2*valueSize:])
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: signature s: %w", err)
+	}
+	if rTop || sTop {
+		return nil, errcode.New(errcode.NonCanonical, "rosetta: signature has a top bit set")
+	}
+	sig, err := signature.New(r, s)
+	if err != nil {
+		return nil, fmt.Errorf("rosetta: %w", err)
+	}
+	return sig, nil
+}
+
+// toHex encodes v little-endian with the digits of each byte swapped,
+// setting the top bit of the last byte if top is set. v must fit in 255
+// bits.
+func toHex(v *big.Int, top bool) string {
+	const digits = "0123456789abcdef"
+	be := v.FillBytes(make([]byte, valueSize))
+	out := make([]byte, 2*valueSize)
+	for i := 0; i < valueSize; i++ {
+		b := be[valueSize-1-i]
+		if i == valueSize-1 && top {
+			b |= 0x80
+		}
+		out[2*i] = digits[b&0x0f]
+		out[2*i+1] = digits[b>>4]
+	}
+	return string(out)
+}
+
+// fromHex decodes the output of toHex, returning the value without the
+// top bit and the top bit.
+func fromHex(h string) (*big.Int, bool, error) {
+	if len(h) != 2*valueSize {
+		return nil, false, errcode.Errorf(errcode.InvalidLength, "%d hex digits, want %d", len(h), 2*valueSize)
+	}
+	if field.IsStrict() && strings.ToLower(h) != h {
+		return nil, false, fmt.Errorf("%w: upper-case hex digits", field.ErrNonCanonical)
+	}
+	be := make([]byte, valueSize)
+	for i := 0; i < valueSize; i++ {
+		lo, okLo := nibble(h[2*i])
+		hi, okHi := nibble(h[2*i+1])
+		if !okLo || !okHi {
+			return nil, false, errcode.Errorf(errcode.InvalidEncoding, "invalid hex digits %q", h[2*i:2*i+2])
+		}
+		be[valueSize-1-i] = hi<<4 | lo
+	}
+	top := be[0]&0x80 != 0
+	be[0] &= 0x7f
+	return new(big.Int).SetBytes(be), top, nil
+}
+
+func nibble(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
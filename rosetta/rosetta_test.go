@@ -0,0 +1,100 @@
+package rosetta_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/rosetta"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+func TestSignatureHexLayout(t *testing.T) {
+	sig, err := signature.New(big.NewInt(0x12), big.NewInt(0xab01))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := rosetta.SignatureToHex(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "21" + strings.Repeat("0", 62) + "10ba" + strings.Repeat("0", 60)
+	if h != want {
+		t.Errorf("SignatureToHex = %s, want %s", h, want)
+	}
+	back, err := rosetta.SignatureFromHex(strings.ToUpper(h))
+	if err != nil || !back.R.Equal(sig.R) || back.S.BigInt().Cmp(sig.S.BigInt()) != 0 {
+		t.Errorf("SignatureFromHex(%s) = %v, %v", h, back, err)
+	}
+}
+
+func TestPublicKeyRoundTrip(t *testing.T) {
+	sk, err := keys.PrivateKeyFromBase58("EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pk := range []keys.PublicKey{sk.ToPublicKey(), keys.PrivateKey{Value: sk.Value.Neg()}.ToPublicKey()} {
+		obj, err := rosetta.NewPublicKey(pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if obj.CurveType != "pallas" || len(obj.HexBytes) != 64 {
+			t.Errorf("NewPublicKey = %+v", obj)
+		}
+		top := obj.HexBytes[63] >= '8'
+		if top != pk.IsOdd {
+			t.Errorf("parity bit of %s = %v, want %v", obj.HexBytes, top, pk.IsOdd)
+		}
+		back, err := obj.PublicKey()
+		if err != nil || !back.Equal(pk) {
+			t.Errorf("PublicKey() = %v, %v", back, err)
+		}
+	}
+
+	obj, _ := rosetta.NewPublicKey(sk.ToPublicKey())
+	obj.CurveType = "secp256k1"
+	if _, err := obj.PublicKey(); err == nil {
+		t.Error("PublicKey accepted another curve_type")
+	}
+}
+
+func TestFromHexRejectsInvalid(t *testing.T) {
+	// x = 0 is not the x coordinate of a Pallas point.
+	if _, err := rosetta.PublicKeyFromHex(strings.Repeat("0", 64)); !errors.Is(err, curve.ErrNotOnCurve) {
+		t.Errorf("PublicKeyFromHex(0) error = %v, want curve.ErrNotOnCurve", err)
+	}
+	for _, h := range []string{"", strings.Repeat("0", 62), strings.Repeat("g", 64)} {
+		if _, err := rosetta.PublicKeyFromHex(h); err == nil {
+			t.Errorf("PublicKeyFromHex(%q) succeeded", h)
+		}
+	}
+
+	q, _ := signature.New(big.NewInt(1), big.NewInt(1))
+	h, _ := rosetta.SignatureToHex(q)
+	sHex := swappedLE(field.Q)
+	if _, err := rosetta.SignatureFromHex(h[:64] + sHex); !errors.Is(err, field.ErrNonCanonical) {
+		t.Errorf("SignatureFromHex(s = q) error = %v, want field.ErrNonCanonical", err)
+	}
+	if _, err := rosetta.SignatureFromHex(h[:63] + "8" + h[64:]); err == nil {
+		t.Error("SignatureFromHex accepted a set top bit")
+	}
+	if _, err := rosetta.SignatureFromHex(h[:126]); err == nil {
+		t.Error("SignatureFromHex accepted a short signature")
+	}
+}
+
+// swappedLE encodes v the Rosetta way, independently of the package.
+func swappedLE(v *big.Int) string {
+	be := v.FillBytes(make([]byte, 32))
+	var sb strings.Builder
+	for i := len(be) - 1; i >= 0; i-- {
+		const digits = "0123456789abcdef"
+		sb.WriteByte(digits[be[i]&0xf])
+		sb.WriteByte(digits[be[i]>>4])
+	}
+	return sb.String()
+}
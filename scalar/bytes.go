@@ -0,0 +1,46 @@
+package scalar
+
+import (
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// Size is the length of the fixed-width scalar encodings.
+const Size = 32
+
+// BytesLE returns s as exactly Size little-endian bytes, the order Mina uses
+// for scalars on the wire and the inverse of FromBytesLE.
+func (s *Scalar) BytesLE() []byte {
+	b := s.BytesBE()
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return b
+}
+
+// BytesBE returns s as exactly Size big-endian bytes, the inverse of
+// FromBytesBE.
+func (s *Scalar) BytesBE() []byte {
+	return s.n.FillBytes(make([]byte, Size))
+}
+
+// FromBytesLE decodes exactly Size little-endian bytes, rejecting values
+// that are not below q.
+func FromBytesLE(b []byte) (*Scalar, error) {
+	n, err := field.Fq.FromBytesLE(b)
+	if err != nil {
+		return nil, fmt.Errorf("scalar: %w", err)
+	}
+	return &Scalar{n: n}, nil
+}
+
+// FromBytesBE decodes exactly Size big-endian bytes, rejecting values that
+// are not below q.
+func FromBytesBE(b []byte) (*Scalar, error) {
+	n, err := field.Fq.FromBytesBE(b)
+	if err != nil {
+		return nil, fmt.Errorf("scalar: %w", err)
+	}
+	return &Scalar{n: n}, nil
+}
@@ -0,0 +1,34 @@
+package scalar
+
+import "github.com/node101-io/mina-signer-go/curve"
+
+// BitSize is the bit length of q, and so of every scalar.
+const BitSize = 255
+
+// The recodings below delegate to the curve package, which uses the same
+// functions for its scalar multiplications; circuits and protocol code that
+// need to match the curve code should decompose scalars through them.
+
+// ToBits returns the n low bits of s, least significant first. The length is
+// n regardless of the value; ToBits(BitSize) is the inverse of ScalarFromBits.
+func (s *Scalar) ToBits(n int) []bool {
+	return curve.Bits(s.n, n)
+}
+
+// ToWindows splits s into ceil(BitSize/w) unsigned w-bit digits, least
+// significant first, for fixed-window multiplication. w must be between 1
+// and 16.
+func (s *Scalar) ToWindows(w uint) []int {
+	return curve.Windows(s.n, w, BitSize)
+}
+
+// NAF returns the non-adjacent form of s, least significant digit first.
+func (s *Scalar) NAF() []int {
+	return curve.NAF(s.n)
+}
+
+// WNAF returns the width-w non-adjacent form of s, least significant digit
+// first, as used by curve.ScaleWNAF. w must be between 2 and 16.
+func (s *Scalar) WNAF(w uint) []int {
+	return curve.WNAF(s.n, w)
+}
@@ -0,0 +1,44 @@
+package scalar
+
+import (
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/base58check"
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+const (
+	// Base58Version is the Mina base58check version byte for scalars, the
+	// one private keys are encoded with.
+	Base58Version byte = 0x5a
+	// base58Binable is the version number of the scalar's binable encoding
+	// that precedes the 32 little-endian value bytes.
+	base58Binable byte = 0x01
+	base58Size         = 32
+)
+
+// ToBase58 returns the Mina base58check encoding of s: the version byte, the
+// binable version, 32 little-endian bytes and a four-byte double-SHA-256
+// checksum. Private keys encoded this way start with "EK".
+func (s *Scalar) ToBase58() string {
+	payload := make([]byte, 1+base58Size)
+	payload[0] = base58Binable
+	copy(payload[1:], s.BytesLE())
+	return base58check.Encode(Base58Version, payload)
+}
+
+// FromBase58 decodes a scalar produced by ToBase58, checking the checksum,
+// the version bytes and that the value is below q.
+func FromBase58(s string) (*Scalar, error) {
+	payload, err := base58check.Decode(s, Base58Version)
+	if err != nil {
+		return nil, fmt.Errorf("scalar: %w", err)
+	}
+	if len(payload) != 1+base58Size {
+		return nil, errcode.Errorf(errcode.InvalidLength, "scalar: invalid base58 length %d", len(payload))
+	}
+	if payload[0] != base58Binable {
+		return nil, errcode.Errorf(errcode.InvalidVersion, "scalar: unexpected base58 binable version 0x%02x", payload[0])
+	}
+	return FromBytesLE(payload[1:])
+}
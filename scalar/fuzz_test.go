@@ -0,0 +1,33 @@
+package scalar
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzFromBytes(f *testing.F) {
+	f.Add(make([]byte, Size))
+	f.Add(bytes.Repeat([]byte{0xff}, Size))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if s, err := FromBytesBE(data); err == nil && !bytes.Equal(s.BytesBE(), data) {
+			t.Fatalf("big-endian round trip: %x", s.BytesBE())
+		}
+		if s, err := FromBytesLE(data); err == nil && !bytes.Equal(s.BytesLE(), data) {
+			t.Fatalf("little-endian round trip: %x", s.BytesLE())
+		}
+	})
+}
+
+func FuzzFromBase58(f *testing.F) {
+	f.Add(NewScalar(12345).ToBase58())
+	f.Add("")
+	f.Fuzz(func(t *testing.T, str string) {
+		s, err := FromBase58(str)
+		if err != nil {
+			return
+		}
+		if out := s.ToBase58(); out != str {
+			t.Fatalf("round trip: %q", out)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package scalar
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// qMinusOne is the order of the multiplicative group of scalars.
+var qMinusOne = new(big.Int).Sub(Q, big.NewInt(1))
+
+// Square returns s².
+func (s *Scalar) Square() *Scalar {
+	e := s.element()
+	return fromElement(e.Square(e))
+}
+
+// Exp returns s^e. A negative exponent is reduced modulo q-1, so it gives
+// (1/s)^|e| for non-zero s and 0 for s = 0. The running time depends on e
+// but not on s.
+func (s *Scalar) Exp(e *big.Int) *Scalar {
+	if e.Sign() < 0 {
+		e = new(big.Int).Mod(e, qMinusOne)
+	}
+	x := s.element()
+	return fromElement(x.Exp(x, e))
+}
+
+// Inverse returns 1/s. It uses the extended Euclidean algorithm, whose
+// running time depends on s; use InverseConstantTime for secret scalars. The
+// error wraps field.ErrNotInvertible when s is 0.
+func (s *Scalar) Inverse() (*Scalar, error) {
+	inv, err := field.InverseErr(s.n, Q)
+	if err != nil {
+		return nil, fmt.Errorf("scalar: %w", err)
+	}
+	return &Scalar{n: inv}, nil
+}
+
+// InverseConstantTime returns 1/s computed as s^(q-2), a fixed sequence of
+// limb multiplications that does not depend on s. It is slower than Inverse
+// and meant for secret values such as nonces or key shares. The error wraps
+// field.ErrNotInvertible when s is 0.
+func (s *Scalar) InverseConstantTime() (*Scalar, error) {
+	x := s.element()
+	if x.IsZero() {
+		return nil, fmt.Errorf("scalar: %w: 0", field.ErrNotInvertible)
+	}
+	return fromElement(x.Inverse(x)), nil
+}
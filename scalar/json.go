@@ -0,0 +1,37 @@
+//go:build !tinygo && !minasigner_core
+
+package scalar
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// MarshalJSON encodes s as a decimal string, as o1js does for scalars in
+// JSON payloads.
+func (s Scalar) MarshalJSON() ([]byte, error) {
+	n := s.n
+	if n == nil {
+		n = new(big.Int)
+	}
+	return json.Marshal(n.String())
+}
+
+// UnmarshalJSON decodes a decimal string, rejecting values that are not
+// below q instead of reducing them.
+func (s *Scalar) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return errcode.Errorf(errcode.InvalidEncoding, "scalar: expected a decimal string: %w", err)
+	}
+	n, err := field.Fq.FromString(str, 10)
+	if err != nil {
+		return fmt.Errorf("scalar: %w", err)
+	}
+	s.n = n
+	return nil
+}
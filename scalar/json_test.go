@@ -0,0 +1,47 @@
+//go:build !tinygo && !minasigner_core
+
+package scalar
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	s := NewScalar("123456789012345678901234567890")
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"123456789012345678901234567890"` {
+		t.Errorf("Marshal = %s", data)
+	}
+	var out Scalar
+	if err := json.Unmarshal(data, &out); err != nil || out.BigInt().Cmp(s.BigInt()) != 0 {
+		t.Errorf("Unmarshal(%s) = %v, %v", data, out.BigInt(), err)
+	}
+
+	for _, bad := range []string{`"` + Q.String() + `"`, `"-1"`, `"12a"`, `12`} {
+		if err := json.Unmarshal([]byte(bad), &out); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded", bad)
+		}
+	}
+	if data, err := json.Marshal(Scalar{}); err != nil || string(data) != `"0"` {
+		t.Errorf("Marshal(Scalar{}) = %s, %v", data, err)
+	}
+}
+
+func FuzzUnmarshalJSON(f *testing.F) {
+	f.Add([]byte(`"123"`))
+	f.Add([]byte(`"-1"`))
+	f.Add([]byte(`123`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var s Scalar
+		if err := json.Unmarshal(data, &s); err != nil {
+			return
+		}
+		if s.BigInt().Cmp(Q) >= 0 || s.BigInt().Sign() < 0 {
+			t.Fatalf("decoded out-of-range scalar %s", s.BigInt())
+		}
+	})
+}
@@ -2,7 +2,7 @@ package scalar
 
 import (
 	"crypto/rand"
-	"errors"
+	"github.com/node101-io/mina-signer-go/errcode"
 	"github.com/node101-io/mina-signer-go/field"
 	"math/big"
 )
@@ -15,10 +15,27 @@ var (
 	Q = field.Q
 )
 
+// NewScalar is NewScalarErr for inputs known to be valid; it panics on an
+// unsupported type or a malformed string.
 func NewScalar(x any) *Scalar {
+	s, err := NewScalarErr(x)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// NewScalarErr converts x to a scalar, reducing integers modulo q. It accepts
+// *big.Int, int, int64, uint64, Scalar, *Scalar and decimal strings, and
+// returns an error for any other type, a nil pointer, or a string that is not
+// entirely a decimal integer.
+func NewScalarErr(x any) (*Scalar, error) {
 	var v *big.Int
 	switch t := x.(type) {
 	case *big.Int:
+		if t == nil {
+			return nil, errcode.New(errcode.MissingValue, "scalar: nil *big.Int")
+		}
 		v = new(big.Int).Set(t)
 	case int:
 		v = big.NewInt(int64(t))
@@ -27,15 +44,24 @@ func NewScalar(x any) *Scalar {
 	case uint64:
 		v = new(big.Int).SetUint64(t)
 	case string:
-		v, _ = new(big.Int).SetString(t, 10)
+		var ok bool
+		if v, ok = new(big.Int).SetString(t, 10); !ok {
+			return nil, errcode.Errorf(errcode.InvalidEncoding, "scalar: invalid decimal string %q", t)
+		}
 	case Scalar:
+		if t.n == nil {
+			return nil, errcode.New(errcode.MissingValue, "scalar: uninitialized Scalar")
+		}
 		v = new(big.Int).Set(t.n)
 	case *Scalar:
+		if t == nil || t.n == nil {
+			return nil, errcode.New(errcode.MissingValue, "scalar: nil Scalar")
+		}
 		v = new(big.Int).Set(t.n)
 	default:
-		panic("unsupported type for Scalar")
+		return nil, errcode.Errorf(errcode.Unsupported, "scalar: unsupported type %T", x)
 	}
-	return &Scalar{n: field.Mod(v, Q)}
+	return &Scalar{n: field.Mod(v, Q)}, nil
 }
 
 func RandomScalar() (*Scalar, error) {
@@ -49,40 +75,111 @@ func RandomScalar() (*Scalar, error) {
 	return &Scalar{n: n}, nil
 }
 
+// BigInt returns a copy of the value of s, or nil for a nil or zero-value
+// Scalar.
 func (s *Scalar) BigInt() *big.Int {
+	if s == nil || s.n == nil {
+		return nil
+	}
 	return new(big.Int).Set(s.n)
 }
 
+// Wipe overwrites the value of s with zero, for a scalar holding a secret
+// that should not linger in memory. Copies made from s earlier, such as by
+// BigInt or arithmetic, are not affected.
+func (s *Scalar) Wipe() {
+	if s == nil || s.n == nil {
+		return
+	}
+	clear(s.n.Bits())
+	s.n.SetInt64(0)
+}
+
+// String returns the decimal representation of s.
+func (s *Scalar) String() string {
+	if s == nil || s.n == nil {
+		return "<nil>"
+	}
+	return s.n.String()
+}
+
+// Add, Sub, Mul, Neg and Select work on fixed-width field.FqElement limbs and
+// run in constant time, so signing can combine the nonce and the private key
+// without timing leaks. Only the conversions to and from the big.Int held by
+// Scalar depend on the values' byte lengths.
+
+// element returns s as four Montgomery limbs.
+func (s *Scalar) element() *field.FqElement {
+	return new(field.FqElement).SetBigInt(s.n)
+}
+
+func fromElement(e *field.FqElement) *Scalar {
+	return &Scalar{n: e.BigInt()}
+}
+
 func (s *Scalar) Add(y *Scalar) *Scalar {
-	return &Scalar{n: field.Mod(new(big.Int).Add(s.n, y.n), Q)}
+	e := s.element()
+	return fromElement(e.Add(e, y.element()))
 }
 func (s *Scalar) Sub(y *Scalar) *Scalar {
-	return &Scalar{n: field.Mod(new(big.Int).Sub(s.n, y.n), Q)}
+	e := s.element()
+	return fromElement(e.Sub(e, y.element()))
 }
 func (s *Scalar) Mul(y *Scalar) *Scalar {
-	return &Scalar{n: field.Mod(new(big.Int).Mul(s.n, y.n), Q)}
+	e := s.element()
+	return fromElement(e.Mul(e, y.element()))
 }
 func (s *Scalar) Neg() *Scalar {
-	return &Scalar{n: field.Mod(new(big.Int).Neg(s.n), Q)}
+	e := s.element()
+	return fromElement(e.Neg(e))
+}
+
+// Select returns a if flag == 1 and b if flag == 0. flag must be 0 or 1.
+func Select(flag int, a, b *Scalar) *Scalar {
+	var e field.FqElement
+	return fromElement(e.Select(flag, a.element(), b.element()))
 }
 func (s *Scalar) Div(y *Scalar) (*Scalar, error) {
 	yInv := new(big.Int).ModInverse(y.n, Q)
 	if yInv == nil {
-		return nil, errors.New("division by zero or not invertible")
+		return nil, errcode.New(errcode.NotInvertible, "division by zero or not invertible")
 	}
 	return &Scalar{n: field.Mod(new(big.Int).Mul(s.n, yInv), Q)}, nil
 }
 
+// ScalarFromBytes interprets bs as a little-endian integer of any length and
+// reduces it modulo q.
+//
+// Deprecated: the name does not say that the input is read little-endian.
+// Use ScalarFromBytesLE, which behaves identically, or ScalarFromBytesBE; use
+// FromBytesLE or FromBytesBE to decode a canonical encoding.
 func ScalarFromBytes(bs []byte) *Scalar {
+	return ScalarFromBytesLE(bs)
+}
 
+// ScalarFromBytesLE interprets bs as a little-endian integer of any length and
+// reduces it modulo q. It suits hash outputs; use FromBytesLE for encodings
+// that must be canonical.
+func ScalarFromBytesLE(bs []byte) *Scalar {
 	rev := make([]byte, len(bs))
 	for i, b := range bs {
 		rev[len(bs)-1-i] = b
 	}
-	n := new(big.Int).SetBytes(rev)
+	return ScalarFromBytesBE(rev)
+}
+
+// ScalarFromBytesBE interprets bs as a big-endian integer of any length and
+// reduces it modulo q. Use FromBytesBE for encodings that must be canonical.
+func ScalarFromBytesBE(bs []byte) *Scalar {
+	n := new(big.Int).SetBytes(bs)
 	return &Scalar{n: field.Mod(n, Q)}
 }
 
+// Bytes returns the minimal big-endian encoding of s.
+//
+// Deprecated: the output is variable-length and big-endian, so it does not
+// round-trip with ScalarFromBytes, which reads little-endian. Use BytesLE or
+// BytesBE with FromBytesLE or FromBytesBE.
 func (s *Scalar) Bytes() []byte {
 	return s.n.Bytes()
 }
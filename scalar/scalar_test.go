@@ -0,0 +1,229 @@
+package scalar
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+func TestShiftMatchesCurve(t *testing.T) {
+	pallas := curve.Pallas()
+	qMinusOne := new(big.Int).Sub(Q, big.NewInt(1))
+	for _, v := range []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), qMinusOne, shift} {
+		s := NewScalar(v)
+		shifted := s.Shift()
+		if want := pallas.ShiftScalar(v); shifted.BigInt().Cmp(want) != 0 {
+			t.Errorf("Shift(%s) = %s, want %s", v, shifted.BigInt(), want)
+		}
+		if back := shifted.Unshift(); back.BigInt().Cmp(s.BigInt()) != 0 {
+			t.Errorf("Unshift(Shift(%s)) = %s", v, back.BigInt())
+		}
+		if want := pallas.UnshiftScalar(v); s.Unshift().BigInt().Cmp(want) != 0 {
+			t.Errorf("Unshift(%s) = %s, want %s", v, s.Unshift().BigInt(), want)
+		}
+	}
+	// The shifted value of 2^255 + 1 is zero.
+	if NewScalar(shift).Shift().BigInt().Sign() != 0 {
+		t.Error("Shift(2^255 + 1) is not zero")
+	}
+}
+
+func TestShiftedScalarMultiplication(t *testing.T) {
+	pallas := curve.Pallas()
+	s := NewScalar(int64(123456789))
+	got, err := pallas.ScaleShifted(pallas.One, s.Shift().BigInt())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := pallas.Scale(pallas.One, s.BigInt())
+	if a, b := pallas.ToAffine(got), pallas.ToAffine(want); a.X.Cmp(b.X) != 0 || a.Y.Cmp(b.Y) != 0 {
+		t.Error("ScaleShifted(Shift(s)) != s·G")
+	}
+}
+
+func TestConstantTimeArithmetic(t *testing.T) {
+	qMinusOne := new(big.Int).Sub(Q, big.NewInt(1))
+	values := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), qMinusOne, new(big.Int).Rsh(Q, 1), shift}
+	mod := func(x *big.Int) *big.Int { return new(big.Int).Mod(x, Q) }
+	for _, a := range values {
+		for _, b := range values {
+			x, y := NewScalar(a), NewScalar(b)
+			for _, c := range []struct {
+				op        string
+				got, want *big.Int
+			}{
+				{"Add", x.Add(y).BigInt(), mod(new(big.Int).Add(a, b))},
+				{"Sub", x.Sub(y).BigInt(), mod(new(big.Int).Sub(a, b))},
+				{"Mul", x.Mul(y).BigInt(), mod(new(big.Int).Mul(a, b))},
+				{"Neg", x.Neg().BigInt(), mod(new(big.Int).Neg(a))},
+				{"Select(1)", Select(1, x, y).BigInt(), a},
+				{"Select(0)", Select(0, x, y).BigInt(), b},
+			} {
+				if c.got.Cmp(c.want) != 0 {
+					t.Errorf("%s(%s, %s) = %s, want %s", c.op, a, b, c.got, c.want)
+				}
+			}
+		}
+	}
+}
+
+func TestBase58RoundTrip(t *testing.T) {
+	qMinusOne := new(big.Int).Sub(Q, big.NewInt(1))
+	for _, v := range []*big.Int{big.NewInt(0), big.NewInt(1), qMinusOne} {
+		s := NewScalar(v)
+		enc := s.ToBase58()
+		if len(enc) != 52 || enc[:2] != "EK" {
+			t.Errorf("ToBase58(%s) = %q, want 52 characters starting with EK", v, enc)
+		}
+		dec, err := FromBase58(enc)
+		if err != nil || dec.BigInt().Cmp(v) != 0 {
+			t.Errorf("FromBase58(ToBase58(%s)) = %v, %v", v, dec, err)
+		}
+	}
+
+	enc := []byte(NewScalar(int64(42)).ToBase58())
+	enc[10]++
+	if _, err := FromBase58(string(enc)); err == nil {
+		t.Error("FromBase58 accepted a corrupted string")
+	}
+	if _, err := FromBase58("EK"); err == nil {
+		t.Error("FromBase58 accepted a truncated string")
+	}
+}
+
+func TestFixedWidthBytes(t *testing.T) {
+	qMinusOne := new(big.Int).Sub(Q, big.NewInt(1))
+	for _, v := range []*big.Int{big.NewInt(0), big.NewInt(258), qMinusOne} {
+		s := NewScalar(v)
+		le, be := s.BytesLE(), s.BytesBE()
+		if len(le) != Size || len(be) != Size {
+			t.Fatalf("encodings of %s have lengths %d and %d", v, len(le), len(be))
+		}
+		for i := range le {
+			if le[i] != be[Size-1-i] {
+				t.Fatalf("BytesLE(%s) is not BytesBE reversed", v)
+			}
+		}
+		if got, err := FromBytesLE(le); err != nil || got.BigInt().Cmp(v) != 0 {
+			t.Errorf("FromBytesLE(BytesLE(%s)) = %v, %v", v, got, err)
+		}
+		if got, err := FromBytesBE(be); err != nil || got.BigInt().Cmp(v) != 0 {
+			t.Errorf("FromBytesBE(BytesBE(%s)) = %v, %v", v, got, err)
+		}
+		// The reducing constructors agree on canonical encodings.
+		if got := ScalarFromBytesLE(le); got.BigInt().Cmp(v) != 0 {
+			t.Errorf("ScalarFromBytesLE(BytesLE(%s)) = %s", v, got.BigInt())
+		}
+		if got := ScalarFromBytesBE(be); got.BigInt().Cmp(v) != 0 {
+			t.Errorf("ScalarFromBytesBE(BytesBE(%s)) = %s", v, got.BigInt())
+		}
+	}
+	if le := NewScalar(258).BytesLE(); le[0] != 2 || le[1] != 1 {
+		t.Errorf("BytesLE(258) starts %x, want 0201", le[:2])
+	}
+
+	if _, err := FromBytesBE(Q.FillBytes(make([]byte, Size))); err == nil {
+		t.Error("FromBytesBE accepted q")
+	}
+	if _, err := FromBytesLE(make([]byte, Size-1)); err == nil {
+		t.Error("FromBytesLE accepted 31 bytes")
+	}
+}
+
+func TestNewScalarErr(t *testing.T) {
+	s, err := NewScalarErr("-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := new(big.Int).Sub(Q, big.NewInt(1)); s.BigInt().Cmp(want) != 0 {
+		t.Errorf("NewScalarErr(\"-1\") = %s, want %s", s.BigInt(), want)
+	}
+	for _, bad := range []any{"12a", "", "0x10", " 1", (*big.Int)(nil), (*Scalar)(nil), 1.5, []byte{1}} {
+		if _, err := NewScalarErr(bad); err == nil {
+			t.Errorf("NewScalarErr(%#v) succeeded", bad)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("NewScalar did not panic on a malformed string")
+		}
+	}()
+	NewScalar("not a number")
+}
+
+func TestDecompositions(t *testing.T) {
+	s := NewScalar("28948022309329048855892746252171976963363056481941647379679742748393362948096")
+	if back := ScalarFromBits(s.ToBits(BitSize)); back.BigInt().Cmp(s.BigInt()) != 0 {
+		t.Errorf("ScalarFromBits(ToBits) = %s, want %s", back, s)
+	}
+	if bits := NewScalar(5).ToBits(4); !bits[0] || bits[1] || !bits[2] || bits[3] {
+		t.Errorf("ToBits(5, 4) = %v", bits)
+	}
+	if windows := s.ToWindows(5); len(windows) != BitSize/5 {
+		t.Errorf("ToWindows(5) has %d digits, want %d", len(windows), BitSize/5)
+	}
+	for _, digits := range [][]int{s.NAF(), s.WNAF(5)} {
+		sum := new(big.Int)
+		for j := len(digits) - 1; j >= 0; j-- {
+			sum.Lsh(sum, 1)
+			sum.Add(sum, big.NewInt(int64(digits[j])))
+		}
+		if sum.Cmp(s.BigInt()) != 0 {
+			t.Errorf("recoding evaluates to %s, want %s", sum, s)
+		}
+	}
+}
+
+func TestInverseExpSquare(t *testing.T) {
+	s := NewScalar("123456789123456789123456789")
+	inv, err := s.Inverse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctInv, err := s.InverseConstantTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.BigInt().Cmp(ctInv.BigInt()) != 0 {
+		t.Errorf("Inverse = %s, InverseConstantTime = %s", inv, ctInv)
+	}
+	if one := s.Mul(inv); one.BigInt().Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("s * Inverse(s) = %s, want 1", one)
+	}
+	if sq, want := s.Square(), s.Mul(s); sq.BigInt().Cmp(want.BigInt()) != 0 {
+		t.Errorf("Square = %s, want %s", sq, want)
+	}
+	if cube, want := s.Exp(big.NewInt(3)), s.Mul(s).Mul(s); cube.BigInt().Cmp(want.BigInt()) != 0 {
+		t.Errorf("Exp(3) = %s, want %s", cube, want)
+	}
+	if got := s.Exp(big.NewInt(-1)); got.BigInt().Cmp(inv.BigInt()) != 0 {
+		t.Errorf("Exp(-1) = %s, want %s", got, inv)
+	}
+
+	zero := NewScalar(0)
+	if _, err := zero.Inverse(); !errors.Is(err, field.ErrNotInvertible) {
+		t.Errorf("Inverse(0) error = %v, want field.ErrNotInvertible", err)
+	}
+	if _, err := zero.InverseConstantTime(); !errors.Is(err, field.ErrNotInvertible) {
+		t.Errorf("InverseConstantTime(0) error = %v, want field.ErrNotInvertible", err)
+	}
+}
+
+func TestScalarFromBytesReduces(t *testing.T) {
+	qPlusOne := new(big.Int).Add(Q, big.NewInt(1)).FillBytes(make([]byte, Size))
+	if got := ScalarFromBytesBE(qPlusOne); got.BigInt().Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("ScalarFromBytesBE(q+1) = %s, want 1", got)
+	}
+	// Inputs longer than Size are accepted, as for hash outputs.
+	wide := make([]byte, 64)
+	wide[0] = 5
+	if got := ScalarFromBytesLE(wide); got.BigInt().Cmp(big.NewInt(5)) != 0 {
+		t.Errorf("ScalarFromBytesLE(wide) = %s, want 5", got)
+	}
+	if got := ScalarFromBytes(wide); got.BigInt().Cmp(big.NewInt(5)) != 0 {
+		t.Errorf("ScalarFromBytes(wide) = %s, want 5", got)
+	}
+}
@@ -0,0 +1,34 @@
+package scalar
+
+import (
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// o1js hands scalars to in-circuit scalar multiplication in shifted form: the
+// circuit computes (2t + 2^255 + 1)·P from a value t, so a scalar s is passed
+// as t = (s - 2^255 - 1) / 2 mod q. Shift and Unshift convert between the two
+// so values can cross between this package and circuit code unambiguously;
+// they agree with curve.ShiftScalar and curve.UnshiftScalar on Pallas.
+
+var (
+	// shift is 2^255 + 1 mod q.
+	shift = field.Mod(new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1)), Q)
+	// oneHalf is 1/2 mod q.
+	oneHalf = new(big.Int).ModInverse(big.NewInt(2), Q)
+)
+
+// Shift returns the shifted representation t of s, with s = 2t + 2^255 + 1
+// mod q.
+func (s *Scalar) Shift() *Scalar {
+	t := new(big.Int).Sub(s.n, shift)
+	return &Scalar{n: field.Mod(t.Mul(t, oneHalf), Q)}
+}
+
+// Unshift returns the scalar 2t + 2^255 + 1 mod q represented by the shifted
+// value t held in s.
+func (s *Scalar) Unshift() *Scalar {
+	t := new(big.Int).Lsh(s.n, 1)
+	return &Scalar{n: field.Mod(t.Add(t, shift), Q)}
+}
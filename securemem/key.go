@@ -0,0 +1,76 @@
+package securemem
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/keys"
+)
+
+// Key is a private key stored in a frozen Buffer. Only its public key is
+// kept on the heap.
+//
+// Signing still needs the key as a keys.PrivateKey, whose arithmetic runs
+// on heap integers; With decodes such a copy for the duration of one call
+// and drops it afterwards. Locked storage shortens the time the key spends
+// in ordinary memory to those calls and keeps it out of swap between them;
+// it does not make the arithmetic itself leak-free.
+//
+// A Key is safe for concurrent use: Destroy waits for the calls to With in
+// progress, and later calls fail with ErrDestroyed.
+type Key struct {
+	// mu is held for reading by With and for writing by Destroy, so the
+	// buffer is not released while it is read.
+	mu  sync.RWMutex
+	buf *Buffer
+	pub keys.PublicKey
+}
+
+// NewKey copies sk into locked memory. The caller should drop its own
+// copy of sk afterwards.
+func NewKey(sk keys.PrivateKey) (*Key, error) {
+	encoded, err := sk.MarshalBytes()
+	if err != nil {
+		return nil, fmt.Errorf("securemem: %w", err)
+	}
+	defer clear(encoded)
+	buf, err := New(keys.PrivateKeyByteSize)
+	if err != nil {
+		return nil, err
+	}
+	copy(buf.Bytes(), encoded)
+	if err := buf.Freeze(); err != nil {
+		buf.Destroy()
+		return nil, err
+	}
+	return &Key{buf: buf, pub: sk.ToPublicKey()}, nil
+}
+
+// PublicKey returns the public key of k.
+func (k *Key) PublicKey() keys.PublicKey {
+	return k.pub
+}
+
+// With calls f with a copy of the private key, which it wipes when f
+// returns. f must not retain it. With returns ErrDestroyed after Destroy.
+func (k *Key) With(f func(keys.PrivateKey) error) error {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if err := k.buf.Check(); err != nil {
+		return err
+	}
+	var sk keys.PrivateKey
+	if err := sk.UnmarshalBytes(k.buf.Bytes()); err != nil {
+		return fmt.Errorf("securemem: %w", err)
+	}
+	defer sk.Value.Wipe()
+	return f(sk)
+}
+
+// Destroy wipes and releases the key once no call to With is in progress.
+// See Buffer.Destroy.
+func (k *Key) Destroy() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.buf.Destroy()
+}
@@ -0,0 +1,9 @@
+//go:build !unix || tinygo
+
+package securemem
+
+func alloc(int) (*Buffer, error) { return nil, ErrUnsupported }
+
+func protect([]byte, bool) error { return ErrUnsupported }
+
+func free(*Buffer) error { return ErrUnsupported }
@@ -0,0 +1,58 @@
+//go:build unix && !tinygo
+
+package securemem
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// alloc maps a Buffer with size bytes of data between two guard pages.
+func alloc(size int) (*Buffer, error) {
+	page := os.Getpagesize()
+	innerLen := (size + page - 1) / page * page
+	region, err := unix.Mmap(-1, 0, innerLen+2*page, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANON)
+	if err != nil {
+		return nil, errcode.Errorf(errcode.Internal, "securemem: mmap: %w", err)
+	}
+	b := &Buffer{region: region, inner: region[page : page+innerLen]}
+	b.data = b.inner[innerLen-size:]
+	if err := unix.Mprotect(region[:page], unix.PROT_NONE); err != nil {
+		unix.Munmap(region)
+		return nil, errcode.Errorf(errcode.Internal, "securemem: guard page: %w", err)
+	}
+	if err := unix.Mprotect(region[page+innerLen:], unix.PROT_NONE); err != nil {
+		unix.Munmap(region)
+		return nil, errcode.Errorf(errcode.Internal, "securemem: guard page: %w", err)
+	}
+	if err := unix.Mlock(b.inner); err != nil {
+		unix.Munmap(region)
+		return nil, errcode.Errorf(errcode.Internal, "securemem: mlock: %w", err)
+	}
+	return b, nil
+}
+
+func protect(inner []byte, writable bool) error {
+	prot := unix.PROT_READ
+	if writable {
+		prot |= unix.PROT_WRITE
+	}
+	if err := unix.Mprotect(inner, prot); err != nil {
+		return errcode.Errorf(errcode.Internal, "securemem: mprotect: %w", err)
+	}
+	return nil
+}
+
+func free(b *Buffer) error {
+	if err := unix.Munlock(b.inner); err != nil {
+		unix.Munmap(b.region)
+		return errcode.Errorf(errcode.Internal, "securemem: munlock: %w", err)
+	}
+	if err := unix.Munmap(b.region); err != nil {
+		return errcode.Errorf(errcode.Internal, "securemem: munmap: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Package securemem keeps secrets in memory outside the Go heap, in the
+// manner of memguard, for deployments whose key-handling rules forbid
+// private keys in ordinary process memory.
+//
+// A Buffer is a separate mapping laid out as
+//
+//	| guard page | canary ... | data | guard page |
+//
+// The data pages are locked with mlock so they are never written to swap.
+// The guard pages are inaccessible, so a linear overflow or underflow
+// faults instead of reading or corrupting a neighbouring allocation. The
+// data ends at the upper guard page, and the space before it is filled with
+// a random canary that Destroy checks, so a write that stays inside the
+// mapping is detected. Destroy wipes the mapping before releasing it.
+//
+// Buffers are only supported on Unix systems with the standard toolchain;
+// elsewhere New returns ErrUnsupported.
+package securemem
+
+import (
+	"crypto/rand"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+var (
+	// ErrUnsupported is returned by New on platforms without locked
+	// memory.
+	ErrUnsupported = errcode.New(errcode.Unsupported, "securemem: locked memory is not supported on this platform")
+	// ErrCanary is returned by Check and Destroy when the canary before
+	// the data was overwritten.
+	ErrCanary = errcode.New(errcode.Internal, "securemem: buffer canary overwritten")
+	// ErrDestroyed is returned when a destroyed Buffer is used.
+	ErrDestroyed = errcode.New(errcode.InvalidState, "securemem: buffer destroyed")
+)
+
+// canary is the process-wide random value repeated before the data of
+// every Buffer.
+var canary = sync.OnceValue(func() [32]byte {
+	var c [32]byte
+	if _, err := rand.Read(c[:]); err != nil {
+		panic("securemem: cannot draw the canary: " + err.Error())
+	}
+	return c
+})
+
+// Buffer is a fixed-size secret in locked memory. It is not safe for
+// concurrent use.
+type Buffer struct {
+	// region is the whole mapping, guard pages included.
+	region []byte
+	// inner is the locked part between the guard pages; data is its tail.
+	inner []byte
+	data  []byte
+}
+
+// New allocates a zeroed Buffer of size bytes.
+func New(size int) (*Buffer, error) {
+	if size <= 0 {
+		return nil, errcode.New(errcode.InvalidArgument, "securemem: size must be positive")
+	}
+	b, err := alloc(size)
+	if err != nil {
+		return nil, err
+	}
+	c := canary()
+	prefix := b.inner[:len(b.inner)-size]
+	for i := range prefix {
+		prefix[i] = c[i%len(c)]
+	}
+	return b, nil
+}
+
+// Bytes returns the data of b. The slice aliases locked memory and is
+// invalid after Destroy; do not copy it to the heap.
+func (b *Buffer) Bytes() []byte {
+	return b.data
+}
+
+// Check returns ErrCanary if the canary was overwritten and ErrDestroyed
+// if b was destroyed.
+func (b *Buffer) Check() error {
+	if b.region == nil {
+		return ErrDestroyed
+	}
+	c := canary()
+	prefix := b.inner[:len(b.inner)-len(b.data)]
+	var diff byte
+	for i := range prefix {
+		diff |= prefix[i] ^ c[i%len(c)]
+	}
+	if diff != 0 {
+		return ErrCanary
+	}
+	return nil
+}
+
+// Freeze makes b read-only until Melt, so stray writes fault.
+func (b *Buffer) Freeze() error {
+	if b.region == nil {
+		return ErrDestroyed
+	}
+	return protect(b.inner, false)
+}
+
+// Melt makes a frozen b writable again.
+func (b *Buffer) Melt() error {
+	if b.region == nil {
+		return ErrDestroyed
+	}
+	return protect(b.inner, true)
+}
+
+// Destroy checks the canary, wipes b and releases its memory. It returns
+// ErrCanary if the canary was overwritten; the memory is released either
+// way. Destroying a Buffer twice is a no-op.
+func (b *Buffer) Destroy() error {
+	if b.region == nil {
+		return nil
+	}
+	if err := protect(b.inner, true); err != nil {
+		return err
+	}
+	err := b.Check()
+	clear(b.inner)
+	if ferr := free(b); err == nil {
+		err = ferr
+	}
+	b.region, b.inner, b.data = nil, nil, nil
+	return err
+}
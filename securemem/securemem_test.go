@@ -0,0 +1,140 @@
+//go:build unix && !tinygo
+
+package securemem
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+func TestBuffer(t *testing.T) {
+	b, err := New(40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := b.Bytes()
+	if len(data) != 40 {
+		t.Fatalf("len(Bytes()) = %d, want 40", len(data))
+	}
+	for i := range data {
+		data[i] = byte(i)
+	}
+	if err := b.Freeze(); err != nil {
+		t.Fatal(err)
+	}
+	if data[39] != 39 {
+		t.Error("frozen buffer lost its data")
+	}
+	if err := b.Melt(); err != nil {
+		t.Fatal(err)
+	}
+	data[0] = 0xff
+	if err := b.Check(); err != nil {
+		t.Errorf("Check: %v", err)
+	}
+	if err := b.Destroy(); err != nil {
+		t.Errorf("Destroy: %v", err)
+	}
+	if err := b.Destroy(); err != nil {
+		t.Errorf("second Destroy: %v", err)
+	}
+	if err := b.Check(); !errors.Is(err, ErrDestroyed) {
+		t.Errorf("Check after Destroy = %v, want ErrDestroyed", err)
+	}
+}
+
+func TestBufferCanary(t *testing.T) {
+	b, err := New(32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// An underflow from the data into the canary.
+	b.inner[len(b.inner)-33] ^= 1
+	if err := b.Check(); !errors.Is(err, ErrCanary) {
+		t.Errorf("Check = %v, want ErrCanary", err)
+	}
+	if err := b.Destroy(); !errors.Is(err, ErrCanary) {
+		t.Errorf("Destroy = %v, want ErrCanary", err)
+	}
+}
+
+func TestKey(t *testing.T) {
+	sk := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(123456789))}
+	k, err := NewKey(sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer k.Destroy()
+	if pub := sk.ToPublicKey(); !pub.Equal(k.PublicKey()) {
+		t.Error("PublicKey does not match the stored key")
+	}
+	message := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(1)}}
+	want, err := sk.Sign(message, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = k.With(func(stored keys.PrivateKey) error {
+		got, err := stored.Sign(message, "testnet")
+		if err != nil {
+			return err
+		}
+		if got.R.BigInt().Cmp(want.R.BigInt()) != 0 || got.S.BigInt().Cmp(want.S.BigInt()) != 0 {
+			t.Error("stored key signs differently")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seen keys.PrivateKey
+	if err := k.With(func(stored keys.PrivateKey) error { seen = stored; return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if seen.Value.BigInt().Sign() != 0 {
+		t.Error("With left the decoded key in memory")
+	}
+	if _, err := NewKey(keys.PrivateKey{}); !errors.Is(err, keys.ErrNilKey) {
+		t.Errorf("NewKey(unset) = %v, want ErrNilKey", err)
+	}
+}
+
+func TestKeyDestroyWhileInUse(t *testing.T) {
+	k, err := NewKey(keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(42))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entered, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- k.With(func(keys.PrivateKey) error {
+			close(entered)
+			<-release
+			return nil
+		})
+	}()
+	<-entered
+	destroyed := make(chan error)
+	go func() { destroyed <- k.Destroy() }()
+	// Give Destroy ample time to run; it must still be waiting for With.
+	select {
+	case <-destroyed:
+		t.Fatal("Destroy released the key while With was using it")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("With: %v", err)
+	}
+	if err := <-destroyed; err != nil {
+		t.Errorf("Destroy: %v", err)
+	}
+	if err := k.With(func(keys.PrivateKey) error { return nil }); !errors.Is(err, ErrDestroyed) {
+		t.Errorf("With after Destroy = %v, want ErrDestroyed", err)
+	}
+}
@@ -0,0 +1,62 @@
+package signature
+
+import (
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/base58check"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+const (
+	// Base58Version is the base58check version byte of Mina signatures.
+	Base58Version byte = 0x9a
+	// base58Binable is the version number of the binable (r, s) record that
+	// follows the version byte; r and s are 32 little-endian bytes each.
+	base58Binable byte = 0x01
+)
+
+// ToBase58 returns the base58check encoding of sig used by o1js and the
+// mina-signer signFields output.
+func (sig *Signature) ToBase58() (string, error) {
+	if !sig.isSet() {
+		return "", fmt.Errorf("cannot encode Signature: %w", ErrNilSignature)
+	}
+	payload := make([]byte, 1+TotalSignatureSize)
+	payload[0] = base58Binable
+	r := sig.R.BigInt().FillBytes(make([]byte, BigIntSize))
+	for i, b := range r {
+		payload[BigIntSize-i] = b
+	}
+	copy(payload[1+BigIntSize:], sig.S.BytesLE())
+	return base58check.Encode(Base58Version, payload), nil
+}
+
+// FromBase58 decodes a signature produced by ToBase58, rejecting
+// non-canonical R or S like UnmarshalBytes.
+func FromBase58(s string) (*Signature, error) {
+	payload, err := base58check.Decode(s, Base58Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Signature: %w", err)
+	}
+	if len(payload) != 1+TotalSignatureSize {
+		return nil, fmt.Errorf("invalid Signature: %w: unexpected payload of %d bytes", field.ErrInvalidLength, len(payload))
+	}
+	if payload[0] != base58Binable {
+		return nil, errcode.Errorf(errcode.InvalidVersion, "invalid Signature: unexpected binable version 0x%02x", payload[0])
+	}
+	r := make([]byte, BigIntSize)
+	for i, b := range payload[1 : 1+BigIntSize] {
+		r[BigIntSize-1-i] = b
+	}
+	rElem, err := new(field.FpElement).SetBytesCanonical(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Signature.R: %w", err)
+	}
+	sScalar, err := scalar.FromBytesLE(payload[1+BigIntSize:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid Signature.S: %w", err)
+	}
+	return &Signature{R: rElem, S: sScalar}, nil
+}
@@ -0,0 +1,47 @@
+package signature_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+func FuzzUnmarshalBytes(f *testing.F) {
+	f.Add(make([]byte, signature.TotalSignatureSize))
+	f.Add(bytes.Repeat([]byte{0xff}, signature.TotalSignatureSize))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var sig signature.Signature
+		if err := sig.UnmarshalBytes(data); err != nil {
+			return
+		}
+		out, err := sig.MarshalBytes()
+		if err != nil || !bytes.Equal(out, data) {
+			t.Fatalf("round trip: %x, %v", out, err)
+		}
+	})
+}
+
+func FuzzFromBase58(f *testing.F) {
+	seed, err := signature.New(big.NewInt(1), big.NewInt(2))
+	if err != nil {
+		f.Fatal(err)
+	}
+	encoded, err := seed.ToBase58()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(encoded)
+	f.Add("")
+	f.Fuzz(func(t *testing.T, s string) {
+		sig, err := signature.FromBase58(s)
+		if err != nil {
+			return
+		}
+		out, err := sig.ToBase58()
+		if err != nil || out != s {
+			t.Fatalf("round trip: %q, %v", out, err)
+		}
+	})
+}
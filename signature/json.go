@@ -0,0 +1,49 @@
+//go:build !tinygo && !minasigner_core
+
+package signature
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/node101-io/mina-signer-go/field"
+)
+
+// signatureJSON is the {field, scalar} object mina-signer uses for
+// signatures, with both values as decimal strings.
+type signatureJSON struct {
+	Field  string `json:"field"`
+	Scalar string `json:"scalar"`
+}
+
+// MarshalJSON encodes sig as {"field": R, "scalar": S}.
+func (sig Signature) MarshalJSON() ([]byte, error) {
+	if !sig.isSet() {
+		return nil, fmt.Errorf("cannot marshal Signature: %w", ErrNilSignature)
+	}
+	return json.Marshal(signatureJSON{Field: sig.R.String(), Scalar: sig.S.String()})
+}
+
+// UnmarshalJSON decodes the {field, scalar} form, rejecting values that are
+// not below their moduli and, under strict decoding, anything but the form
+// MarshalJSON writes.
+func (sig *Signature) UnmarshalJSON(data []byte) error {
+	var raw signatureJSON
+	if err := field.DecodeJSONObject(data, &raw); err != nil {
+		return err
+	}
+	r, err := field.Fp.FromString(raw.Field, 10)
+	if err != nil {
+		return fmt.Errorf("invalid Signature.R: %w", err)
+	}
+	s, err := field.Fq.FromString(raw.Scalar, 10)
+	if err != nil {
+		return fmt.Errorf("invalid Signature.S: %w", err)
+	}
+	decoded, err := New(r, s)
+	if err != nil {
+		return err
+	}
+	*sig = *decoded
+	return nil
+}
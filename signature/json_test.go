@@ -0,0 +1,47 @@
+//go:build !tinygo && !minasigner_core
+
+package signature_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+func TestJSON(t *testing.T) {
+	sig, err := signature.New(big.NewInt(12345), new(big.Int).Sub(field.Q, big.NewInt(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"field":"12345","scalar":"` + sig.S.String() + `"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+	var fromJSON signature.Signature
+	if err := json.Unmarshal([]byte(`{"field":"1","scalar":"`+field.Q.String()+`"}`), &fromJSON); !errors.Is(err, field.ErrNonCanonical) {
+		t.Errorf("UnmarshalJSON(s = q) error = %v, want field.ErrNonCanonical", err)
+	}
+}
+
+func FuzzUnmarshalJSON(f *testing.F) {
+	f.Add([]byte(`{"field":"1","scalar":"2"}`))
+	f.Add([]byte(`{"field":"-1","scalar":""}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var sig signature.Signature
+		if err := json.Unmarshal(data, &sig); err != nil {
+			return
+		}
+		if _, err := json.Marshal(sig); err != nil {
+			t.Fatalf("decoded signature does not encode: %v", err)
+		}
+	})
+}
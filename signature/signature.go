@@ -3,6 +3,10 @@ package signature
 import (
 	"fmt"
 	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/scalar"
 )
 
 const (
@@ -13,51 +17,75 @@ const (
 	TotalSignatureSize = BigIntSize * 2
 )
 
+// ErrNilSignature is returned when a signature or one of its components is
+// nil.
+var ErrNilSignature = errcode.New(errcode.MissingValue, "signature: R or S is nil")
+
+// Signature is a Schnorr signature over Pallas. R is a base field element and
+// S a scalar; the distinct types keep the two fields from being mixed up, and
+// both expose BigInt for callers that work with integers.
 type Signature struct {
-	R *big.Int // Field element
-	S *big.Int // Scalar
+	R *field.FpElement // x-coordinate of the nonce commitment
+	S *scalar.Scalar
+}
+
+// New returns the signature (r, s). Both values must already be canonical:
+// r below the base field modulus and s below the scalar field modulus.
+func New(r, s *big.Int) (*Signature, error) {
+	if r == nil || s == nil {
+		return nil, fmt.Errorf("cannot create Signature: %w", ErrNilSignature)
+	}
+	if !field.Fp.IsCanonical(r) {
+		return nil, fmt.Errorf("invalid Signature.R: %w: %s", field.ErrNonCanonical, r)
+	}
+	if !field.Fq.IsCanonical(s) {
+		return nil, fmt.Errorf("invalid Signature.S: %w: %s", field.ErrNonCanonical, s)
+	}
+	return &Signature{R: new(field.FpElement).SetBigInt(r), S: scalar.NewScalar(s)}, nil
 }
 
 // MarshalBytes serializes the Signature into a byte slice.
 // The format is [R (32 bytes)][S (32 bytes)], totaling 64 bytes.
 func (sig *Signature) MarshalBytes() ([]byte, error) {
-	if sig == nil || sig.R == nil || sig.S == nil {
-		return nil, fmt.Errorf("cannot marshal Signature: R or S is nil")
+	if !sig.isSet() {
+		return nil, fmt.Errorf("cannot marshal Signature: %w", ErrNilSignature)
 	}
 
 	out := make([]byte, TotalSignatureSize)
-
-	rBytes := sig.R.Bytes()
-	if len(rBytes) > BigIntSize {
-		return nil, fmt.Errorf("Signature.R is too large: got %d bytes, max %d bytes", len(rBytes), BigIntSize)
-	}
-	copy(out[BigIntSize-len(rBytes):BigIntSize], rBytes) // Left-pad R
-
-	sBytes := sig.S.Bytes()
-	if len(sBytes) > BigIntSize {
-		return nil, fmt.Errorf("Signature.S is too large: got %d bytes, max %d bytes", len(sBytes), BigIntSize)
-	}
-	copy(out[BigIntSize+(BigIntSize-len(sBytes)):], sBytes) // Left-pad S into the second half
+	sig.R.BigInt().FillBytes(out[:BigIntSize])
+	copy(out[BigIntSize:], sig.S.BytesBE())
 
 	return out, nil
 }
 
 // UnmarshalBytes deserializes data into the Signature.
-// data is expected to be TotalSignatureSize (64) bytes long.
+// data is expected to be TotalSignatureSize (64) bytes long, with R below the
+// base field modulus and S below the scalar field modulus. Larger values are
+// rejected rather than reduced so that each signature has one encoding.
 func (sig *Signature) UnmarshalBytes(data []byte) error {
 	if len(data) != TotalSignatureSize {
-		return fmt.Errorf("invalid data length for Signature: expected %d bytes, got %d bytes", TotalSignatureSize, len(data))
+		return fmt.Errorf("invalid data length for Signature: %w: expected %d bytes, got %d bytes", field.ErrInvalidLength, TotalSignatureSize, len(data))
 	}
 
-	if sig.R == nil {
-		sig.R = new(big.Int)
+	r, err := new(field.FpElement).SetBytesCanonical(data[0:BigIntSize])
+	if err != nil {
+		return fmt.Errorf("invalid Signature.R: %w", err)
 	}
-	sig.R.SetBytes(data[0:BigIntSize])
-
-	if sig.S == nil {
-		sig.S = new(big.Int)
+	s, err := scalar.FromBytesBE(data[BigIntSize:])
+	if err != nil {
+		return fmt.Errorf("invalid Signature.S: %w", err)
 	}
-	sig.S.SetBytes(data[BigIntSize:])
-
+	sig.R, sig.S = r, s
 	return nil
 }
+
+// IsCanonical reports whether R and S are both set. The field and scalar
+// types only hold reduced values, so a set signature is always canonical;
+// out-of-range encodings are rejected when decoding.
+func (sig *Signature) IsCanonical() bool {
+	return sig.isSet()
+}
+
+func (sig *Signature) isSet() bool {
+	return sig != nil && sig.R != nil && sig.S != nil && sig.S.BigInt() != nil
+}
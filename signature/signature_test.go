@@ -2,14 +2,17 @@ package signature_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/rand"
 	"os"
 	"testing"
 
+	"github.com/node101-io/mina-signer-go/field"
 	"github.com/node101-io/mina-signer-go/keys"
 	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
 	"github.com/node101-io/mina-signer-go/signature"
 )
 
@@ -73,9 +76,14 @@ func TestSignaturesFromJSON(t *testing.T) {
 			continue
 		}
 
-		expectedSignature := &signature.Signature{R: r, S: s}
+		expectedSignature, err := signature.New(r, s)
+		if err != nil {
+			t.Errorf("Case %d: invalid signature: %v", i, err)
+			failed++
+			continue
+		}
 
-		privateKey := keys.PrivateKey{Value: priv}
+		privateKey := keys.PrivateKey{Value: scalar.NewScalar(priv)}
 		pubKey := privateKey.ToPublicKey()
 
 		msgInput := poseidonbigint.HashInput{
@@ -90,7 +98,7 @@ func TestSignaturesFromJSON(t *testing.T) {
 			continue
 		}
 
-		if derivedSignature.R.Cmp(expectedSignature.R) != 0 || derivedSignature.S.Cmp(expectedSignature.S) != 0 {
+		if !derivedSignature.R.Equal(expectedSignature.R) || derivedSignature.S.BigInt().Cmp(expectedSignature.S.BigInt()) != 0 {
 			t.Errorf("Case %d: Signature mismatch\nExpected: (R: %s, S: %s)\nGot: (R: %s, S: %s)\nPriv: %s\nMsg: %v",
 				i, expectedSignature.R, expectedSignature.S, derivedSignature.R, derivedSignature.S, priv, tc.Message)
 			failed++
@@ -154,13 +162,18 @@ func TestInvalidSignature(t *testing.T) {
 			continue
 		}
 
-		validSignature := &signature.Signature{R: r, S: s}
+		validSignature, err := signature.New(r, s)
+		if err != nil {
+			t.Errorf("Case %d: invalid signature: %v", i, err)
+			failed++
+			continue
+		}
 
-		privateKey := keys.PrivateKey{Value: priv}
+		privateKey := keys.PrivateKey{Value: scalar.NewScalar(priv)}
 		pubKey := privateKey.ToPublicKey()
 
 		intruderVal := new(big.Int).Add(priv, big.NewInt(1))
-		intruderPrivateKey := keys.PrivateKey{Value: intruderVal}
+		intruderPrivateKey := keys.PrivateKey{Value: scalar.NewScalar(intruderVal)}
 		intruderPubKey := intruderPrivateKey.ToPublicKey()
 
 		corruptedMsg := make([]*big.Int, len(msg))
@@ -187,3 +200,52 @@ func TestInvalidSignature(t *testing.T) {
 		t.Logf("Total failed cases in TestInvalidSignature: %d/%d", failed, len(testCases))
 	}
 }
+
+func TestUnmarshalBytesRejectsNonCanonical(t *testing.T) {
+	valid, err := signature.New(big.NewInt(1), big.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := valid.MarshalBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded signature.Signature
+	if err := decoded.UnmarshalBytes(data); err != nil || !decoded.IsCanonical() {
+		t.Fatalf("UnmarshalBytes(valid) = %v", err)
+	}
+
+	for name, values := range map[string][2]*big.Int{
+		"R = p": {field.P, big.NewInt(2)},
+		"S = q": {big.NewInt(1), field.Q},
+	} {
+		if _, err := signature.New(values[0], values[1]); !errors.Is(err, field.ErrNonCanonical) {
+			t.Errorf("New(%s) error = %v, want field.ErrNonCanonical", name, err)
+		}
+		data := make([]byte, signature.TotalSignatureSize)
+		values[0].FillBytes(data[:signature.BigIntSize])
+		values[1].FillBytes(data[signature.BigIntSize:])
+		var out signature.Signature
+		if err := out.UnmarshalBytes(data); !errors.Is(err, field.ErrNonCanonical) {
+			t.Errorf("UnmarshalBytes(%s) error = %v, want field.ErrNonCanonical", name, err)
+		}
+		if out.R != nil || out.S != nil {
+			t.Errorf("UnmarshalBytes(%s) modified the signature on error", name)
+		}
+	}
+}
+
+func TestBase58(t *testing.T) {
+	sig, err := signature.New(big.NewInt(12345), new(big.Int).Sub(field.Q, big.NewInt(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := sig.ToBase58()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := signature.FromBase58(enc)
+	if err != nil || !decoded.R.Equal(sig.R) || decoded.S.BigInt().Cmp(sig.S.BigInt()) != 0 {
+		t.Errorf("FromBase58(ToBase58) = %v, %v", decoded, err)
+	}
+}
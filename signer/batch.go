@@ -0,0 +1,128 @@
+package signer
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// BatchObserver is implemented by hooks that record the size of batched
+// operations, such as metrics.Collector. Batch.Verify reports to it.
+type BatchObserver interface {
+	ObserveBatch(operation string, size int)
+}
+
+// Batch verifies the signatures of many signed commands and field
+// signatures at once, such as those of a block, with a keys.BatchVerifier.
+// A zkApp fee payer signs the full commitment of its command with
+// SignFields, so AddFields checks it.
+//
+// A Batch is not safe for concurrent use.
+type Batch struct {
+	client   *Client
+	verifier keys.BatchVerifier
+	signers  []string
+}
+
+// NewBatch returns an empty Batch for the client's network.
+func (c *Client) NewBatch() *Batch {
+	return &Batch{client: c}
+}
+
+// AddTransaction queues a check that VerifyTransaction would accept signed.
+func (b *Batch) AddTransaction(signed *Signed[transaction.Command]) {
+	pk, ok := signerKey(b.client, signed)
+	if !ok || signed.Data == nil || !pk.Equal(signed.Data.FeePayer()) {
+		reject(b, signed)
+		return
+	}
+	input, err := signed.Data.InputLegacy()
+	if err != nil {
+		reject(b, signed)
+		return
+	}
+	b.verifier.AddLegacy(pk, signed.Signature, input, b.client.network.Network)
+	b.signers = append(b.signers, signed.PublicKey)
+}
+
+// AddFields queues a check that VerifyFields would accept signed, with the
+// client's scheme.
+func (b *Batch) AddFields(signed *Signed[[]*big.Int]) {
+	pk, ok := signerKey(b.client, signed)
+	if !ok || b.client.scheme.Validate() != nil {
+		reject(b, signed)
+		return
+	}
+	if b.client.scheme == keys.SchemeLegacy {
+		b.verifier.AddLegacy(pk, signed.Signature, poseidonbigint.HashInputLegacy{Fields: signed.Data}, b.client.network.Network)
+	} else {
+		b.verifier.Add(pk, signed.Signature, poseidonbigint.HashInput{Fields: signed.Data}, b.client.network.Network)
+	}
+	b.signers = append(b.signers, signed.PublicKey)
+}
+
+// AddMessage queues a check that VerifyMessage would accept signed.
+func (b *Batch) AddMessage(signed *Signed[string]) {
+	pk, ok := signerKey(b.client, signed)
+	if !ok {
+		reject(b, signed)
+		return
+	}
+	b.verifier.AddLegacy(pk, signed.Signature, poseidonbigint.StringToInput(signed.Data), b.client.network.Network)
+	b.signers = append(b.signers, signed.PublicKey)
+}
+
+// reject queues an entry that fails whatever its signature, keeping the
+// results in the order of the Add calls.
+func reject[T any](b *Batch, signed *Signed[T]) {
+	b.verifier.Add(keys.PublicKey{}, nil, poseidonbigint.HashInput{}, b.client.network.Network)
+	var pub string
+	if signed != nil {
+		pub = signed.PublicKey
+	}
+	b.signers = append(b.signers, pub)
+}
+
+// Len returns the number of signatures queued.
+func (b *Batch) Len() int {
+	return b.verifier.Len()
+}
+
+// Verify checks the queued signatures and reports whether all are valid,
+// with the result of each in the order they were added. It reports one
+// VerifyEvent per signature to the client's hook, under SpanVerifyBatch
+// and with the batch's duration shared equally, and the batch size to the
+// hook if it is a BatchObserver. The batch is emptied for reuse.
+func (b *Batch) Verify() (bool, []bool) {
+	_, span := startSpan(context.Background(), SpanVerifyBatch)
+	start := time.Now()
+	ok, valid := b.verifier.Verify()
+	span.End(nil)
+	if h := b.client.activeHook(); h != nil && len(valid) > 0 {
+		if o, isObserver := h.(BatchObserver); isObserver {
+			o.ObserveBatch(SpanVerifyBatch, len(valid))
+		}
+		share := time.Since(start) / time.Duration(len(valid))
+		for i, v := range valid {
+			h.OnVerify(VerifyEvent{Operation: SpanVerifyBatch, PublicKey: b.signers[i], Duration: share, Valid: v})
+		}
+	}
+	b.verifier.Reset()
+	clear(b.signers)
+	b.signers = b.signers[:0]
+	return ok, valid
+}
+
+// VerifyTransactions checks many signed commands in one Batch. The results
+// match those of VerifyTransaction on each.
+func (c *Client) VerifyTransactions(signed []*Signed[transaction.Command]) (bool, []bool) {
+	b := c.NewBatch()
+	for _, s := range signed {
+		b.AddTransaction(s)
+	}
+	return b.Verify()
+}
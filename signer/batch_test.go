@@ -0,0 +1,99 @@
+package signer_test
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// batchLog is an auditLog that also records batch sizes.
+type batchLog struct {
+	auditLog
+	sizes []int
+}
+
+func (b *batchLog) ObserveBatch(operation string, size int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sizes = append(b.sizes, size)
+}
+
+func TestBatch(t *testing.T) {
+	log := &batchLog{}
+	c := signer.NewClient(signer.NetworkTestnet).WithHook(log)
+	from, err := keys.PublicKeyFromBase58(testPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var txs []*signer.Signed[transaction.Command]
+	for nonce := uint32(0); nonce < 4; nonce++ {
+		var tx transaction.Command = transaction.Payment{From: from, To: from, Amount: 1, Fee: 1, Nonce: nonce}
+		if nonce == 3 {
+			tx = transaction.StakeDelegation{From: from, To: from, Fee: 1, Nonce: nonce}
+		}
+		signed, err := c.SignTransaction(tx, testPrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		txs = append(txs, signed)
+	}
+	if ok, valid := c.VerifyTransactions(txs); !ok || len(valid) != len(txs) {
+		t.Fatalf("VerifyTransactions() = %v, %v for valid commands", ok, valid)
+	}
+
+	modified := *txs[1]
+	modified.Data = transaction.Payment{From: from, To: from, Amount: 2, Fee: 1, Nonce: 1}
+	feePayer, err := c.SignFields([]*big.Int{big.NewInt(42)}, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, err := c.SignMessage("hello", testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := c.NewBatch()
+	b.AddTransaction(txs[0])
+	b.AddTransaction(&modified)
+	b.AddTransaction(nil)
+	b.AddFields(feePayer)
+	b.AddMessage(message)
+	b.AddMessage(&signer.Signed[string]{Signature: message.Signature, PublicKey: "B62", Data: "hello"})
+	ok, valid := b.Verify()
+	want := []bool{true, false, false, true, true, false}
+	if ok || !slices.Equal(valid, want) {
+		t.Errorf("Verify() = %v, %v, want false, %v", ok, valid, want)
+	}
+	singles := []bool{
+		c.VerifyTransaction(txs[0]),
+		c.VerifyTransaction(&modified),
+		c.VerifyTransaction(nil),
+		c.VerifyFields(feePayer),
+		c.VerifyMessage(message),
+		c.VerifyMessage(&signer.Signed[string]{Signature: message.Signature, PublicKey: "B62", Data: "hello"}),
+	}
+	if !slices.Equal(singles, want) {
+		t.Errorf("single verification gives %v, want %v", singles, want)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Len() = %d after Verify, want 0", b.Len())
+	}
+
+	if !slices.Equal(log.sizes, []int{4, 6}) {
+		t.Errorf("observed batch sizes %v, want [4 6]", log.sizes)
+	}
+	var batchEvents []signer.VerifyEvent
+	for _, e := range log.verifies {
+		if e.Operation == signer.SpanVerifyBatch {
+			batchEvents = append(batchEvents, e)
+		}
+	}
+	if len(batchEvents) != 10 || batchEvents[4].PublicKey != testPublicKey || batchEvents[9].PublicKey != "B62" || batchEvents[9].Valid {
+		t.Errorf("batch verify events = %+v", batchEvents)
+	}
+}
@@ -0,0 +1,45 @@
+package signer_test
+
+import (
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// benchPayment returns a payment from the test key to itself.
+func benchPayment(b *testing.B) transaction.Payment {
+	b.Helper()
+	from, err := keys.PublicKeyFromBase58(testPublicKey)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return transaction.Payment{From: from, To: from, Amount: 1_000_000_000, Fee: 10_000_000, Nonce: 3, Memo: "bench"}
+}
+
+func BenchmarkSignTransaction(b *testing.B) {
+	c := signer.NewClient(signer.NetworkMainnet)
+	tx := benchPayment(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.SignTransaction(tx, testPrivateKey); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyTransaction(b *testing.B) {
+	c := signer.NewClient(signer.NetworkMainnet)
+	signed, err := c.SignTransaction(benchPayment(b), testPrivateKey)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !c.VerifyTransaction(signed) {
+			b.Fatal("VerifyTransaction rejected a valid signature")
+		}
+	}
+}
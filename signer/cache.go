@@ -0,0 +1,168 @@
+package signer
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"math/big"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signature"
+)
+
+// VerifyCache remembers the results of recent verifications, so that a
+// signature checked again, such as a transaction gossiped to several
+// services of one process, costs a map lookup instead of a scalar
+// multiplication. Entries are keyed by the public key, the signature and a
+// SHA-256 digest of the scheme, signature prefix and packed message, which
+// is everything a verification depends on, so one cache can serve clients
+// of different networks. When full, it evicts the least recently used
+// entry.
+//
+// A VerifyCache is safe for concurrent use. Install it on a client with
+// Client.WithVerifyCache. Batch does not consult it.
+type VerifyCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[[sha256.Size]byte]*list.Element
+	order   list.List // of *cacheEntry, most recently used first
+	stats   CacheStats
+}
+
+// cacheEntry is a verification result held by a VerifyCache.
+type cacheEntry struct {
+	key   [sha256.Size]byte
+	valid bool
+}
+
+// CacheStats counts the activity of a VerifyCache since it was created.
+type CacheStats struct {
+	// Hits and Misses count lookups that found and did not find a result.
+	Hits, Misses uint64
+	// Evictions counts entries dropped to make room for new ones.
+	Evictions uint64
+	// Len is the number of entries held, at most the size of the cache.
+	Len int
+}
+
+// NewVerifyCache returns a cache holding up to size results. A size of 0
+// or less returns nil, and a nil cache caches nothing.
+func NewVerifyCache(size int) *VerifyCache {
+	if size <= 0 {
+		return nil
+	}
+	return &VerifyCache{size: size, entries: make(map[[sha256.Size]byte]*list.Element)}
+}
+
+// Stats returns the counters of c.
+func (c *VerifyCache) Stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Len = c.order.Len()
+	return stats
+}
+
+// Purge removes every entry, keeping the counters.
+func (c *VerifyCache) Purge() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.order.Init()
+}
+
+// lookup returns the result stored under key.
+func (c *VerifyCache) lookup(key [sha256.Size]byte) (valid, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return false, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(e)
+	return e.Value.(*cacheEntry).valid, true
+}
+
+// store records the result of the verification under key.
+func (c *VerifyCache) store(key [sha256.Size]byte, valid bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		// Another goroutine verified the same signature meanwhile.
+		c.order.MoveToFront(e)
+		return
+	}
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.stats.Evictions++
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, valid: valid})
+}
+
+// WithVerifyCache returns a copy of c that looks up the results of its
+// verification methods in cache before computing them. A nil cache turns
+// caching off.
+func (c *Client) WithVerifyCache(cache *VerifyCache) *Client {
+	clone := *c
+	clone.cache = cache
+	return &clone
+}
+
+// cachedVerify returns the result of verify for sig by pk over the packed
+// message under scheme, from the client's cache when it holds it, and
+// reports whether it did.
+func (c *Client) cachedVerify(scheme keys.Scheme, pk keys.PublicKey, sig *signature.Signature, packed func() []*big.Int, verify func() bool) (valid, cached bool) {
+	if c.cache == nil {
+		return verify(), false
+	}
+	key, ok := cacheKey(scheme.String(), c.network.SignaturePrefix, pk, sig, packed())
+	if !ok {
+		return verify(), false
+	}
+	if valid, ok := c.cache.lookup(key); ok {
+		return valid, true
+	}
+	valid = verify()
+	c.cache.store(key, valid)
+	return valid, false
+}
+
+// cacheKey returns the digest identifying a verification. It reports false
+// for a key or signature that cannot be encoded, whose verification fails
+// early and is not worth caching.
+func cacheKey(scheme, prefix string, pk keys.PublicKey, sig *signature.Signature, packed []*big.Int) ([sha256.Size]byte, bool) {
+	pkBytes, err := pk.MarshalBytes()
+	if err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	sigBytes, err := sig.MarshalBytes()
+	if err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	h := sha256.New()
+	h.Write([]byte(scheme))
+	h.Write([]byte{0, byte(len(prefix))})
+	h.Write([]byte(prefix))
+	h.Write(pkBytes)
+	h.Write(sigBytes)
+	for _, f := range packed {
+		if f == nil {
+			return [sha256.Size]byte{}, false
+		}
+		h.Write([]byte(f.Text(16)))
+		h.Write([]byte{','})
+	}
+	var key [sha256.Size]byte
+	h.Sum(key[:0])
+	return key, true
+}
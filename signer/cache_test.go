@@ -0,0 +1,87 @@
+package signer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+func TestVerifyCache(t *testing.T) {
+	cache := signer.NewVerifyCache(2)
+	log := &auditLog{}
+	testnet := signer.NewClient(signer.NetworkTestnet).WithHook(log).WithVerifyCache(cache)
+	from, err := keys.PublicKeyFromBase58(testPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := testnet.SignTransaction(transaction.Payment{From: from, To: from, Amount: 1, Fee: 1}, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields, err := testnet.SignFields([]*big.Int{big.NewInt(1)}, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, err := testnet.SignMessage("hello", testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !testnet.VerifyTransaction(tx) || !testnet.VerifyTransaction(tx) {
+		t.Fatal("VerifyTransaction rejected a valid payment")
+	}
+	if got := cache.Stats(); got.Hits != 1 || got.Misses != 1 || got.Len != 1 {
+		t.Errorf("Stats() = %+v after verifying twice, want 1 hit and 1 miss", got)
+	}
+	if n := len(log.verifies); n != 2 || log.verifies[0].Cached || !log.verifies[1].Cached {
+		t.Errorf("verify events = %+v, want the second cached", log.verifies)
+	}
+
+	// The network's signature prefix is part of the key, so a client of
+	// another network sharing the cache computes its own result.
+	mainnet := signer.NewClient(signer.NetworkMainnet).WithVerifyCache(cache)
+	if mainnet.VerifyTransaction(tx) {
+		t.Error("mainnet client accepted a testnet signature")
+	}
+	if !testnet.VerifyTransaction(tx) {
+		t.Error("testnet result was replaced by the mainnet one")
+	}
+
+	modified := *tx
+	modified.Data = transaction.Payment{From: from, To: from, Amount: 2, Fee: 1}
+	if testnet.VerifyTransaction(&modified) {
+		t.Error("VerifyTransaction accepted a modified payment")
+	}
+
+	// With room for two, the modified payment evicts the mainnet entry,
+	// which was used less recently than the payment; the fields and message
+	// signatures then evict the payment and the modified payment.
+	if !testnet.VerifyFields(fields) || !testnet.VerifyMessage(message) {
+		t.Fatal("a valid signature was rejected")
+	}
+	before := cache.Stats()
+	if !testnet.VerifyMessage(message) || !testnet.VerifyFields(fields) {
+		t.Fatal("a valid signature was rejected from the cache")
+	}
+	if got := cache.Stats(); got.Hits != before.Hits+2 || got.Len != 2 {
+		t.Errorf("Stats() = %+v, want the last two results cached", got)
+	}
+	if got := cache.Stats(); got.Evictions != 3 {
+		t.Errorf("Evictions = %d, want 3", got.Evictions)
+	}
+
+	cache.Purge()
+	if got := cache.Stats(); got.Len != 0 || got.Hits == 0 {
+		t.Errorf("Stats() = %+v after Purge, want no entries and the counters kept", got)
+	}
+
+	if signer.NewVerifyCache(0) != nil {
+		t.Error("NewVerifyCache(0) returned a cache")
+	}
+	if !testnet.WithVerifyCache(nil).VerifyFields(fields) {
+		t.Error("VerifyFields without a cache rejected a valid signature")
+	}
+}
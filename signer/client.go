@@ -0,0 +1,312 @@
+// Package signer is the application-facing entry point of the module. Its
+// Client mirrors the mina-signer TypeScript client: keys travel as base58
+// strings, and every signing method returns the signature together with the
+// signer's address and the signed data.
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// Network is the chain a Client signs for: the keys.Network that binds
+// signatures to it and the base58check version byte of its addresses.
+type Network struct {
+	keys.Network
+	// AddressVersion is the version byte of base58 addresses; Mina
+	// addresses use keys.PublicKeyBase58Version.
+	AddressVersion byte
+}
+
+var (
+	// NetworkMainnet is the Mina mainnet.
+	NetworkMainnet = Network{Network: keys.NetworkMainnet, AddressVersion: keys.PublicKeyBase58Version}
+	// NetworkDevnet is the Mina devnet.
+	NetworkDevnet = Network{Network: keys.NetworkDevnet, AddressVersion: keys.PublicKeyBase58Version}
+	// NetworkTestnet is the Mina testnet.
+	NetworkTestnet = Network{Network: keys.NetworkTestnet, AddressVersion: keys.PublicKeyBase58Version}
+)
+
+// NetworkFromName returns the Network for a networkId string as accepted by
+// the keys package, with Mina addresses.
+func NetworkFromName(name string) Network {
+	return Network{Network: keys.NetworkFromID(name), AddressVersion: keys.PublicKeyBase58Version}
+}
+
+// WithContext returns n bound to an application context, as
+// keys.Network.WithContext, keeping its address version.
+func (n Network) WithContext(context []byte) Network {
+	return Network{Network: n.Network.WithContext(context), AddressVersion: n.AddressVersion}
+}
+
+// Client signs and verifies for one network. A Client is immutable and safe
+// for concurrent use; WithHook, WithVerifyCache and WithScheme return a new
+// one.
+type Client struct {
+	network Network
+	scheme  keys.Scheme
+	hook    Hook
+	cache   *VerifyCache
+}
+
+// NewClient returns a Client for network: one of the predefined networks, or
+// a custom chain with its own signature prefix, id and address version. All
+// methods, including key derivation, use it consistently.
+func NewClient(network Network) *Client {
+	return &Client{network: network}
+}
+
+// Network returns the network the client signs for.
+func (c *Client) Network() Network {
+	return c.network
+}
+
+// WithScheme returns a copy of c whose SignFields and VerifyFields, and
+// Batch.AddFields, use scheme, so one program can sign and check field
+// messages of the networks before Berkeley, which are legacy, and after.
+// The default is keys.SchemeKimchi, as in o1js. A signature carries no
+// scheme: it verifies only with a client of the scheme that made it.
+// Messages and transactions keep the legacy scheme, which mina-signer and
+// the protocol fix for them.
+func (c *Client) WithScheme(scheme keys.Scheme) *Client {
+	clone := *c
+	clone.scheme = scheme
+	return &clone
+}
+
+// Scheme returns the scheme of the client's field methods.
+func (c *Client) Scheme() keys.Scheme {
+	return c.scheme
+}
+
+// Keypair is a base58-encoded private key ("EK...") and its address
+// ("B62...").
+type Keypair struct {
+	PrivateKey string `json:"privateKey"`
+	PublicKey  string `json:"publicKey"`
+}
+
+// Signed is the result of a signing method: the signature, the address of
+// the signer and the data that was signed.
+type Signed[T any] struct {
+	Signature *signature.Signature `json:"signature"`
+	PublicKey string               `json:"publicKey"`
+	Data      T                    `json:"data"`
+}
+
+// GenKeys returns a fresh random keypair.
+func (c *Client) GenKeys() (Keypair, error) {
+	var s *scalar.Scalar
+	for s == nil || s.BigInt().Sign() == 0 {
+		var err error
+		if s, err = scalar.RandomScalar(); err != nil {
+			return Keypair{}, fmt.Errorf("signer: generating key: %w", err)
+		}
+	}
+	pub, err := c.address(keys.PrivateKey{Value: s}.ToPublicKey())
+	if err != nil {
+		return Keypair{}, err
+	}
+	return Keypair{PrivateKey: s.ToBase58(), PublicKey: pub}, nil
+}
+
+// DerivePublicKey returns the address of a base58 private key.
+func (c *Client) DerivePublicKey(privateKey string) (string, error) {
+	_, pub, err := c.privateKey(privateKey)
+	return pub, err
+}
+
+// VerifyKeypair checks that publicKey is the address of privateKey. Beyond
+// comparing the derived address it signs and verifies a test message, so a
+// key that decodes but cannot sign is caught too. It returns nil for a
+// matching pair.
+func (c *Client) VerifyKeypair(privateKey, publicKey string) error {
+	sk, derived, err := c.privateKey(privateKey)
+	if err != nil {
+		return err
+	}
+	pk, err := keys.PublicKeyFromBase58WithVersion(publicKey, c.network.AddressVersion)
+	if err != nil {
+		return err
+	}
+	if derived != publicKey {
+		return errcode.New(errcode.KeyMismatch, "signer: public key does not match the private key")
+	}
+	input := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(1)}}
+	sig, err := sk.SignForNetwork(input, c.network.Network, keys.SignOptions{})
+	if err != nil {
+		return fmt.Errorf("signer: test signature: %w", err)
+	}
+	if !pk.VerifyForNetwork(sig, input, c.network.Network) {
+		return errcode.New(errcode.InvalidSignature, "signer: test signature does not verify")
+	}
+	return nil
+}
+
+// SignFields signs a list of base field elements with the client's scheme.
+func (c *Client) SignFields(fields []*big.Int, privateKey string) (_ *Signed[[]*big.Int], err error) {
+	_, span := startSpan(context.Background(), SpanSignFields)
+	var pub string
+	defer func(start time.Time) { span.End(err); c.onSign(SpanSignFields, pub, start, err) }(time.Now())
+	sk, pub, err := c.privateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.scheme.Validate(); err != nil {
+		return nil, err
+	}
+	var sig *signature.Signature
+	if c.scheme == keys.SchemeLegacy {
+		sig, err = sk.SignLegacyForNetwork(poseidonbigint.HashInputLegacy{Fields: fields}, c.network.Network, keys.SignOptions{})
+	} else {
+		sig, err = sk.SignForNetwork(poseidonbigint.HashInput{Fields: fields}, c.network.Network, keys.SignOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Signed[[]*big.Int]{Signature: sig, PublicKey: pub, Data: fields}, nil
+}
+
+// VerifyFields checks a signature produced by SignFields with the client's
+// scheme.
+func (c *Client) VerifyFields(signed *Signed[[]*big.Int]) (valid bool) {
+	_, span := startSpan(context.Background(), SpanVerifyFields)
+	var cached bool
+	defer func(start time.Time) { span.End(nil); onVerify(c, SpanVerifyFields, signed, start, valid, cached) }(time.Now())
+	pk, ok := signerKey(c, signed)
+	if !ok || c.scheme.Validate() != nil {
+		return false
+	}
+	if c.scheme == keys.SchemeLegacy {
+		input := poseidonbigint.HashInputLegacy{Fields: signed.Data}
+		valid, cached = c.cachedVerify(keys.SchemeLegacy, pk, signed.Signature,
+			func() []*big.Int { return poseidonbigint.PackToFieldsLegacy(input) },
+			func() bool { return pk.VerifyLegacyForNetwork(signed.Signature, input, c.network.Network) })
+		return valid
+	}
+	input := poseidonbigint.HashInput{Fields: signed.Data}
+	valid, cached = c.cachedVerify(keys.SchemeKimchi, pk, signed.Signature,
+		func() []*big.Int { return poseidonbigint.PackToFields(input) },
+		func() bool { return pk.VerifyForNetwork(signed.Signature, input, c.network.Network) })
+	return valid
+}
+
+// SignMessage signs a string message with the legacy scheme, exactly as
+// mina-signer does, so the signature verifies in TypeScript.
+func (c *Client) SignMessage(message string, privateKey string) (_ *Signed[string], err error) {
+	_, span := startSpan(context.Background(), SpanSignMessage)
+	var pub string
+	defer func(start time.Time) { span.End(err); c.onSign(SpanSignMessage, pub, start, err) }(time.Now())
+	sk, pub, err := c.privateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := sk.SignLegacyForNetwork(poseidonbigint.StringToInput(message), c.network.Network, keys.SignOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &Signed[string]{Signature: sig, PublicKey: pub, Data: message}, nil
+}
+
+// VerifyMessage checks a signature produced by SignMessage or by
+// mina-signer's signMessage.
+func (c *Client) VerifyMessage(signed *Signed[string]) (valid bool) {
+	_, span := startSpan(context.Background(), SpanVerifyMessage)
+	var cached bool
+	defer func(start time.Time) { span.End(nil); onVerify(c, SpanVerifyMessage, signed, start, valid, cached) }(time.Now())
+	pk, ok := signerKey(c, signed)
+	if !ok {
+		return false
+	}
+	input := poseidonbigint.StringToInput(signed.Data)
+	valid, cached = c.cachedVerify(keys.SchemeLegacy, pk, signed.Signature,
+		func() []*big.Int { return poseidonbigint.PackToFieldsLegacy(input) },
+		func() bool { return pk.VerifyLegacyForNetwork(signed.Signature, input, c.network.Network) })
+	return valid
+}
+
+// SignTransaction signs a payment or stake delegation. The private key must
+// belong to the fee payer, whose signature the network checks.
+func (c *Client) SignTransaction(tx transaction.Command, privateKey string) (_ *Signed[transaction.Command], err error) {
+	_, span := startSpan(context.Background(), SpanSignTransaction)
+	var pub string
+	defer func(start time.Time) { span.End(err); c.onSign(SpanSignTransaction, pub, start, err) }(time.Now())
+	sk, pub, err := c.privateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	pk := sk.ToPublicKey()
+	if !pk.Equal(tx.FeePayer()) {
+		return nil, errcode.New(errcode.KeyMismatch, "signer: private key does not belong to the fee payer")
+	}
+	input, err := tx.InputLegacy()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := sk.SignLegacyForNetwork(input, c.network.Network, keys.SignOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &Signed[transaction.Command]{Signature: sig, PublicKey: pub, Data: tx}, nil
+}
+
+// VerifyTransaction checks a signature produced by SignTransaction. The
+// signer must be the fee payer of the command.
+func (c *Client) VerifyTransaction(signed *Signed[transaction.Command]) (valid bool) {
+	_, span := startSpan(context.Background(), SpanVerifyTransaction)
+	var cached bool
+	defer func(start time.Time) { span.End(nil); onVerify(c, SpanVerifyTransaction, signed, start, valid, cached) }(time.Now())
+	pk, ok := signerKey(c, signed)
+	if !ok || signed.Data == nil || !pk.Equal(signed.Data.FeePayer()) {
+		return false
+	}
+	input, err := signed.Data.InputLegacy()
+	if err != nil {
+		return false
+	}
+	valid, cached = c.cachedVerify(keys.SchemeLegacy, pk, signed.Signature,
+		func() []*big.Int { return poseidonbigint.PackToFieldsLegacy(input) },
+		func() bool { return pk.VerifyLegacyForNetwork(signed.Signature, input, c.network.Network) })
+	return valid
+}
+
+// privateKey decodes a base58 private key and returns it with its address.
+func (c *Client) privateKey(privateKey string) (keys.PrivateKey, string, error) {
+	sk, err := keys.PrivateKeyFromBase58(privateKey)
+	if err != nil {
+		return keys.PrivateKey{}, "", err
+	}
+	pub, err := c.address(sk.ToPublicKey())
+	if err != nil {
+		return keys.PrivateKey{}, "", err
+	}
+	return sk, pub, nil
+}
+
+// address returns the base58 address of pk on the client's network.
+func (c *Client) address(pk keys.PublicKey) (string, error) {
+	return pk.ToBase58WithVersion(c.network.AddressVersion)
+}
+
+// signerKey decodes the signer address of a Signed value. It reports false
+// for a nil value or signature and for an address that is invalid on c's
+// network.
+func signerKey[T any](c *Client, signed *Signed[T]) (keys.PublicKey, bool) {
+	if signed == nil || signed.Signature == nil {
+		return keys.PublicKey{}, false
+	}
+	pk, err := keys.PublicKeyFromBase58WithVersion(signed.PublicKey, c.network.AddressVersion)
+	if err != nil {
+		return keys.PublicKey{}, false
+	}
+	return pk, true
+}
@@ -0,0 +1,344 @@
+package signer_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/constants"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/hashgeneric"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidon"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+const (
+	testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+	testPublicKey  = "B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg"
+)
+
+// TestNetworkSignatureDomains checks that the signature prefix of each
+// predefined network salts both Poseidon variants into the states Mina's
+// hash_prefix_states lists, so payments and field signatures are bound to
+// the node's domains.
+func TestNetworkSignatureDomains(t *testing.T) {
+	kimchi := hashgeneric.CreateHashHelpers(field.Fp, poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp))
+	legacy := hashgeneric.CreateHashHelpers(field.Fp, poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsLegacyFp))
+	for _, tc := range []struct {
+		network signer.Network
+		prefix  string
+	}{
+		{signer.NetworkMainnet, "MinaSignatureMainnet"},
+		{signer.NetworkTestnet, "CodaSignature*******"},
+		{signer.NetworkDevnet, "CodaSignature*******"},
+	} {
+		for _, h := range []struct {
+			name   string
+			salt   []*big.Int
+			states map[string][][]string
+		}{
+			{"kimchi", kimchi.Salt(tc.network.SignaturePrefix), constants.PrefixHashes},
+			{"legacy", legacy.Salt(tc.network.SignaturePrefix), constants.PrefixHashesLegacy},
+		} {
+			want := h.states[tc.prefix][0]
+			for i := range want {
+				if h.salt[i].String() != want[i] {
+					t.Errorf("%s: %s state of the signature prefix differs from %s", tc.network.Name, h.name, tc.prefix)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestGenKeysAndDerive(t *testing.T) {
+	c := signer.NewClient(signer.NetworkMainnet)
+	kp, err := c.GenKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := c.DerivePublicKey(kp.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub != kp.PublicKey {
+		t.Errorf("DerivePublicKey = %s, want %s", pub, kp.PublicKey)
+	}
+	if pub, err := c.DerivePublicKey(testPrivateKey); err != nil || pub != testPublicKey {
+		t.Errorf("DerivePublicKey(test key) = %s, %v, want %s", pub, err, testPublicKey)
+	}
+	if _, err := c.DerivePublicKey("not a key"); err == nil {
+		t.Error("DerivePublicKey accepted an invalid key")
+	}
+}
+
+func TestSignVerifyFieldsAndMessage(t *testing.T) {
+	c := signer.NewClient(signer.NetworkTestnet)
+	fields, err := c.SignFields([]*big.Int{big.NewInt(1), big.NewInt(2)}, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields.PublicKey != testPublicKey || !c.VerifyFields(fields) {
+		t.Error("VerifyFields rejected a valid signature")
+	}
+	fields.Data = []*big.Int{big.NewInt(1), big.NewInt(3)}
+	if c.VerifyFields(fields) {
+		t.Error("VerifyFields accepted modified data")
+	}
+
+	msg, err := c.SignMessage("hello", testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.VerifyMessage(msg) {
+		t.Error("VerifyMessage rejected a valid signature")
+	}
+	if signer.NewClient(signer.NetworkMainnet).VerifyMessage(msg) {
+		t.Error("VerifyMessage accepted a testnet signature on mainnet")
+	}
+	if c.VerifyMessage(&signer.Signed[string]{PublicKey: testPublicKey, Data: "hello"}) {
+		t.Error("VerifyMessage accepted a missing signature")
+	}
+}
+
+func TestWithScheme(t *testing.T) {
+	cache := signer.NewVerifyCache(4)
+	kimchi := signer.NewClient(signer.NetworkTestnet).WithVerifyCache(cache)
+	legacy := kimchi.WithScheme(keys.SchemeLegacy)
+	if kimchi.Scheme() != keys.SchemeKimchi || legacy.Scheme() != keys.SchemeLegacy {
+		t.Fatalf("Scheme() = %v and %v", kimchi.Scheme(), legacy.Scheme())
+	}
+	fields := []*big.Int{big.NewInt(1), big.NewInt(2)}
+	signed, err := legacy.SignFields(fields, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk, err := keys.PrivateKeyFromBase58(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := sk.SignWithScheme(keys.SchemeLegacy, keys.ROInput{Fields: fields}, keys.NetworkTestnet, keys.SignOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signed.Signature.R.Equal(want.R) {
+		t.Error("SignFields with the legacy scheme differs from keys.SignWithScheme")
+	}
+
+	// The cache keeps the result of each scheme apart.
+	for range 2 {
+		if !legacy.VerifyFields(signed) || kimchi.VerifyFields(signed) {
+			t.Fatal("VerifyFields does not check the scheme")
+		}
+	}
+	batch := legacy.NewBatch()
+	batch.AddFields(signed)
+	if ok, _ := batch.Verify(); !ok {
+		t.Error("Batch.AddFields rejected a legacy signature on a legacy client")
+	}
+	batch = kimchi.NewBatch()
+	batch.AddFields(signed)
+	if ok, _ := batch.Verify(); ok {
+		t.Error("Batch.AddFields accepted a legacy signature on a kimchi client")
+	}
+
+	// Messages stay legacy whatever the scheme.
+	msg, err := kimchi.SignMessage("hello", testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !legacy.VerifyMessage(msg) {
+		t.Error("the scheme changed how messages are signed")
+	}
+
+	unknown := kimchi.WithScheme(keys.Scheme(7))
+	if _, err := unknown.SignFields(fields, testPrivateKey); err == nil {
+		t.Error("SignFields accepted an unknown scheme")
+	}
+	if unknown.VerifyFields(signed) {
+		t.Error("VerifyFields accepted an unknown scheme")
+	}
+}
+
+func TestSignVerifyTransaction(t *testing.T) {
+	c := signer.NewClient(signer.NetworkMainnet)
+	from, err := keys.PublicKeyFromBase58(testPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kp, err := c.GenKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := keys.PublicKeyFromBase58(kp.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tx := range []transaction.Command{
+		transaction.Payment{From: from, To: to, Amount: 1_000_000_000, Fee: 10_000_000, Nonce: 3, Memo: "test"},
+		transaction.StakeDelegation{From: from, To: to, Fee: 10_000_000, Nonce: 4},
+	} {
+		signed, err := c.SignTransaction(tx, testPrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !c.VerifyTransaction(signed) {
+			t.Errorf("VerifyTransaction rejected a valid %T", tx)
+		}
+		data, err := json.Marshal(signed.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded signature.Signature
+		if err := json.Unmarshal(data, &decoded); err != nil || !decoded.R.Equal(signed.Signature.R) {
+			t.Errorf("signature JSON %s did not round-trip: %v", data, err)
+		}
+	}
+
+	payment := transaction.Payment{From: from, To: to, Amount: 5, Fee: 1}
+	signed, err := c.SignTransaction(payment, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payment.Amount = 6
+	signed.Data = payment
+	if c.VerifyTransaction(signed) {
+		t.Error("VerifyTransaction accepted a modified payment")
+	}
+
+	if _, err := c.SignTransaction(transaction.Payment{From: to, To: from}, testPrivateKey); err == nil {
+		t.Error("SignTransaction signed for another fee payer")
+	}
+	if _, err := c.SignTransaction(transaction.Payment{From: from, To: to, Memo: string(make([]byte, 33))}, testPrivateKey); err == nil {
+		t.Error("SignTransaction accepted an oversized memo")
+	}
+}
+
+func TestVerifyKeypair(t *testing.T) {
+	c := signer.NewClient(signer.NetworkMainnet)
+	if err := c.VerifyKeypair(testPrivateKey, testPublicKey); err != nil {
+		t.Errorf("VerifyKeypair rejected a matching pair: %v", err)
+	}
+	other, err := c.GenKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.VerifyKeypair(testPrivateKey, other.PublicKey); err == nil {
+		t.Error("VerifyKeypair accepted a mismatched pair")
+	}
+	if err := c.VerifyKeypair(testPrivateKey, "B62qinvalid"); err == nil {
+		t.Error("VerifyKeypair accepted an invalid address")
+	}
+	if err := c.VerifyKeypair("EKinvalid", testPublicKey); err == nil {
+		t.Error("VerifyKeypair accepted an invalid private key")
+	}
+}
+
+func TestCustomNetwork(t *testing.T) {
+	custom := signer.Network{
+		Network:        keys.Network{Name: "private", SignaturePrefix: "PrivateSignature", ID: []byte{0x2a}},
+		AddressVersion: 0x20,
+	}
+	c := signer.NewClient(custom)
+	pub, err := c.DerivePublicKey(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub == testPublicKey {
+		t.Error("custom network produced a Mina address")
+	}
+	if err := c.VerifyKeypair(testPrivateKey, pub); err != nil {
+		t.Errorf("VerifyKeypair rejected a custom address: %v", err)
+	}
+	if err := c.VerifyKeypair(testPrivateKey, testPublicKey); err == nil {
+		t.Error("VerifyKeypair accepted a Mina address on a custom network")
+	}
+
+	msg, err := c.SignMessage("hello", testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.PublicKey != pub || !c.VerifyMessage(msg) {
+		t.Error("VerifyMessage rejected a custom network signature")
+	}
+	// Same address version, different prefix and id: the signature must not
+	// carry over.
+	other := custom
+	other.Network = keys.NetworkMainnet
+	if signer.NewClient(other).VerifyMessage(msg) {
+		t.Error("VerifyMessage accepted a custom network signature on mainnet")
+	}
+
+	invalid := custom
+	invalid.ID = nil
+	if _, err := signer.NewClient(invalid).SignMessage("hello", testPrivateKey); err == nil {
+		t.Error("SignMessage accepted a network without an id")
+	}
+}
+
+func TestNetworkFromName(t *testing.T) {
+	c := signer.NewClient(signer.NetworkFromName("testnet"))
+	msg, err := c.SignMessage("hello", testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signer.NewClient(signer.NetworkDevnet).VerifyMessage(msg) {
+		t.Error("devnet rejected a testnet signature")
+	}
+}
+
+func TestSignVerifyJSON(t *testing.T) {
+	c := signer.NewClient(signer.NetworkMainnet)
+	signed, err := c.SignJSON([]byte(`{ "b": [1.0, "x"], "a": true }`), testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(signed.Data) != `{"a":true,"b":[1,"x"]}` {
+		t.Errorf("Data = %s", signed.Data)
+	}
+	if !c.VerifyJSON(signed) {
+		t.Fatal("VerifyJSON rejected a valid signature")
+	}
+	// The signature is the one signMessage gives for the canonical text.
+	msg, err := c.SignMessage(string(signed.Data), testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !msg.Signature.R.Equal(signed.Signature.R) {
+		t.Error("SignJSON differs from SignMessage of the canonical document")
+	}
+
+	signed.Data = []byte(`{"b":[1,"x"],"a":true}`)
+	if !c.VerifyJSON(signed) {
+		t.Error("VerifyJSON rejected a reordered document")
+	}
+	signed.Data = []byte(`{"a":false,"b":[1,"x"]}`)
+	if c.VerifyJSON(signed) {
+		t.Error("VerifyJSON accepted a different document")
+	}
+	if _, err := c.SignJSON([]byte(`{"a":1,"a":2}`), testPrivateKey); err == nil {
+		t.Error("SignJSON accepted duplicate names")
+	}
+}
+
+func TestNetworkWithContext(t *testing.T) {
+	network := signer.NetworkMainnet.WithContext([]byte("my-app"))
+	c := signer.NewClient(network)
+	signed, err := c.SignMessage("hello", testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signed.PublicKey != testPublicKey {
+		t.Errorf("address %s, want %s", signed.PublicKey, testPublicKey)
+	}
+	if !c.VerifyMessage(signed) {
+		t.Fatal("VerifyMessage rejected a valid signature")
+	}
+	if signer.NewClient(signer.NetworkMainnet).VerifyMessage(signed) {
+		t.Error("signature verifies without its context")
+	}
+}
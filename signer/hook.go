@@ -0,0 +1,105 @@
+package signer
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Hook receives an event after each signing and verification operation of
+// the package, so a service can keep an audit log of every signature it
+// issues without wrapping its call sites. Hooks run synchronously on the
+// calling goroutine and must be safe for concurrent use; slow work such as
+// shipping logs belongs on a queue.
+type Hook interface {
+	// OnSign is called after a Client or KeySigner signing method returns.
+	OnSign(SignEvent)
+	// OnVerify is called after a Client verification method returns.
+	OnVerify(VerifyEvent)
+}
+
+// SignEvent describes a signing operation.
+type SignEvent struct {
+	// Operation is the span name of the method, such as SpanSignFields.
+	Operation string
+	// PublicKey is the address of the signing key, empty when the private
+	// key could not be decoded.
+	PublicKey string
+	// Duration is the time the method took.
+	Duration time.Duration
+	// Err is the error the method returned, nil on success.
+	Err error
+}
+
+// VerifyEvent describes a verification.
+type VerifyEvent struct {
+	// Operation is the span name of the method, such as SpanVerifyFields.
+	Operation string
+	// PublicKey is the claimed signer address, empty for a nil input.
+	PublicKey string
+	// Duration is the time the method took.
+	Duration time.Duration
+	// Valid is the result the method returned.
+	Valid bool
+	// Cached reports that the result came from the client's VerifyCache.
+	Cached bool
+}
+
+// hookHolder lets an atomic.Pointer hold any Hook.
+type hookHolder struct{ Hook }
+
+var hook atomic.Pointer[hookHolder]
+
+// SetHook installs h for all Clients and KeySigners that have no hook of
+// their own. Passing nil removes it. SetHook is safe to call while
+// operations are running.
+func SetHook(h Hook) {
+	if h == nil {
+		hook.Store(nil)
+		return
+	}
+	hook.Store(&hookHolder{h})
+}
+
+// WithHook returns a copy of c that reports to h instead of the hook
+// installed with SetHook. A nil h restores the package hook.
+func (c *Client) WithHook(h Hook) *Client {
+	clone := *c
+	clone.hook = h
+	return &clone
+}
+
+// activeHook returns the hook of c, or the package hook, or nil.
+func (c *Client) activeHook() Hook {
+	if c.hook != nil {
+		return c.hook
+	}
+	return packageHook()
+}
+
+func packageHook() Hook {
+	if h := hook.Load(); h != nil {
+		return h.Hook
+	}
+	return nil
+}
+
+// onSign reports a signing operation that started at start to c's hook.
+func (c *Client) onSign(operation, publicKey string, start time.Time, err error) {
+	if h := c.activeHook(); h != nil {
+		h.OnSign(SignEvent{Operation: operation, PublicKey: publicKey, Duration: time.Since(start), Err: err})
+	}
+}
+
+// onVerify reports a verification of signed that started at start to c's
+// hook.
+func onVerify[T any](c *Client, operation string, signed *Signed[T], start time.Time, valid, cached bool) {
+	h := c.activeHook()
+	if h == nil {
+		return
+	}
+	event := VerifyEvent{Operation: operation, Duration: time.Since(start), Valid: valid, Cached: cached}
+	if signed != nil {
+		event.PublicKey = signed.PublicKey
+	}
+	h.OnVerify(event)
+}
@@ -0,0 +1,129 @@
+package signer_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+// auditLog is a Hook that records every event.
+type auditLog struct {
+	mu       sync.Mutex
+	signs    []signer.SignEvent
+	verifies []signer.VerifyEvent
+}
+
+func (a *auditLog) OnSign(e signer.SignEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.signs = append(a.signs, e)
+}
+
+func (a *auditLog) OnVerify(e signer.VerifyEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.verifies = append(a.verifies, e)
+}
+
+func TestHook(t *testing.T) {
+	log := &auditLog{}
+	signer.SetHook(log)
+	t.Cleanup(func() { signer.SetHook(nil) })
+
+	c := signer.NewClient(signer.NetworkTestnet)
+	signed, err := c.SignFields([]*big.Int{big.NewInt(1)}, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.VerifyFields(signed)
+	signed.Data = []*big.Int{big.NewInt(2)}
+	c.VerifyFields(signed)
+	if _, err := c.SignMessage("hello", "not a key"); err == nil {
+		t.Fatal("SignMessage accepted an invalid key")
+	}
+	sk, _ := keys.PrivateKeyFromBase58(testPrivateKey)
+	if _, err := signer.NewKeySigner(sk, signer.NetworkTestnet).SignFields(context.Background(), []*big.Int{big.NewInt(1)}); err != nil {
+		t.Fatal(err)
+	}
+
+	wantSigns := []signer.SignEvent{
+		{Operation: signer.SpanSignFields, PublicKey: testPublicKey},
+		{Operation: signer.SpanSignMessage},
+		{Operation: signer.SpanSignFields, PublicKey: testPublicKey},
+	}
+	if len(log.signs) != len(wantSigns) {
+		t.Fatalf("recorded %d sign events, want %d", len(log.signs), len(wantSigns))
+	}
+	for i, w := range wantSigns {
+		got := log.signs[i]
+		if got.Operation != w.Operation || got.PublicKey != w.PublicKey || (got.Err != nil) != (w.PublicKey == "") || got.Duration < 0 {
+			t.Errorf("sign event %d = %+v, want %+v", i, got, w)
+		}
+	}
+	if len(log.verifies) != 2 || !log.verifies[0].Valid || log.verifies[1].Valid || log.verifies[0].PublicKey != testPublicKey {
+		t.Errorf("verify events = %+v", log.verifies)
+	}
+
+	// A client hook replaces the package hook.
+	own := &auditLog{}
+	if _, err := c.WithHook(own).SignFields([]*big.Int{big.NewInt(1)}, testPrivateKey); err != nil {
+		t.Fatal(err)
+	}
+	if len(own.signs) != 1 || len(log.signs) != len(wantSigns) {
+		t.Error("client hook did not replace the package hook")
+	}
+
+	signer.SetHook(nil)
+	if _, err := c.SignFields([]*big.Int{big.NewInt(1)}, testPrivateKey); err != nil {
+		t.Fatal(err)
+	}
+	if len(log.signs) != len(wantSigns) {
+		t.Error("events were recorded after SetHook(nil)")
+	}
+}
+
+// TestConcurrentClient verifies with one Client from many goroutines while
+// the package hook is replaced. Run it with -race.
+func TestConcurrentClient(t *testing.T) {
+	t.Cleanup(func() { signer.SetHook(nil) })
+	c := signer.NewClient(signer.NetworkTestnet)
+	fields, err := c.SignFields([]*big.Int{big.NewInt(1), big.NewInt(2)}, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := c.SignMessage("hello", testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := &auditLog{}
+	hooked := c.WithHook(log)
+
+	const goroutines = 16
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			if g%4 == 0 {
+				signer.SetHook(&auditLog{})
+			}
+			if !c.VerifyFields(fields) || !hooked.VerifyFields(fields) {
+				t.Error("VerifyFields rejected a valid signature")
+			}
+			if !c.VerifyMessage(msg) {
+				t.Error("VerifyMessage rejected a valid signature")
+			}
+			if _, err := hooked.SignMessage("hello", testPrivateKey); err != nil {
+				t.Error(err)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if len(log.verifies) != goroutines || len(log.signs) != goroutines {
+		t.Errorf("hook saw %d verifications and %d signatures, want %d each", len(log.verifies), len(log.signs), goroutines)
+	}
+}
@@ -0,0 +1,37 @@
+package signer
+
+import (
+	"encoding/json"
+
+	"github.com/node101-io/mina-signer-go/canonicaljson"
+)
+
+// SignJSON signs the canonical form of a JSON document (RFC 8785) as a
+// string message, so equivalent documents share one signature whatever
+// their whitespace or key order. The returned Data is the canonical
+// document; mina-signer's signMessage over the same canonical text gives
+// the same signature.
+func (c *Client) SignJSON(document []byte, privateKey string) (*Signed[json.RawMessage], error) {
+	canonical, err := canonicaljson.Canonicalize(document)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := c.SignMessage(string(canonical), privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Signed[json.RawMessage]{Signature: signed.Signature, PublicKey: signed.PublicKey, Data: canonical}, nil
+}
+
+// VerifyJSON checks a signature produced by SignJSON. Data may be any
+// document equivalent to the one signed.
+func (c *Client) VerifyJSON(signed *Signed[json.RawMessage]) bool {
+	if signed == nil {
+		return false
+	}
+	canonical, err := canonicaljson.Canonicalize(signed.Data)
+	if err != nil {
+		return false
+	}
+	return c.VerifyMessage(&Signed[string]{Signature: signed.Signature, PublicKey: signed.PublicKey, Data: string(canonical)})
+}
@@ -0,0 +1,126 @@
+package signer
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/securemem"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// Signer holds one key and signs with it. Implementations may keep the key
+// in process, as KeySigner does, or behind a remote service or a hardware
+// boundary; callers only see the public key and signatures.
+type Signer interface {
+	// PublicKey returns the key signatures verify against.
+	PublicKey(ctx context.Context) (keys.PublicKey, error)
+	// SignFields signs a list of base field elements with the kimchi
+	// scheme, as Client.SignFields does by default.
+	SignFields(ctx context.Context, fields []*big.Int) (*signature.Signature, error)
+	// SignTransaction signs a payment or stake delegation with the legacy
+	// scheme. The key must belong to the fee payer.
+	SignTransaction(ctx context.Context, tx transaction.Command) (*signature.Signature, error)
+}
+
+// KeySigner is a Signer for a private key held in memory.
+type KeySigner struct {
+	key     keys.PrivateKey
+	locked  *securemem.Key
+	network Network
+}
+
+// NewKeySigner returns a Signer that signs with key for network.
+func NewKeySigner(key keys.PrivateKey, network Network) *KeySigner {
+	return &KeySigner{key: key, network: network}
+}
+
+// NewLockedKeySigner is NewKeySigner with the key moved to locked memory
+// outside the Go heap, as described in package securemem. The caller
+// should drop its copy of key, and Close the signer when done with it. It
+// fails where locked memory is unavailable.
+func NewLockedKeySigner(key keys.PrivateKey, network Network) (*KeySigner, error) {
+	locked, err := securemem.NewKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &KeySigner{locked: locked, network: network}, nil
+}
+
+// Close wipes the key of a signer built by NewLockedKeySigner, once the
+// signatures in progress are done; later signatures fail with
+// securemem.ErrDestroyed. It does nothing for other signers.
+func (s *KeySigner) Close() error {
+	if s.locked == nil {
+		return nil
+	}
+	return s.locked.Destroy()
+}
+
+// PublicKey returns the public key of the signer's private key.
+func (s *KeySigner) PublicKey(context.Context) (keys.PublicKey, error) {
+	return s.publicKey(), nil
+}
+
+func (s *KeySigner) publicKey() keys.PublicKey {
+	if s.locked != nil {
+		return s.locked.PublicKey()
+	}
+	return s.key.ToPublicKey()
+}
+
+// withKey calls f with the private key.
+func (s *KeySigner) withKey(f func(keys.PrivateKey) error) error {
+	if s.locked != nil {
+		return s.locked.With(f)
+	}
+	return f(s.key)
+}
+
+// SignFields signs fields for the signer's network.
+func (s *KeySigner) SignFields(ctx context.Context, fields []*big.Int) (sig *signature.Signature, err error) {
+	_, span := startSpan(ctx, SpanSignFields)
+	defer func(start time.Time) { span.End(err); s.onSign(SpanSignFields, start, err) }(time.Now())
+	err = s.withKey(func(key keys.PrivateKey) (err error) {
+		sig, err = key.SignForNetwork(poseidonbigint.HashInput{Fields: fields}, s.network.Network, keys.SignOptions{})
+		return err
+	})
+	return sig, err
+}
+
+// SignTransaction signs tx for the signer's network.
+func (s *KeySigner) SignTransaction(ctx context.Context, tx transaction.Command) (sig *signature.Signature, err error) {
+	_, span := startSpan(ctx, SpanSignTransaction)
+	defer func(start time.Time) { span.End(err); s.onSign(SpanSignTransaction, start, err) }(time.Now())
+	pk := s.publicKey()
+	if !pk.Equal(tx.FeePayer()) {
+		return nil, errcode.New(errcode.KeyMismatch, "signer: private key does not belong to the fee payer")
+	}
+	input, err := tx.InputLegacy()
+	if err != nil {
+		return nil, err
+	}
+	err = s.withKey(func(key keys.PrivateKey) (err error) {
+		sig, err = key.SignLegacyForNetwork(input, s.network.Network, keys.SignOptions{})
+		return err
+	})
+	return sig, err
+}
+
+// onSign reports a signing operation that started at start to the hook
+// installed with SetHook.
+func (s *KeySigner) onSign(operation string, start time.Time, err error) {
+	h := packageHook()
+	if h == nil {
+		return
+	}
+	event := SignEvent{Operation: operation, Duration: time.Since(start), Err: err}
+	if s.locked != nil || s.key.Value != nil {
+		event.PublicKey, _ = s.publicKey().ToBase58WithVersion(s.network.AddressVersion)
+	}
+	h.OnSign(event)
+}
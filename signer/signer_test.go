@@ -0,0 +1,68 @@
+package signer_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"runtime"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/securemem"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+func TestLockedKeySigner(t *testing.T) {
+	sk, err := keys.PrivateKeyFromBase58(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	locked, err := signer.NewLockedKeySigner(sk, signer.NetworkTestnet)
+	if errors.Is(err, securemem.ErrUnsupported) {
+		t.Skipf("no locked memory on %s", runtime.GOOS)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain := signer.NewKeySigner(sk, signer.NetworkTestnet)
+	ctx := context.Background()
+
+	pub, err := locked.PublicKey(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address, _ := pub.ToBase58(); address != testPublicKey {
+		t.Errorf("PublicKey = %s, want %s", address, testPublicKey)
+	}
+
+	fields := []*big.Int{big.NewInt(1), big.NewInt(2)}
+	tx := transaction.Payment{From: pub, To: pub, Amount: 1, Fee: 10_000_000, Nonce: 2}
+	for name, sign := range map[string]func(signer.Signer) (*signature.Signature, error){
+		"SignFields":      func(s signer.Signer) (*signature.Signature, error) { return s.SignFields(ctx, fields) },
+		"SignTransaction": func(s signer.Signer) (*signature.Signature, error) { return s.SignTransaction(ctx, tx) },
+	} {
+		got, err := sign(locked)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		want, err := sign(plain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.R.BigInt().Cmp(want.R.BigInt()) != 0 || got.S.BigInt().Cmp(want.S.BigInt()) != 0 {
+			t.Errorf("%s: locked key signed differently", name)
+		}
+	}
+
+	if err := locked.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := locked.SignFields(ctx, fields); !errors.Is(err, securemem.ErrDestroyed) {
+		t.Errorf("SignFields after Close = %v, want ErrDestroyed", err)
+	}
+	if err := plain.Close(); err != nil {
+		t.Errorf("Close of an in-memory signer: %v", err)
+	}
+}
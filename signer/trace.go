@@ -0,0 +1,76 @@
+package signer
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Span names used by Client and KeySigner.
+const (
+	SpanSignFields        = "mina.signer.SignFields"
+	SpanVerifyFields      = "mina.signer.VerifyFields"
+	SpanSignMessage       = "mina.signer.SignMessage"
+	SpanVerifyMessage     = "mina.signer.VerifyMessage"
+	SpanSignTransaction   = "mina.signer.SignTransaction"
+	SpanVerifyTransaction = "mina.signer.VerifyTransaction"
+	SpanVerifyBatch       = "mina.signer.VerifyBatch"
+)
+
+// Tracer starts a span for each signing and verification operation of the
+// package. It is a narrow interface so any tracing library fits behind it;
+// for OpenTelemetry:
+//
+//	type otelTracer struct{ t trace.Tracer }
+//
+//	func (o otelTracer) Start(ctx context.Context, name string) (context.Context, signer.Span) {
+//		ctx, span := o.t.Start(ctx, name)
+//		return ctx, otelSpan{span}
+//	}
+//
+// with an otelSpan whose End records a non-nil error before ending the span.
+type Tracer interface {
+	// Start begins a span named name as a child of any span in ctx.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is an operation in progress.
+type Span interface {
+	// End finishes the span. err is the error the operation returned, nil
+	// on success and for verifications, which report their outcome as a
+	// bool.
+	End(err error)
+}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) End(error) {}
+
+// tracerHolder lets an atomic.Pointer hold any Tracer.
+type tracerHolder struct{ Tracer }
+
+var tracer atomic.Pointer[tracerHolder]
+
+func init() {
+	SetTracer(nil)
+}
+
+// SetTracer installs t for all Clients and KeySigners. The default, and
+// the result of passing nil, is a Tracer that does nothing. SetTracer is
+// safe to call while operations are running.
+func SetTracer(t Tracer) {
+	if t == nil {
+		t = noopTracer{}
+	}
+	tracer.Store(&tracerHolder{t})
+}
+
+// startSpan starts a span with the installed Tracer.
+func startSpan(ctx context.Context, name string) (context.Context, Span) {
+	return tracer.Load().Start(ctx, name)
+}
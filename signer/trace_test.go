@@ -0,0 +1,89 @@
+package signer_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+type spanKey struct{}
+
+// recorder is a Tracer that records ended spans and the parent found in
+// the context of each.
+type recorder struct {
+	mu    sync.Mutex
+	spans []recorded
+}
+
+type recorded struct {
+	name, parent string
+	err          error
+}
+
+type recordingSpan struct {
+	r            *recorder
+	name, parent string
+}
+
+func (r *recorder) Start(ctx context.Context, name string) (context.Context, signer.Span) {
+	parent, _ := ctx.Value(spanKey{}).(string)
+	return context.WithValue(ctx, spanKey{}, name), &recordingSpan{r: r, name: name, parent: parent}
+}
+
+func (s *recordingSpan) End(err error) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	s.r.spans = append(s.r.spans, recorded{s.name, s.parent, err})
+}
+
+func TestTracer(t *testing.T) {
+	r := &recorder{}
+	signer.SetTracer(r)
+	t.Cleanup(func() { signer.SetTracer(nil) })
+
+	c := signer.NewClient(signer.NetworkTestnet)
+	signed, err := c.SignFields([]*big.Int{big.NewInt(1)}, testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.VerifyFields(signed)
+	if _, err := c.SignMessage("hello", "not a key"); err == nil {
+		t.Fatal("SignMessage accepted an invalid key")
+	}
+	sk, _ := keys.PrivateKeyFromBase58(testPrivateKey)
+	ctx := context.WithValue(context.Background(), spanKey{}, "request")
+	if _, err := signer.NewKeySigner(sk, signer.NetworkTestnet).SignFields(ctx, []*big.Int{big.NewInt(1)}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		name, parent string
+		failed       bool
+	}{
+		{signer.SpanSignFields, "", false},
+		{signer.SpanVerifyFields, "", false},
+		{signer.SpanSignMessage, "", true},
+		{signer.SpanSignFields, "request", false},
+	}
+	if len(r.spans) != len(want) {
+		t.Fatalf("recorded %d spans, want %d: %+v", len(r.spans), len(want), r.spans)
+	}
+	for i, w := range want {
+		got := r.spans[i]
+		if got.name != w.name || got.parent != w.parent || (got.err != nil) != w.failed {
+			t.Errorf("span %d = %+v, want %+v", i, got, w)
+		}
+	}
+
+	signer.SetTracer(nil)
+	if _, err := c.SignFields([]*big.Int{big.NewInt(1)}, testPrivateKey); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.spans) != len(want) {
+		t.Error("spans were recorded after SetTracer(nil)")
+	}
+}
@@ -0,0 +1,178 @@
+// Package stealth derives one-time Mina addresses from a published
+// meta-address, so that payments to the same recipient cannot be linked
+// on chain.
+//
+// A recipient publishes a MetaAddress made of a scan key A = a·G and a
+// spend key B = b·G. To pay it, a sender draws an ephemeral key r, derives
+// the shared secret s of r and A with keys.SharedSecret and pays to
+//
+//	P' = B + h(s)·G
+//
+// where h hashes s to a scalar. The sender publishes the ephemeral public
+// key R = r·G alongside the payment, for example in the memo, as an
+// Announcement. The recipient scans announcements with a alone: the same
+// secret is the shared secret of a and R, so it recomputes P' and checks
+// whether it was paid. Only the holder of b can spend, with b + h(s).
+//
+// The scan key can be given to a wallet service that watches for payments
+// without being able to spend them. A recipient that does not need that
+// split can use the same key pair for A and B.
+//
+// Each Announcement also carries a one-byte view tag, the first byte of a
+// hash of s, so that a scanner skips 255 of 256 foreign announcements
+// after one scalar multiplication and without deriving P'.
+package stealth
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Domain tags of the hashes of the shared secret.
+const (
+	tweakLabel   = "MinaStealthTweak"
+	viewTagLabel = "MinaStealthViewTag"
+)
+
+// metaAddressPrefix starts the text form of a MetaAddress.
+const metaAddressPrefix = "st:mina:"
+
+// MetaAddress is the published address of a stealth recipient.
+type MetaAddress struct {
+	ScanKey  keys.PublicKey
+	SpendKey keys.PublicKey
+}
+
+// NewMetaAddress returns the meta-address of the scan and spend private
+// keys.
+func NewMetaAddress(scan, spend keys.PrivateKey) MetaAddress {
+	return MetaAddress{ScanKey: scan.ToPublicKey(), SpendKey: spend.ToPublicKey()}
+}
+
+// String returns m as "st:mina:" followed by the base58 addresses of the
+// scan and spend keys separated by a colon.
+func (m MetaAddress) String() string {
+	scan, err := m.ScanKey.ToBase58()
+	if err != nil {
+		return "<invalid meta-address>"
+	}
+	spend, err := m.SpendKey.ToBase58()
+	if err != nil {
+		return "<invalid meta-address>"
+	}
+	return metaAddressPrefix + scan + ":" + spend
+}
+
+// ParseMetaAddress parses the text form String returns.
+func ParseMetaAddress(s string) (MetaAddress, error) {
+	rest, ok := strings.CutPrefix(s, metaAddressPrefix)
+	if !ok {
+		return MetaAddress{}, errcode.Errorf(errcode.InvalidEncoding, "stealth: meta-address does not start with %q", metaAddressPrefix)
+	}
+	scan, spend, ok := strings.Cut(rest, ":")
+	if !ok {
+		return MetaAddress{}, errcode.New(errcode.MissingValue, "stealth: meta-address needs a scan and a spend key")
+	}
+	var m MetaAddress
+	var err error
+	if m.ScanKey, err = keys.PublicKeyFromBase58(scan); err != nil {
+		return MetaAddress{}, fmt.Errorf("stealth: scan key: %w", err)
+	}
+	if m.SpendKey, err = keys.PublicKeyFromBase58(spend); err != nil {
+		return MetaAddress{}, fmt.Errorf("stealth: spend key: %w", err)
+	}
+	return m, nil
+}
+
+// Announcement is what a sender publishes with a payment to a one-time
+// address.
+type Announcement struct {
+	EphemeralKey keys.PublicKey
+	ViewTag      byte
+}
+
+// NewPayment draws an ephemeral key and returns the one-time address to
+// pay and the announcement to publish with the payment.
+func (m MetaAddress) NewPayment() (keys.PublicKey, Announcement, error) {
+	r, err := scalar.RandomScalar()
+	if err != nil {
+		return keys.PublicKey{}, Announcement{}, err
+	}
+	ephemeral := keys.PrivateKey{Value: r}
+	secret, err := keys.SharedSecret(ephemeral, m.ScanKey)
+	if err != nil {
+		return keys.PublicKey{}, Announcement{}, fmt.Errorf("stealth: %w", err)
+	}
+	address, err := oneTimeAddress(m.SpendKey, secret)
+	if err != nil {
+		return keys.PublicKey{}, Announcement{}, err
+	}
+	return address, Announcement{EphemeralKey: ephemeral.ToPublicKey(), ViewTag: viewTag(secret)}, nil
+}
+
+// Scan reports whether the payment to address with announcement a is for
+// the recipient with the scan private key scan and the spend public key
+// spend.
+func Scan(scan keys.PrivateKey, spend keys.PublicKey, a Announcement, address keys.PublicKey) (bool, error) {
+	secret, err := keys.SharedSecret(scan, a.EphemeralKey)
+	if err != nil {
+		return false, fmt.Errorf("stealth: %w", err)
+	}
+	if viewTag(secret) != a.ViewTag {
+		return false, nil
+	}
+	want, err := oneTimeAddress(spend, secret)
+	if err != nil {
+		return false, err
+	}
+	return want.Equal(address), nil
+}
+
+// SpendingKey returns the private key of the one-time address announced
+// by a. It does not check that the payment is for the recipient; use Scan
+// for that.
+func SpendingKey(scan, spend keys.PrivateKey, a Announcement) (keys.PrivateKey, error) {
+	if spend.Value == nil {
+		return keys.PrivateKey{}, errcode.New(errcode.MissingValue, "stealth: spend key is not set")
+	}
+	secret, err := keys.SharedSecret(scan, a.EphemeralKey)
+	if err != nil {
+		return keys.PrivateKey{}, fmt.Errorf("stealth: %w", err)
+	}
+	sk, err := spend.Tweak(tweak(secret))
+	if err != nil {
+		return keys.PrivateKey{}, fmt.Errorf("stealth: %w", err)
+	}
+	return sk, nil
+}
+
+// oneTimeAddress returns spend + h(secret)·G.
+func oneTimeAddress(spend keys.PublicKey, secret []byte) (keys.PublicKey, error) {
+	address, err := spend.Tweak(tweak(secret))
+	if err != nil {
+		return keys.PublicKey{}, fmt.Errorf("stealth: spend key: %w", err)
+	}
+	return address, nil
+}
+
+// tweak hashes the shared secret to a scalar, reducing 512 bits so that
+// the result is uniform.
+func tweak(secret []byte) *scalar.Scalar {
+	h, _ := blake2b.New512(nil)
+	h.Write([]byte(tweakLabel))
+	h.Write(secret)
+	return scalar.ScalarFromBytesLE(h.Sum(nil))
+}
+
+func viewTag(secret []byte) byte {
+	h, _ := blake2b.New256(nil)
+	h.Write([]byte(viewTagLabel))
+	h.Write(secret)
+	return h.Sum(nil)[0]
+}
@@ -0,0 +1,73 @@
+package stealth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/stealth"
+)
+
+func TestStealthPayment(t *testing.T) {
+	scan := keys.PrivateKey{Value: scalar.NewScalar(1111)}
+	spend := keys.PrivateKey{Value: scalar.NewScalar(2222)}
+	meta, err := stealth.ParseMetaAddress(stealth.NewMetaAddress(scan, spend).String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address, announcement, err := meta.NewPayment()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address.Equal(meta.SpendKey) {
+		t.Fatal("one-time address is the spend key")
+	}
+	other, _, err := meta.NewPayment()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Equal(address) {
+		t.Error("two payments share a one-time address")
+	}
+
+	ok, err := stealth.Scan(scan, meta.SpendKey, announcement, address)
+	if err != nil || !ok {
+		t.Fatalf("recipient did not find its payment: %v", err)
+	}
+	stranger := keys.PrivateKey{Value: scalar.NewScalar(3333)}
+	if ok, _ := stealth.Scan(stranger, meta.SpendKey, announcement, address); ok {
+		t.Error("another scan key found the payment")
+	}
+
+	sk, err := stealth.SpendingKey(scan, spend, announcement)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub := sk.ToPublicKey(); !pub.Equal(address) {
+		t.Fatal("spending key does not match the one-time address")
+	}
+	msg := poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(1)}}
+	sig, err := sk.Sign(msg, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !address.Verify(sig, msg, "testnet") {
+		t.Error("one-time key does not sign for its address")
+	}
+}
+
+func TestParseMetaAddressRejectsInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg",
+		"st:mina:B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg",
+		"st:mina:B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg:invalid",
+	} {
+		if _, err := stealth.ParseMetaAddress(s); err == nil {
+			t.Errorf("ParseMetaAddress(%q) succeeded", s)
+		}
+	}
+}
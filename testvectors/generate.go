@@ -0,0 +1,320 @@
+package testvectors
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/rand/v2"
+	"strconv"
+
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+	"github.com/node101-io/mina-signer-go/zkapp"
+)
+
+const (
+	// maxFields bounds the number of field elements in a generated field
+	// vector.
+	maxFields = 8
+	// maxHashInputs bounds the number of field elements and of packed
+	// values in a generated hash input.
+	maxHashInputs = 4
+	// maxZkappUpdates and maxZkappDepth bound the account updates at each
+	// level of a generated call forest and its depth.
+	maxZkappUpdates = 3
+	maxZkappDepth   = 2
+)
+
+// messageAlphabet is the character set of generated string messages. It
+// includes multi-byte characters so implementations that hash UTF-16 or
+// runes instead of UTF-8 bytes disagree.
+const messageAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 .,:;!?-_'\"{}[]\néü€"
+
+// Generate returns a corpus for network with n vectors of each kind. The
+// vectors are a function of seed alone, so a corpus can be regenerated
+// instead of stored.
+func Generate(network string, seed uint64, n int) (*Corpus, error) {
+	g := NewGenerator(network, seed)
+	c := &Corpus{Version: SchemaVersion, Network: network}
+	for i := 0; i < n; i++ {
+		k, err := g.Key()
+		if err != nil {
+			return nil, fmt.Errorf("testvectors: keys: %w", err)
+		}
+		c.Keys = append(c.Keys, k)
+	}
+	for i := 0; i < n; i++ {
+		v, err := g.Fields()
+		if err != nil {
+			return nil, fmt.Errorf("testvectors: fields: %w", err)
+		}
+		c.Fields = append(c.Fields, v)
+	}
+	for i := 0; i < n; i++ {
+		v, err := g.Message()
+		if err != nil {
+			return nil, fmt.Errorf("testvectors: strings: %w", err)
+		}
+		c.Strings = append(c.Strings, v)
+	}
+	for i := 0; i < n; i++ {
+		v, err := g.Payment()
+		if err != nil {
+			return nil, fmt.Errorf("testvectors: payments: %w", err)
+		}
+		c.Payments = append(c.Payments, v)
+	}
+	for i := 0; i < n; i++ {
+		v, err := g.StakeDelegation()
+		if err != nil {
+			return nil, fmt.Errorf("testvectors: stakeDelegations: %w", err)
+		}
+		c.StakeDelegations = append(c.StakeDelegations, v)
+	}
+	for i := 0; i < n; i++ {
+		v, err := g.ZkappCommand()
+		if err != nil {
+			return nil, fmt.Errorf("testvectors: zkappCommands: %w", err)
+		}
+		c.ZkappCommands = append(c.ZkappCommands, v)
+	}
+	return c, nil
+}
+
+// Generator produces random valid vectors with their expected signatures,
+// for property-based and differential tests that want more vectors than a
+// stored corpus holds. The sequence of vectors is a function of the
+// network and seed alone, so a failing case is reproduced from the seed
+// and its index. A Generator is not safe for concurrent use.
+type Generator struct {
+	rng     *rand.Rand
+	client  *signer.Client
+	network keys.Network
+}
+
+// NewGenerator returns a Generator of vectors for network from seed.
+func NewGenerator(network string, seed uint64) *Generator {
+	var key [32]byte
+	binary.LittleEndian.PutUint64(key[:], seed)
+	return &Generator{
+		rng:     rand.New(rand.NewChaCha8(key)),
+		client:  signer.NewClient(signer.NetworkFromName(network)),
+		network: keys.NetworkFromID(network),
+	}
+}
+
+// element returns a uniformly distributed value below modulus, reducing
+// 64 random bytes so the bias is negligible.
+func (g *Generator) element(modulus *big.Int) *big.Int {
+	b := make([]byte, 64)
+	for i := 0; i < len(b); i += 8 {
+		binary.LittleEndian.PutUint64(b[i:], g.rng.Uint64())
+	}
+	return new(big.Int).Mod(new(big.Int).SetBytes(b), modulus)
+}
+
+// Key returns a random key.
+func (g *Generator) Key() (Key, error) {
+	s := g.element(scalar.Q)
+	for s.Sign() == 0 {
+		s = g.element(scalar.Q)
+	}
+	return newKey(g.client, keys.PrivateKey{Value: scalar.NewScalar(s)})
+}
+
+// Fields returns a signature over 1 to 8 random field elements.
+func (g *Generator) Fields() (Fields, error) {
+	k, err := g.Key()
+	if err != nil {
+		return Fields{}, err
+	}
+	fields := make([]*big.Int, 1+g.rng.IntN(maxFields))
+	strs := make([]string, len(fields))
+	for i := range fields {
+		fields[i] = g.element(field.P)
+		strs[i] = fields[i].String()
+	}
+	signed, err := g.client.SignFields(fields, k.PrivateKey)
+	if err != nil {
+		return Fields{}, err
+	}
+	return Fields{Key: k, Fields: strs, Signature: signed.Signature}, nil
+}
+
+func (g *Generator) text(maxBytes int) string {
+	alphabet := []rune(messageAlphabet)
+	var out []rune
+	for size := 0; ; {
+		r := alphabet[g.rng.IntN(len(alphabet))]
+		if size += len(string(r)); size > maxBytes {
+			return string(out)
+		}
+		out = append(out, r)
+	}
+}
+
+// Message returns a signature over a random string of up to 127 bytes.
+func (g *Generator) Message() (String, error) {
+	k, err := g.Key()
+	if err != nil {
+		return String{}, err
+	}
+	msg := g.text(g.rng.IntN(128))
+	signed, err := g.client.SignMessage(msg, k.PrivateKey)
+	if err != nil {
+		return String{}, err
+	}
+	return String{Key: k, Message: msg, Signature: signed.Signature}, nil
+}
+
+// common holds the fields payments and stake delegations share.
+type common struct {
+	from, to   Key
+	fee        uint64
+	nonce      uint32
+	validUntil uint32
+	memo       string
+}
+
+// common returns random fee payer and receiver keys and common fields. One
+// in four commands never expires.
+func (g *Generator) common() (common, error) {
+	from, err := g.Key()
+	if err != nil {
+		return common{}, err
+	}
+	to, err := g.Key()
+	if err != nil {
+		return common{}, err
+	}
+	c := common{from: from, to: to, fee: g.rng.Uint64(), nonce: g.rng.Uint32(), validUntil: transaction.NoExpiry}
+	if g.rng.IntN(4) != 0 {
+		c.validUntil = g.rng.Uint32()
+	}
+	c.memo = g.text(g.rng.IntN(transaction.MaxMemoLength + 1))
+	return c, nil
+}
+
+// Payment returns a signed payment between random keys.
+func (g *Generator) Payment() (Payment, error) {
+	c, err := g.common()
+	if err != nil {
+		return Payment{}, err
+	}
+	body := PaymentBody{
+		From: c.from.PublicKey, To: c.to.PublicKey, Amount: g.rng.Uint64(), Fee: c.fee,
+		Nonce: c.nonce, ValidUntil: c.validUntil, Memo: c.memo,
+	}
+	tx, err := body.command()
+	if err != nil {
+		return Payment{}, err
+	}
+	signed, err := g.client.SignTransaction(tx, c.from.PrivateKey)
+	if err != nil {
+		return Payment{}, err
+	}
+	return Payment{Key: c.from, Payment: body, Signature: signed.Signature}, nil
+}
+
+// StakeDelegation returns a signed stake delegation between random keys.
+func (g *Generator) StakeDelegation() (StakeDelegation, error) {
+	c, err := g.common()
+	if err != nil {
+		return StakeDelegation{}, err
+	}
+	body := StakeDelegationBody{
+		From: c.from.PublicKey, To: c.to.PublicKey, Fee: c.fee,
+		Nonce: c.nonce, ValidUntil: c.validUntil, Memo: c.memo,
+	}
+	tx, err := body.command()
+	if err != nil {
+		return StakeDelegation{}, err
+	}
+	signed, err := g.client.SignTransaction(tx, c.from.PrivateKey)
+	if err != nil {
+		return StakeDelegation{}, err
+	}
+	return StakeDelegation{Key: c.from, StakeDelegation: body, Signature: signed.Signature}, nil
+}
+
+// hashInput returns a random hash input of up to four field elements and
+// four packed values of 1, 32 or 64 bits.
+func (g *Generator) hashInput() HashInput {
+	var h HashInput
+	for range g.rng.IntN(maxHashInputs + 1) {
+		h.Fields = append(h.Fields, g.element(field.P).String())
+	}
+	for range g.rng.IntN(maxHashInputs + 1) {
+		size := []int{1, 32, 64}[g.rng.IntN(3)]
+		v := g.rng.Uint64() >> (64 - size)
+		h.Packed = append(h.Packed, PackedField{Value: strconv.FormatUint(v, 10), Size: size})
+	}
+	return h
+}
+
+// ZkappCommand returns a signed zkApp command skeleton: a fee payer and up
+// to three account updates, each calling up to two more, with random
+// bodies, authorizations and flags. Updates authorized by a signature
+// either use the full commitment or increment a fixed nonce, as
+// zkapp.Command.Validate requires. The bodies are not valid account
+// updates; see ZkappCommand.
+func (g *Generator) ZkappCommand() (ZkappCommand, error) {
+	payer, err := g.Key()
+	if err != nil {
+		return ZkappCommand{}, err
+	}
+	v := ZkappCommand{Key: payer, FeePayerBody: g.hashInput()}
+	if v.AccountUpdates, err = g.accountUpdates(maxZkappDepth); err != nil {
+		return ZkappCommand{}, err
+	}
+	v.Memo = g.text(g.rng.IntN(transaction.MaxMemoLength + 1))
+	cmd, c, err := v.sign(g.network)
+	if err != nil {
+		return ZkappCommand{}, err
+	}
+	v.Commitment, v.FullCommitment = c.Commitment.String(), c.FullCommitment.String()
+	v.Signature = cmd.FeePayer.Signature
+	copySignatures(v.AccountUpdates, cmd.AccountUpdates)
+	return v, nil
+}
+
+// accountUpdates returns up to three random account updates calling
+// forests of at most depth-1 levels.
+func (g *Generator) accountUpdates(depth int) ([]ZkappAccountUpdate, error) {
+	if depth == 0 {
+		return nil, nil
+	}
+	var us []ZkappAccountUpdate
+	for range g.rng.IntN(maxZkappUpdates + 1) {
+		k, err := g.Key()
+		if err != nil {
+			return nil, err
+		}
+		u := ZkappAccountUpdate{
+			Key: k, Body: g.hashInput(), Authorization: authorizations[g.rng.IntN(len(authorizations))],
+			UseFullCommitment: g.rng.IntN(2) == 0, IncrementNonce: g.rng.IntN(2) == 0, ConstantNonce: g.rng.IntN(2) == 0,
+		}
+		if u.Authorization == authorizations[zkapp.AuthorizationSignature] && !u.UseFullCommitment {
+			u.IncrementNonce, u.ConstantNonce = true, true
+		}
+		if u.Calls, err = g.accountUpdates(depth - 1); err != nil {
+			return nil, err
+		}
+		us = append(us, u)
+	}
+	return us, nil
+}
+
+// copySignatures copies the signatures of the updates authorized by a
+// signature in us to the vectors vs.
+func copySignatures(vs []ZkappAccountUpdate, us []zkapp.AccountUpdate) {
+	for i := range vs {
+		if us[i].Authorization == zkapp.AuthorizationSignature {
+			vs[i].Signature = us[i].Signature
+		}
+		copySignatures(vs[i].Calls, us[i].Calls)
+	}
+}
@@ -0,0 +1,555 @@
+// Package testvectors reads and writes the cross-implementation test vectors
+// shared with the o1js test suite.
+//
+// A Corpus holds keys, field signatures, string signatures, signed
+// commands and zkApp command skeletons for one network in a stable JSON
+// schema: keys and addresses are base58, field elements and signature
+// components decimal strings, and commands use the mina-signer JSON names
+// with numbers as strings. Generate produces a corpus from a seed and
+// Verify checks one, whichever implementation wrote it; a Generator
+// produces the same vectors one at a time for property-based tests.
+// LoadLegacy converts the older testJSON files of the signature package to
+// the same schema.
+//
+// The zkApp command skeletons are self-consistency vectors only. Their
+// bodies are random hash inputs, not encoded account updates, so o1js
+// cannot rebuild them as transactions; another implementation can check
+// them only by hashing the given bodies as this module does. They pin the
+// forest, memo and commitment hashing and the signatures, not the layout
+// of account updates.
+package testvectors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"slices"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+	"github.com/node101-io/mina-signer-go/zkapp"
+)
+
+// SchemaVersion is the version of the Corpus schema this package writes.
+// Readers reject corpora with a different version.
+const SchemaVersion = 1
+
+// Corpus is a set of test vectors for one network.
+type Corpus struct {
+	Version int `json:"version"`
+	// Network is the networkId the signatures are bound to, as accepted
+	// by signer.NetworkFromName.
+	Network          string            `json:"network"`
+	Keys             []Key             `json:"keys"`
+	Fields           []Fields          `json:"fields"`
+	Strings          []String          `json:"strings"`
+	Payments         []Payment         `json:"payments"`
+	StakeDelegations []StakeDelegation `json:"stakeDelegations"`
+	// ZkappCommands was added after the first corpora were written and is
+	// omitted when empty, so older corpora still read.
+	ZkappCommands []ZkappCommand `json:"zkappCommands,omitempty"`
+}
+
+// Key is a private key and the address derived from it.
+type Key struct {
+	PrivateKey string `json:"privateKey"`
+	PublicKey  string `json:"publicKey"`
+}
+
+// Fields is a signature over base field elements, as signFields produces.
+type Fields struct {
+	Key
+	Fields    []string             `json:"fields"`
+	Signature *signature.Signature `json:"signature"`
+}
+
+// String is a signature over a string, as signMessage produces.
+type String struct {
+	Key
+	Message   string               `json:"message"`
+	Signature *signature.Signature `json:"signature"`
+}
+
+// PaymentBody is a payment in the mina-signer JSON form.
+type PaymentBody struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Amount     uint64 `json:"amount,string"`
+	Fee        uint64 `json:"fee,string"`
+	Nonce      uint32 `json:"nonce,string"`
+	ValidUntil uint32 `json:"validUntil,string"`
+	Memo       string `json:"memo"`
+}
+
+// Payment is a signed payment. Key is the fee payer's.
+type Payment struct {
+	Key
+	Payment   PaymentBody          `json:"payment"`
+	Signature *signature.Signature `json:"signature"`
+}
+
+// StakeDelegationBody is a stake delegation in the mina-signer JSON form.
+type StakeDelegationBody struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Fee        uint64 `json:"fee,string"`
+	Nonce      uint32 `json:"nonce,string"`
+	ValidUntil uint32 `json:"validUntil,string"`
+	Memo       string `json:"memo"`
+}
+
+// StakeDelegation is a signed stake delegation. Key is the delegator's.
+type StakeDelegation struct {
+	Key
+	StakeDelegation StakeDelegationBody  `json:"stakeDelegation"`
+	Signature       *signature.Signature `json:"signature"`
+}
+
+// HashInput is a random-oracle input in decimal, the form of
+// poseidonbigint.HashInput: field elements, then values packed with their
+// sizes in bits.
+type HashInput struct {
+	Fields []string      `json:"fields"`
+	Packed []PackedField `json:"packed,omitempty"`
+}
+
+// PackedField is a value packed into a HashInput with its size in bits.
+type PackedField struct {
+	Value string `json:"value"`
+	Size  int    `json:"size"`
+}
+
+// authorizations names the zkapp.AuthorizationKind values in vectors.
+var authorizations = []string{
+	zkapp.AuthorizationNone:      "none",
+	zkapp.AuthorizationSignature: "signature",
+	zkapp.AuthorizationProof:     "proof",
+}
+
+// ZkappAccountUpdate is an account update of a ZkappCommand. Key is the
+// key of its account.
+type ZkappAccountUpdate struct {
+	Key
+	Body HashInput `json:"body"`
+	// Authorization is "none", "signature" or "proof".
+	Authorization     string `json:"authorization"`
+	UseFullCommitment bool   `json:"useFullCommitment"`
+	IncrementNonce    bool   `json:"incrementNonce"`
+	ConstantNonce     bool   `json:"constantNonce"`
+	// Signature is set for an update authorized by a signature.
+	Signature *signature.Signature `json:"signature,omitempty"`
+	Calls     []ZkappAccountUpdate `json:"calls,omitempty"`
+}
+
+// ZkappCommand is a signed zkApp command skeleton, a zkapp.Command whose
+// fee payer and account update bodies are random hash inputs instead of
+// encoded o1js account updates. It pins the forest and memo hashing, the
+// commitments and the choice between them, and the signatures, without
+// depending on the layout of bodies. It is a self-consistency vector: no
+// o1js transaction has these bodies, so it cannot be checked against
+// o1js's own zkApp signing. Key is the fee payer's.
+type ZkappCommand struct {
+	Key
+	FeePayerBody   HashInput            `json:"feePayerBody"`
+	AccountUpdates []ZkappAccountUpdate `json:"accountUpdates"`
+	Memo           string               `json:"memo"`
+	Commitment     string               `json:"commitment"`
+	FullCommitment string               `json:"fullCommitment"`
+	Signature      *signature.Signature `json:"signature"`
+}
+
+// Read decodes a corpus written by Write.
+func Read(r io.Reader) (*Corpus, error) {
+	var c Corpus
+	if err := json.NewDecoder(r).Decode(&c); err != nil {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "testvectors: %w", err)
+	}
+	if c.Version != SchemaVersion {
+		return nil, errcode.Errorf(errcode.InvalidVersion, "testvectors: schema version %d, want %d", c.Version, SchemaVersion)
+	}
+	return &c, nil
+}
+
+// ReadFile decodes the corpus in the file at path.
+func ReadFile(path string) (*Corpus, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Write encodes c as indented JSON.
+func Write(w io.Writer, c *Corpus) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c)
+}
+
+// legacyCase is an entry of the signature package's testJSON files: a
+// decimal private key, the signed fields and a decimal (r, s) signature.
+type legacyCase struct {
+	PrivateKey struct {
+		S string `json:"s"`
+	} `json:"privateKey"`
+	Message   []string `json:"message"`
+	Signature struct {
+		R string `json:"r"`
+		S string `json:"s"`
+	} `json:"signature"`
+}
+
+// LegacyNetwork is the network the testJSON files were signed for.
+const LegacyNetwork = "testnet"
+
+// LoadLegacy reads a testJSON file of the signature package and returns
+// its cases as field vectors for LegacyNetwork. At most limit cases are
+// converted; limit <= 0 converts all of them.
+func LoadLegacy(r io.Reader, limit int) ([]Fields, error) {
+	var cases []legacyCase
+	if err := json.NewDecoder(r).Decode(&cases); err != nil {
+		return nil, errcode.Errorf(errcode.InvalidEncoding, "testvectors: %w", err)
+	}
+	if limit > 0 && len(cases) > limit {
+		cases = cases[:limit]
+	}
+	client := signer.NewClient(signer.NetworkFromName(LegacyNetwork))
+	out := make([]Fields, len(cases))
+	for i, tc := range cases {
+		v, err := tc.fields(client)
+		if err != nil {
+			return nil, fmt.Errorf("testvectors: case %d: %w", i, err)
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func (tc legacyCase) fields(client *signer.Client) (Fields, error) {
+	sk, err := scalar.NewScalarErr(tc.PrivateKey.S)
+	if err != nil {
+		return Fields{}, fmt.Errorf("private key: %w", err)
+	}
+	key, err := newKey(client, keys.PrivateKey{Value: sk})
+	if err != nil {
+		return Fields{}, err
+	}
+	r, okR := new(big.Int).SetString(tc.Signature.R, 10)
+	sigS, okS := new(big.Int).SetString(tc.Signature.S, 10)
+	if !okR || !okS {
+		return Fields{}, errcode.Errorf(errcode.InvalidEncoding, "invalid signature (%q, %q)", tc.Signature.R, tc.Signature.S)
+	}
+	sig, err := signature.New(r, sigS)
+	if err != nil {
+		return Fields{}, err
+	}
+	return Fields{Key: key, Fields: tc.Message, Signature: sig}, nil
+}
+
+// LoadLegacyFile is LoadLegacy for the file at path.
+func LoadLegacyFile(path string, limit int) ([]Fields, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadLegacy(f, limit)
+}
+
+func newKey(client *signer.Client, sk keys.PrivateKey) (Key, error) {
+	priv := sk.Value.ToBase58()
+	pub, err := client.DerivePublicKey(priv)
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{PrivateKey: priv, PublicKey: pub}, nil
+}
+
+// Verify checks every vector of c: each address must derive from its
+// private key and each signature must be the one this implementation
+// produces and must verify. It reports the first mismatch.
+func Verify(c *Corpus) error {
+	if c.Version != SchemaVersion {
+		return errcode.Errorf(errcode.InvalidVersion, "testvectors: schema version %d, want %d", c.Version, SchemaVersion)
+	}
+	client := signer.NewClient(signer.NetworkFromName(c.Network))
+	for i, k := range c.Keys {
+		if err := client.VerifyKeypair(k.PrivateKey, k.PublicKey); err != nil {
+			return fmt.Errorf("testvectors: keys[%d]: %w", i, err)
+		}
+	}
+	for i, v := range c.Fields {
+		if err := VerifyFields(client, v); err != nil {
+			return fmt.Errorf("testvectors: fields[%d]: %w", i, err)
+		}
+	}
+	for i, v := range c.Strings {
+		signed, err := client.SignMessage(v.Message, v.PrivateKey)
+		if err == nil {
+			err = check(client.VerifyMessage, signed, v.Key, v.Signature)
+		}
+		if err != nil {
+			return fmt.Errorf("testvectors: strings[%d]: %w", i, err)
+		}
+	}
+	for i, v := range c.Payments {
+		if err := verifyCommand(client, v.Key, v.Payment.command, v.Signature); err != nil {
+			return fmt.Errorf("testvectors: payments[%d]: %w", i, err)
+		}
+	}
+	for i, v := range c.StakeDelegations {
+		if err := verifyCommand(client, v.Key, v.StakeDelegation.command, v.Signature); err != nil {
+			return fmt.Errorf("testvectors: stakeDelegations[%d]: %w", i, err)
+		}
+	}
+	network := keys.NetworkFromID(c.Network)
+	for i, v := range c.ZkappCommands {
+		if err := VerifyZkappCommand(network, v); err != nil {
+			return fmt.Errorf("testvectors: zkappCommands[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// VerifyZkappCommand checks a single zkApp command vector for network: its
+// commitments and every signature must be the ones this implementation
+// produces, and the command must verify.
+func VerifyZkappCommand(network keys.Network, v ZkappCommand) error {
+	cmd, c, err := v.sign(network)
+	if err != nil {
+		return err
+	}
+	if got := c.Commitment.String(); got != v.Commitment {
+		return errcode.Errorf(errcode.InvalidArgument, "commitment %s, computed %s", v.Commitment, got)
+	}
+	if got := c.FullCommitment.String(); got != v.FullCommitment {
+		return errcode.Errorf(errcode.InvalidArgument, "full commitment %s, computed %s", v.FullCommitment, got)
+	}
+	if err := compareSignature("fee payer", v.Signature, cmd.FeePayer.Signature); err != nil {
+		return err
+	}
+	cmd.FeePayer.Signature = v.Signature
+	if err := compareUpdates("accountUpdates", v.AccountUpdates, cmd.AccountUpdates); err != nil {
+		return err
+	}
+	return cmd.Verify(network)
+}
+
+// compareUpdates checks the signatures of the updates us this
+// implementation made against those of the vectors vs, and replaces them
+// with the expected ones.
+func compareUpdates(path string, vs []ZkappAccountUpdate, us []zkapp.AccountUpdate) error {
+	for i := range vs {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		if us[i].Authorization == zkapp.AuthorizationSignature {
+			if err := compareSignature(p, vs[i].Signature, us[i].Signature); err != nil {
+				return err
+			}
+			us[i].Signature = vs[i].Signature
+		}
+		if err := compareUpdates(p+".calls", vs[i].Calls, us[i].Calls); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compareSignature(what string, want, got *signature.Signature) error {
+	if want == nil {
+		return errcode.Errorf(errcode.MissingValue, "%s: missing signature", what)
+	}
+	if !sameSignature(want, got) {
+		return errcode.Errorf(errcode.InvalidSignature, "%s: signature (%s, %s), produced (%s, %s)", what, want.R, want.S, got.R, got.S)
+	}
+	return nil
+}
+
+func sameSignature(a, b *signature.Signature) bool {
+	return a.R.Equal(b.R) && a.S.BigInt().Cmp(b.S.BigInt()) == 0
+}
+
+// sign builds the command of v and signs it with the fee payer's key and
+// the keys of the updates authorized by a signature. It returns the
+// command and its commitments.
+func (v ZkappCommand) sign(network keys.Network) (*zkapp.Command, zkapp.Commitments, error) {
+	var signers []Key
+	feePayer, err := keys.PublicKeyFromBase58(v.PublicKey)
+	if err != nil {
+		return nil, zkapp.Commitments{}, fmt.Errorf("fee payer: %w", err)
+	}
+	body, err := v.FeePayerBody.input()
+	if err != nil {
+		return nil, zkapp.Commitments{}, fmt.Errorf("fee payer: %w", err)
+	}
+	cmd := &zkapp.Command{FeePayer: zkapp.FeePayer{PublicKey: feePayer, Body: body}, Memo: v.Memo}
+	signers = append(signers, v.Key)
+	if cmd.AccountUpdates, err = accountUpdates("accountUpdates", v.AccountUpdates, &signers); err != nil {
+		return nil, zkapp.Commitments{}, err
+	}
+	c, err := cmd.Commitments(network)
+	if err != nil {
+		return nil, zkapp.Commitments{}, err
+	}
+	signed := make(map[string]bool)
+	for _, k := range signers {
+		if signed[k.PublicKey] {
+			continue
+		}
+		signed[k.PublicKey] = true
+		sk, err := keys.PrivateKeyFromBase58(k.PrivateKey)
+		if err != nil {
+			return nil, zkapp.Commitments{}, err
+		}
+		if address, err := sk.ToPublicKey().ToBase58(); err != nil || address != k.PublicKey {
+			return nil, zkapp.Commitments{}, errcode.Errorf(errcode.KeyMismatch, "address %s, derived %s", k.PublicKey, address)
+		}
+		if _, err := cmd.Sign(sk, network); err != nil {
+			return nil, zkapp.Commitments{}, err
+		}
+	}
+	return cmd, c, nil
+}
+
+// accountUpdates converts the account updates vs, adding the keys of those
+// authorized by a signature to signers.
+func accountUpdates(path string, vs []ZkappAccountUpdate, signers *[]Key) ([]zkapp.AccountUpdate, error) {
+	var us []zkapp.AccountUpdate
+	for i, v := range vs {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		pk, err := keys.PublicKeyFromBase58(v.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		body, err := v.Body.input()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		auth := slices.Index(authorizations, v.Authorization)
+		if auth < 0 {
+			return nil, errcode.Errorf(errcode.InvalidEncoding, "%s: unknown authorization %q", p, v.Authorization)
+		}
+		calls, err := accountUpdates(p+".calls", v.Calls, signers)
+		if err != nil {
+			return nil, err
+		}
+		if zkapp.AuthorizationKind(auth) == zkapp.AuthorizationSignature {
+			*signers = append(*signers, v.Key)
+		}
+		us = append(us, zkapp.AccountUpdate{
+			PublicKey: pk, Body: body, Authorization: zkapp.AuthorizationKind(auth),
+			UseFullCommitment: v.UseFullCommitment, IncrementNonce: v.IncrementNonce, ConstantNonce: v.ConstantNonce,
+			Calls: calls,
+		})
+	}
+	return us, nil
+}
+
+func (h HashInput) input() (poseidonbigint.HashInput, error) {
+	var in poseidonbigint.HashInput
+	for _, f := range h.Fields {
+		n, ok := new(big.Int).SetString(f, 10)
+		if !ok {
+			return poseidonbigint.HashInput{}, errcode.Errorf(errcode.InvalidEncoding, "invalid field %q", f)
+		}
+		in.Fields = append(in.Fields, n)
+	}
+	for _, p := range h.Packed {
+		n, ok := new(big.Int).SetString(p.Value, 10)
+		if !ok || p.Size <= 0 || n.Sign() < 0 || n.BitLen() > p.Size {
+			return poseidonbigint.HashInput{}, errcode.Errorf(errcode.InvalidEncoding, "invalid packed value %q of %d bits", p.Value, p.Size)
+		}
+		in.Packed = append(in.Packed, poseidonbigint.PackedField{Field: n, Size: p.Size})
+	}
+	return in, nil
+}
+
+// VerifyFields checks a single field vector against client, which must be
+// for the network the vector was signed for.
+func VerifyFields(client *signer.Client, v Fields) error {
+	fields := make([]*big.Int, len(v.Fields))
+	for i, f := range v.Fields {
+		n, ok := new(big.Int).SetString(f, 10)
+		if !ok {
+			return errcode.Errorf(errcode.InvalidEncoding, "invalid field %q", f)
+		}
+		fields[i] = n
+	}
+	signed, err := client.SignFields(fields, v.PrivateKey)
+	if err != nil {
+		return err
+	}
+	return check(client.VerifyFields, signed, v.Key, v.Signature)
+}
+
+func verifyCommand(client *signer.Client, key Key, command func() (transaction.Command, error), want *signature.Signature) error {
+	tx, err := command()
+	if err != nil {
+		return err
+	}
+	signed, err := client.SignTransaction(tx, key.PrivateKey)
+	if err != nil {
+		return err
+	}
+	return check(client.VerifyTransaction, signed, key, want)
+}
+
+// check compares the signature this implementation produced with the
+// expected one and verifies the expected one.
+func check[T any](verify func(*signer.Signed[T]) bool, signed *signer.Signed[T], key Key, want *signature.Signature) error {
+	if signed.PublicKey != key.PublicKey {
+		return errcode.Errorf(errcode.KeyMismatch, "address %s, derived %s", key.PublicKey, signed.PublicKey)
+	}
+	if want == nil {
+		return errcode.New(errcode.MissingValue, "missing signature")
+	}
+	if !sameSignature(signed.Signature, want) {
+		return errcode.Errorf(errcode.InvalidSignature, "signature (%s, %s), produced (%s, %s)", want.R, want.S, signed.Signature.R, signed.Signature.S)
+	}
+	signed.Signature = want
+	if !verify(signed) {
+		return errcode.New(errcode.InvalidSignature, "signature does not verify")
+	}
+	return nil
+}
+
+func (p PaymentBody) command() (transaction.Command, error) {
+	from, to, err := publicKeys(p.From, p.To)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.Payment{
+		From: from, To: to, Amount: p.Amount, Fee: p.Fee,
+		Nonce: p.Nonce, ValidUntil: p.ValidUntil, Memo: p.Memo,
+	}, nil
+}
+
+func (d StakeDelegationBody) command() (transaction.Command, error) {
+	from, to, err := publicKeys(d.From, d.To)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.StakeDelegation{
+		From: from, To: to, Fee: d.Fee,
+		Nonce: d.Nonce, ValidUntil: d.ValidUntil, Memo: d.Memo,
+	}, nil
+}
+
+func publicKeys(from, to string) (keys.PublicKey, keys.PublicKey, error) {
+	f, err := keys.PublicKeyFromBase58(from)
+	if err != nil {
+		return keys.PublicKey{}, keys.PublicKey{}, fmt.Errorf("from: %w", err)
+	}
+	t, err := keys.PublicKeyFromBase58(to)
+	if err != nil {
+		return keys.PublicKey{}, keys.PublicKey{}, fmt.Errorf("to: %w", err)
+	}
+	return f, t, nil
+}
@@ -0,0 +1,154 @@
+package testvectors_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/testvectors"
+)
+
+func TestGenerateRoundTrip(t *testing.T) {
+	c, err := testvectors.Generate("testnet", 7, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := testvectors.Verify(c); err != nil {
+		t.Fatalf("Verify(Generate) = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := testvectors.Write(&buf, c); err != nil {
+		t.Fatal(err)
+	}
+	again, err := testvectors.Generate("testnet", 7, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf2 bytes.Buffer
+	if err := testvectors.Write(&buf2, again); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), buf2.Bytes()) {
+		t.Error("Generate is not deterministic for a fixed seed")
+	}
+
+	read, err := testvectors.Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := testvectors.Verify(read); err != nil {
+		t.Errorf("Verify(Read(Write)) = %v", err)
+	}
+}
+
+func TestVerifyDetectsMismatch(t *testing.T) {
+	c, err := testvectors.Generate("mainnet", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Network = "testnet"
+	if err := testvectors.Verify(c); err == nil || !strings.Contains(err.Error(), "fields[0]") {
+		t.Errorf("Verify with the wrong network = %v, want a fields[0] mismatch", err)
+	}
+
+	c.Network = "mainnet"
+	c.Payments[0].Payment.Amount++
+	if err := testvectors.Verify(c); err == nil || !strings.Contains(err.Error(), "payments[0]") {
+		t.Errorf("Verify with a changed amount = %v, want a payments[0] mismatch", err)
+	}
+	c.Payments[0].Payment.Amount--
+
+	c.Version++
+	if err := testvectors.Verify(c); err == nil {
+		t.Error("Verify accepted an unknown schema version")
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	g, again := testvectors.NewGenerator("devnet", 3), testvectors.NewGenerator("devnet", 3)
+	c := &testvectors.Corpus{Version: testvectors.SchemaVersion, Network: "devnet"}
+	signed := 0
+	for range 10 {
+		v, err := g.ZkappCommand()
+		if err != nil {
+			t.Fatal(err)
+		}
+		w, err := again.ZkappCommand()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.FullCommitment != w.FullCommitment {
+			t.Fatal("Generator is not deterministic for a fixed seed")
+		}
+		for _, u := range v.AccountUpdates {
+			if u.Signature != nil {
+				signed++
+			}
+		}
+		c.ZkappCommands = append(c.ZkappCommands, v)
+	}
+	if signed == 0 {
+		t.Error("no generated account update is authorized by a signature")
+	}
+	if err := testvectors.Verify(c); err != nil {
+		t.Fatalf("Verify(generated zkApp commands) = %v", err)
+	}
+
+	g = testvectors.NewGenerator("mainnet", 11)
+	c = &testvectors.Corpus{Version: testvectors.SchemaVersion, Network: "mainnet"}
+	for range 10 {
+		p, err := g.Payment()
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := g.StakeDelegation()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Payments, c.StakeDelegations = append(c.Payments, p), append(c.StakeDelegations, d)
+	}
+	if err := testvectors.Verify(c); err != nil {
+		t.Fatalf("Verify(generated commands) = %v", err)
+	}
+}
+
+func TestVerifyZkappCommand(t *testing.T) {
+	c, err := testvectors.Generate("testnet", 5, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &c.ZkappCommands[0]
+	v.Memo += "!"
+	if err := testvectors.Verify(c); errcode.CodeOf(err) != errcode.InvalidArgument || !strings.Contains(err.Error(), "zkappCommands[0]") {
+		t.Errorf("Verify with a changed memo = %v, want a zkappCommands[0] commitment mismatch", err)
+	}
+	v.Memo = strings.TrimSuffix(v.Memo, "!")
+	v.FeePayerBody.Fields = append(v.FeePayerBody.Fields, "1")
+	if err := testvectors.Verify(c); errcode.CodeOf(err) != errcode.InvalidArgument {
+		t.Errorf("Verify with a changed fee payer body = %v, want a commitment mismatch", err)
+	}
+	v.FeePayerBody.Fields = v.FeePayerBody.Fields[:len(v.FeePayerBody.Fields)-1]
+	if err := testvectors.Verify(c); err != nil {
+		t.Fatalf("Verify(restored) = %v", err)
+	}
+	v.Signature = c.ZkappCommands[1].Signature
+	if err := testvectors.Verify(c); errcode.CodeOf(err) != errcode.InvalidSignature {
+		t.Errorf("Verify with another fee payer signature = %v, want InvalidSignature", err)
+	}
+}
+
+func TestLoadLegacy(t *testing.T) {
+	vectors, err := testvectors.LoadLegacyFile("../signature/testJSON/1.json", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) != 5 {
+		t.Fatalf("LoadLegacyFile returned %d vectors, want 5", len(vectors))
+	}
+	c := &testvectors.Corpus{Version: testvectors.SchemaVersion, Network: testvectors.LegacyNetwork, Fields: vectors}
+	if err := testvectors.Verify(c); err != nil {
+		t.Errorf("Verify(legacy vectors) = %v", err)
+	}
+}
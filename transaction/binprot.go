@@ -0,0 +1,78 @@
+package transaction
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/keys"
+)
+
+// The helpers below append values in the bin_prot encoding the node uses to
+// serialize commands.
+
+// appendInt appends n as a bin_prot integer: values in [0, 0x80) take one
+// byte, others a code byte and the two, four or eight little-endian bytes
+// of the smallest width that holds them.
+func appendInt(b []byte, n int64) []byte {
+	switch {
+	case n >= 0 && n < 0x80:
+		return append(b, byte(n))
+	case n < 0 && n >= -0x80:
+		return append(b, 0xff, byte(n))
+	case n >= -0x8000 && n < 0x8000:
+		return binary.LittleEndian.AppendUint16(append(b, 0xfe), uint16(n))
+	case n >= -0x80000000 && n < 0x80000000:
+		return binary.LittleEndian.AppendUint32(append(b, 0xfd), uint32(n))
+	default:
+		return binary.LittleEndian.AppendUint64(append(b, 0xfc), uint64(n))
+	}
+}
+
+// appendUint64 appends v as the node's unsigned 64-bit integers, which are
+// serialized as the int64 with the same bits.
+func appendUint64(b []byte, v uint64) []byte {
+	return appendInt(b, int64(v))
+}
+
+// appendUint32 appends v as the node's unsigned 32-bit integers, which are
+// serialized as the int32 with the same bits.
+func appendUint32(b []byte, v uint32) []byte {
+	return appendInt(b, int64(int32(v)))
+}
+
+// appendString appends s with its bin_prot length prefix.
+func appendString(b, s []byte) []byte {
+	return append(appendNat0(b, len(s)), s...)
+}
+
+func appendNat0(b []byte, n int) []byte {
+	switch {
+	case n < 0x80:
+		return append(b, byte(n))
+	case n < 0x10000:
+		return binary.LittleEndian.AppendUint16(append(b, 0xfe), uint16(n))
+	default:
+		return binary.LittleEndian.AppendUint32(append(b, 0xfd), uint32(n))
+	}
+}
+
+// appendField appends a field element or scalar as 32 little-endian bytes.
+func appendField(b []byte, v *big.Int) []byte {
+	be := v.FillBytes(make([]byte, 32))
+	for i := len(be) - 1; i >= 0; i-- {
+		b = append(b, be[i])
+	}
+	return b
+}
+
+func appendBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, 1)
+	}
+	return append(b, 0)
+}
+
+// appendPublicKey appends a compressed public key: x, then the parity of y.
+func appendPublicKey(b []byte, pk keys.PublicKey) []byte {
+	return appendBool(appendField(b, pk.X.BigInt()), pk.IsOdd)
+}
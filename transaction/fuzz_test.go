@@ -0,0 +1,48 @@
+package transaction
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+)
+
+// FuzzCommand builds commands from arbitrary keys, which need not be on
+// the curve, amounts and memos, and checks that hashing them reports an
+// error or a result instead of panicking.
+func FuzzCommand(f *testing.F) {
+	f.Add([]byte{1}, false, []byte{2}, true, uint64(1), uint64(2), uint32(3), uint32(0), "memo", false)
+	f.Add([]byte{}, false, []byte{}, false, uint64(0), uint64(0), uint32(0), uint32(0), "a memo that is longer than the 32 bytes allowed", true)
+	f.Fuzz(func(t *testing.T, fromX []byte, fromOdd bool, toX []byte, toOdd bool, amount, fee uint64, nonce, validUntil uint32, memo string, delegation bool) {
+		key := func(x []byte, isOdd bool) keys.PublicKey {
+			if len(x) == 0 {
+				return keys.PublicKey{}
+			}
+			return keys.PublicKey{X: new(field.FpElement).SetBigInt(new(big.Int).SetBytes(x)), IsOdd: isOdd}
+		}
+		var tx Command = Payment{From: key(fromX, fromOdd), To: key(toX, toOdd), Amount: amount, Fee: fee, Nonce: nonce, ValidUntil: validUntil, Memo: memo}
+		if delegation {
+			tx = StakeDelegation{From: key(fromX, fromOdd), To: key(toX, toOdd), Fee: fee, Nonce: nonce, ValidUntil: validUntil, Memo: memo}
+		}
+		_, inputErr := tx.InputLegacy()
+		_, hashErr := Hash(tx)
+		if (inputErr == nil) != (hashErr == nil) {
+			t.Fatalf("InputLegacy error %v, Hash error %v", inputErr, hashErr)
+		}
+	})
+}
+
+func FuzzEncodeMemo(f *testing.F) {
+	f.Add("")
+	f.Add("hello")
+	f.Fuzz(func(t *testing.T, memo string) {
+		b, err := EncodeMemo(memo)
+		if err != nil {
+			return
+		}
+		if len(b) != MemoSize || int(b[1]) != len(memo) || string(b[2:2+len(memo)]) != memo {
+			t.Fatalf("EncodeMemo(%q) = %x", memo, b)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package transaction
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/base58check"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"golang.org/x/crypto/blake2b"
+)
+
+// HashBase58Version is the base58check version byte of transaction hashes,
+// which start with "5J".
+const HashBase58Version byte = 0x1d
+
+// ErrHashUnsupported is returned by Hash for commands other than Payment
+// and StakeDelegation. zkApp commands have hashes too, over the binary
+// form of their full account updates, but neither this package nor package
+// zkapp models that layout yet; hashing them is tracked as a separate
+// change.
+var ErrHashUnsupported = errcode.New(errcode.Unsupported, "transaction: hash of this command kind is not supported")
+
+// Tags of the variants nested in the serialized command: the only
+// constructors of the stake delegation body and of the expiry slot.
+const (
+	setDelegateTag  = 0
+	sinceGenesisTag = 0
+)
+
+// Hash returns the hash of a signed payment or stake delegation as the
+// node reports it and the archive database stores it in
+// user_commands.hash.
+//
+// The hash is the BLAKE2b-256 digest of the serialized signed command with
+// the signature replaced by the dummy signature (1, 1), so it does not
+// depend on the signature and cannot be changed by re-signing. The digest
+// is encoded like the node's Blake2 values: a length byte followed by the
+// 32 bytes, in base58check under HashBase58Version.
+//
+// Hash supports payments and stake delegations only, and returns an error
+// wrapping ErrHashUnsupported for other commands.
+func Hash(tx Command) (string, error) {
+	b, err := marshalSigned(tx, big.NewInt(1), big.NewInt(1))
+	if err != nil {
+		return "", err
+	}
+	digest := blake2b.Sum256(b)
+	return base58check.Encode(HashBase58Version, appendString(nil, digest[:])), nil
+}
+
+// marshalSigned serializes tx as the node's Signed_command.Stable.V2: the
+// payload, the fee payer as signer and the signature (r, s).
+func marshalSigned(tx Command, r, s *big.Int) ([]byte, error) {
+	var b []byte
+	switch t := tx.(type) {
+	case Payment:
+		if err := checkKeys(t.From, t.To); err != nil {
+			return nil, err
+		}
+		var err error
+		if b, err = appendCommon(b, t.Fee, t.From, t.Nonce, t.ValidUntil, t.Memo); err != nil {
+			return nil, err
+		}
+		b = append(b, tagPayment)
+		b = appendPublicKey(b, t.To)
+		b = appendUint64(b, t.Amount)
+	case StakeDelegation:
+		if err := checkKeys(t.From, t.To); err != nil {
+			return nil, err
+		}
+		var err error
+		if b, err = appendCommon(b, t.Fee, t.From, t.Nonce, t.ValidUntil, t.Memo); err != nil {
+			return nil, err
+		}
+		b = append(b, tagStakeDelegation, setDelegateTag)
+		b = appendPublicKey(b, t.To)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrHashUnsupported, tx)
+	}
+	b = appendPublicKey(b, tx.FeePayer())
+	b = appendField(b, r)
+	return appendField(b, s), nil
+}
+
+// appendCommon appends the common part of the payload: fee, fee payer,
+// nonce, expiry slot and encoded memo.
+func appendCommon(b []byte, fee uint64, feePayer keys.PublicKey, nonce, validUntil uint32, memo string) ([]byte, error) {
+	if validUntil == 0 {
+		validUntil = NoExpiry
+	}
+	m, err := EncodeMemo(memo)
+	if err != nil {
+		return nil, err
+	}
+	b = appendUint64(b, fee)
+	b = appendPublicKey(b, feePayer)
+	b = appendUint32(b, nonce)
+	b = appendUint32(append(b, sinceGenesisTag), validUntil)
+	return appendString(b, m), nil
+}
@@ -0,0 +1,189 @@
+package transaction
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/decred/base58"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/node101-io/mina-signer-go/base58check"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+func TestAppendInt(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{0x7f, []byte{0x7f}},
+		{0x80, []byte{0xfe, 0x80, 0x00}},
+		{0x7fff, []byte{0xfe, 0xff, 0x7f}},
+		{0x8000, []byte{0xfd, 0x00, 0x80, 0x00, 0x00}},
+		{0x80000000, []byte{0xfc, 0x00, 0x00, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00}},
+		{-1, []byte{0xff, 0xff}},
+		{-0x81, []byte{0xfe, 0x7f, 0xff}},
+		{math.MinInt64, []byte{0xfc, 0, 0, 0, 0, 0, 0, 0, 0x80}},
+	}
+	for _, tt := range tests {
+		if got := appendInt(nil, tt.n); !bytes.Equal(got, tt.want) {
+			t.Errorf("appendInt(%d) = %x, want %x", tt.n, got, tt.want)
+		}
+	}
+	// Unsigned values above the signed range wrap to negative integers.
+	if got := appendUint32(nil, NoExpiry); !bytes.Equal(got, []byte{0xff, 0xff}) {
+		t.Errorf("appendUint32(NoExpiry) = %x", got)
+	}
+	if got := appendUint64(nil, math.MaxUint64); !bytes.Equal(got, []byte{0xff, 0xff}) {
+		t.Errorf("appendUint64(MaxUint64) = %x", got)
+	}
+}
+
+func TestMarshalSignedLayout(t *testing.T) {
+	from := keys.PrivateKey{Value: scalar.NewScalar(1)}.ToPublicKey()
+	to := keys.PrivateKey{Value: scalar.NewScalar(2)}.ToPublicKey()
+	one := scalar.NewScalar(1).BigInt()
+
+	p := Payment{From: from, To: to, Amount: 5, Fee: 3, Nonce: 1, Memo: "hi"}
+	b, err := marshalSigned(p, one, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// fee, fee payer, nonce, since-genesis tag and valid_until (-1), memo
+	// with its length; body tag, receiver, amount; signer; r and s.
+	want := 1 + 33 + 1 + 1 + 2 + 1 + MemoSize + 1 + 33 + 1 + 33 + 64
+	if len(b) != want {
+		t.Errorf("payment serializes to %d bytes, want %d", len(b), want)
+	}
+	if b[0] != 3 || b[34] != 1 || b[35] != sinceGenesisTag || b[36] != 0xff || b[38] != MemoSize {
+		t.Errorf("unexpected common prefix %x", b[:40])
+	}
+
+	d := StakeDelegation{From: from, To: to, Fee: 3, Nonce: 1, Memo: "hi"}
+	b, err = marshalSigned(d, one, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 1 + 33 + 1 + 1 + 2 + 1 + MemoSize + 2 + 33 + 33 + 64; len(b) != want {
+		t.Errorf("delegation serializes to %d bytes, want %d", len(b), want)
+	}
+}
+
+// le returns the 32 little-endian bytes of x, as bin_prot writes a field
+// element or scalar.
+func le(x *big.Int) []byte {
+	b := x.FillBytes(make([]byte, 32))
+	slices.Reverse(b)
+	return b
+}
+
+// TestHashInput spells out, field by field, the Signed_command.Stable.V2
+// bytes Hash digests, with the dummy signature, and the encoding of the
+// digest, so a change to the layout, the field order or the dummy
+// signature fails here.
+func TestHashInput(t *testing.T) {
+	from := keys.PrivateKey{Value: scalar.NewScalar(1)}.ToPublicKey()
+	to := keys.PrivateKey{Value: scalar.NewScalar(2)}.ToPublicKey()
+	pk := func(k keys.PublicKey) []byte {
+		parity := byte(0)
+		if k.IsOdd {
+			parity = 1
+		}
+		return append(le(k.X.BigInt()), parity)
+	}
+	memo := append([]byte{MemoSize, 0x01, 2, 'h', 'i'}, make([]byte, MemoSize-4)...)
+	common := slices.Concat(
+		[]byte{0xfe, 0x40, 0x1f},       // fee 8000, an int16
+		pk(from),                       // fee payer
+		[]byte{0x07},                   // nonce
+		[]byte{0x00, 0xfe, 0xe8, 0x03}, // Since_genesis, valid_until 1000
+		memo,
+	)
+	signature := slices.Concat(pk(from), le(big.NewInt(1)), le(big.NewInt(1)))
+	for _, tt := range []struct {
+		tx   Command
+		body []byte
+	}{
+		{
+			Payment{From: from, To: to, Amount: 1 << 40, Fee: 8000, Nonce: 7, ValidUntil: 1000, Memo: "hi"},
+			// Payment tag, receiver, amount as an int64.
+			slices.Concat([]byte{0x00}, pk(to), []byte{0xfc, 0, 0, 0, 0, 0, 1, 0, 0}),
+		},
+		{
+			StakeDelegation{From: from, To: to, Fee: 8000, Nonce: 7, ValidUntil: 1000, Memo: "hi"},
+			// Stake_delegation tag, Set_delegate tag, new delegate.
+			slices.Concat([]byte{0x01, 0x00}, pk(to)),
+		},
+	} {
+		want := slices.Concat(common, tt.body, signature)
+		got, err := marshalSigned(tt.tx, big.NewInt(1), big.NewInt(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%T serializes to\n%x\nwant\n%x", tt.tx, got, want)
+		}
+		digest := blake2b.Sum256(want)
+		h, err := Hash(tt.tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if wantHash := base58check.Encode(HashBase58Version, append([]byte{32}, digest[:]...)); h != wantHash {
+			t.Errorf("Hash(%T) = %s, want %s", tt.tx, h, wantHash)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	from := keys.PrivateKey{Value: scalar.NewScalar(1)}.ToPublicKey()
+	to := keys.PrivateKey{Value: scalar.NewScalar(2)}.ToPublicKey()
+	p := Payment{From: from, To: to, Amount: 5, Fee: 3, Nonce: 1}
+
+	h, err := Hash(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(h, "5J") {
+		t.Errorf("Hash = %s, want a 5J... hash", h)
+	}
+	if raw := base58.Decode(h); len(raw) != 1+1+32+4 || raw[0] != HashBase58Version || raw[1] != 32 {
+		t.Errorf("Hash = %s decodes to %x", h, raw)
+	}
+
+	// An explicit NoExpiry is the same command as the zero default.
+	p.ValidUntil = NoExpiry
+	if again, _ := Hash(p); again != h {
+		t.Errorf("Hash with ValidUntil = NoExpiry = %s, want %s", again, h)
+	}
+	p.Nonce++
+	if other, _ := Hash(p); other == h {
+		t.Error("Hash did not change with the nonce")
+	}
+	d := StakeDelegation{From: from, To: to, Fee: 3, Nonce: 1}
+	if other, _ := Hash(d); other == h {
+		t.Error("a delegation hashes like a payment")
+	}
+	if _, err := Hash(Payment{From: from}); err == nil {
+		t.Error("Hash accepted a payment without a receiver")
+	}
+	if _, err := Hash(otherCommand{}); !errors.Is(err, ErrHashUnsupported) {
+		t.Errorf("Hash of another command kind: %v, want ErrHashUnsupported", err)
+	}
+}
+
+// otherCommand is a Command of a kind Hash does not know.
+type otherCommand struct{}
+
+func (otherCommand) FeePayer() keys.PublicKey { return keys.PublicKey{} }
+
+func (otherCommand) InputLegacy() (poseidonbigint.HashInputLegacy, error) {
+	return poseidonbigint.HashInputLegacy{}, nil
+}
@@ -0,0 +1,43 @@
+package transaction
+
+import "github.com/node101-io/mina-signer-go/errcode"
+
+const (
+	// MemoSize is the length of an encoded memo.
+	MemoSize = 34
+	// MaxMemoLength is the longest memo string, in bytes, that fits.
+	MaxMemoLength = MemoSize - 2
+	// memoTagString marks a memo that holds a user-supplied string.
+	memoTagString byte = 0x01
+)
+
+// EncodeMemo returns the 34-byte encoding of a string memo: a tag byte, the
+// length, and the string padded with zeros. It fails for strings longer
+// than MaxMemoLength bytes.
+func EncodeMemo(memo string) ([]byte, error) {
+	if len(memo) > MaxMemoLength {
+		return nil, errcode.Errorf(errcode.InvalidLength, "transaction: memo is %d bytes, max %d", len(memo), MaxMemoLength)
+	}
+	out := make([]byte, MemoSize)
+	out[0] = memoTagString
+	out[1] = byte(len(memo))
+	copy(out[2:], memo)
+	return out, nil
+}
+
+// MemoBits returns the bits of the encoded memo, least significant bit of
+// each byte first, as they enter the legacy hash input of a command and
+// the memo hash of a zkApp command.
+func MemoBits(memo string) ([]bool, error) {
+	b, err := EncodeMemo(memo)
+	if err != nil {
+		return nil, err
+	}
+	bits := make([]bool, 0, 8*len(b))
+	for _, x := range b {
+		for i := 0; i < 8; i++ {
+			bits = append(bits, x>>i&1 == 1)
+		}
+	}
+	return bits, nil
+}
@@ -0,0 +1,141 @@
+// Package transaction models the signed commands a Mina account can issue
+// with a plain Schnorr signature, payments and stake delegations, and builds
+// the legacy hash input those signatures cover.
+package transaction
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+)
+
+// NoExpiry is the ValidUntil slot of a command that never expires.
+const NoExpiry = math.MaxUint32
+
+// legacyTokenID is the default token (MINA) in the legacy encoding.
+const legacyTokenID = 1
+
+// Tags distinguish the command bodies in the hash input.
+const (
+	tagPayment         = 0
+	tagStakeDelegation = 1
+)
+
+// Command is a signed command body. Its signature is a legacy Schnorr
+// signature by the fee payer over InputLegacy.
+type Command interface {
+	// FeePayer returns the account that pays the fee and signs the command.
+	FeePayer() keys.PublicKey
+	// InputLegacy returns the hash input the signature covers.
+	InputLegacy() (poseidonbigint.HashInputLegacy, error)
+}
+
+// Payment transfers Amount nanomina from From to To. Fee is in nanomina too.
+// A zero ValidUntil is treated as NoExpiry, the mina-signer default.
+type Payment struct {
+	From       keys.PublicKey
+	To         keys.PublicKey
+	Amount     uint64
+	Fee        uint64
+	Nonce      uint32
+	ValidUntil uint32
+	Memo       string
+}
+
+// FeePayer returns the sender of the payment.
+func (p Payment) FeePayer() keys.PublicKey { return p.From }
+
+// InputLegacy returns the legacy hash input of the payment.
+func (p Payment) InputLegacy() (poseidonbigint.HashInputLegacy, error) {
+	if err := checkKeys(p.From, p.To); err != nil {
+		return poseidonbigint.HashInputLegacy{}, err
+	}
+	common, err := commonInputLegacy(p.Fee, p.From, p.Nonce, p.ValidUntil, p.Memo)
+	if err != nil {
+		return poseidonbigint.HashInputLegacy{}, err
+	}
+	body := bodyInputLegacy(tagPayment, p.From, p.To, p.Amount)
+	return (poseidonbigint.HashInputLegacyHelpers{}).Append(common, body), nil
+}
+
+// StakeDelegation delegates the stake of From to To, the new delegate.
+// ValidUntil follows the same convention as for Payment.
+type StakeDelegation struct {
+	From       keys.PublicKey
+	To         keys.PublicKey
+	Fee        uint64
+	Nonce      uint32
+	ValidUntil uint32
+	Memo       string
+}
+
+// FeePayer returns the delegator.
+func (d StakeDelegation) FeePayer() keys.PublicKey { return d.From }
+
+// InputLegacy returns the legacy hash input of the delegation.
+func (d StakeDelegation) InputLegacy() (poseidonbigint.HashInputLegacy, error) {
+	if err := checkKeys(d.From, d.To); err != nil {
+		return poseidonbigint.HashInputLegacy{}, err
+	}
+	common, err := commonInputLegacy(d.Fee, d.From, d.Nonce, d.ValidUntil, d.Memo)
+	if err != nil {
+		return poseidonbigint.HashInputLegacy{}, err
+	}
+	body := bodyInputLegacy(tagStakeDelegation, d.From, d.To, 0)
+	return (poseidonbigint.HashInputLegacyHelpers{}).Append(common, body), nil
+}
+
+// commonInputLegacy encodes the fields every signed command shares: fee, fee
+// token, fee payer, nonce, expiry slot and memo.
+func commonInputLegacy(fee uint64, feePayer keys.PublicKey, nonce, validUntil uint32, memo string) (poseidonbigint.HashInputLegacy, error) {
+	if validUntil == 0 {
+		validUntil = NoExpiry
+	}
+	mb, err := MemoBits(memo)
+	if err != nil {
+		return poseidonbigint.HashInputLegacy{}, err
+	}
+	h := poseidonbigint.HashInputLegacyHelpers{}
+	input := h.Bits(uintBits(fee, 64))
+	input = h.Append(input, h.Bits(uintBits(legacyTokenID, 64)))
+	input = h.Append(input, publicKeyInputLegacy(feePayer))
+	input = h.Append(input, h.Bits(uintBits(uint64(nonce), 32)))
+	input = h.Append(input, h.Bits(uintBits(uint64(validUntil), 32)))
+	return h.Append(input, h.Bits(mb)), nil
+}
+
+// bodyInputLegacy encodes the command body: a three-bit tag, source,
+// receiver, token, amount and the token_locked flag.
+func bodyInputLegacy(tag int, source, receiver keys.PublicKey, amount uint64) poseidonbigint.HashInputLegacy {
+	h := poseidonbigint.HashInputLegacyHelpers{}
+	input := h.Bits([]bool{tag&4 != 0, tag&2 != 0, tag&1 != 0})
+	input = h.Append(input, publicKeyInputLegacy(source))
+	input = h.Append(input, publicKeyInputLegacy(receiver))
+	input = h.Append(input, h.Bits(uintBits(legacyTokenID, 64)))
+	input = h.Append(input, h.Bits(uintBits(amount, 64)))
+	return h.Append(input, h.Bits([]bool{false}))
+}
+
+func publicKeyInputLegacy(pk keys.PublicKey) poseidonbigint.HashInputLegacy {
+	return poseidonbigint.HashInputLegacy{Fields: []*big.Int{pk.X.BigInt()}, Bits: []bool{pk.IsOdd}}
+}
+
+// checkKeys reports an error if a public key of the command is unset.
+func checkKeys(from, to keys.PublicKey) error {
+	if from.X == nil || to.X == nil {
+		return errcode.New(errcode.MissingValue, "transaction: From and To must be set")
+	}
+	return nil
+}
+
+// uintBits returns the n low bits of v, least significant first.
+func uintBits(v uint64, n int) []bool {
+	bits := make([]bool, n)
+	for i := range bits {
+		bits[i] = v>>i&1 == 1
+	}
+	return bits
+}
@@ -0,0 +1,65 @@
+package transaction
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+func TestEncodeMemo(t *testing.T) {
+	got, err := EncodeMemo("hi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append([]byte{0x01, 0x02, 'h', 'i'}, make([]byte, 30)...)
+	if !bytes.Equal(got, want) {
+		t.Errorf("EncodeMemo(\"hi\") = %x, want %x", got, want)
+	}
+	if _, err := EncodeMemo(string(make([]byte, MaxMemoLength+1))); err == nil {
+		t.Error("EncodeMemo accepted an oversized memo")
+	}
+}
+
+func TestInputLegacyLayout(t *testing.T) {
+	from := keys.PrivateKey{Value: scalar.NewScalar(1)}.ToPublicKey()
+	to := keys.PrivateKey{Value: scalar.NewScalar(2)}.ToPublicKey()
+	p := Payment{From: from, To: to, Amount: 7, Fee: 3, Nonce: 1}
+	input, err := p.InputLegacy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Fee payer, source and receiver x-coordinates are the only fields.
+	if len(input.Fields) != 3 {
+		t.Fatalf("payment input has %d fields, want 3", len(input.Fields))
+	}
+	// common: fee, token, parity, nonce, valid_until, memo;
+	// body: tag, two parities, token, amount, token_locked.
+	wantBits := 64 + 64 + 1 + 32 + 32 + 8*MemoSize + 3 + 1 + 1 + 64 + 64 + 1
+	if len(input.Bits) != wantBits {
+		t.Errorf("payment input has %d bits, want %d", len(input.Bits), wantBits)
+	}
+	if !input.Bits[0] || !input.Bits[1] || input.Bits[2] {
+		t.Errorf("fee bits start %v, want 3 least significant first", input.Bits[:3])
+	}
+	// valid_until defaults to NoExpiry, all ones.
+	for _, b := range input.Bits[64+64+1+32 : 64+64+1+32+32] {
+		if !b {
+			t.Fatal("a zero ValidUntil was not encoded as NoExpiry")
+		}
+	}
+
+	d := StakeDelegation{From: from, To: to, Fee: 3, Nonce: 1}
+	dInput, err := d.InputLegacy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag := 64 + 64 + 1 + 32 + 32 + 8*MemoSize
+	if dInput.Bits[tag+2] != true || input.Bits[tag+2] != false {
+		t.Error("delegation and payment tags are not distinguished")
+	}
+	if _, err := (Payment{From: from}).InputLegacy(); err == nil {
+		t.Error("InputLegacy accepted a payment without a receiver")
+	}
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// AESGCMSealer seals with AES-256-GCM under a fixed key, prepending a
+// random nonce to each ciphertext.
+type AESGCMSealer struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCMSealer returns a Sealer for a 32-byte key.
+func NewAESGCMSealer(key []byte) (*AESGCMSealer, error) {
+	if len(key) != 32 {
+		return nil, errcode.Errorf(errcode.InvalidLength, "vault: sealing key is %d bytes, want 32", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &AESGCMSealer{aead: aead}, nil
+}
+
+// Seal encrypts plaintext and authenticates it with associatedData.
+func (s *AESGCMSealer) Seal(plaintext, associatedData []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize(), s.aead.NonceSize()+len(plaintext)+s.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return s.aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// Unseal decrypts a ciphertext that Seal produced with the same
+// associatedData.
+func (s *AESGCMSealer) Unseal(ciphertext, associatedData []byte) ([]byte, error) {
+	n := s.aead.NonceSize()
+	if len(ciphertext) < n {
+		return nil, errcode.New(errcode.InvalidLength, "vault: sealed value too short")
+	}
+	return s.aead.Open(nil, ciphertext[:n], ciphertext[n:], associatedData)
+}
+
+// MemoryStorage is a Storage backed by a map.
+type MemoryStorage struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+// NewMemoryStorage returns an empty MemoryStorage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{entries: make(map[string][]byte)}
+}
+
+// Get returns a copy of the entry for name.
+func (m *MemoryStorage) Get(_ context.Context, name string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.entries[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrNotFound, name)
+	}
+	return append([]byte(nil), v...), nil
+}
+
+// Put stores a copy of value under name.
+func (m *MemoryStorage) Put(_ context.Context, name string, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[name] = append([]byte(nil), value...)
+	return nil
+}
@@ -0,0 +1,261 @@
+// Package vault stores Mina private keys sealed at rest and signs with them
+// in place, in the manner of Vault's transit engine: keys are created or
+// imported by name, never exported, and every signature is recorded by an
+// audit hook before it is released.
+//
+// Engine is the reference implementation. A Vault plugin wraps it with its
+// logical.Storage as Storage and the barrier or a KMS as Sealer.
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+// ErrNotFound is returned for a key name with no stored key.
+var ErrNotFound = errcode.New(errcode.NotFound, "vault: key not found")
+
+// ErrExists is returned when creating or importing a key under a name that
+// is already taken.
+var ErrExists = errcode.New(errcode.AlreadyExists, "vault: key already exists")
+
+// Storage persists sealed keys by name. An Engine checks that a name is
+// free before it stores a key under it, holding a lock so that two of its
+// own calls cannot both pass the check; Engines that share a Storage must
+// not create or import keys under the same name concurrently.
+type Storage interface {
+	// Get returns the entry for name, or an error wrapping ErrNotFound.
+	Get(ctx context.Context, name string) ([]byte, error)
+	// Put stores value under name.
+	Put(ctx context.Context, name string, value []byte) error
+}
+
+// Sealer encrypts keys before they reach Storage. The Engine passes the
+// associated data of each key, which names it, so that a Sealer that
+// authenticates it refuses a sealed key moved under another name.
+type Sealer interface {
+	Seal(plaintext, associatedData []byte) ([]byte, error)
+	Unseal(ciphertext, associatedData []byte) ([]byte, error)
+}
+
+// sealVersion is the version of the layout of sealed keys, bound into
+// their associated data.
+const sealVersion = 1
+
+// associatedData returns the data a key stored under name is sealed with.
+func associatedData(name string) []byte {
+	return fmt.Appendf(nil, "mina-vault-key/v%d\x00%s", sealVersion, name)
+}
+
+// Operations recorded in audit events.
+const (
+	OpCreate          = "create"
+	OpImport          = "import"
+	OpSignFields      = "sign-fields"
+	OpSignTransaction = "sign-transaction"
+)
+
+// AuditEvent describes one operation on a key.
+type AuditEvent struct {
+	Time      time.Time
+	Key       string
+	Operation string
+	// PublicKey is the address of the key, empty if it could not be read.
+	PublicKey string
+	// Signature is the signature issued, nil for other operations and for
+	// failures.
+	Signature *signature.Signature
+	// Err is the failure of the operation, nil on success.
+	Err error
+}
+
+// AuditHook records an event. If it fails for a successful operation the
+// operation fails too and the signature is withheld, so no signature leaves
+// the engine unrecorded.
+type AuditHook func(ctx context.Context, e AuditEvent) error
+
+// Config configures an Engine.
+type Config struct {
+	Storage Storage
+	Sealer  Sealer
+	Network signer.Network
+	// Audit is called for every operation; nil disables auditing.
+	Audit AuditHook
+	// Now returns the time of audit events; nil means time.Now.
+	Now func() time.Time
+}
+
+// Engine manages named keys sealed in Storage.
+type Engine struct {
+	cfg Config
+	// mu serializes the check for a free name and the store under it.
+	mu sync.Mutex
+}
+
+// New returns an Engine for cfg. Storage and Sealer are required.
+func New(cfg Config) (*Engine, error) {
+	if cfg.Storage == nil || cfg.Sealer == nil {
+		return nil, errcode.New(errcode.InvalidArgument, "vault: Storage and Sealer are required")
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &Engine{cfg: cfg}, nil
+}
+
+// CreateKey generates a key under name and returns its public key.
+func (e *Engine) CreateKey(ctx context.Context, name string) (keys.PublicKey, error) {
+	var k *scalar.Scalar
+	for k == nil || k.BigInt().Sign() == 0 {
+		var err error
+		if k, err = scalar.RandomScalar(); err != nil {
+			return keys.PublicKey{}, fmt.Errorf("vault: generating key: %w", err)
+		}
+	}
+	return e.store(ctx, OpCreate, name, keys.PrivateKey{Value: k})
+}
+
+// ImportKey stores sk under name and returns its public key.
+func (e *Engine) ImportKey(ctx context.Context, name string, sk keys.PrivateKey) (keys.PublicKey, error) {
+	return e.store(ctx, OpImport, name, sk)
+}
+
+func (e *Engine) store(ctx context.Context, op, name string, sk keys.PrivateKey) (pk keys.PublicKey, err error) {
+	ev := AuditEvent{Key: name, Operation: op}
+	defer func() { err = e.audit(ctx, ev, err) }()
+
+	if name == "" {
+		return keys.PublicKey{}, errcode.New(errcode.InvalidArgument, "vault: empty key name")
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.cfg.Storage.Get(ctx, name); err == nil {
+		return keys.PublicKey{}, fmt.Errorf("%w: %q", ErrExists, name)
+	} else if !errors.Is(err, ErrNotFound) {
+		return keys.PublicKey{}, err
+	}
+	raw, err := sk.MarshalBytes()
+	if err != nil {
+		return keys.PublicKey{}, err
+	}
+	defer clear(raw)
+	sealed, err := e.cfg.Sealer.Seal(raw, associatedData(name))
+	if err != nil {
+		return keys.PublicKey{}, fmt.Errorf("vault: seal: %w", err)
+	}
+	if err := e.cfg.Storage.Put(ctx, name, sealed); err != nil {
+		return keys.PublicKey{}, err
+	}
+	pk = sk.ToPublicKey()
+	ev.PublicKey, _ = pk.ToBase58WithVersion(e.cfg.Network.AddressVersion)
+	return pk, nil
+}
+
+// load unseals the key stored under name.
+func (e *Engine) load(ctx context.Context, name string) (keys.PrivateKey, error) {
+	sealed, err := e.cfg.Storage.Get(ctx, name)
+	if err != nil {
+		return keys.PrivateKey{}, err
+	}
+	raw, err := e.cfg.Sealer.Unseal(sealed, associatedData(name))
+	if err != nil {
+		return keys.PrivateKey{}, fmt.Errorf("vault: unseal %q: %w", name, err)
+	}
+	defer clear(raw)
+	var sk keys.PrivateKey
+	if err := sk.UnmarshalBytes(raw); err != nil {
+		return keys.PrivateKey{}, fmt.Errorf("vault: key %q: %w", name, err)
+	}
+	return sk, nil
+}
+
+// PublicKey returns the public key stored under name.
+func (e *Engine) PublicKey(ctx context.Context, name string) (keys.PublicKey, error) {
+	sk, err := e.load(ctx, name)
+	if err != nil {
+		return keys.PublicKey{}, err
+	}
+	return sk.ToPublicKey(), nil
+}
+
+// SignFields signs fields with the key stored under name.
+func (e *Engine) SignFields(ctx context.Context, name string, fields []*big.Int) (*signature.Signature, error) {
+	return e.sign(ctx, OpSignFields, name, func(s *signer.KeySigner) (*signature.Signature, error) {
+		return s.SignFields(ctx, fields)
+	})
+}
+
+// SignTransaction signs tx with the key stored under name, which must
+// belong to the fee payer.
+func (e *Engine) SignTransaction(ctx context.Context, name string, tx transaction.Command) (*signature.Signature, error) {
+	return e.sign(ctx, OpSignTransaction, name, func(s *signer.KeySigner) (*signature.Signature, error) {
+		return s.SignTransaction(ctx, tx)
+	})
+}
+
+func (e *Engine) sign(ctx context.Context, op, name string, f func(*signer.KeySigner) (*signature.Signature, error)) (sig *signature.Signature, err error) {
+	ev := AuditEvent{Key: name, Operation: op}
+	defer func() {
+		ev.Signature = sig
+		if err = e.audit(ctx, ev, err); err != nil {
+			sig = nil
+		}
+	}()
+
+	sk, err := e.load(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	ev.PublicKey, _ = sk.ToPublicKey().ToBase58WithVersion(e.cfg.Network.AddressVersion)
+	return f(signer.NewKeySigner(sk, e.cfg.Network))
+}
+
+// audit records ev with the outcome err and returns the error the
+// operation should report.
+func (e *Engine) audit(ctx context.Context, ev AuditEvent, err error) error {
+	if e.cfg.Audit == nil {
+		return err
+	}
+	ev.Time = e.cfg.Now()
+	ev.Err = err
+	if err != nil {
+		ev.Signature = nil
+	}
+	if aerr := e.cfg.Audit(ctx, ev); aerr != nil && err == nil {
+		return fmt.Errorf("vault: audit: %w", aerr)
+	}
+	return err
+}
+
+// Signer returns a signer.Signer for the key stored under name.
+func (e *Engine) Signer(name string) signer.Signer {
+	return keySigner{engine: e, name: name}
+}
+
+type keySigner struct {
+	engine *Engine
+	name   string
+}
+
+func (k keySigner) PublicKey(ctx context.Context) (keys.PublicKey, error) {
+	return k.engine.PublicKey(ctx, k.name)
+}
+
+func (k keySigner) SignFields(ctx context.Context, fields []*big.Int) (*signature.Signature, error) {
+	return k.engine.SignFields(ctx, k.name, fields)
+}
+
+func (k keySigner) SignTransaction(ctx context.Context, tx transaction.Command) (*signature.Signature, error) {
+	return k.engine.SignTransaction(ctx, k.name, tx)
+}
@@ -0,0 +1,135 @@
+package vault_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/signer"
+	"github.com/node101-io/mina-signer-go/vault"
+)
+
+func newEngine(t *testing.T, audit vault.AuditHook) (*vault.Engine, *vault.MemoryStorage) {
+	t.Helper()
+	sealer, err := vault.NewAESGCMSealer(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	storage := vault.NewMemoryStorage()
+	e, err := vault.New(vault.Config{Storage: storage, Sealer: sealer, Network: signer.NetworkMainnet, Audit: audit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e, storage
+}
+
+func TestEngine(t *testing.T) {
+	ctx := context.Background()
+	var events []vault.AuditEvent
+	e, storage := newEngine(t, func(_ context.Context, ev vault.AuditEvent) error {
+		events = append(events, ev)
+		return nil
+	})
+
+	sk := keys.PrivateKey{Value: scalar.NewScalar(big.NewInt(31337))}
+	pk, err := e.ImportKey(ctx, "treasury", sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.CreateKey(ctx, "treasury"); !errors.Is(err, vault.ErrExists) {
+		t.Errorf("CreateKey over an existing key: err = %v, want ErrExists", err)
+	}
+	sealed, _ := storage.Get(ctx, "treasury")
+	raw, _ := sk.MarshalBytes()
+	if len(sealed) <= len(raw) || string(sealed[len(sealed)-len(raw):]) == string(raw) {
+		t.Error("key is not sealed at rest")
+	}
+
+	fields := []*big.Int{big.NewInt(1)}
+	sig, err := e.Signer("treasury").SignFields(ctx, fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pk.Verify(sig, poseidonbigint.HashInput{Fields: fields}, "mainnet") {
+		t.Error("signature does not verify")
+	}
+	if _, err := e.SignFields(ctx, "missing", fields); !errors.Is(err, vault.ErrNotFound) {
+		t.Errorf("SignFields with a missing key: err = %v, want ErrNotFound", err)
+	}
+
+	if len(events) != 4 {
+		t.Fatalf("got %d audit events, want 4", len(events))
+	}
+	if ev := events[2]; ev.Operation != vault.OpSignFields || ev.Signature != sig || ev.Err != nil || ev.PublicKey == "" || ev.Time.IsZero() {
+		t.Errorf("sign event = %+v", ev)
+	}
+	if ev := events[3]; ev.Err == nil || ev.Signature != nil {
+		t.Errorf("failed sign event = %+v", ev)
+	}
+}
+
+func TestAuditFailureWithholdsSignature(t *testing.T) {
+	ctx := context.Background()
+	fail := false
+	e, _ := newEngine(t, func(context.Context, vault.AuditEvent) error {
+		if fail {
+			return errors.New("audit device unavailable")
+		}
+		return nil
+	})
+	if _, err := e.CreateKey(ctx, "k"); err != nil {
+		t.Fatal(err)
+	}
+	fail = true
+	if sig, err := e.SignFields(ctx, "k", []*big.Int{big.NewInt(1)}); err == nil || sig != nil {
+		t.Errorf("SignFields = %v, %v; want no signature when auditing fails", sig, err)
+	}
+}
+
+func TestCreateKeyConcurrently(t *testing.T) {
+	ctx := context.Background()
+	e, _ := newEngine(t, nil)
+	const n = 16
+	errs := make(chan error, n)
+	for range n {
+		go func() {
+			_, err := e.CreateKey(ctx, "k")
+			errs <- err
+		}()
+	}
+	created := 0
+	for range n {
+		err := <-errs
+		switch {
+		case err == nil:
+			created++
+		case !errors.Is(err, vault.ErrExists):
+			t.Errorf("CreateKey: %v", err)
+		}
+	}
+	if created != 1 {
+		t.Errorf("%d concurrent CreateKey calls under one name succeeded, want 1", created)
+	}
+}
+
+func TestSealedKeyIsBoundToName(t *testing.T) {
+	ctx := context.Background()
+	e, storage := newEngine(t, nil)
+	if _, err := e.CreateKey(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := storage.Get(ctx, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Put(ctx, "b", sealed); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.PublicKey(ctx, "b"); err == nil {
+		t.Error("a sealed key moved under another name was unsealed")
+	}
+}
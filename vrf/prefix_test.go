@@ -0,0 +1,26 @@
+package vrf
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/constants"
+)
+
+// TestHashPrefixStates checks the salted Poseidon states of the VRF
+// prefixes against those Mina's hash_prefix_states lists.
+func TestHashPrefixStates(t *testing.T) {
+	for _, prefix := range []string{messagePrefix, outputPrefix, evaluationPrefix} {
+		states, ok := constants.PrefixHashes[prefix]
+		if !ok {
+			t.Fatalf("no hash prefix state for %q", prefix)
+		}
+		got := hashHelpers.Salt(prefix)
+		for i, s := range states[0] {
+			want, _ := new(big.Int).SetString(s, 10)
+			if got[i].Cmp(want) != 0 {
+				t.Errorf("Salt(%q)[%d] = %v, want %v", prefix, i, got[i], want)
+			}
+		}
+	}
+}
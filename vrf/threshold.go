@@ -0,0 +1,101 @@
+package vrf
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+)
+
+// thresholdPrec is the precision in bits of the threshold computation,
+// comfortably above OutputBits.
+const thresholdPrec = 320
+
+// activeSlotsCoefficient is the consensus parameter f of mainnet and
+// devnet: the probability that the stake of the whole ledger wins a slot.
+var activeSlotsCoefficient = big.NewRat(3, 4)
+
+// Threshold returns the probability that stake wins a slot when the
+// staking ledger holds totalStake, 1 - (1 - f)^(stake/totalStake) for the
+// active slots coefficient f = 3/4. Amounts are in nanomina.
+//
+// The result is the exact function, accurate to more than OutputBits
+// bits. It is not the threshold the node compares VRF outputs with, which
+// truncates stake/totalStake and sums a truncated fixed-point Taylor
+// series of the same function (Snarky_taylor). Use it to estimate, not to
+// decide whether an output wins a slot.
+func Threshold(stake, totalStake uint64) (*big.Float, error) {
+	if totalStake == 0 {
+		return nil, errcode.New(errcode.InvalidArgument, "vrf: total stake is zero")
+	}
+	if stake > totalStake {
+		return nil, errcode.Errorf(errcode.OutOfRange, "vrf: stake %d exceeds total stake %d", stake, totalStake)
+	}
+	// (1 - f)^a = exp(a·ln(1 - f)).
+	a := new(big.Float).SetPrec(thresholdPrec).SetUint64(stake)
+	a.Quo(a, new(big.Float).SetPrec(thresholdPrec).SetUint64(totalStake))
+	x := a.Mul(a, lnOneMinusF())
+	t := exp(x)
+	return t.Sub(new(big.Float).SetPrec(thresholdPrec).SetInt64(1), t), nil
+}
+
+// WinProbability returns Threshold as a float64, the expected fraction of
+// slots stake wins.
+func WinProbability(stake, totalStake uint64) (float64, error) {
+	t, err := Threshold(stake, totalStake)
+	if err != nil {
+		return 0, err
+	}
+	f, _ := t.Float64()
+	return f, nil
+}
+
+var (
+	lnOneMinusFOnce  sync.Once
+	lnOneMinusFValue *big.Float
+)
+
+// lnOneMinusF returns ln(1 - f) for the active slots coefficient f.
+func lnOneMinusF() *big.Float {
+	lnOneMinusFOnce.Do(func() {
+		q := new(big.Rat).Sub(big.NewRat(1, 1), activeSlotsCoefficient)
+		lnOneMinusFValue = ln(new(big.Float).SetPrec(thresholdPrec).SetRat(q))
+	})
+	return new(big.Float).Copy(lnOneMinusFValue)
+}
+
+// exp returns e^x for |x| of a few units with the Taylor series.
+func exp(x *big.Float) *big.Float {
+	sum := new(big.Float).SetPrec(thresholdPrec).SetInt64(1)
+	term := new(big.Float).SetPrec(thresholdPrec).SetInt64(1)
+	k := new(big.Float).SetPrec(thresholdPrec)
+	for i := 1; ; i++ {
+		term.Mul(term, x)
+		term.Quo(term, k.SetInt64(int64(i)))
+		if term.Sign() == 0 || term.MantExp(nil)-sum.MantExp(nil) < -thresholdPrec {
+			return sum
+		}
+		sum.Add(sum, term)
+	}
+}
+
+// ln returns the natural logarithm of y in (0, 1] with the series
+// ln(y) = 2·artanh((y - 1) / (y + 1)).
+func ln(y *big.Float) *big.Float {
+	one := new(big.Float).SetPrec(thresholdPrec).SetInt64(1)
+	z := new(big.Float).SetPrec(thresholdPrec).Sub(y, one)
+	z.Quo(z, new(big.Float).SetPrec(thresholdPrec).Add(y, one))
+	z2 := new(big.Float).SetPrec(thresholdPrec).Mul(z, z)
+	sum := new(big.Float).SetPrec(thresholdPrec)
+	power := new(big.Float).SetPrec(thresholdPrec).Set(z)
+	term := new(big.Float).SetPrec(thresholdPrec)
+	k := new(big.Float).SetPrec(thresholdPrec)
+	for i := 1; ; i += 2 {
+		term.Quo(power, k.SetInt64(int64(i)))
+		if term.Sign() == 0 || (sum.Sign() != 0 && term.MantExp(nil)-sum.MantExp(nil) < -thresholdPrec) {
+			return sum.Mul(sum, big.NewFloat(2))
+		}
+		sum.Add(sum, term)
+		power.Mul(power, z2)
+	}
+}
@@ -0,0 +1,259 @@
+// Package vrf implements the verifiable random function of Mina's
+// proof-of-stake consensus, which decides the slots a block producer wins.
+//
+// For each slot, the producer evaluates the VRF on a Message made of the
+// epoch seed, the global slot and the index of a delegator in the staking
+// ledger. The message is hashed to a point H of Pallas with Poseidon and
+// the BW19 group map, the evaluation is sk·H with a discrete-log-equality
+// proof that it was computed with the producer's key, and the output is a
+// Poseidon hash of the message and sk·H truncated to OutputBits bits. The
+// slot is won for the delegator's stake when the output, as a fraction of
+// 2^OutputBits, is at most the node's threshold for that stake.
+//
+// Delegation programs can use Evaluation.Verify and Output to check the
+// outputs a producer reports, and WinProbability to estimate the slots a
+// stake is expected to win. The package does not decide slot wins: the
+// node's threshold is a fixed-point series (Snarky_taylor) this package
+// does not reproduce, and a check that disagrees with consensus near the
+// threshold would be worse than none.
+package vrf
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/node101-io/mina-signer-go/constants"
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/hashgeneric"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidon"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/scalar"
+)
+
+const (
+	// LedgerDepth is the depth of the mainnet and devnet staking ledgers,
+	// which is the number of bits of a delegator index.
+	LedgerDepth = 35
+	// OutputBits is the length of a truncated VRF output.
+	OutputBits = 253
+)
+
+// Hash prefixes, padded with '*' to 20 characters as Mina's Hash_prefix
+// does.
+var (
+	messagePrefix    = hashPrefix("MinaVrfMessage")
+	outputPrefix     = hashPrefix("MinaVrfOutput")
+	evaluationPrefix = hashPrefix("MinaVrfEvaluation")
+)
+
+func hashPrefix(s string) string {
+	return s + strings.Repeat("*", 20-len(s))
+}
+
+var hashHelpers = hashgeneric.CreateHashHelpers(field.Fp, poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp))
+
+// Message is the input of a slot evaluation.
+type Message struct {
+	// GlobalSlot is the global slot since the hard fork.
+	GlobalSlot uint32
+	// EpochSeed is the seed of the staking epoch, a base field element.
+	EpochSeed *big.Int
+	// DelegatorIndex is the index of the delegating account in the
+	// staking ledger.
+	DelegatorIndex uint64
+	// LedgerDepth is the depth of the staking ledger. Zero means the
+	// package's LedgerDepth.
+	LedgerDepth int
+}
+
+// input returns the hash input of m: the seed, the slot packed in 32 bits
+// and the bits of the delegator index, least significant first.
+func (m Message) input() (poseidonbigint.HashInput, error) {
+	if m.EpochSeed == nil || m.EpochSeed.Sign() < 0 || m.EpochSeed.Cmp(field.P) >= 0 {
+		return poseidonbigint.HashInput{}, errcode.New(errcode.NonCanonical, "vrf: epoch seed is not a field element")
+	}
+	depth := m.LedgerDepth
+	if depth == 0 {
+		depth = LedgerDepth
+	}
+	if depth < 0 || depth > 64 {
+		return poseidonbigint.HashInput{}, errcode.Errorf(errcode.OutOfRange, "vrf: ledger depth %d out of range", depth)
+	}
+	if depth < 64 && m.DelegatorIndex>>depth != 0 {
+		return poseidonbigint.HashInput{}, errcode.Errorf(errcode.OutOfRange, "vrf: delegator index %d does not fit a ledger of depth %d", m.DelegatorIndex, depth)
+	}
+	packed := []poseidonbigint.PackedField{{Field: big.NewInt(int64(m.GlobalSlot)), Size: 32}}
+	for i := 0; i < depth; i++ {
+		packed = append(packed, poseidonbigint.PackedField{Field: big.NewInt(int64(m.DelegatorIndex >> i & 1)), Size: 1})
+	}
+	return poseidonbigint.HashInput{Fields: []*big.Int{m.EpochSeed}, Packed: packed}, nil
+}
+
+// HashToGroup returns the point H the VRF scales for m.
+func (m Message) HashToGroup() (keys.Point, error) {
+	input, err := m.input()
+	if err != nil {
+		return keys.Point{}, err
+	}
+	h, err := hashToGroup(input)
+	if err != nil {
+		return keys.Point{}, err
+	}
+	return toPoint(h), nil
+}
+
+func hashToGroup(input poseidonbigint.HashInput) (*curve.GroupProjective, error) {
+	digest := hashHelpers.HashWithPrefix(messagePrefix, poseidonbigint.PackToFields(input))
+	return curve.Pallas().GroupMap(digest)
+}
+
+// Evaluation is a VRF evaluation with its proof, as Mina's
+// Vrf.Integrated evaluation and the output of
+// "mina advanced vrf batch-generate-witness".
+type Evaluation struct {
+	Message   Message
+	PublicKey keys.PublicKey
+	// ScaledMessageHash is sk·H for the hash H of Message.
+	ScaledMessageHash keys.Point
+	// C and S prove that log_G(PublicKey) = log_H(ScaledMessageHash).
+	C, S *scalar.Scalar
+}
+
+// Evaluate evaluates the VRF on m with sk. The proof uses a random nonce,
+// so two evaluations of the same message differ in C and S but not in
+// ScaledMessageHash or Output.
+func Evaluate(sk keys.PrivateKey, m Message) (*Evaluation, error) {
+	if sk.Value == nil || sk.Value.BigInt().Sign() == 0 {
+		return nil, errcode.New(errcode.MissingValue, "vrf: private key is not set")
+	}
+	input, err := m.input()
+	if err != nil {
+		return nil, err
+	}
+	h, err := hashToGroup(input)
+	if err != nil {
+		return nil, err
+	}
+	c := curve.Pallas()
+	k := sk.Value.BigInt()
+	pub := sk.ToPublicKey()
+	pubPoint, err := pub.ToGroup()
+	if err != nil {
+		return nil, err
+	}
+	scaled := c.ScaleConstantTime(h, k)
+
+	r, err := scalar.RandomScalar()
+	if err != nil {
+		return nil, err
+	}
+	g1 := c.ScaleConstantTime(c.One, r.BigInt())
+	g2 := c.ScaleConstantTime(h, r.BigInt())
+	ch := challenge(input, pubPoint, toPoint(g1), toPoint(g2))
+	return &Evaluation{
+		Message:           m,
+		PublicKey:         pub,
+		ScaledMessageHash: toPoint(scaled),
+		C:                 ch,
+		S:                 r.Add(sk.Value.Mul(ch)),
+	}, nil
+}
+
+// Verify reports whether e carries a valid proof that ScaledMessageHash is
+// the evaluation of the VRF on Message with the key of PublicKey.
+func (e *Evaluation) Verify() bool {
+	if e == nil || e.C == nil || e.S == nil {
+		return false
+	}
+	input, err := e.Message.input()
+	if err != nil {
+		return false
+	}
+	c := curve.Pallas()
+	pubPoint, err := e.PublicKey.ToGroup()
+	if err != nil || e.PublicKey.Validate() != nil {
+		return false
+	}
+	scaled, err := fromPoint(e.ScaledMessageHash)
+	if err != nil {
+		return false
+	}
+	h, err := hashToGroup(input)
+	if err != nil {
+		return false
+	}
+	pub := &curve.GroupProjective{X: pubPoint.X, Y: pubPoint.Y, Z: big.NewInt(1)}
+	s, ch := e.S.BigInt(), e.C.BigInt()
+	// g1 = s·G - c·pk and g2 = s·H - c·(sk·H) are r·G and r·H for an
+	// honest evaluation.
+	g1 := c.Sub(c.ScaleBase(s), c.ScaleVartime(pub, ch))
+	g2 := c.Sub(c.ScaleVartime(h, s), c.ScaleVartime(scaled, ch))
+	if c.ToAffine(g1).Infinity || c.ToAffine(g2).Infinity {
+		return false
+	}
+	return challenge(input, pubPoint, toPoint(g1), toPoint(g2)).BigInt().Cmp(ch) == 0
+}
+
+// Output returns the truncated VRF output of e, an integer below
+// 2^OutputBits. It does not check the proof; call Verify first for
+// evaluations from untrusted sources.
+func (e *Evaluation) Output() (*big.Int, error) {
+	if e == nil {
+		return nil, errcode.New(errcode.MissingValue, "vrf: nil evaluation")
+	}
+	return Output(e.Message, e.ScaledMessageHash)
+}
+
+// Output returns the truncated VRF output for m and the scaled message
+// hash sk·H: the low OutputBits bits of the Poseidon hash of m and the
+// point.
+func Output(m Message, scaledMessageHash keys.Point) (*big.Int, error) {
+	input, err := m.input()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fromPoint(scaledMessageHash); err != nil {
+		return nil, err
+	}
+	input = poseidonbigint.HashInputHelpers{}.Append(input, poseidonbigint.HashInput{
+		Fields: []*big.Int{scaledMessageHash.X, scaledMessageHash.Y},
+	})
+	digest := hashHelpers.HashWithPrefix(outputPrefix, poseidonbigint.PackToFields(input))
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), OutputBits), big.NewInt(1))
+	return digest.And(digest, mask), nil
+}
+
+// challenge hashes the message and the three points of the proof to the
+// scalar c. The digest is a base field element, which is below the scalar
+// modulus and is used as is.
+func challenge(message poseidonbigint.HashInput, pub, g1, g2 keys.Point) *scalar.Scalar {
+	input := poseidonbigint.HashInputHelpers{}.Append(message, poseidonbigint.HashInput{
+		Fields: []*big.Int{pub.X, pub.Y, g1.X, g1.Y, g2.X, g2.Y},
+	})
+	return scalar.NewScalar(hashHelpers.HashWithPrefix(evaluationPrefix, poseidonbigint.PackToFields(input)))
+}
+
+func toPoint(g *curve.GroupProjective) keys.Point {
+	a := curve.Pallas().ToAffine(g)
+	return keys.Point{X: a.X, Y: a.Y}
+}
+
+// fromPoint checks that p is a finite point of Pallas with canonical
+// coordinates.
+func fromPoint(p keys.Point) (*curve.GroupProjective, error) {
+	if p.X == nil || p.Y == nil {
+		return nil, errcode.New(errcode.MissingValue, "vrf: point coordinate is nil")
+	}
+	if p.X.Sign() < 0 || p.X.Cmp(field.P) >= 0 || p.Y.Sign() < 0 || p.Y.Cmp(field.P) >= 0 {
+		return nil, errcode.New(errcode.NonCanonical, "vrf: point coordinate is not a field element")
+	}
+	g := &curve.GroupProjective{X: p.X, Y: p.Y, Z: big.NewInt(1)}
+	if err := curve.ValidatePoint(g); err != nil {
+		return nil, fmt.Errorf("vrf: %w", err)
+	}
+	return g, nil
+}
@@ -0,0 +1,179 @@
+package vrf_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/curve"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/scalar"
+	"github.com/node101-io/mina-signer-go/vrf"
+)
+
+const testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+
+func testKey(t *testing.T) keys.PrivateKey {
+	t.Helper()
+	sk, err := keys.PrivateKeyFromBase58(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sk
+}
+
+func testMessage() vrf.Message {
+	return vrf.Message{GlobalSlot: 123456, EpochSeed: big.NewInt(987654321), DelegatorIndex: 42}
+}
+
+func TestEvaluateVerify(t *testing.T) {
+	sk := testKey(t)
+	m := testMessage()
+	e, err := vrf.Evaluate(sk, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.Verify() {
+		t.Fatal("evaluation does not verify")
+	}
+
+	// The evaluation is sk·H.
+	h, err := m.HashToGroup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := curve.Pallas()
+	want := c.ToAffine(c.Scale(&curve.GroupProjective{X: h.X, Y: h.Y, Z: big.NewInt(1)}, sk.Value.BigInt()))
+	if e.ScaledMessageHash.X.Cmp(want.X) != 0 || e.ScaledMessageHash.Y.Cmp(want.Y) != 0 {
+		t.Error("ScaledMessageHash is not sk·H")
+	}
+
+	// Proofs are randomized, outputs are not.
+	e2, err := vrf.Evaluate(sk, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.C.BigInt().Cmp(e2.C.BigInt()) == 0 {
+		t.Error("two evaluations have the same proof")
+	}
+	out, err := e.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out2, err := e2.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Cmp(out2) != 0 {
+		t.Error("outputs differ between evaluations")
+	}
+	if out.BitLen() > vrf.OutputBits {
+		t.Errorf("output has %d bits", out.BitLen())
+	}
+
+	other := m
+	other.DelegatorIndex++
+	e3, err := vrf.Evaluate(sk, other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out3, err := e3.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Cmp(out3) == 0 {
+		t.Error("different messages have the same output")
+	}
+}
+
+func TestVerifyRejectsTampering(t *testing.T) {
+	sk := testKey(t)
+	e, err := vrf.Evaluate(sk, testMessage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	one := scalar.NewScalar(1)
+	tests := map[string]func(e *vrf.Evaluation){
+		"c":     func(e *vrf.Evaluation) { e.C = e.C.Add(one) },
+		"s":     func(e *vrf.Evaluation) { e.S = e.S.Add(one) },
+		"slot":  func(e *vrf.Evaluation) { e.Message.GlobalSlot++ },
+		"seed":  func(e *vrf.Evaluation) { e.Message.EpochSeed = big.NewInt(1) },
+		"index": func(e *vrf.Evaluation) { e.Message.DelegatorIndex++ },
+		"key": func(e *vrf.Evaluation) {
+			e.PublicKey = keys.PrivateKey{Value: sk.Value.Add(one)}.ToPublicKey()
+		},
+		"point": func(e *vrf.Evaluation) {
+			e.ScaledMessageHash = keys.Point{X: e.ScaledMessageHash.X, Y: field.Fp.Negate(e.ScaledMessageHash.Y)}
+		},
+		"off curve": func(e *vrf.Evaluation) {
+			e.ScaledMessageHash = keys.Point{X: e.ScaledMessageHash.X, Y: big.NewInt(1)}
+		},
+	}
+	for name, tamper := range tests {
+		t.Run(name, func(t *testing.T) {
+			tampered := *e
+			tamper(&tampered)
+			if tampered.Verify() {
+				t.Error("tampered evaluation verifies")
+			}
+		})
+	}
+}
+
+func TestMessageValidation(t *testing.T) {
+	tests := map[string]vrf.Message{
+		"nil seed":   {},
+		"large seed": {EpochSeed: field.P},
+		"index":      {EpochSeed: big.NewInt(1), DelegatorIndex: 1 << vrf.LedgerDepth},
+		"depth":      {EpochSeed: big.NewInt(1), DelegatorIndex: 4, LedgerDepth: 2},
+	}
+	for name, m := range tests {
+		if _, err := m.HashToGroup(); err == nil {
+			t.Errorf("%s: HashToGroup accepted %+v", name, m)
+		}
+	}
+	m := vrf.Message{EpochSeed: big.NewInt(1), DelegatorIndex: 3, LedgerDepth: 2}
+	if _, err := m.HashToGroup(); err != nil {
+		t.Errorf("HashToGroup: %v", err)
+	}
+}
+
+func TestThreshold(t *testing.T) {
+	tests := []struct {
+		stake, total uint64
+		want         float64
+	}{
+		{0, 100, 0},
+		{100, 100, 0.75},
+		// 1 - (1/4)^(1/2) = 1/2.
+		{50, 100, 0.5},
+		{1, 1 << 60, 1.2024186864244718e-18},
+	}
+	for _, tt := range tests {
+		got, err := vrf.WinProbability(tt.stake, tt.total)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(got-tt.want) > 1e-15*tt.want {
+			t.Errorf("WinProbability(%d, %d) = %v, want %v", tt.stake, tt.total, got, tt.want)
+		}
+	}
+	prev := -1.0
+	for stake := uint64(0); stake <= 1000; stake += 50 {
+		p, err := vrf.WinProbability(stake, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p <= prev {
+			t.Errorf("WinProbability(%d, 1000) = %v is not above %v", stake, p, prev)
+		}
+		prev = p
+	}
+	if _, err := vrf.Threshold(1, 0); err == nil {
+		t.Error("Threshold accepted a zero total stake")
+	}
+	if _, err := vrf.Threshold(2, 1); err == nil {
+		t.Error("Threshold accepted a stake above the total")
+	}
+}
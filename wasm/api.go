@@ -0,0 +1,52 @@
+// Package wasm is the API the js/wasm build exposes to JavaScript. The
+// functions take and return strings, with structured values as the JSON
+// mina-signer uses, so the syscall/js bindings in this package stay thin
+// and the API itself can be tested natively.
+//
+// Build the module for browsers with
+//
+//	GOOS=js GOARCH=wasm go build -o mina-signer.wasm ./cmd/mina-signer-wasm
+//
+// and load it with wasm_exec.js from the Go distribution. It installs a
+// global minaSigner object with sign, verify and deriveAddress.
+package wasm
+
+import (
+	"encoding/json"
+
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/signer"
+)
+
+// Sign signs message with privateKey for network ("mainnet", "devnet",
+// "testnet" or a custom name) as mina-signer's signMessage does, and
+// returns the signed message as JSON: {"signature": {"field", "scalar"},
+// "publicKey", "data"}.
+func Sign(network, privateKey, message string) (string, error) {
+	signed, err := signer.NewClient(signer.NetworkFromName(network)).SignMessage(message, privateKey)
+	if err != nil {
+		return "", err
+	}
+	out, err := json.Marshal(signed)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// Verify checks a signed message in the JSON form returned by Sign.
+func Verify(network, signedJSON string) (bool, error) {
+	var signed signer.Signed[string]
+	if err := json.Unmarshal([]byte(signedJSON), &signed); err != nil {
+		return false, err
+	}
+	if signed.Signature == nil {
+		return false, errcode.New(errcode.MissingValue, "wasm: missing signature")
+	}
+	return signer.NewClient(signer.NetworkFromName(network)).VerifyMessage(&signed), nil
+}
+
+// DeriveAddress returns the address of a base58 private key.
+func DeriveAddress(network, privateKey string) (string, error) {
+	return signer.NewClient(signer.NetworkFromName(network)).DerivePublicKey(privateKey)
+}
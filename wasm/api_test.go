@@ -0,0 +1,24 @@
+package wasm
+
+import "testing"
+
+const testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+
+func TestAPI(t *testing.T) {
+	if addr, err := DeriveAddress("mainnet", testPrivateKey); err != nil || addr != "B62qiy32p8kAKnny8ZFwoMhYpBppM1DWVCqAPBYNcXnsAHhnfAAuXgg" {
+		t.Errorf("DeriveAddress = %s, %v", addr, err)
+	}
+	signed, err := Sign("devnet", testPrivateKey, "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := Verify("devnet", signed); err != nil || !ok {
+		t.Errorf("Verify = %v, %v", ok, err)
+	}
+	if ok, _ := Verify("mainnet", signed); ok {
+		t.Error("Verify accepted a devnet signature on mainnet")
+	}
+	if _, err := Verify("devnet", `{"publicKey": "x"}`); err == nil {
+		t.Error("Verify accepted a missing signature")
+	}
+}
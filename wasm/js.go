@@ -0,0 +1,49 @@
+//go:build js && wasm
+
+package wasm
+
+import "syscall/js"
+
+// Register installs the API on the global object as minaSigner:
+//
+//	minaSigner.sign(network, privateKey, message) // signed message JSON
+//	minaSigner.verify(network, signedJSON)        // boolean
+//	minaSigner.deriveAddress(network, privateKey) // "B62..."
+//
+// A function whose arguments are not strings, or whose operation fails,
+// returns an Error object rather than throwing: a Go panic would stop the
+// runtime and leave every later call failing. Callers test the result with
+// instanceof Error.
+func Register() {
+	api := js.Global().Get("Object").New()
+	api.Set("sign", function(3, func(a []string) (any, error) { return Sign(a[0], a[1], a[2]) }))
+	api.Set("verify", function(2, func(a []string) (any, error) { return Verify(a[0], a[1]) }))
+	api.Set("deriveAddress", function(2, func(a []string) (any, error) { return DeriveAddress(a[0], a[1]) }))
+	js.Global().Set("minaSigner", api)
+}
+
+// function wraps f as a JS function taking n string arguments. Errors are
+// returned as JS Error values.
+func function(n int, f func(args []string) (any, error)) js.Func {
+	return js.FuncOf(func(_ js.Value, args []js.Value) any {
+		if len(args) != n {
+			return jsError("expected string arguments")
+		}
+		strs := make([]string, n)
+		for i, a := range args {
+			if a.Type() != js.TypeString {
+				return jsError("expected string arguments")
+			}
+			strs[i] = a.String()
+		}
+		v, err := f(strs)
+		if err != nil {
+			return jsError(err.Error())
+		}
+		return v
+	})
+}
+
+func jsError(msg string) js.Value {
+	return js.Global().Get("Error").New(msg)
+}
@@ -0,0 +1,308 @@
+// Package zkapp computes the commitments the signatures of a zkApp command
+// cover, and signs and verifies its signature-authorized parts as o1js and
+// the Mina node do.
+//
+// A zkApp command is a fee payer and a forest of account updates. Its
+// commitment hashes the forest; its full commitment also hashes the memo
+// and the fee payer. The fee payer always signs the full commitment. An
+// account update authorized by a signature signs the full commitment when
+// its useFullCommitment flag is set and the commitment otherwise, so that
+// an update can be signed before the fee payer and memo are known.
+//
+// The package does not model the layout of account update bodies. Each
+// body is given as its random-oracle input, as o1js's AccountUpdate.toInput
+// returns it, and hashed with the zkApp body prefix of the network. The
+// flags the signing rules depend on are given alongside and must agree
+// with the body. For the same reason the package does not compute the
+// transaction hashes of zkApp commands; see transaction.ErrHashUnsupported.
+package zkapp
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/node101-io/mina-signer-go/constants"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/hashgeneric"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidon"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/signature"
+	"github.com/node101-io/mina-signer-go/transaction"
+)
+
+var hashHelpers = hashgeneric.CreateHashHelpers(field.Fp, poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp))
+
+// AuthorizationKind is how an account update is authorized.
+type AuthorizationKind int
+
+const (
+	// AuthorizationNone is an update that needs no authorization.
+	AuthorizationNone AuthorizationKind = iota
+	// AuthorizationSignature is an update signed by the key of its
+	// account.
+	AuthorizationSignature
+	// AuthorizationProof is an update proved by the zkApp of its account.
+	AuthorizationProof
+)
+
+// AccountUpdate is an account update of a zkApp command with the account
+// updates it calls.
+type AccountUpdate struct {
+	// PublicKey is the account the update applies to. Its key signs an
+	// update authorized by a signature.
+	PublicKey keys.PublicKey
+	// Body is the random-oracle input of the update's body.
+	Body poseidonbigint.HashInput
+	// Authorization is the authorization kind declared in Body.
+	Authorization AuthorizationKind
+	// UseFullCommitment is the useFullCommitment flag of Body.
+	UseFullCommitment bool
+	// IncrementNonce is the incrementNonce flag of Body.
+	IncrementNonce bool
+	// ConstantNonce reports that the account precondition of Body fixes
+	// the nonce to a single value.
+	ConstantNonce bool
+	// Signature is the signature of an update authorized by a signature,
+	// nil until signed.
+	Signature *signature.Signature
+	// Calls are the account updates this one calls, its children in the
+	// call forest.
+	Calls []AccountUpdate
+}
+
+// FeePayer is the fee payer of a zkApp command.
+type FeePayer struct {
+	// PublicKey is the account that pays the fee and signs the full
+	// commitment.
+	PublicKey keys.PublicKey
+	// Body is the random-oracle input of the fee payer's body as an
+	// account update body, as o1js hashes it.
+	Body poseidonbigint.HashInput
+	// Signature is the fee payer's signature, nil until signed.
+	Signature *signature.Signature
+}
+
+// Command is a zkApp command.
+type Command struct {
+	FeePayer FeePayer
+	// AccountUpdates are the roots of the call forest, in order.
+	AccountUpdates []AccountUpdate
+	// Memo is the memo text, at most transaction.MaxMemoLength bytes.
+	Memo string
+}
+
+// Commitments are the values the signatures of a command cover.
+type Commitments struct {
+	// Commitment is the hash of the call forest.
+	Commitment *big.Int
+	// FullCommitment hashes the memo, the fee payer and Commitment.
+	FullCommitment *big.Int
+}
+
+// For returns the commitment an account update authorized by a signature
+// signs: FullCommitment if it sets useFullCommitment, Commitment
+// otherwise.
+func (c Commitments) For(u *AccountUpdate) *big.Int {
+	if u.UseFullCommitment {
+		return c.FullCommitment
+	}
+	return c.Commitment
+}
+
+// Commitments computes the commitments of cmd on network.
+func (cmd *Command) Commitments(network keys.Network) (Commitments, error) {
+	prefix, err := bodyPrefix(network)
+	if err != nil {
+		return Commitments{}, err
+	}
+	memoBits, err := transaction.MemoBits(cmd.Memo)
+	if err != nil {
+		return Commitments{}, fmt.Errorf("zkapp: %w", err)
+	}
+	commitment := forestHash(cmd.AccountUpdates, prefix)
+	memoHash := hashHelpers.HashWithPrefix(constants.Prefixes["zkappMemo"],
+		poseidonbigint.PackToFieldsLegacy(poseidonbigint.HashInputLegacy{Bits: memoBits}))
+	feePayerHash := bodyHash(cmd.FeePayer.Body, prefix)
+	full := hashHelpers.HashWithPrefix(constants.Prefixes["accountUpdateCons"], []*big.Int{memoHash, feePayerHash, commitment})
+	return Commitments{Commitment: commitment, FullCommitment: full}, nil
+}
+
+// bodyPrefix returns the prefix account update bodies are hashed with on
+// network, which follows its signature prefix: mainnet has its own, devnet
+// shares testnet's, and a custom network of keys.NetworkFromID hashes
+// with its id followed by "ZkappBody", as o1js does. Other networks, such
+// as those of Network.WithContext, have no zkApp body prefix.
+func bodyPrefix(network keys.Network) (string, error) {
+	switch network.SignaturePrefix {
+	case constants.Prefixes["signatureMainnet"]:
+		return constants.Prefixes["zkappBodyMainnet"], nil
+	case constants.Prefixes["signatureTestnet"]:
+		return constants.Prefixes["zkappBodyTestnet"], nil
+	case keys.CustomPrefix(network.Name + "Signature"):
+		return keys.CustomPrefix(network.Name + "ZkappBody"), nil
+	}
+	return "", errcode.Errorf(errcode.Unsupported, "zkapp: no zkApp body prefix for network %q", network.Name)
+}
+
+// bodyHash returns the hash of an account update body.
+func bodyHash(body poseidonbigint.HashInput, prefix string) *big.Int {
+	return hashHelpers.HashWithPrefix(prefix, poseidonbigint.PackToFields(body))
+}
+
+// forestHash returns the hash of a call forest: the trees are consed from
+// the last to the first onto the empty forest, 0, and each tree hashes its
+// update with the hash of its calls.
+func forestHash(forest []AccountUpdate, prefix string) *big.Int {
+	stack := new(big.Int)
+	for i := len(forest) - 1; i >= 0; i-- {
+		u := &forest[i]
+		tree := hashHelpers.HashWithPrefix(constants.Prefixes["accountUpdateNode"], []*big.Int{bodyHash(u.Body, prefix), forestHash(u.Calls, prefix)})
+		stack = hashHelpers.HashWithPrefix(constants.Prefixes["accountUpdateCons"], []*big.Int{tree, stack})
+	}
+	return stack
+}
+
+// Validate checks the rules the node applies to the authorization of cmd
+// before any signature: the fee payer is set, and every update authorized
+// by a signature names its account and is protected against replay, by
+// signing the full commitment, which covers the fee payer's nonce, or by
+// incrementing its own nonce under a precondition that fixes it.
+func (cmd *Command) Validate() error {
+	if cmd.FeePayer.PublicKey.X == nil {
+		return errcode.New(errcode.MissingValue, "zkapp: fee payer is not set")
+	}
+	return walk(cmd.AccountUpdates, func(u *AccountUpdate) error {
+		if u.Authorization != AuthorizationSignature {
+			return nil
+		}
+		if u.PublicKey.X == nil {
+			return errcode.New(errcode.MissingValue, "zkapp: signed account update has no public key")
+		}
+		if !u.UseFullCommitment && !(u.IncrementNonce && u.ConstantNonce) {
+			return errcode.New(errcode.InvalidArgument, "zkapp: signed account update must use the full commitment or increment a fixed nonce")
+		}
+		return nil
+	})
+}
+
+// Sign signs the parts of cmd that key is expected to sign on network: the
+// fee payer if key is the fee payer's, and every account update authorized
+// by a signature whose account is key's, each over the commitment For
+// selects. It returns the number of signatures made, and fails if it made
+// none or cmd does not Validate.
+func (cmd *Command) Sign(key keys.PrivateKey, network keys.Network) (int, error) {
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+	c, err := cmd.Commitments(network)
+	if err != nil {
+		return 0, err
+	}
+	pk := key.ToPublicKey()
+	sign := func(commitment *big.Int) (*signature.Signature, error) {
+		return key.SignForNetwork(poseidonbigint.HashInput{Fields: []*big.Int{commitment}}, network, keys.SignOptions{})
+	}
+	n := 0
+	if pk.Equal(cmd.FeePayer.PublicKey) {
+		if cmd.FeePayer.Signature, err = sign(c.FullCommitment); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	err = walk(cmd.AccountUpdates, func(u *AccountUpdate) error {
+		if u.Authorization != AuthorizationSignature || !pk.Equal(u.PublicKey) {
+			return nil
+		}
+		sig, err := sign(c.For(u))
+		if err != nil {
+			return err
+		}
+		u.Signature = sig
+		n++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, errcode.New(errcode.KeyMismatch, "zkapp: key signs neither the fee payer nor an account update")
+	}
+	return n, nil
+}
+
+// Verify checks the signatures of cmd on network: the fee payer's over
+// the full commitment and those of the updates authorized by a signature,
+// each over the commitment For selects. Proofs are not checked.
+func (cmd *Command) Verify(network keys.Network) error {
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+	c, err := cmd.Commitments(network)
+	if err != nil {
+		return err
+	}
+	if err := cmd.verifyFeePayer(c, network); err != nil {
+		return err
+	}
+	i := 0
+	return walk(cmd.AccountUpdates, func(u *AccountUpdate) error {
+		defer func() { i++ }()
+		if u.Authorization == AuthorizationSignature && !verifyCommitment(u.PublicKey, u.Signature, c.For(u), network) {
+			return errcode.Errorf(errcode.InvalidSignature, "zkapp: invalid signature on account update %d", i)
+		}
+		return nil
+	})
+}
+
+// VerifyFeePayer checks the fee payer's signature of cmd on network only,
+// recomputing the memo hash, the commitment of the call forest and the
+// full commitment with the prefixes of network. It is the check a mempool
+// makes before a command is worth proving, and does not Validate the
+// account updates.
+func (cmd *Command) VerifyFeePayer(network keys.Network) error {
+	if cmd.FeePayer.PublicKey.X == nil {
+		return errcode.New(errcode.MissingValue, "zkapp: fee payer is not set")
+	}
+	c, err := cmd.Commitments(network)
+	if err != nil {
+		return err
+	}
+	return cmd.verifyFeePayer(c, network)
+}
+
+// VerifyZkappFeePayer is Command.VerifyFeePayer for the network a
+// networkId string selects, as keys.NetworkFromID maps it: "mainnet",
+// "testnet", "devnet" or the id of a custom network.
+func VerifyZkappFeePayer(cmd *Command, networkId string) error {
+	return cmd.VerifyFeePayer(keys.NetworkFromID(networkId))
+}
+
+func (cmd *Command) verifyFeePayer(c Commitments, network keys.Network) error {
+	if !verifyCommitment(cmd.FeePayer.PublicKey, cmd.FeePayer.Signature, c.FullCommitment, network) {
+		return errcode.New(errcode.InvalidSignature, "zkapp: invalid fee payer signature")
+	}
+	return nil
+}
+
+// verifyCommitment reports whether sig is pk's signature of commitment on
+// network.
+func verifyCommitment(pk keys.PublicKey, sig *signature.Signature, commitment *big.Int, network keys.Network) bool {
+	return pk.VerifyForNetwork(sig, poseidonbigint.HashInput{Fields: []*big.Int{commitment}}, network)
+}
+
+// walk calls f on the updates of forest in depth-first order, the order
+// of the account updates of a command in o1js, stopping at the first
+// error.
+func walk(forest []AccountUpdate, f func(*AccountUpdate) error) error {
+	for i := range forest {
+		if err := f(&forest[i]); err != nil {
+			return err
+		}
+		if err := walk(forest[i].Calls, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
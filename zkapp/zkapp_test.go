@@ -0,0 +1,236 @@
+package zkapp_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/node101-io/mina-signer-go/constants"
+	"github.com/node101-io/mina-signer-go/errcode"
+	"github.com/node101-io/mina-signer-go/field"
+	"github.com/node101-io/mina-signer-go/keys"
+	"github.com/node101-io/mina-signer-go/poseidon"
+	"github.com/node101-io/mina-signer-go/poseidonbigint"
+	"github.com/node101-io/mina-signer-go/transaction"
+	"github.com/node101-io/mina-signer-go/zkapp"
+)
+
+const testPrivateKey = "EKFKgDtU3rcuFTVSEpmpXSkukjmX4cKefYREi6Sdsk7E7wsT7KRw"
+
+func body(x int64) poseidonbigint.HashInput {
+	return poseidonbigint.HashInput{Fields: []*big.Int{big.NewInt(x)}}
+}
+
+// testCommand returns a command paid by the test key with an update signed
+// by another key over the full commitment, one it calls signed by the same
+// key over the commitment, and a proved update.
+func testCommand(t *testing.T) (*zkapp.Command, keys.PrivateKey, keys.PrivateKey) {
+	t.Helper()
+	payer, err := keys.PrivateKeyFromBase58(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := keys.NewPrivateKeyFromBytes([32]byte{1})
+	cmd := &zkapp.Command{
+		FeePayer: zkapp.FeePayer{PublicKey: payer.ToPublicKey(), Body: body(1)},
+		AccountUpdates: []zkapp.AccountUpdate{
+			{
+				PublicKey: other.ToPublicKey(), Body: body(2),
+				Authorization: zkapp.AuthorizationSignature, UseFullCommitment: true,
+				Calls: []zkapp.AccountUpdate{{
+					PublicKey: other.ToPublicKey(), Body: body(3),
+					Authorization: zkapp.AuthorizationSignature, IncrementNonce: true, ConstantNonce: true,
+				}},
+			},
+			{PublicKey: other.ToPublicKey(), Body: body(4), Authorization: zkapp.AuthorizationProof},
+		},
+		Memo: "zkapp",
+	}
+	return cmd, payer, other
+}
+
+func TestSignVerify(t *testing.T) {
+	cmd, payer, other := testCommand(t)
+	if n, err := cmd.Sign(other, keys.NetworkTestnet); err != nil || n != 2 {
+		t.Fatalf("Sign(other) = %d, %v, want 2 signatures", n, err)
+	}
+	if err := cmd.Verify(keys.NetworkTestnet); errcode.CodeOf(err) != errcode.InvalidSignature {
+		t.Fatalf("Verify() without the fee payer: %v, want InvalidSignature", err)
+	}
+	if n, err := cmd.Sign(payer, keys.NetworkTestnet); err != nil || n != 1 {
+		t.Fatalf("Sign(payer) = %d, %v, want 1 signature", n, err)
+	}
+	if err := cmd.Verify(keys.NetworkTestnet); err != nil {
+		t.Fatalf("Verify() = %v", err)
+	}
+	if err := cmd.Verify(keys.NetworkDevnet); err != nil {
+		t.Errorf("Verify() on devnet = %v, want testnet signatures accepted", err)
+	}
+	if err := cmd.Verify(keys.NetworkMainnet); err == nil {
+		t.Error("Verify() on mainnet accepted testnet signatures")
+	}
+
+	// The memo is part of the full commitment only: the fee payer and the
+	// update signing the full commitment are invalidated, the one signing
+	// the commitment is not.
+	before, err := cmd.Commitments(keys.NetworkTestnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd.Memo = "changed"
+	after, err := cmd.Commitments(keys.NetworkTestnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before.Commitment.Cmp(after.Commitment) != 0 || before.FullCommitment.Cmp(after.FullCommitment) == 0 {
+		t.Error("changing the memo changed the commitment or kept the full commitment")
+	}
+	if err := cmd.Verify(keys.NetworkTestnet); err == nil {
+		t.Error("Verify() accepted a changed memo")
+	}
+	cmd.Memo = "zkapp"
+
+	// Changing a nested body changes the commitment.
+	cmd.AccountUpdates[0].Calls[0].Body = body(5)
+	if err := cmd.Verify(keys.NetworkTestnet); errcode.CodeOf(err) != errcode.InvalidSignature {
+		t.Errorf("Verify() with a changed update: %v, want InvalidSignature", err)
+	}
+}
+
+// prefixHash hashes input from the Poseidon state Mina's hash_prefix_states
+// lists for prefix, so the test does not share the package's prefix
+// strings or its salting.
+func prefixHash(t *testing.T, prefix string, input ...*big.Int) *big.Int {
+	t.Helper()
+	states, ok := constants.PrefixHashes[prefix]
+	if !ok {
+		t.Fatalf("no hash prefix state for %q", prefix)
+	}
+	state := make([]*big.Int, len(states[0]))
+	for i, s := range states[0] {
+		state[i], _ = new(big.Int).SetString(s, 10)
+	}
+	return poseidon.CreatePoseidon(*field.Fp, constants.PoseidonParamsKimchiFp).Update(state, input)[0]
+}
+
+// TestCommitmentsFromPrefixStates rebuilds the commitments of testCommand
+// as o1js's transactionCommitments does, starting from Mina's prefix
+// states, with and without useFullCommitment.
+func TestCommitmentsFromPrefixStates(t *testing.T) {
+	for _, network := range []struct {
+		network keys.Network
+		body    string
+	}{
+		{keys.NetworkMainnet, "MainnetZkappBody****"},
+		{keys.NetworkTestnet, "TestnetZkappBody****"},
+		{keys.NetworkDevnet, "TestnetZkappBody****"},
+	} {
+		cmd, payer, _ := testCommand(t)
+		hashBody := func(x int64) *big.Int { return prefixHash(t, network.body, big.NewInt(x)) }
+		node := func(update, calls *big.Int) *big.Int { return prefixHash(t, "MinaAcctUpdateNode**", update, calls) }
+		cons := func(tree, rest *big.Int) *big.Int { return prefixHash(t, "MinaAcctUpdateCons**", tree, rest) }
+
+		empty := new(big.Int)
+		calls := cons(node(hashBody(3), empty), empty)
+		commitment := cons(node(hashBody(2), calls), cons(node(hashBody(4), empty), empty))
+		memo, err := transaction.MemoBits(cmd.Memo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		memoHash := prefixHash(t, "MinaZkappMemo*******", poseidonbigint.PackToFieldsLegacy(poseidonbigint.HashInputLegacy{Bits: memo})...)
+		full := prefixHash(t, "MinaAcctUpdateCons**", memoHash, hashBody(1), commitment)
+
+		got, err := cmd.Commitments(network.network)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Commitment.Cmp(commitment) != 0 || got.FullCommitment.Cmp(full) != 0 {
+			t.Errorf("%s: Commitments() = %v, %v, want %v, %v", network.network.Name, got.Commitment, got.FullCommitment, commitment, full)
+		}
+		if got.For(&cmd.AccountUpdates[0]) != got.FullCommitment || got.For(&cmd.AccountUpdates[0].Calls[0]) != got.Commitment {
+			t.Errorf("%s: For() does not follow useFullCommitment", network.network.Name)
+		}
+
+		// The fee payer signs the full commitment as a single field.
+		if _, err := cmd.Sign(payer, network.network); err != nil {
+			t.Fatal(err)
+		}
+		pk := payer.ToPublicKey()
+		if !pk.VerifyForNetwork(cmd.FeePayer.Signature, poseidonbigint.HashInput{Fields: []*big.Int{full}}, network.network) {
+			t.Errorf("%s: the fee payer signature is not over the full commitment", network.network.Name)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cmd, payer, _ := testCommand(t)
+	cmd.AccountUpdates[0].Calls[0].ConstantNonce = false
+	if err := cmd.Validate(); errcode.CodeOf(err) != errcode.InvalidArgument {
+		t.Errorf("Validate() of a replayable update: %v, want InvalidArgument", err)
+	}
+	if _, err := cmd.Sign(payer, keys.NetworkTestnet); err == nil {
+		t.Error("Sign() accepted a replayable update")
+	}
+
+	cmd, _, _ = testCommand(t)
+	cmd.FeePayer.PublicKey = keys.PublicKey{}
+	if err := cmd.Validate(); errcode.CodeOf(err) != errcode.MissingValue {
+		t.Errorf("Validate() without a fee payer: %v, want MissingValue", err)
+	}
+
+	cmd, payer, _ = testCommand(t)
+	if _, err := cmd.Commitments(keys.NetworkMainnet.WithContext([]byte("app"))); errcode.CodeOf(err) != errcode.Unsupported {
+		t.Errorf("Commitments() on a network with a context: %v, want Unsupported", err)
+	}
+	cmd.Memo = string(make([]byte, 33))
+	if _, err := cmd.Sign(payer, keys.NetworkTestnet); err == nil {
+		t.Error("Sign() accepted a memo that is too long")
+	}
+
+	cmd, _, _ = testCommand(t)
+	stranger := keys.NewPrivateKeyFromBytes([32]byte{2})
+	if _, err := cmd.Sign(stranger, keys.NetworkTestnet); errcode.CodeOf(err) != errcode.KeyMismatch {
+		t.Errorf("Sign() with an unrelated key: %v, want KeyMismatch", err)
+	}
+}
+
+func TestVerifyZkappFeePayer(t *testing.T) {
+	cmd, payer, _ := testCommand(t)
+	zeko := keys.NetworkFromID("zeko")
+	custom, err := cmd.Commitments(zeko)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testnet, err := cmd.Commitments(keys.NetworkTestnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if custom.Commitment.Cmp(testnet.Commitment) == 0 {
+		t.Error("a custom network hashes account updates with the testnet prefix")
+	}
+
+	// The fee payer's signature is checked without those of the updates,
+	// which are not signed yet.
+	if _, err := cmd.Sign(payer, zeko); err != nil {
+		t.Fatal(err)
+	}
+	if err := zkapp.VerifyZkappFeePayer(cmd, "zeko"); err != nil {
+		t.Errorf("VerifyZkappFeePayer(zeko) = %v", err)
+	}
+	if err := cmd.Verify(zeko); errcode.CodeOf(err) != errcode.InvalidSignature {
+		t.Errorf("Verify() with unsigned updates: %v, want InvalidSignature", err)
+	}
+	for _, id := range []string{"testnet", "mainnet", "zeko2"} {
+		if err := zkapp.VerifyZkappFeePayer(cmd, id); errcode.CodeOf(err) != errcode.InvalidSignature {
+			t.Errorf("VerifyZkappFeePayer(%s): %v, want InvalidSignature", id, err)
+		}
+	}
+
+	cmd.FeePayer.Body = body(6)
+	if err := zkapp.VerifyZkappFeePayer(cmd, "zeko"); errcode.CodeOf(err) != errcode.InvalidSignature {
+		t.Errorf("VerifyZkappFeePayer() with a changed fee payer: %v, want InvalidSignature", err)
+	}
+	cmd.FeePayer.PublicKey = keys.PublicKey{}
+	if err := zkapp.VerifyZkappFeePayer(cmd, "zeko"); errcode.CodeOf(err) != errcode.MissingValue {
+		t.Errorf("VerifyZkappFeePayer() without a fee payer: %v, want MissingValue", err)
+	}
+}